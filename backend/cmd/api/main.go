@@ -7,12 +7,19 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"expense-management-system/internal/application/dto"
 	"expense-management-system/internal/application/usecase"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/event"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/internal/infrastructure/messaging"
+	"expense-management-system/internal/infrastructure/notification"
 	"expense-management-system/internal/infrastructure/persistence"
+	"expense-management-system/internal/infrastructure/scheduler"
 	"expense-management-system/internal/infrastructure/web"
 	"expense-management-system/internal/infrastructure/web/handler"
 )
@@ -22,19 +29,139 @@ func main() {
 	userRepo := persistence.NewMemoryUserRepository()
 	categoryRepo := persistence.NewMemoryCategoryRepository()
 	expenseRepo := persistence.NewMemoryExpenseRepository()
+	expenseReportRepo := persistence.NewMemoryExpenseReportRepository()
+	tripRequestRepo := persistence.NewMemoryTripRequestRepository()
+	perDiemRateRepo := persistence.NewMemoryPerDiemRateRepository()
+	advanceRepo := persistence.NewMemoryAdvanceRepository()
+	cardTransactionRepo := persistence.NewMemoryCardTransactionRepository()
+	transitRideRepo := persistence.NewMemoryTransitRideRepository()
+	journalEntryRepo := persistence.NewMemoryJournalEntryRepository()
+	accountingPeriodRepo := persistence.NewMemoryAccountingPeriodRepository()
+	budgetRepo := persistence.NewMemoryBudgetRepository()
+	departmentRepo := persistence.NewMemoryDepartmentRepository()
+	costCenterRepo := persistence.NewMemoryCostCenterRepository()
+	projectRepo := persistence.NewMemoryProjectRepository()
+	recurringExpenseRepo := persistence.NewMemoryRecurringExpenseRepository()
+
+	// イベント配信の初期化
+	publisher := messaging.NewInMemoryPublisher()
+	notifier := notification.NewLogNotifier(nil)
+	publisher.Subscribe(event.ExpenseEscalatedEvent, notifier.Handle)
+
+	// 会計年度の暦の初期化（デフォルトは4月開始）
+	fiscalCalendar, err := valueobject.NewFiscalCalendar(getEnvInt("FISCAL_YEAR_START_MONTH", 4))
+	if err != nil {
+		log.Fatalf("Invalid fiscal calendar: %v", err)
+	}
+
+	// 現在日時の取得元（システムの時計）
+	systemClock := clock.System()
 
 	// ユースケースの初期化
-	userUseCase := usecase.NewUserUseCase(userRepo)
-	categoryUseCase := usecase.NewCategoryUseCase(categoryRepo, expenseRepo)
-	expenseUseCase := usecase.NewExpenseUseCase(expenseRepo, userRepo, categoryRepo)
+	userUseCase := usecase.NewUserUseCase(userRepo, departmentRepo, costCenterRepo, systemClock)
+	categoryUseCase := usecase.NewCategoryUseCase(categoryRepo, expenseRepo, systemClock)
+	expenseUseCase := usecase.NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithExpenseReportRepository(expenseReportRepo), usecase.WithTripRequestRepository(tripRequestRepo), usecase.WithEventPublisher(publisher), usecase.WithFiscalPeriods(fiscalCalendar, accountingPeriodRepo), usecase.WithBudgetRepository(budgetRepo), usecase.WithCostCenterRepository(costCenterRepo), usecase.WithProjectRepository(projectRepo))
+	expenseReportUseCase := usecase.NewExpenseReportUseCase(expenseReportRepo, expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithReportEventPublisher(publisher), usecase.WithReportFiscalPeriods(fiscalCalendar, accountingPeriodRepo), usecase.WithReportCostCenterRepository(costCenterRepo), usecase.WithReportBudgetRepository(budgetRepo))
+	tripRequestUseCase := usecase.NewTripRequestUseCase(tripRequestRepo, expenseRepo, userRepo, systemClock)
+	perDiemUseCase := usecase.NewPerDiemUseCase(perDiemRateRepo, expenseRepo, userRepo, categoryRepo, tripRequestRepo, systemClock, usecase.WithPerDiemFiscalPeriods(fiscalCalendar, accountingPeriodRepo))
+	advanceUseCase := usecase.NewAdvanceUseCase(advanceRepo, expenseRepo, expenseReportRepo, userRepo, systemClock)
+	cardTransactionUseCase := usecase.NewCardTransactionUseCase(cardTransactionRepo, expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithCardFiscalPeriods(fiscalCalendar, accountingPeriodRepo))
+	transitUseCase := usecase.NewTransitUseCase(transitRideRepo, expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithTransitFiscalPeriods(fiscalCalendar, accountingPeriodRepo))
+	expenseImportUseCase := usecase.NewExpenseImportUseCase(expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithImportFiscalPeriods(fiscalCalendar, accountingPeriodRepo))
+	ledgerUseCase := usecase.NewLedgerUseCase(journalEntryRepo, expenseRepo, categoryRepo, systemClock)
+	fiscalPeriodUseCase := usecase.NewFiscalPeriodUseCase(fiscalCalendar, accountingPeriodRepo, userRepo, systemClock)
+	budgetUseCase := usecase.NewBudgetUseCase(budgetRepo, expenseRepo, userRepo, categoryRepo, departmentRepo, systemClock)
+	departmentUseCase := usecase.NewDepartmentUseCase(departmentRepo, costCenterRepo, userRepo, budgetRepo, systemClock)
+	costCenterUseCase := usecase.NewCostCenterUseCase(costCenterRepo, departmentRepo, userRepo, expenseRepo, systemClock)
+	projectUseCase := usecase.NewProjectUseCase(projectRepo, expenseRepo, userRepo, categoryRepo, systemClock)
+	recurringExpenseUseCase := usecase.NewRecurringExpenseUseCase(recurringExpenseRepo, expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithRecurringFiscalPeriods(fiscalCalendar, accountingPeriodRepo))
+	escalationUseCase := usecase.NewEscalationUseCase(expenseRepo, userRepo, publisher, getEnvDuration("APPROVAL_SLA", 72*time.Hour), systemClock)
+
+	// スケジューラの初期化
+	jobScheduler := scheduler.NewScheduler(nil)
+	if err := jobScheduler.Register(scheduler.Job{
+		Name:     "approval-escalation",
+		Interval: getEnvDuration("ESCALATION_INTERVAL", time.Hour),
+		Run: func(ctx context.Context) error {
+			result, err := escalationUseCase.EscalateStaleExpenses(ctx)
+			if err != nil {
+				return err
+			}
+			for _, failure := range result.Failures {
+				log.Printf("⚠️ Failed to escalate expense %s: %s", failure.ExpenseID, failure.Message)
+			}
+			if result.EscalatedCount > 0 {
+				log.Printf("⏫ Escalated %d stale expenses (%d skipped)", result.EscalatedCount, result.SkippedCount)
+			}
+			return nil
+		},
+	}); err != nil {
+		log.Fatalf("Failed to register job: %v", err)
+	}
+	if err := jobScheduler.Register(scheduler.Job{
+		Name:     "recurring-expenses",
+		Interval: getEnvDuration("RECURRING_EXPENSE_INTERVAL", time.Hour),
+		Run: func(ctx context.Context) error {
+			result, err := recurringExpenseUseCase.GenerateDueExpenses(ctx)
+			if err != nil {
+				return err
+			}
+			for _, failure := range result.Failures {
+				log.Printf("⚠️ Failed to generate expenses from recurring expense %s: %s", failure.RecurringExpenseID, failure.Message)
+			}
+			if result.CreatedCount > 0 {
+				log.Printf("🔁 Created %d draft expenses from recurring expenses (%d skipped)", result.CreatedCount, result.SkippedCount)
+			}
+			return nil
+		},
+	}); err != nil {
+		log.Fatalf("Failed to register job: %v", err)
+	}
+
+	// 経費の承認・支払いから仕訳を作成（通知時に作成できなかった仕訳は定期的に再試行する）
+	publisher.Subscribe(event.ExpenseApprovedEvent, ledgerUseCase.HandleEvent)
+	publisher.Subscribe(event.ExpensePaidEvent, ledgerUseCase.HandleEvent)
+	if err := jobScheduler.Register(scheduler.Job{
+		Name:     "ledger-posting",
+		Interval: getEnvDuration("LEDGER_POSTING_INTERVAL", 10*time.Minute),
+		Run: func(ctx context.Context) error {
+			result, err := ledgerUseCase.PostMissingEntries(ctx)
+			if err != nil {
+				return err
+			}
+			for _, failure := range result.Failures {
+				log.Printf("⚠️ Failed to post journal entry for expense %s: %s", failure.ExpenseID, failure.Message)
+			}
+			if result.PostedCount > 0 {
+				log.Printf("📒 Posted %d missing journal entries", result.PostedCount)
+			}
+			return nil
+		},
+	}); err != nil {
+		log.Fatalf("Failed to register job: %v", err)
+	}
 
 	// ハンドラーの初期化
 	userHandler := handler.NewUserHandler(userUseCase)
 	categoryHandler := handler.NewCategoryHandler(categoryUseCase)
 	expenseHandler := handler.NewExpenseHandler(expenseUseCase)
+	expenseReportHandler := handler.NewExpenseReportHandler(expenseReportUseCase)
+	tripRequestHandler := handler.NewTripRequestHandler(tripRequestUseCase)
+	perDiemHandler := handler.NewPerDiemHandler(perDiemUseCase)
+	advanceHandler := handler.NewAdvanceHandler(advanceUseCase)
+	cardTransactionHandler := handler.NewCardTransactionHandler(cardTransactionUseCase)
+	transitHandler := handler.NewTransitHandler(transitUseCase)
+	expenseImportHandler := handler.NewExpenseImportHandler(expenseImportUseCase)
+	ledgerHandler := handler.NewLedgerHandler(ledgerUseCase)
+	fiscalPeriodHandler := handler.NewFiscalPeriodHandler(fiscalPeriodUseCase)
+	budgetHandler := handler.NewBudgetHandler(budgetUseCase)
+	departmentHandler := handler.NewDepartmentHandler(departmentUseCase)
+	costCenterHandler := handler.NewCostCenterHandler(costCenterUseCase)
+	projectHandler := handler.NewProjectHandler(projectUseCase)
+	recurringExpenseHandler := handler.NewRecurringExpenseHandler(recurringExpenseUseCase)
 
 	// ルーターの設定
-	router := web.SetupRouter(userHandler, categoryHandler, expenseHandler)
+	router := web.SetupRouter(userHandler, categoryHandler, expenseHandler, expenseReportHandler, tripRequestHandler, perDiemHandler, advanceHandler, cardTransactionHandler, transitHandler, expenseImportHandler, ledgerHandler, fiscalPeriodHandler, budgetHandler, departmentHandler, costCenterHandler, projectHandler, recurringExpenseHandler)
 
 	// サーバーの設定
 	port := os.Getenv("PORT")
@@ -52,6 +179,14 @@ func main() {
 		log.Printf("Failed to create sample data: %v", err)
 	}
 
+	// 日当単価表の初期値を登録
+	if err := createDefaultPerDiemRates(perDiemUseCase); err != nil {
+		log.Printf("Failed to create per-diem rates: %v", err)
+	}
+
+	// ジョブの開始
+	jobScheduler.Start()
+
 	// サーバー開始
 	go func() {
 		fmt.Printf("🚀 Server is running on port %s\n", port)
@@ -75,9 +210,68 @@ func main() {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
 
+	if err := jobScheduler.Stop(ctx); err != nil {
+		log.Printf("Scheduler forced to stop: %v", err)
+	}
+
 	fmt.Println("✅ Server exited")
 }
 
+// getEnvDuration 環境変数から期間を取得（未設定・不正な場合はデフォルト値）
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Printf("Invalid %s=%q, using default %s", key, value, defaultValue)
+		return defaultValue
+	}
+
+	return d
+}
+
+// getEnvInt 環境変数から整数を取得（未設定・不正な値の場合はデフォルト値）
+func getEnvInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Invalid %s=%q, using default %d", key, value, defaultValue)
+		return defaultValue
+	}
+
+	return n
+}
+
+// createDefaultPerDiemRates 出張旅費規程の日当単価表（地域区分×職能等級）の初期値を登録
+func createDefaultPerDiemRates(perDiemUseCase *usecase.PerDiemUseCase) error {
+	ctx := context.Background()
+
+	rates := []dto.PerDiemRateRequest{
+		{DestinationClass: "domestic", Grade: "staff", DailyAmount: 2000},
+		{DestinationClass: "domestic", Grade: "manager", DailyAmount: 3000},
+		{DestinationClass: "domestic", Grade: "executive", DailyAmount: 4000},
+		{DestinationClass: "overseas", Grade: "staff", DailyAmount: 5000},
+		{DestinationClass: "overseas", Grade: "manager", DailyAmount: 6000},
+		{DestinationClass: "overseas", Grade: "executive", DailyAmount: 8000},
+	}
+
+	for _, rate := range rates {
+		rate.Currency = "JPY"
+		if _, err := perDiemUseCase.SavePerDiemRate(ctx, &rate); err != nil {
+			return fmt.Errorf("failed to create per-diem rate %s/%s: %w", rate.DestinationClass, rate.Grade, err)
+		}
+	}
+
+	return nil
+}
+
 // createSampleData サンプルデータを作成
 func createSampleData(userUseCase *usecase.UserUseCase, categoryUseCase *usecase.CategoryUseCase, expenseUseCase *usecase.ExpenseUseCase) error {
 	ctx := context.Background()
@@ -86,6 +280,8 @@ func createSampleData(userUseCase *usecase.UserUseCase, categoryUseCase *usecase
 	user1, err := userUseCase.CreateUser(ctx, &dto.CreateUserRequest{
 		Name:  "田中太郎",
 		Email: "tanaka@example.com",
+		Grade: "manager",
+		Role:  "admin",
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create user1: %w", err)
@@ -94,6 +290,7 @@ func createSampleData(userUseCase *usecase.UserUseCase, categoryUseCase *usecase
 	user2, err := userUseCase.CreateUser(ctx, &dto.CreateUserRequest{
 		Name:  "佐藤花子",
 		Email: "sato@example.com",
+		Grade: "staff",
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create user2: %w", err)
@@ -134,7 +331,7 @@ func createSampleData(userUseCase *usecase.UserUseCase, categoryUseCase *usecase
 		Currency:    "JPY",
 		Title:       "渋谷駅からオフィスまでの電車代",
 		Description: "営業会議出席のための交通費",
-		Date:        time.Now().AddDate(0, 0, -1),
+		Date:        time.Now().AddDate(0, 0, -1).Format("2006-01-02"),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create expense1: %w", err)
@@ -146,7 +343,7 @@ func createSampleData(userUseCase *usecase.UserUseCase, categoryUseCase *usecase
 		Currency:    "JPY",
 		Title:       "クライアントとの会食",
 		Description: "新規プロジェクトの打ち合わせランチ",
-		Date:        time.Now().AddDate(0, 0, -2),
+		Date:        time.Now().AddDate(0, 0, -2).Format("2006-01-02"),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create expense2: %w", err)
@@ -158,7 +355,7 @@ func createSampleData(userUseCase *usecase.UserUseCase, categoryUseCase *usecase
 		Currency:    "JPY",
 		Title:       "プリンタ用紙購入",
 		Description: "オフィス用のA4コピー用紙",
-		Date:        time.Now().AddDate(0, 0, -3),
+		Date:        time.Now().AddDate(0, 0, -3).Format("2006-01-02"),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create expense3: %w", err)
@@ -0,0 +1,54 @@
+package dto
+
+import "time"
+
+// CreateAdvanceRequest 仮払金作成リクエスト
+type CreateAdvanceRequest struct {
+	Amount   float64 `json:"amount" binding:"required,gt=0"`
+	Currency string  `json:"currency"`
+	Purpose  string  `json:"purpose" binding:"required"`
+}
+
+// UpdateAdvanceRequest 仮払金更新リクエスト
+type UpdateAdvanceRequest struct {
+	Amount   float64 `json:"amount" binding:"required,gt=0"`
+	Currency string  `json:"currency"`
+	Purpose  string  `json:"purpose" binding:"required"`
+}
+
+// SettleAdvanceRequest 仮払金精算リクエスト（経費と経費レポートの少なくとも一方を指定）
+type SettleAdvanceRequest struct {
+	ExpenseIDs       []string `json:"expense_ids" binding:"required_without=ExpenseReportIDs"`
+	ExpenseReportIDs []string `json:"expense_report_ids" binding:"required_without=ExpenseIDs"`
+}
+
+// AdvanceResponse 仮払金レスポンス
+type AdvanceResponse struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Amount    float64   `json:"amount"`
+	Currency  string    `json:"currency"`
+	Purpose   string    `json:"purpose"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Settlement *AdvanceSettlementResponse `json:"settlement,omitempty"`
+}
+
+// AdvanceSettlementResponse 仮払金の精算結果レスポンス
+type AdvanceSettlementResponse struct {
+	ExpenseIDs        []string  `json:"expense_ids"`
+	ExpenseReportIDs  []string  `json:"expense_report_ids"`
+	ActualAmount      float64   `json:"actual_amount"`      // 精算対象の経費の合計
+	RefundAmount      float64   `json:"refund_amount"`      // 従業員から返金される額
+	AdditionalPayment float64   `json:"additional_payment"` // 従業員へ追加で支払う額
+	SettledAt         time.Time `json:"settled_at"`
+}
+
+// OpenAdvancesResponse 未精算の仮払金一覧レスポンス
+type OpenAdvancesResponse struct {
+	UserID   string             `json:"user_id"`
+	Advances []*AdvanceResponse `json:"advances"`
+	Count    int                `json:"count"`
+}
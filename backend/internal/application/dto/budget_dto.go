@@ -0,0 +1,65 @@
+package dto
+
+import "time"
+
+// CreateBudgetRequest 予算作成リクエスト（カテゴリ・ユーザー・部署のいずれかを指定）
+type CreateBudgetRequest struct {
+	Name         string  `json:"name" binding:"required,max=100"`
+	CategoryID   string  `json:"category_id"`
+	UserID       string  `json:"user_id"`
+	DepartmentID string  `json:"department_id"`
+	PeriodFrom   string  `json:"period_from" binding:"required"` // 期間の開始日（YYYY-MM-DD）
+	PeriodTo     string  `json:"period_to" binding:"required"`   // 期間の終了日（YYYY-MM-DD、この日を含む）
+	Amount       float64 `json:"amount" binding:"required,gt=0"`
+	Currency     string  `json:"currency"` // 基準通貨（省略時はJPY）
+}
+
+// UpdateBudgetRequest 予算更新リクエスト
+type UpdateBudgetRequest struct {
+	Name         string  `json:"name" binding:"required,max=100"`
+	CategoryID   string  `json:"category_id"`
+	UserID       string  `json:"user_id"`
+	DepartmentID string  `json:"department_id"`
+	PeriodFrom   string  `json:"period_from" binding:"required"`
+	PeriodTo     string  `json:"period_to" binding:"required"`
+	Amount       float64 `json:"amount" binding:"required,gt=0"`
+	Currency     string  `json:"currency"`
+}
+
+// BudgetStatusRequest 予算の消化状況の一覧取得リクエスト
+type BudgetStatusRequest struct {
+	Date string `form:"date"` // 期間にこの日を含む予算（YYYY-MM-DD、省略時は今日）
+}
+
+// BudgetResponse 予算レスポンス
+type BudgetResponse struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	CategoryID   string    `json:"category_id,omitempty"`
+	UserID       string    `json:"user_id,omitempty"`
+	DepartmentID string    `json:"department_id,omitempty"`
+	PeriodFrom   string    `json:"period_from"`
+	PeriodTo     string    `json:"period_to"`
+	Amount       float64   `json:"amount"`
+	Currency     string    `json:"currency"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// BudgetStatusResponse 予算の消化状況レスポンス
+type BudgetStatusResponse struct {
+	Budget          *BudgetResponse `json:"budget"`
+	Committed       float64         `json:"committed"`        // 申請中の経費の合計
+	Actual          float64         `json:"actual"`           // 承認済みの経費の合計
+	Remaining       float64         `json:"remaining"`        // 残額（超過している場合は負の値）
+	UtilizationRate float64         `json:"utilization_rate"` // 消化率（%）
+	Exceeded        bool            `json:"exceeded"`
+
+	Excluded []*BudgetExcludedAmountResponse `json:"excluded,omitempty"` // 予算と異なる通貨のため集計しなかった経費の通貨ごとの合計
+}
+
+// BudgetExcludedAmountResponse 予算の消化状況に集計しなかった経費の合計レスポンス
+type BudgetExcludedAmountResponse struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
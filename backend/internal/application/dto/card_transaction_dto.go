@@ -0,0 +1,86 @@
+package dto
+
+import "time"
+
+// ImportCardStatementRequest カード利用明細の取込リクエスト
+type ImportCardStatementRequest struct {
+	Format   string                       `json:"format" binding:"required,oneof=csv ofx"`
+	Content  string                       `json:"content" binding:"required"`
+	Currency string                       `json:"currency"`                                 // 省略時はOFXのCURDEF、それもなければJPY
+	Mapping  *CardStatementMappingRequest `json:"mapping" binding:"required_if=Format csv"` // CSVの列の対応
+}
+
+// CardStatementMappingRequest CSVの列の対応
+// 各列はヘッダー名、または1始まりの列番号で指定する
+type CardStatementMappingRequest struct {
+	Date          string `json:"date" binding:"required"`
+	Vendor        string `json:"vendor" binding:"required"`
+	Amount        string `json:"amount" binding:"required"`
+	Description   string `json:"description"`
+	TransactionID string `json:"transaction_id"` // 省略時は行の内容から識別子を生成
+	DateFormat    string `json:"date_format"`    // 例: YYYY/MM/DD（省略時はYYYY-MM-DDとYYYY/MM/DD）
+	NoHeader      bool   `json:"no_header"`      // 1行目がヘッダーでない場合はtrue
+	NegateAmount  bool   `json:"negate_amount"`  // 利用額が負の値で記載されている場合はtrue
+}
+
+// MatchCardTransactionRequest カード利用明細の手動照合リクエスト
+type MatchCardTransactionRequest struct {
+	ExpenseID string `json:"expense_id" binding:"required"`
+}
+
+// ReconcileCardTransactionsRequest カード利用明細の照合リクエスト
+type ReconcileCardTransactionsRequest struct {
+	CategoryID string `json:"category_id"` // 指定した場合は照合できなかった明細から下書きの経費を作成
+}
+
+// CardTransactionResponse カード利用明細レスポンス
+type CardTransactionResponse struct {
+	ID              string    `json:"id"`
+	UserID          string    `json:"user_id"`
+	ExternalID      string    `json:"external_id"`
+	TransactionDate time.Time `json:"transaction_date"`
+	Vendor          string    `json:"vendor"`
+	Amount          float64   `json:"amount"`
+	Currency        string    `json:"currency"`
+	Description     string    `json:"description"`
+	Status          string    `json:"status"`
+	ExpenseID       string    `json:"expense_id,omitempty"`
+	ImportedAt      time.Time `json:"imported_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// CardStatementLineResponse 取り込まなかった明細行
+type CardStatementLineResponse struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
+
+// ImportCardStatementResponse カード利用明細の取込結果
+type ImportCardStatementResponse struct {
+	ImportedCount int                          `json:"imported_count"`
+	SkippedCount  int                          `json:"skipped_count"`
+	Transactions  []*CardTransactionResponse   `json:"transactions"`
+	Skipped       []*CardStatementLineResponse `json:"skipped"`
+}
+
+// CardReconciliationItemResponse 照合結果の明細
+type CardReconciliationItemResponse struct {
+	Transaction *CardTransactionResponse `json:"transaction"`
+	ExpenseID   string                   `json:"expense_id,omitempty"`
+	Score       float64                  `json:"score,omitempty"` // 自動照合のスコア
+	Error       string                   `json:"error,omitempty"` // 下書きの経費を作成できなかった理由
+}
+
+// ReconcileCardTransactionsResponse カード利用明細の照合結果
+type ReconcileCardTransactionsResponse struct {
+	Matched   []*CardReconciliationItemResponse `json:"matched"`   // 既存の経費と照合した明細
+	Created   []*CardReconciliationItemResponse `json:"created"`   // 下書きの経費を作成した明細
+	Unmatched []*CardReconciliationItemResponse `json:"unmatched"` // 照合できなかった明細
+}
+
+// UnreconciledCardTransactionsResponse 未照合のカード利用明細一覧
+type UnreconciledCardTransactionsResponse struct {
+	UserID       string                     `json:"user_id"`
+	Transactions []*CardTransactionResponse `json:"transactions"`
+	Count        int                        `json:"count"`
+}
@@ -4,16 +4,55 @@ import "time"
 
 // CreateCategoryRequest カテゴリ作成リクエスト
 type CreateCategoryRequest struct {
-	Name        string `json:"name" binding:"required"`
-	Description string `json:"description"`
-	Color       string `json:"color"`
+	Name        string                 `json:"name" binding:"required"`
+	Description string                 `json:"description"`
+	Color       string                 `json:"color"`
+	Accounting  *AccountMappingRequest `json:"accounting"`                            // 会計ソフトへの仕訳の対応
+	DatePolicy  *DatePolicyRequest     `json:"date_policy"`                           // 経費日付として認める期間（省略時は既定の期間）
+	Rules       []SpendingRuleRequest  `json:"rules" binding:"omitempty,max=20,dive"` // 支出規程のルール
+
+	Entertainment bool `json:"entertainment"` // 飲食・接待のカテゴリ（参加者から会議費・交際費を区分する）
 }
 
 // UpdateCategoryRequest カテゴリ更新リクエスト
 type UpdateCategoryRequest struct {
-	Name        string `json:"name" binding:"required"`
-	Description string `json:"description"`
-	Color       string `json:"color"`
+	Name        string                 `json:"name" binding:"required"`
+	Description string                 `json:"description"`
+	Color       string                 `json:"color"`
+	Accounting  *AccountMappingRequest `json:"accounting"`                            // 省略した場合は仕訳の対応を解除
+	DatePolicy  *DatePolicyRequest     `json:"date_policy"`                           // 省略した場合は既定の期間に戻す
+	Rules       []SpendingRuleRequest  `json:"rules" binding:"omitempty,max=20,dive"` // 省略した場合は支出規程を解除
+
+	Entertainment bool `json:"entertainment"` // 飲食・接待のカテゴリ（参加者から会議費・交際費を区分する）
+}
+
+// AccountMappingRequest 会計ソフトへの仕訳の対応
+type AccountMappingRequest struct {
+	DebitAccount string `json:"debit_account" binding:"required"`                                             // 借方勘定科目（例: 旅費交通費）
+	TaxCode      string `json:"tax_code" binding:"omitempty,oneof=standard reduced non_taxable out_of_scope"` // 省略時はstandard（課税仕入10%）
+	Department   string `json:"department"`                                                                   // 借方部門
+}
+
+// DatePolicyRequest 経費日付として認める期間
+type DatePolicyRequest struct {
+	MaxAgeDays  int    `json:"max_age_days" binding:"required,min=1"`               // 経費日付の古さの上限（日数）
+	FutureDays  int    `json:"future_days" binding:"min=0"`                         // 未来の日付の許容日数（事前に予約する出張など）
+	Enforcement string `json:"enforcement" binding:"omitempty,oneof=error warning"` // 古すぎる場合の扱い（省略時はerror）
+}
+
+// SpendingRuleRequest 支出規程のルール（ルールの種類ごとに必要な項目を指定）
+type SpendingRuleRequest struct {
+	Type          string                `json:"type" binding:"required,oneof=max_amount max_per_head required_fields weekdays time_window currency min_distance any_of"`
+	Enforcement   string                `json:"enforcement" binding:"omitempty,oneof=block exception"` // 違反時の扱い（省略時はblock）
+	MaxAmount     float64               `json:"max_amount"`                                            // max_amount, max_per_head: 金額・1人当たりの金額の上限
+	Currency      string                `json:"currency"`                                              // max_amount, max_per_head: 上限額の通貨（省略時はJPY）
+	Fields        []string              `json:"fields"`                                                // required_fields: description, trip_request, time, attendees, distance
+	Weekdays      []string              `json:"weekdays"`                                              // weekdays: sun, mon, tue, wed, thu, fri, sat
+	From          string                `json:"from"`                                                  // time_window: 開始時刻（HH:MM）
+	To            string                `json:"to"`                                                    // time_window: 終了時刻（HH:MM、開始より前の場合は翌日）
+	Currencies    []string              `json:"currencies"`                                            // currency: 利用できる通貨
+	MinDistanceKm float64               `json:"min_distance_km"`                                       // min_distance: 移動距離の下限（km）
+	Rules         []SpendingRuleRequest `json:"rules" binding:"omitempty,max=10,dive"`                 // any_of: いずれかを満たせばよいルール（違反時の扱いは any_of のものを使う）
 }
 
 // CategoryResponse カテゴリレスポンス
@@ -24,4 +63,39 @@ type CategoryResponse struct {
 	Color       string    `json:"color"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+
+	Accounting *AccountMappingResponse `json:"accounting,omitempty"`
+	DatePolicy *DatePolicyResponse     `json:"date_policy,omitempty"`
+	Rules      []SpendingRuleResponse  `json:"rules,omitempty"`
+
+	Entertainment bool `json:"entertainment"`
+}
+
+// AccountMappingResponse 会計ソフトへの仕訳の対応レスポンス
+type AccountMappingResponse struct {
+	DebitAccount string `json:"debit_account"`
+	TaxCode      string `json:"tax_code"`
+	Department   string `json:"department,omitempty"`
+}
+
+// DatePolicyResponse 経費日付として認める期間レスポンス
+type DatePolicyResponse struct {
+	MaxAgeDays  int    `json:"max_age_days"`
+	FutureDays  int    `json:"future_days"`
+	Enforcement string `json:"enforcement"`
+}
+
+// SpendingRuleResponse 支出規程のルールレスポンス
+type SpendingRuleResponse struct {
+	Type          string                 `json:"type"`
+	Enforcement   string                 `json:"enforcement"`
+	MaxAmount     float64                `json:"max_amount,omitempty"`
+	Currency      string                 `json:"currency,omitempty"`
+	Fields        []string               `json:"fields,omitempty"`
+	Weekdays      []string               `json:"weekdays,omitempty"`
+	From          string                 `json:"from,omitempty"`
+	To            string                 `json:"to,omitempty"`
+	Currencies    []string               `json:"currencies,omitempty"`
+	MinDistanceKm float64                `json:"min_distance_km,omitempty"`
+	Rules         []SpendingRuleResponse `json:"rules,omitempty"`
 }
@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+// CreateCostCenterRequest コストセンター作成リクエスト
+type CreateCostCenterRequest struct {
+	Code         string `json:"code" binding:"required,max=20"` // 会計ソフトの部門として出力するコード
+	Name         string `json:"name" binding:"required,max=100"`
+	DepartmentID string `json:"department_id" binding:"required"` // 所属する部署
+}
+
+// UpdateCostCenterRequest コストセンター更新リクエスト
+type UpdateCostCenterRequest struct {
+	Code         string `json:"code" binding:"required,max=20"`
+	Name         string `json:"name" binding:"required,max=100"`
+	DepartmentID string `json:"department_id" binding:"required"`
+}
+
+// CostCenterResponse コストセンターレスポンス
+type CostCenterResponse struct {
+	ID           string    `json:"id"`
+	Code         string    `json:"code"`
+	Name         string    `json:"name"`
+	DepartmentID string    `json:"department_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// CostCenterListRequest コストセンター一覧の検索条件
+type CostCenterListRequest struct {
+	DepartmentID string `form:"department_id"` // 所属する部署で絞り込む
+}
@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+// CreateDepartmentRequest 部署作成リクエスト
+type CreateDepartmentRequest struct {
+	Code string `json:"code" binding:"required,max=20"` // 部署コード（英数字・ハイフン・アンダースコア）
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+// UpdateDepartmentRequest 部署更新リクエスト
+type UpdateDepartmentRequest struct {
+	Code string `json:"code" binding:"required,max=20"`
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+// DepartmentResponse 部署レスポンス
+type DepartmentResponse struct {
+	ID        string    `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
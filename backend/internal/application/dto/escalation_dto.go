@@ -0,0 +1,14 @@
+package dto
+
+// EscalationResult 滞留経費のエスカレーション結果
+type EscalationResult struct {
+	EscalatedCount int                  `json:"escalated_count"` // 上位の承認者に回付した件数
+	SkippedCount   int                  `json:"skipped_count"`   // 回付先がなく据え置いた件数
+	Failures       []*EscalationFailure `json:"failures"`        // 回付の保存または通知に失敗した経費
+}
+
+// EscalationFailure 経費ごとのエスカレーションの失敗
+type EscalationFailure struct {
+	ExpenseID string `json:"expense_id"`
+	Message   string `json:"message"`
+}
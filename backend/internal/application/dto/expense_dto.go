@@ -4,22 +4,60 @@ import "time"
 
 // CreateExpenseRequest 経費作成リクエスト
 type CreateExpenseRequest struct {
-	CategoryID  string    `json:"category_id" binding:"required"`
-	Amount      float64   `json:"amount" binding:"required,min=0"`
-	Currency    string    `json:"currency"`
-	Title       string    `json:"title" binding:"required"`
-	Description string    `json:"description"`
-	Date        time.Time `json:"date" binding:"required"`
+	CategoryID  string  `json:"category_id" binding:"required"`
+	Amount      float64 `json:"amount" binding:"required_without=Mileage,min=0"`
+	Currency    string  `json:"currency"`
+	Title       string  `json:"title" binding:"required"`
+	Description string  `json:"description"`
+	Date        string  `json:"date" binding:"required"` // 経費日付（YYYY-MM-DD）
+
+	TripRequestID string          `json:"trip_request_id"`
+	Mileage       *MileageRequest `json:"mileage"` // 指定した場合は走行距離精算として金額を自動計算
+
+	Time                string  `json:"time"`                                   // 利用時刻（HH:MM、任意）
+	DistanceKm          float64 `json:"distance_km" binding:"min=0"`            // 移動距離（km、任意）
+	PolicyJustification string  `json:"policy_justification" binding:"max=500"` // 支出規程の対象外となる経費を申請する理由
+
+	Attendees []AttendeeRequest `json:"attendees" binding:"omitempty,max=200,dive"` // 飲食を伴う経費の参加者（更新で省略した場合は解除）
+
+	Allocations []CostAllocationRequest `json:"allocations" binding:"omitempty,max=20,dive"` // コストセンターへの按分（省略時は申請者の既定のコストセンター）
+
+	ProjectID string `json:"project_id"` // 顧客向けプロジェクト（更新で省略した場合は解除）
+	Billable  bool   `json:"billable"`   // 顧客に請求する経費かどうか（プロジェクトの指定が必要）
+
+	LineItems []LineItemRequest `json:"line_items" binding:"omitempty,max=50,dive"` // カテゴリの異なる明細（金額の合計は経費の金額、更新で省略した場合は解除）
+
+	Vendor      string `json:"vendor" binding:"max=100"`                            // 支払先（更新で省略した場合は解除）
+	ReceiptHash string `json:"receipt_hash" binding:"omitempty,len=64,hexadecimal"` // 領収書ファイルのSHA-256ハッシュ（16進数、更新で省略した場合は解除）
 }
 
 // UpdateExpenseRequest 経費更新リクエスト
 type UpdateExpenseRequest struct {
-	CategoryID  string    `json:"category_id" binding:"required"`
-	Amount      float64   `json:"amount" binding:"required,min=0"`
-	Currency    string    `json:"currency"`
-	Title       string    `json:"title" binding:"required"`
-	Description string    `json:"description"`
-	Date        time.Time `json:"date" binding:"required"`
+	CategoryID  string  `json:"category_id" binding:"required"`
+	Amount      float64 `json:"amount" binding:"required_without=Mileage,min=0"`
+	Currency    string  `json:"currency"`
+	Title       string  `json:"title" binding:"required"`
+	Description string  `json:"description"`
+	Date        string  `json:"date" binding:"required"` // 経費日付（YYYY-MM-DD）
+
+	TripRequestID string          `json:"trip_request_id"`
+	Mileage       *MileageRequest `json:"mileage"` // 指定した場合は走行距離精算として金額を自動計算
+
+	Time                string  `json:"time"`                                   // 利用時刻（HH:MM、任意）
+	DistanceKm          float64 `json:"distance_km" binding:"min=0"`            // 移動距離（km、任意）
+	PolicyJustification string  `json:"policy_justification" binding:"max=500"` // 支出規程の対象外となる経費を申請する理由
+
+	Attendees []AttendeeRequest `json:"attendees" binding:"omitempty,max=200,dive"` // 飲食を伴う経費の参加者（更新で省略した場合は解除）
+
+	Allocations []CostAllocationRequest `json:"allocations" binding:"omitempty,max=20,dive"` // コストセンターへの按分（省略時は申請者の既定のコストセンター）
+
+	ProjectID string `json:"project_id"` // 顧客向けプロジェクト（更新で省略した場合は解除）
+	Billable  bool   `json:"billable"`   // 顧客に請求する経費かどうか（プロジェクトの指定が必要）
+
+	LineItems []LineItemRequest `json:"line_items" binding:"omitempty,max=50,dive"` // カテゴリの異なる明細（金額の合計は経費の金額、更新で省略した場合は解除）
+
+	Vendor      string `json:"vendor" binding:"max=100"`                            // 支払先（更新で省略した場合は解除）
+	ReceiptHash string `json:"receipt_hash" binding:"omitempty,len=64,hexadecimal"` // 領収書ファイルのSHA-256ハッシュ（16進数、更新で省略した場合は解除）
 }
 
 // ExpenseResponse 経費レスポンス
@@ -32,22 +70,192 @@ type ExpenseResponse struct {
 	Currency    string            `json:"currency"`
 	Title       string            `json:"title"`
 	Description string            `json:"description"`
-	Date        time.Time         `json:"date"`
+	Date        string            `json:"date"` // 経費日付（YYYY-MM-DD）
 	Status      string            `json:"status"`
 	CreatedAt   time.Time         `json:"created_at"`
 	UpdatedAt   time.Time         `json:"updated_at"`
+
+	ApproverID      string     `json:"approver_id,omitempty"`
+	SubmittedAt     *time.Time `json:"submitted_at,omitempty"`
+	EscalationLevel int        `json:"escalation_level"`
+	Comment         string     `json:"comment,omitempty"`
+	TripRequestID   string     `json:"trip_request_id,omitempty"`
+
+	Kind    string           `json:"kind"`
+	Mileage *MileageResponse `json:"mileage,omitempty"`
+
+	PaidAt *time.Time `json:"paid_at,omitempty"`
+
+	Time                string                     `json:"time,omitempty"`                 // 利用時刻（HH:MM）
+	DistanceKm          float64                    `json:"distance_km,omitempty"`          // 移動距離（km、走行距離精算は走行距離）
+	PolicyJustification string                     `json:"policy_justification,omitempty"` // 規程外の経費として申請する理由
+	PolicyException     bool                       `json:"policy_exception"`               // 規程外の経費として申請されたかどうか
+	PolicyViolations    []*PolicyViolationResponse `json:"policy_violations,omitempty"`    // 下書きは現在の違反、申請後は規程外として認めた違反
+
+	Attendees          []AttendeeResponse `json:"attendees,omitempty"`
+	AttendeeCount      int                `json:"attendee_count,omitempty"`
+	PerHeadAmount      float64            `json:"per_head_amount,omitempty"`     // 参加者1人当たりの金額
+	EntertainmentClass string             `json:"entertainment_class,omitempty"` // meeting: 会議費 / entertainment: 交際費
+
+	Allocations []CostAllocationResponse `json:"allocations,omitempty"` // コストセンターへの按分（按分を指定した場合のみ）
+
+	ProjectID string `json:"project_id,omitempty"`
+	Billable  bool   `json:"billable"`
+
+	LineItems []LineItemResponse `json:"line_items,omitempty"` // 明細（明細に分けた場合のみ）
+
+	Vendor              string                      `json:"vendor,omitempty"`
+	ReceiptHash         string                      `json:"receipt_hash,omitempty"`
+	SuspectedDuplicates []*DuplicateExpenseResponse `json:"suspected_duplicates,omitempty"` // 重複の疑いがある経費（作成・更新時と承認待ち一覧のみ）
+
+	Warnings []string `json:"warnings,omitempty"` // 作成・更新・申請時の警告（遅延申請など）
+}
+
+// DuplicateExpenseResponse 重複の疑いがある経費レスポンス
+type DuplicateExpenseResponse struct {
+	ExpenseID string   `json:"expense_id"`
+	UserID    string   `json:"user_id"`
+	UserName  string   `json:"user_name,omitempty"` // 削除済みのユーザーは空
+	Title     string   `json:"title"`
+	Amount    float64  `json:"amount"`
+	Currency  string   `json:"currency"`
+	Date      string   `json:"date"` // 経費日付（YYYY-MM-DD）
+	Status    string   `json:"status"`
+	Reasons   []string `json:"reasons"` // same_receipt: 領収書ファイルが同じ / same_vendor: 金額・日付・支払先が同じ / similar_title: 金額・日付が同じで件名が類似
+	Message   string   `json:"message"`
+}
+
+// PolicyViolationResponse 支出規程の違反レスポンス
+type PolicyViolationResponse struct {
+	Rule        string `json:"rule"`
+	Enforcement string `json:"enforcement"` // block: 申請できない / exception: 理由を入力すれば申請できる
+	Message     string `json:"message"`
+}
+
+// AttendeeRequest 参加者リクエスト
+type AttendeeRequest struct {
+	Name    string `json:"name" binding:"required,max=100"`
+	Company string `json:"company" binding:"max=100"`                       // 社外の参加者は必須
+	Type    string `json:"type" binding:"required,oneof=internal external"` // internal: 社内 / external: 社外
+}
+
+// AttendeeResponse 参加者レスポンス
+type AttendeeResponse struct {
+	Name    string `json:"name"`
+	Company string `json:"company,omitempty"`
+	Type    string `json:"type"`
+}
+
+// MileageRequest 走行距離精算の明細リクエスト
+type MileageRequest struct {
+	Origin      string  `json:"origin" binding:"required"`
+	Destination string  `json:"destination" binding:"required"`
+	DistanceKm  float64 `json:"distance_km" binding:"required,gt=0"`
+	VehicleType string  `json:"vehicle_type" binding:"required,oneof=car motorcycle"`
+}
+
+// MileageResponse 走行距離精算の明細レスポンス
+type MileageResponse struct {
+	Origin      string  `json:"origin"`
+	Destination string  `json:"destination"`
+	DistanceKm  float64 `json:"distance_km"`
+	VehicleType string  `json:"vehicle_type"`
+	RatePerKm   float64 `json:"rate_per_km"`
 }
 
 // ExpenseListRequest 経費一覧取得リクエスト
 type ExpenseListRequest struct {
-	UserID     string    `json:"user_id"`
-	CategoryID string    `json:"category_id"`
-	Status     string    `json:"status"`
-	DateFrom   time.Time `json:"date_from"`
-	DateTo     time.Time `json:"date_to"`
+	UserID     string `json:"user_id" form:"user_id"`
+	CategoryID string `json:"category_id" form:"category_id"`
+	Status     string `json:"status" form:"status"`
+	DateFrom   string `json:"date_from" form:"date_from"` // YYYY-MM-DD
+	DateTo     string `json:"date_to" form:"date_to"`     // YYYY-MM-DD（この日を含む）
+}
+
+// ExportExpensesRequest 経費エクスポートリクエスト（検索条件は経費一覧と同じ）
+type ExportExpensesRequest struct {
+	ExpenseListRequest
+	Format   string `form:"format" binding:"omitempty,oneof=csv xlsx"`                    // 省略時はcsv
+	Encoding string `form:"encoding" binding:"omitempty,oneof=utf-8 utf-8-bom shift_jis"` // CSVの文字コード（省略時はutf-8）
+}
+
+// ExportJournalRequest 会計ソフト向け仕訳エクスポートリクエスト（期間内の承認済みの経費が対象）
+type ExportJournalRequest struct {
+	Software      string `form:"software" binding:"required,oneof=freee moneyforward yayoi"`
+	DateFrom      string `form:"date_from" binding:"required"` // YYYY-MM-DD
+	DateTo        string `form:"date_to" binding:"required"`   // YYYY-MM-DD（この日を含む）
+	CreditAccount string `form:"credit_account"`               // 貸方勘定科目（省略時は未払金）
+}
+
+// PayExpenseRequest 経費支払いリクエスト
+type PayExpenseRequest struct {
+	PaidAt *time.Time `json:"paid_at"` // 省略時は現在日時
 }
 
 // ExpenseStatusChangeRequest 経費ステータス変更リクエスト
 type ExpenseStatusChangeRequest struct {
 	Status string `json:"status" binding:"required,oneof=submitted approved rejected"`
 }
+
+// BulkExpenseActionRequest 経費一括ステータス変更リクエスト
+type BulkExpenseActionRequest struct {
+	ExpenseIDs []string `json:"expense_ids" binding:"required,min=1,max=100"`
+	Comment    string   `json:"comment" binding:"max=500"`
+}
+
+// BulkExpenseActionResponse 経費一括ステータス変更レスポンス
+type BulkExpenseActionResponse struct {
+	Action         string                     `json:"action"`
+	SucceededCount int                        `json:"succeeded_count"`
+	FailedCount    int                        `json:"failed_count"`
+	Results        []*BulkExpenseActionResult `json:"results"`
+}
+
+// BulkExpenseActionResult 経費ごとの処理結果
+type BulkExpenseActionResult struct {
+	ExpenseID string           `json:"expense_id"`
+	Success   bool             `json:"success"`
+	Expense   *ExpenseResponse `json:"expense,omitempty"`
+	Error     *BulkItemError   `json:"error,omitempty"`
+}
+
+// BulkItemError 一括処理の項目ごとのエラー
+type BulkItemError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// CostAllocationRequest コストセンターへの按分リクエスト（割合・金額のどちらかを指定）
+type CostAllocationRequest struct {
+	CostCenterID string  `json:"cost_center_id" binding:"required"`
+	Percentage   float64 `json:"percentage" binding:"omitempty,gt=0,max=100"` // 金額で指定した按分を除いた残りに対する割合（%）
+	Amount       float64 `json:"amount" binding:"omitempty,gt=0"`             // 経費と同じ通貨の金額
+}
+
+// CostAllocationResponse コストセンターへの按分レスポンス
+type CostAllocationResponse struct {
+	CostCenterID string  `json:"cost_center_id"`
+	Percentage   float64 `json:"percentage,omitempty"` // 割合で指定した場合のみ
+	Amount       float64 `json:"amount"`               // 按分した金額
+}
+
+// LineItemRequest 経費の明細リクエスト
+type LineItemRequest struct {
+	CategoryID  string  `json:"category_id" binding:"required"`
+	Amount      float64 `json:"amount" binding:"required,gt=0"`                                               // 経費と同じ通貨の金額（税込）
+	TaxCode     string  `json:"tax_code" binding:"omitempty,oneof=standard reduced non_taxable out_of_scope"` // 省略時はカテゴリの税区分
+	Description string  `json:"description" binding:"max=100"`
+}
+
+// LineItemResponse 経費の明細レスポンス
+type LineItemResponse struct {
+	CategoryID  string  `json:"category_id"`
+	Amount      float64 `json:"amount"`
+	TaxCode     string  `json:"tax_code,omitempty"` // 指定した場合のみ
+	Description string  `json:"description,omitempty"`
+}
+
+// SplitExpenseRequest 下書きの経費を明細に分けるリクエスト
+type SplitExpenseRequest struct {
+	LineItems []LineItemRequest `json:"line_items" binding:"required,min=2,max=50,dive"`
+}
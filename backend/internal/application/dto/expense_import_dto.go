@@ -0,0 +1,43 @@
+package dto
+
+// ImportExpensesRequest 経費の一括取込リクエスト
+// commitがfalseの場合は検証のみを行い（ドライラン）、trueの場合は全ての行が正しいときだけまとめて作成する
+type ImportExpensesRequest struct {
+	Content       string                       `json:"content" binding:"required_without=ContentBase64"`   // UTF-8のCSV
+	ContentBase64 string                       `json:"content_base64" binding:"required_without=Content"`  // Base64でエンコードしたCSV（Shift_JISのファイルはこちら）
+	Encoding      string                       `json:"encoding" binding:"omitempty,oneof=utf-8 shift_jis"` // content_base64の文字コード（省略時は自動判定）
+	UserID        string                       `json:"user_id"`                                            // ユーザーの列がない場合の申請者
+	Currency      string                       `json:"currency"`                                           // 通貨の列がない場合の通貨
+	Mapping       *ExpenseImportMappingRequest `json:"mapping" binding:"required"`
+	Commit        bool                         `json:"commit"`
+}
+
+// ExpenseImportMappingRequest CSVのヘッダー名と経費の項目の対応
+type ExpenseImportMappingRequest struct {
+	User        string `json:"user"`                        // ユーザーIDまたはメールアドレス
+	Category    string `json:"category" binding:"required"` // カテゴリ名またはカテゴリID
+	Amount      string `json:"amount" binding:"required"`
+	Currency    string `json:"currency"`
+	Title       string `json:"title" binding:"required"`
+	Description string `json:"description"`
+	Date        string `json:"date" binding:"required"`
+	DateFormat  string `json:"date_format"` // 例: YYYY/MM/DD（省略時はYYYY-MM-DDとYYYY/MM/DD）
+}
+
+// ExpenseImportErrorResponse 行ごとの検証エラー
+type ExpenseImportErrorResponse struct {
+	Line    int    `json:"line"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportExpensesResponse 経費の一括取込結果
+type ImportExpensesResponse struct {
+	DryRun      bool                          `json:"dry_run"`
+	Committed   bool                          `json:"committed"`
+	TotalRows   int                           `json:"total_rows"`
+	ValidRows   int                           `json:"valid_rows"`
+	InvalidRows int                           `json:"invalid_rows"`
+	Errors      []*ExpenseImportErrorResponse `json:"errors"`
+	ExpenseIDs  []string                      `json:"expense_ids,omitempty"` // 作成した経費（コミット時のみ）
+}
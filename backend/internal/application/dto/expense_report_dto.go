@@ -0,0 +1,54 @@
+package dto
+
+import "time"
+
+// CreateExpenseReportRequest 経費レポート作成リクエスト
+type CreateExpenseReportRequest struct {
+	Title       string   `json:"title" binding:"required"`
+	PeriodStart string   `json:"period_start" binding:"required"` // 対象期間の開始日（YYYY-MM-DD）
+	PeriodEnd   string   `json:"period_end" binding:"required"`   // 対象期間の終了日（YYYY-MM-DD、この日を含む）
+	ExpenseIDs  []string `json:"expense_ids"`
+}
+
+// UpdateExpenseReportRequest 経費レポート更新リクエスト
+type UpdateExpenseReportRequest struct {
+	Title       string   `json:"title" binding:"required"`
+	PeriodStart string   `json:"period_start" binding:"required"`
+	PeriodEnd   string   `json:"period_end" binding:"required"`
+	ExpenseIDs  []string `json:"expense_ids"`
+}
+
+// ExpenseReportResponse 経費レポートレスポンス
+type ExpenseReportResponse struct {
+	ID          string                     `json:"id"`
+	OwnerID     string                     `json:"owner_id"`
+	Title       string                     `json:"title"`
+	PeriodStart string                     `json:"period_start"` // YYYY-MM-DD
+	PeriodEnd   string                     `json:"period_end"`   // YYYY-MM-DD
+	Status      string                     `json:"status"`
+	Expenses    []*ExpenseResponse         `json:"expenses"`
+	TotalAmount float64                    `json:"total_amount"`
+	Currency    string                     `json:"currency"`
+	CostCenters []*CostCenterTotalResponse `json:"cost_centers"`
+	Categories  []*CategoryTotalResponse   `json:"categories"` // 明細に分けた経費は明細のカテゴリで集計
+	CreatedAt   time.Time                  `json:"created_at"`
+	UpdatedAt   time.Time                  `json:"updated_at"`
+
+	Warnings []string `json:"warnings,omitempty"` // 申請・承認時の警告（遅延申請・通知の失敗など）
+}
+
+// CategoryTotalResponse 経費レポートのカテゴリごとの合計
+type CategoryTotalResponse struct {
+	CategoryID string  `json:"category_id"`
+	Name       string  `json:"name,omitempty"` // 削除済みのカテゴリは空
+	Amount     float64 `json:"amount"`
+}
+
+// CostCenterTotalResponse 経費レポートのコストセンターごとの負担額
+// 按分がなく申請者の既定のコストセンターもない金額はcost_center_idを空にして集計する
+type CostCenterTotalResponse struct {
+	CostCenterID string  `json:"cost_center_id"`
+	Code         string  `json:"code,omitempty"`
+	Name         string  `json:"name,omitempty"`
+	Amount       float64 `json:"amount"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+// FiscalYearRequest 会計年度の会計期間一覧取得リクエスト
+type FiscalYearRequest struct {
+	FiscalYear int `form:"fiscal_year" binding:"omitempty,min=1900,max=9999"` // 省略時は現在の会計年度
+}
+
+// FiscalPeriodActionRequest 会計期間の締め・再開リクエスト
+type FiscalPeriodActionRequest struct {
+	UserID string `json:"user_id" binding:"required"` // 操作するユーザー（締めは経理担当者・管理者、再開は管理者）
+	Reason string `json:"reason" binding:"max=500"`   // 再開の理由
+}
+
+// FiscalYearResponse 会計年度の会計期間一覧レスポンス
+type FiscalYearResponse struct {
+	FiscalYear int                     `json:"fiscal_year"`
+	StartMonth int                     `json:"start_month"`
+	Periods    []*FiscalPeriodResponse `json:"periods"`
+}
+
+// FiscalPeriodResponse 会計期間レスポンス
+type FiscalPeriodResponse struct {
+	Period     string    `json:"period"` // YYYY-MM
+	FiscalYear int       `json:"fiscal_year"`
+	Number     int       `json:"number"` // 会計年度の第何期か（1〜12）
+	StartDate  time.Time `json:"start_date"`
+	EndDate    time.Time `json:"end_date"`
+	Status     string    `json:"status"`
+
+	ClosedBy     string     `json:"closed_by,omitempty"`
+	ClosedAt     *time.Time `json:"closed_at,omitempty"`
+	ReopenedBy   string     `json:"reopened_by,omitempty"`
+	ReopenedAt   *time.Time `json:"reopened_at,omitempty"`
+	ReopenReason string     `json:"reopen_reason,omitempty"`
+}
@@ -0,0 +1,74 @@
+package dto
+
+import "time"
+
+// LedgerPeriodRequest 仕訳帳・試算表の期間指定リクエスト
+type LedgerPeriodRequest struct {
+	DateFrom string `form:"date_from" binding:"required"` // YYYY-MM-DD
+	DateTo   string `form:"date_to" binding:"required"`   // YYYY-MM-DD（この日を含む）
+}
+
+// LedgerPostingResult 仕訳が未作成の経費の仕訳を作成した結果
+type LedgerPostingResult struct {
+	PostedCount int                     `json:"posted_count"` // 作成した仕訳の件数
+	Failures    []*LedgerPostingFailure `json:"failures"`     // 仕訳を作成できなかった経費
+}
+
+// LedgerPostingFailure 経費ごとの仕訳の作成の失敗
+type LedgerPostingFailure struct {
+	ExpenseID string `json:"expense_id"`
+	Message   string `json:"message"`
+}
+
+// JournalEntryResponse 仕訳レスポンス
+type JournalEntryResponse struct {
+	ID          string                 `json:"id"`
+	Date        string                 `json:"date"` // YYYY-MM-DD
+	Description string                 `json:"description"`
+	Source      string                 `json:"source"`
+	SourceID    string                 `json:"source_id"`
+	Currency    string                 `json:"currency"`
+	Lines       []*JournalLineResponse `json:"lines"`
+	CreatedAt   time.Time              `json:"created_at"`
+}
+
+// JournalLineResponse 仕訳の明細行レスポンス
+type JournalLineResponse struct {
+	Account    string  `json:"account"`
+	Department string  `json:"department,omitempty"`
+	Debit      float64 `json:"debit"`
+	Credit     float64 `json:"credit"`
+}
+
+// JournalEntryListResponse 仕訳帳レスポンス
+type JournalEntryListResponse struct {
+	DateFrom string                  `json:"date_from"`
+	DateTo   string                  `json:"date_to"`
+	Entries  []*JournalEntryResponse `json:"entries"`
+	Count    int                     `json:"count"`
+}
+
+// TrialBalanceResponse 試算表レスポンス
+type TrialBalanceResponse struct {
+	DateFrom string                         `json:"date_from"`
+	DateTo   string                         `json:"date_to"`
+	Accounts []*TrialBalanceAccountResponse `json:"accounts"`
+	Totals   []*TrialBalanceTotalResponse   `json:"totals"`   // 通貨ごとの合計
+	Balanced bool                           `json:"balanced"` // 全ての通貨で借方と貸方の合計が一致するかどうか
+}
+
+// TrialBalanceAccountResponse 試算表の勘定科目ごとの行
+type TrialBalanceAccountResponse struct {
+	Account  string  `json:"account"`
+	Currency string  `json:"currency"`
+	Debit    float64 `json:"debit"`
+	Credit   float64 `json:"credit"`
+	Balance  float64 `json:"balance"` // 借方残高は正、貸方残高は負
+}
+
+// TrialBalanceTotalResponse 試算表の通貨ごとの合計
+type TrialBalanceTotalResponse struct {
+	Currency string  `json:"currency"`
+	Debit    float64 `json:"debit"`
+	Credit   float64 `json:"credit"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// PerDiemRateRequest 日当単価登録リクエスト
+type PerDiemRateRequest struct {
+	DestinationClass string  `json:"destination_class" binding:"required"`
+	Grade            string  `json:"grade" binding:"required"`
+	DailyAmount      float64 `json:"daily_amount" binding:"required,gt=0"`
+	Currency         string  `json:"currency"`
+}
+
+// PerDiemRateResponse 日当単価レスポンス
+type PerDiemRateResponse struct {
+	DestinationClass string    `json:"destination_class"`
+	Grade            string    `json:"grade"`
+	DailyAmount      float64   `json:"daily_amount"`
+	Currency         string    `json:"currency"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// GeneratePerDiemRequest 日当経費生成リクエスト
+type GeneratePerDiemRequest struct {
+	DestinationClass string    `json:"destination_class" binding:"required"`
+	CategoryID       string    `json:"category_id" binding:"required"`
+	DepartureAt      time.Time `json:"departure_at" binding:"required"`
+	ReturnAt         time.Time `json:"return_at" binding:"required"`
+	TripRequestID    string    `json:"trip_request_id"`
+}
+
+// PerDiemDayResponse 日当の支給対象日レスポンス
+type PerDiemDayResponse struct {
+	Date    time.Time `json:"date"`
+	HalfDay bool      `json:"half_day"`
+	Amount  float64   `json:"amount"`
+}
+
+// GeneratePerDiemResponse 日当経費生成レスポンス
+type GeneratePerDiemResponse struct {
+	DestinationClass string                `json:"destination_class"`
+	Grade            string                `json:"grade"`
+	DailyAmount      float64               `json:"daily_amount"`
+	Currency         string                `json:"currency"`
+	Days             []*PerDiemDayResponse `json:"days"`
+	TotalAmount      float64               `json:"total_amount"`
+	Expenses         []*ExpenseResponse    `json:"expenses"`
+	SkippedDates     []string              `json:"skipped_dates"` // 日当の経費が既にあるため作成しなかった日付（YYYY-MM-DD）
+}
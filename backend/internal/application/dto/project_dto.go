@@ -0,0 +1,95 @@
+package dto
+
+import "time"
+
+// CreateProjectRequest プロジェクト作成リクエスト
+type CreateProjectRequest struct {
+	Code      string  `json:"code" binding:"required,max=20"` // プロジェクトコード（英数字・ハイフン・アンダースコア）
+	Name      string  `json:"name" binding:"required,max=100"`
+	Client    string  `json:"client" binding:"required,max=100"` // 請求先の顧客名
+	StartDate string  `json:"start_date" binding:"required"`     // 期間の開始日（YYYY-MM-DD）
+	EndDate   string  `json:"end_date"`                          // 期間の終了日（YYYY-MM-DD、この日を含む、省略時は終了日なし）
+	Budget    float64 `json:"budget" binding:"min=0"`            // 予算（省略時は予算なし）
+	Currency  string  `json:"currency"`                          // 予算の通貨（省略時はJPY）
+}
+
+// UpdateProjectRequest プロジェクト更新リクエスト
+type UpdateProjectRequest struct {
+	Code      string  `json:"code" binding:"required,max=20"`
+	Name      string  `json:"name" binding:"required,max=100"`
+	Client    string  `json:"client" binding:"required,max=100"`
+	StartDate string  `json:"start_date" binding:"required"`
+	EndDate   string  `json:"end_date"`
+	Budget    float64 `json:"budget" binding:"min=0"`
+	Currency  string  `json:"currency"`
+}
+
+// ProjectResponse プロジェクトレスポンス
+type ProjectResponse struct {
+	ID        string    `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Client    string    `json:"client"`
+	StartDate string    `json:"start_date"`         // YYYY-MM-DD
+	EndDate   string    `json:"end_date,omitempty"` // YYYY-MM-DD
+	Budget    float64   `json:"budget,omitempty"`
+	Currency  string    `json:"currency,omitempty"` // 予算の通貨
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BillableSummaryRequest プロジェクトの請求対象経費の集計リクエスト
+type BillableSummaryRequest struct {
+	DateFrom         string  `form:"date_from"`                                 // この日以降（YYYY-MM-DD、省略時は制限なし）
+	DateTo           string  `form:"date_to"`                                   // この日まで（YYYY-MM-DD、省略時は制限なし）
+	MarkupPercentage float64 `form:"markup_percentage" binding:"min=0,max=100"` // 請求時の上乗せ率（%、省略時は0）
+}
+
+// ExportBillableRequest プロジェクトの請求対象経費のエクスポートリクエスト
+type ExportBillableRequest struct {
+	BillableSummaryRequest
+	Format   string `form:"format" binding:"omitempty,oneof=csv xlsx"`                    // 省略時はcsv
+	Encoding string `form:"encoding" binding:"omitempty,oneof=utf-8 utf-8-bom shift_jis"` // CSVの文字コード（省略時はutf-8）
+}
+
+// BillableSummaryResponse プロジェクトの請求対象経費の集計レスポンス
+type BillableSummaryResponse struct {
+	Project          *ProjectResponse           `json:"project"`
+	DateFrom         string                     `json:"date_from,omitempty"`
+	DateTo           string                     `json:"date_to,omitempty"`
+	MarkupPercentage float64                    `json:"markup_percentage"`
+	Expenses         []*BillableExpenseResponse `json:"expenses"`
+	Totals           []*BillableTotalResponse   `json:"totals"` // 通貨ごとの合計
+	Budget           *ProjectBudgetResponse     `json:"budget,omitempty"`
+}
+
+// BillableExpenseResponse 請求対象の経費1件
+type BillableExpenseResponse struct {
+	ExpenseID    string  `json:"expense_id"`
+	Date         string  `json:"date"` // YYYY-MM-DD
+	UserID       string  `json:"user_id"`
+	UserName     string  `json:"user_name"`
+	CategoryName string  `json:"category_name"`
+	Title        string  `json:"title"`
+	Currency     string  `json:"currency"`
+	Amount       float64 `json:"amount"`        // 経費の金額
+	Markup       float64 `json:"markup"`        // 上乗せ額
+	BilledAmount float64 `json:"billed_amount"` // 請求額
+}
+
+// BillableTotalResponse 請求対象の経費の通貨ごとの合計
+type BillableTotalResponse struct {
+	Currency     string  `json:"currency"`
+	Count        int     `json:"count"`
+	Amount       float64 `json:"amount"`
+	Markup       float64 `json:"markup"`
+	BilledAmount float64 `json:"billed_amount"`
+}
+
+// ProjectBudgetResponse プロジェクトの予算の消化状況（請求対象かどうかにかかわらず承認済みの経費を集計）
+type ProjectBudgetResponse struct {
+	Amount    float64 `json:"amount"`
+	Currency  string  `json:"currency"`
+	Spent     float64 `json:"spent"`
+	Remaining float64 `json:"remaining"` // 超過している場合は負の値
+}
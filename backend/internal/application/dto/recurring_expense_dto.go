@@ -0,0 +1,69 @@
+package dto
+
+import "time"
+
+// CreateRecurringExpenseRequest 定期経費作成リクエスト
+type CreateRecurringExpenseRequest struct {
+	CategoryID  string  `json:"category_id" binding:"required"`
+	Amount      float64 `json:"amount" binding:"required,gt=0"`
+	Currency    string  `json:"currency"` // 省略時はJPY
+	Title       string  `json:"title" binding:"required,max=100"`
+	Description string  `json:"description" binding:"max=500"`
+	Frequency   string  `json:"frequency" binding:"required,oneof=monthly weekly"`
+	DayOfMonth  int     `json:"day_of_month" binding:"omitempty,min=1,max=31"`                 // monthly: 日（月末より後の日はその月の末日）
+	Weekday     string  `json:"weekday" binding:"omitempty,oneof=sun mon tue wed thu fri sat"` // weekly: 曜日
+	StartDate   string  `json:"start_date" binding:"required"`                                 // 開始日（YYYY-MM-DD）
+	EndDate     string  `json:"end_date"`                                                      // 終了日（YYYY-MM-DD、この日を含む、省略時は終了日なし）
+	Paused      bool    `json:"paused"`                                                        // 一時停止（経費を作成しない）
+}
+
+// UpdateRecurringExpenseRequest 定期経費更新リクエスト
+type UpdateRecurringExpenseRequest struct {
+	CategoryID  string  `json:"category_id" binding:"required"`
+	Amount      float64 `json:"amount" binding:"required,gt=0"`
+	Currency    string  `json:"currency"`
+	Title       string  `json:"title" binding:"required,max=100"`
+	Description string  `json:"description" binding:"max=500"`
+	Frequency   string  `json:"frequency" binding:"required,oneof=monthly weekly"`
+	DayOfMonth  int     `json:"day_of_month" binding:"omitempty,min=1,max=31"`
+	Weekday     string  `json:"weekday" binding:"omitempty,oneof=sun mon tue wed thu fri sat"`
+	StartDate   string  `json:"start_date" binding:"required"`
+	EndDate     string  `json:"end_date"`
+	Paused      bool    `json:"paused"`
+}
+
+// RecurringExpenseResponse 定期経費レスポンス
+type RecurringExpenseResponse struct {
+	ID               string    `json:"id"`
+	UserID           string    `json:"user_id"`
+	CategoryID       string    `json:"category_id"`
+	CategoryName     string    `json:"category_name,omitempty"` // 削除済みのカテゴリは空
+	Amount           float64   `json:"amount"`
+	Currency         string    `json:"currency"`
+	Title            string    `json:"title"`
+	Description      string    `json:"description,omitempty"`
+	Frequency        string    `json:"frequency"`
+	DayOfMonth       int       `json:"day_of_month,omitempty"`
+	Weekday          string    `json:"weekday,omitempty"`
+	StartDate        string    `json:"start_date"`         // YYYY-MM-DD
+	EndDate          string    `json:"end_date,omitempty"` // YYYY-MM-DD
+	Paused           bool      `json:"paused"`
+	NextDate         string    `json:"next_date,omitempty"`         // 次に経費を作成する発生日（YYYY-MM-DD、終了した場合は空）
+	ProcessedThrough string    `json:"processed_through,omitempty"` // 経費の作成を処理済みの最後の発生日（YYYY-MM-DD）
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// RecurringExpenseRunResult 定期経費からの経費の作成結果
+type RecurringExpenseRunResult struct {
+	CreatedCount int                        `json:"created_count"` // 下書きとして作成した経費の件数
+	SkippedCount int                        `json:"skipped_count"` // 重複・経費日付の期間外・締め済みの会計期間のため作成しなかった発生日の件数
+	Failures     []*RecurringExpenseFailure `json:"failures"`      // 経費の作成または処理済みの発生日の更新に失敗した定期経費
+}
+
+// RecurringExpenseFailure 定期経費ごとの経費の作成の失敗
+type RecurringExpenseFailure struct {
+	RecurringExpenseID string `json:"recurring_expense_id"`
+	Date               string `json:"date,omitempty"` // 作成に失敗した発生日（YYYY-MM-DD、更新の失敗の場合は省略）
+	Message            string `json:"message"`
+}
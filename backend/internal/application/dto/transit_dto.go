@@ -0,0 +1,31 @@
+package dto
+
+// ImportTransitHistoryRequest 交通系ICカードの利用履歴取込リクエスト
+type ImportTransitHistoryRequest struct {
+	Content    string `json:"content" binding:"required"` // ICカードリーダーが出力した利用履歴CSV
+	CategoryID string `json:"category_id"`                // 省略時は「交通費」カテゴリ
+}
+
+// TransitImportLineResponse 利用履歴の行ごとの取込結果
+type TransitImportLineResponse struct {
+	Line         int     `json:"line"`
+	Status       string  `json:"status"` // imported, duplicate, skipped, error
+	Reason       string  `json:"reason,omitempty"`
+	Date         string  `json:"date,omitempty"`
+	EntryStation string  `json:"entry_station,omitempty"`
+	ExitStation  string  `json:"exit_station,omitempty"`
+	Amount       float64 `json:"amount,omitempty"`
+	ExpenseID    string  `json:"expense_id,omitempty"`
+}
+
+// ImportTransitHistoryResponse 交通系ICカードの利用履歴取込結果
+type ImportTransitHistoryResponse struct {
+	CategoryID     string                       `json:"category_id"`
+	ImportedCount  int                          `json:"imported_count"`
+	DuplicateCount int                          `json:"duplicate_count"`
+	SkippedCount   int                          `json:"skipped_count"`
+	ErrorCount     int                          `json:"error_count"`
+	TotalAmount    float64                      `json:"total_amount"`
+	Currency       string                       `json:"currency"`
+	Lines          []*TransitImportLineResponse `json:"lines"`
+}
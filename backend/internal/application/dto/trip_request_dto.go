@@ -0,0 +1,51 @@
+package dto
+
+import "time"
+
+// CreateTripRequestRequest 出張申請作成リクエスト
+type CreateTripRequestRequest struct {
+	Destination   string  `json:"destination" binding:"required"`
+	StartDate     string  `json:"start_date" binding:"required"` // 出発日（YYYY-MM-DD）
+	EndDate       string  `json:"end_date" binding:"required"`   // 帰着日（YYYY-MM-DD、この日を含む）
+	Purpose       string  `json:"purpose" binding:"required"`
+	EstimatedCost float64 `json:"estimated_cost" binding:"min=0"`
+	Currency      string  `json:"currency"`
+}
+
+// UpdateTripRequestRequest 出張申請更新リクエスト
+type UpdateTripRequestRequest struct {
+	Destination   string  `json:"destination" binding:"required"`
+	StartDate     string  `json:"start_date" binding:"required"`
+	EndDate       string  `json:"end_date" binding:"required"`
+	Purpose       string  `json:"purpose" binding:"required"`
+	EstimatedCost float64 `json:"estimated_cost" binding:"min=0"`
+	Currency      string  `json:"currency"`
+}
+
+// TripRequestResponse 出張申請レスポンス
+type TripRequestResponse struct {
+	ID            string    `json:"id"`
+	UserID        string    `json:"user_id"`
+	Destination   string    `json:"destination"`
+	StartDate     string    `json:"start_date"` // YYYY-MM-DD
+	EndDate       string    `json:"end_date"`   // YYYY-MM-DD
+	Days          int       `json:"days"`
+	Purpose       string    `json:"purpose"`
+	EstimatedCost float64   `json:"estimated_cost"`
+	Currency      string    `json:"currency"`
+	Status        string    `json:"status"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// TripCostReportResponse 出張の概算費用と実費の比較レスポンス
+type TripCostReportResponse struct {
+	TripRequestID   string  `json:"trip_request_id"`
+	Destination     string  `json:"destination"`
+	Currency        string  `json:"currency"`
+	EstimatedAmount float64 `json:"estimated_amount"`
+	ActualAmount    float64 `json:"actual_amount"`   // 却下を除く関連経費の合計
+	ApprovedAmount  float64 `json:"approved_amount"` // 承認済みの関連経費の合計
+	Difference      float64 `json:"difference"`      // 実費 - 概算（正の値は超過）
+	ExpenseCount    int     `json:"expense_count"`
+}
@@ -4,21 +4,39 @@ import "time"
 
 // CreateUserRequest ユーザー作成リクエスト
 type CreateUserRequest struct {
-	Name  string `json:"name" binding:"required"`
-	Email string `json:"email" binding:"required,email"`
+	Name         string `json:"name" binding:"required"`
+	Email        string `json:"email" binding:"required,email"`
+	ManagerID    string `json:"manager_id"`
+	Grade        string `json:"grade"`
+	Role         string `json:"role" binding:"omitempty,oneof=member finance admin"` // 省略時は member
+	Timezone     string `json:"timezone"`                                            // IANAのタイムゾーン名（省略時は Asia/Tokyo）
+	DepartmentID string `json:"department_id"`                                       // 所属部署（予算の集計に使用）
+	CostCenterID string `json:"cost_center_id"`                                      // 按分を指定しない経費の既定のコストセンター
 }
 
 // UpdateUserRequest ユーザー更新リクエスト
 type UpdateUserRequest struct {
-	Name  string `json:"name" binding:"required"`
-	Email string `json:"email" binding:"required,email"`
+	Name         string `json:"name" binding:"required"`
+	Email        string `json:"email" binding:"required,email"`
+	ManagerID    string `json:"manager_id"`
+	Grade        string `json:"grade"`
+	Role         string `json:"role" binding:"omitempty,oneof=member finance admin"` // 省略時は member
+	Timezone     string `json:"timezone"`                                            // IANAのタイムゾーン名（省略時は Asia/Tokyo）
+	DepartmentID string `json:"department_id"`                                       // 所属部署（予算の集計に使用）
+	CostCenterID string `json:"cost_center_id"`                                      // 按分を指定しない経費の既定のコストセンター
 }
 
 // UserResponse ユーザーレスポンス
 type UserResponse struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	ManagerID    string    `json:"manager_id,omitempty"`
+	Grade        string    `json:"grade,omitempty"`
+	DepartmentID string    `json:"department_id,omitempty"`
+	CostCenterID string    `json:"cost_center_id,omitempty"`
+	Role         string    `json:"role"`
+	Timezone     string    `json:"timezone"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
 }
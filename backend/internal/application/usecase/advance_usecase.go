@@ -0,0 +1,365 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+)
+
+// AdvanceUseCase 仮払金ユースケース
+type AdvanceUseCase struct {
+	advanceRepo repository.AdvanceRepository
+	expenseRepo repository.ExpenseRepository
+	reportRepo  repository.ExpenseReportRepository
+	userRepo    repository.UserRepository
+	clock       clock.Clock
+}
+
+// NewAdvanceUseCase AdvanceUseCaseのコンストラクタ
+func NewAdvanceUseCase(
+	advanceRepo repository.AdvanceRepository,
+	expenseRepo repository.ExpenseRepository,
+	reportRepo repository.ExpenseReportRepository,
+	userRepo repository.UserRepository,
+	clk clock.Clock,
+) *AdvanceUseCase {
+	return &AdvanceUseCase{
+		advanceRepo: advanceRepo,
+		expenseRepo: expenseRepo,
+		reportRepo:  reportRepo,
+		userRepo:    userRepo,
+		clock:       clk,
+	}
+}
+
+// CreateAdvance 仮払金を作成
+func (uc *AdvanceUseCase) CreateAdvance(ctx context.Context, userID string, req *dto.CreateAdvanceRequest) (*dto.AdvanceResponse, error) {
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// ユーザーの存在確認
+	if _, err := uc.userRepo.FindByID(ctx, uid); err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	amount, err := valueobject.NewMoney(req.Amount, req.Currency)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	advance, err := entity.NewAdvance(uc.clock, uid, amount, req.Purpose)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.advanceRepo.Save(ctx, advance); err != nil {
+		return nil, errors.NewApplicationError(errors.AdvanceCreationFailed, "仮払金の作成に失敗しました")
+	}
+
+	return buildAdvanceResponse(advance), nil
+}
+
+// GetAdvance 仮払金を取得
+func (uc *AdvanceUseCase) GetAdvance(ctx context.Context, advanceID string) (*dto.AdvanceResponse, error) {
+	advance, err := uc.findAdvance(ctx, advanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildAdvanceResponse(advance), nil
+}
+
+// GetAdvancesByUser ユーザーの仮払金一覧を取得
+func (uc *AdvanceUseCase) GetAdvancesByUser(ctx context.Context, userID string) ([]*dto.AdvanceResponse, error) {
+	advances, err := uc.findAdvancesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.AdvanceResponse, len(advances))
+	for i, advance := range advances {
+		responses[i] = buildAdvanceResponse(advance)
+	}
+
+	return responses, nil
+}
+
+// GetOpenAdvancesByUser ユーザーの未精算の仮払金一覧を取得
+func (uc *AdvanceUseCase) GetOpenAdvancesByUser(ctx context.Context, userID string) (*dto.OpenAdvancesResponse, error) {
+	advances, err := uc.findAdvancesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.AdvanceResponse, 0)
+	for _, advance := range advances {
+		if advance.IsOpen() {
+			responses = append(responses, buildAdvanceResponse(advance))
+		}
+	}
+
+	return &dto.OpenAdvancesResponse{
+		UserID:   userID,
+		Advances: responses,
+		Count:    len(responses),
+	}, nil
+}
+
+// UpdateAdvance 仮払金を更新
+func (uc *AdvanceUseCase) UpdateAdvance(ctx context.Context, advanceID string, req *dto.UpdateAdvanceRequest) (*dto.AdvanceResponse, error) {
+	advance, err := uc.findAdvance(ctx, advanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := valueobject.NewMoney(req.Amount, req.Currency)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := advance.UpdateDetails(amount, req.Purpose, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.advanceRepo.Update(ctx, advance); err != nil {
+		return nil, errors.NewApplicationError(errors.AdvanceUpdateFailed, "仮払金の更新に失敗しました")
+	}
+
+	return buildAdvanceResponse(advance), nil
+}
+
+// DeleteAdvance 仮払金を削除
+func (uc *AdvanceUseCase) DeleteAdvance(ctx context.Context, advanceID string) error {
+	advance, err := uc.findAdvance(ctx, advanceID)
+	if err != nil {
+		return err
+	}
+
+	if !advance.CanDelete() {
+		return errors.NewApplicationError(errors.ValidationFailed, "下書きまたは却下された仮払金のみ削除できます")
+	}
+
+	if err := uc.advanceRepo.Delete(ctx, advance.ID()); err != nil {
+		return errors.NewApplicationError(errors.AdvanceDeletionFailed, "仮払金の削除に失敗しました")
+	}
+
+	return nil
+}
+
+// SubmitAdvance 仮払金を申請
+func (uc *AdvanceUseCase) SubmitAdvance(ctx context.Context, advanceID string) (*dto.AdvanceResponse, error) {
+	return uc.changeAdvanceStatus(ctx, advanceID, actionSubmit)
+}
+
+// ApproveAdvance 仮払金を承認
+func (uc *AdvanceUseCase) ApproveAdvance(ctx context.Context, advanceID string) (*dto.AdvanceResponse, error) {
+	return uc.changeAdvanceStatus(ctx, advanceID, actionApprove)
+}
+
+// RejectAdvance 仮払金を却下
+func (uc *AdvanceUseCase) RejectAdvance(ctx context.Context, advanceID string) (*dto.AdvanceResponse, error) {
+	return uc.changeAdvanceStatus(ctx, advanceID, actionReject)
+}
+
+// SettleAdvance 承認済みの経費・経費レポートで仮払金を精算し、返金額または追加支払額を計算
+func (uc *AdvanceUseCase) SettleAdvance(ctx context.Context, advanceID string, req *dto.SettleAdvanceRequest) (*dto.AdvanceResponse, error) {
+	advance, err := uc.findAdvance(ctx, advanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	expenseIDs, err := parseExpenseIDs(req.ExpenseIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 経費レポートに含まれる経費を精算対象に加える
+	reportIDs := make([]*valueobject.ExpenseReportID, len(req.ExpenseReportIDs))
+	for i, value := range req.ExpenseReportIDs {
+		reportID, err := valueobject.NewExpenseReportID(value)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		report, err := uc.reportRepo.FindByID(ctx, reportID)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ExpenseReportNotFound, "経費レポートが見つかりません: "+value)
+		}
+
+		if !report.OwnerID().Equals(advance.UserID()) {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, "他のユーザーの経費レポートでは精算できません: "+value)
+		}
+
+		if report.Status() != entity.ExpenseReportStatusApproved {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, "承認済みの経費レポートのみ精算できます: "+value)
+		}
+
+		reportIDs[i] = reportID
+		expenseIDs = append(expenseIDs, report.ExpenseIDs()...)
+	}
+
+	// 重複を除いて実費合計を計算
+	seen := make(map[string]bool)
+	settledIDs := make([]*valueobject.ExpenseID, 0, len(expenseIDs))
+	actual, err := valueobject.NewMoney(0, advance.Amount().Currency())
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	for _, id := range expenseIDs {
+		if seen[id.String()] {
+			continue
+		}
+		seen[id.String()] = true
+
+		expense, err := uc.expenseRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ExpenseNotFound, "経費が見つかりません: "+id.String())
+		}
+
+		if err := uc.validateSettlement(ctx, advance, expense); err != nil {
+			return nil, err
+		}
+
+		actual, err = actual.Add(expense.Amount())
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		settledIDs = append(settledIDs, id)
+	}
+
+	if err := advance.Settle(settledIDs, reportIDs, actual, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.advanceRepo.Update(ctx, advance); err != nil {
+		return nil, errors.NewApplicationError(errors.AdvanceUpdateFailed, "仮払金の精算に失敗しました")
+	}
+
+	return buildAdvanceResponse(advance), nil
+}
+
+// changeAdvanceStatus 仮払金のステータスを変更
+func (uc *AdvanceUseCase) changeAdvanceStatus(ctx context.Context, advanceID string, action string) (*dto.AdvanceResponse, error) {
+	advance, err := uc.findAdvance(ctx, advanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case actionSubmit:
+		err = advance.Submit(uc.clock.Now())
+	case actionApprove:
+		err = advance.Approve(uc.clock.Now())
+	case actionReject:
+		err = advance.Reject(uc.clock.Now())
+	default:
+		return nil, errors.NewApplicationError(errors.ValidationFailed, "無効なアクションです")
+	}
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.advanceRepo.Update(ctx, advance); err != nil {
+		return nil, errors.NewApplicationError(errors.AdvanceUpdateFailed, "仮払金のステータス更新に失敗しました")
+	}
+
+	return buildAdvanceResponse(advance), nil
+}
+
+// validateSettlement 精算対象にする経費の条件を検証
+// 仮払金と同じユーザーの承認済みの経費で、他の仮払金で精算されていないこと
+func (uc *AdvanceUseCase) validateSettlement(ctx context.Context, advance *entity.Advance, expense *entity.Expense) error {
+	if !expense.UserID().Equals(advance.UserID()) {
+		return errors.NewApplicationError(errors.ValidationFailed, "他のユーザーの経費では精算できません: "+expense.ID().String())
+	}
+
+	if expense.Status() != entity.ExpenseStatusApproved {
+		return errors.NewApplicationError(errors.ValidationFailed, "承認済みの経費のみ精算できます: "+expense.ID().String())
+	}
+
+	other, err := uc.advanceRepo.FindByExpenseID(ctx, expense.ID())
+	if err == nil && other != nil && !other.ID().Equals(advance.ID()) {
+		return errors.NewApplicationError(errors.ExpenseAlreadySettled, "経費は既に他の仮払金で精算されています: "+expense.ID().String())
+	}
+
+	return nil
+}
+
+// findAdvance IDで仮払金を取得
+func (uc *AdvanceUseCase) findAdvance(ctx context.Context, advanceID string) (*entity.Advance, error) {
+	id, err := valueobject.NewAdvanceID(advanceID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	advance, err := uc.advanceRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.AdvanceNotFound, "仮払金が見つかりません")
+	}
+
+	return advance, nil
+}
+
+// findAdvancesByUser ユーザーの存在を確認して仮払金を取得
+func (uc *AdvanceUseCase) findAdvancesByUser(ctx context.Context, userID string) ([]*entity.Advance, error) {
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if _, err := uc.userRepo.FindByID(ctx, uid); err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	advances, err := uc.advanceRepo.FindByUserID(ctx, uid)
+	if err != nil {
+		return nil, errors.NewApplicationError("ADVANCE_FETCH_FAILED", "仮払金一覧の取得に失敗しました")
+	}
+
+	return advances, nil
+}
+
+// buildAdvanceResponse 仮払金レスポンスを構築
+func buildAdvanceResponse(advance *entity.Advance) *dto.AdvanceResponse {
+	response := &dto.AdvanceResponse{
+		ID:        advance.ID().String(),
+		UserID:    advance.UserID().String(),
+		Amount:    advance.Amount().Amount(),
+		Currency:  advance.Amount().Currency(),
+		Purpose:   advance.Purpose(),
+		Status:    string(advance.Status()),
+		CreatedAt: advance.CreatedAt(),
+		UpdatedAt: advance.UpdatedAt(),
+	}
+
+	if advance.SettledAmount() != nil {
+		expenseIDs := make([]string, len(advance.ExpenseIDs()))
+		for i, id := range advance.ExpenseIDs() {
+			expenseIDs[i] = id.String()
+		}
+
+		reportIDs := make([]string, len(advance.ExpenseReportIDs()))
+		for i, id := range advance.ExpenseReportIDs() {
+			reportIDs[i] = id.String()
+		}
+
+		response.Settlement = &dto.AdvanceSettlementResponse{
+			ExpenseIDs:        expenseIDs,
+			ExpenseReportIDs:  reportIDs,
+			ActualAmount:      advance.SettledAmount().Amount(),
+			RefundAmount:      advance.RefundAmount().Amount(),
+			AdditionalPayment: advance.AdditionalPayment().Amount(),
+			SettledAt:         advance.SettledAt(),
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/internal/infrastructure/persistence"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdvanceUseCase_Settlement(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	reportRepo := persistence.NewMemoryExpenseReportRepository()
+	advanceRepo := persistence.NewMemoryAdvanceRepository()
+
+	// ユースケースを初期化
+	useCase := NewAdvanceUseCase(advanceRepo, expenseRepo, reportRepo, userRepo, fakeClock)
+
+	// テスト用のユーザーを作成
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	categoryID := valueobject.GenerateCategoryID()
+	newApprovedExpense := func(amount float64) *entity.Expense {
+		money, _ := valueobject.NewMoney(amount, "JPY")
+		expense, err := entity.NewExpense(fakeClock, user.ID(), categoryID, money, "出張経費", "", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -1)))
+		require.NoError(t, err)
+		require.NoError(t, expense.Submit(fakeClock.Now()))
+		require.NoError(t, expense.Approve(fakeClock.Now()))
+		require.NoError(t, expenseRepo.Save(ctx, expense))
+		return expense
+	}
+
+	newApprovedAdvance := func(amount float64) string {
+		advance, err := useCase.CreateAdvance(ctx, user.ID().String(), &dto.CreateAdvanceRequest{
+			Amount:   amount,
+			Currency: "JPY",
+			Purpose:  "大阪出張の仮払い",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "draft", advance.Status)
+
+		_, err = useCase.SubmitAdvance(ctx, advance.ID)
+		require.NoError(t, err)
+		approved, err := useCase.ApproveAdvance(ctx, advance.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "approved", approved.Status)
+
+		return advance.ID
+	}
+
+	t.Run("経費レポートと経費で精算し返金額を計算", func(t *testing.T) {
+		advanceID := newApprovedAdvance(50000)
+
+		open, err := useCase.GetOpenAdvancesByUser(ctx, user.ID().String())
+		require.NoError(t, err)
+		assert.Equal(t, 1, open.Count)
+
+		// 承認済みの経費レポートを用意
+		reportExpense := newApprovedExpense(30000)
+		report, err := entity.NewExpenseReport(fakeClock, user.ID(), "大阪出張", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -7)), valueobject.DateOf(fakeClock.Now()), []*valueobject.ExpenseID{reportExpense.ID()})
+		require.NoError(t, err)
+		require.NoError(t, report.Submit(fakeClock.Now()))
+		require.NoError(t, report.Approve(fakeClock.Now()))
+		require.NoError(t, reportRepo.Save(ctx, report))
+
+		expense := newApprovedExpense(12000)
+
+		result, err := useCase.SettleAdvance(ctx, advanceID, &dto.SettleAdvanceRequest{
+			ExpenseIDs:       []string{expense.ID().String()},
+			ExpenseReportIDs: []string{report.ID().String()},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "settled", result.Status)
+		require.NotNil(t, result.Settlement)
+		assert.Len(t, result.Settlement.ExpenseIDs, 2)
+		assert.Equal(t, 42000.0, result.Settlement.ActualAmount)
+		assert.Equal(t, 8000.0, result.Settlement.RefundAmount)
+		assert.Equal(t, 0.0, result.Settlement.AdditionalPayment)
+
+		open, err = useCase.GetOpenAdvancesByUser(ctx, user.ID().String())
+		require.NoError(t, err)
+		assert.Equal(t, 0, open.Count)
+
+		t.Run("精算済みの経費は他の仮払金で精算できない", func(t *testing.T) {
+			otherAdvanceID := newApprovedAdvance(10000)
+
+			result, err := useCase.SettleAdvance(ctx, otherAdvanceID, &dto.SettleAdvanceRequest{
+				ExpenseIDs: []string{expense.ID().String()},
+			})
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("実費が仮払額を上回る場合は追加支払額を計算", func(t *testing.T) {
+		advanceID := newApprovedAdvance(10000)
+		expense := newApprovedExpense(13500)
+
+		result, err := useCase.SettleAdvance(ctx, advanceID, &dto.SettleAdvanceRequest{
+			ExpenseIDs: []string{expense.ID().String()},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, result.Settlement.RefundAmount)
+		assert.Equal(t, 3500.0, result.Settlement.AdditionalPayment)
+	})
+
+	t.Run("未承認の経費では精算できない", func(t *testing.T) {
+		advanceID := newApprovedAdvance(10000)
+
+		money, _ := valueobject.NewMoney(5000, "JPY")
+		draft, err := entity.NewExpense(fakeClock, user.ID(), categoryID, money, "下書きの経費", "", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -1)))
+		require.NoError(t, err)
+		require.NoError(t, expenseRepo.Save(ctx, draft))
+
+		result, err := useCase.SettleAdvance(ctx, advanceID, &dto.SettleAdvanceRequest{
+			ExpenseIDs: []string{draft.ID().String()},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
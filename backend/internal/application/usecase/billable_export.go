@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"expense-management-system/internal/application/dto"
+	"io"
+	"time"
+)
+
+// billableExportColumns 請求対象経費のエクスポートの列の見出し
+var billableExportColumns = []string{
+	"経費ID", "日付", "申請者", "カテゴリ", "件名", "通貨", "金額", "上乗せ額", "請求額",
+}
+
+// BillableExport 請求書作成用に集計済みのプロジェクトの請求対象経費
+type BillableExport struct {
+	summary  *dto.BillableSummaryResponse
+	format   string
+	encoding string
+	now      time.Time
+}
+
+// Count 請求対象の経費の件数
+func (e *BillableExport) Count() int {
+	return len(e.summary.Expenses)
+}
+
+// ContentType 出力するファイルのContent-Type
+func (e *BillableExport) ContentType() string {
+	return exportContentType(e.format, e.encoding)
+}
+
+// FileName 出力するファイル名
+func (e *BillableExport) FileName() string {
+	return "billable_" + e.summary.Project.Code + "_" + e.now.Format("20060102") + "." + e.format
+}
+
+// Write 請求対象の経費を1件ずつ書き出し、最後に通貨ごとの合計の行を書き出す
+func (e *BillableExport) Write(w io.Writer) error {
+	writer, err := newExportRowWriter(w, e.format, e.encoding, "請求明細")
+	if err != nil {
+		return err
+	}
+
+	header := make([]interface{}, len(billableExportColumns))
+	for i, column := range billableExportColumns {
+		header[i] = column
+	}
+	if err := writer.WriteRow(header); err != nil {
+		return err
+	}
+
+	for _, expense := range e.summary.Expenses {
+		row := []interface{}{
+			expense.ExpenseID, expense.Date, expense.UserName, expense.CategoryName, expense.Title,
+			expense.Currency, expense.Amount, expense.Markup, expense.BilledAmount,
+		}
+		if err := writer.WriteRow(row); err != nil {
+			return err
+		}
+	}
+
+	for _, total := range e.summary.Totals {
+		row := []interface{}{
+			"合計", nil, nil, nil, nil,
+			total.Currency, total.Amount, total.Markup, total.BilledAmount,
+		}
+		if err := writer.WriteRow(row); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,402 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"math"
+	"strconv"
+)
+
+// BudgetUseCase 予算ユースケース
+type BudgetUseCase struct {
+	budgetRepo     repository.BudgetRepository
+	expenseRepo    repository.ExpenseRepository
+	userRepo       repository.UserRepository
+	categoryRepo   repository.CategoryRepository
+	departmentRepo repository.DepartmentRepository
+	clock          clock.Clock
+}
+
+// NewBudgetUseCase BudgetUseCaseのコンストラクタ
+func NewBudgetUseCase(budgetRepo repository.BudgetRepository, expenseRepo repository.ExpenseRepository, userRepo repository.UserRepository, categoryRepo repository.CategoryRepository, departmentRepo repository.DepartmentRepository, clk clock.Clock) *BudgetUseCase {
+	return &BudgetUseCase{
+		budgetRepo:     budgetRepo,
+		expenseRepo:    expenseRepo,
+		userRepo:       userRepo,
+		categoryRepo:   categoryRepo,
+		departmentRepo: departmentRepo,
+		clock:          clk,
+	}
+}
+
+// CreateBudget 予算を作成
+func (uc *BudgetUseCase) CreateBudget(ctx context.Context, req *dto.CreateBudgetRequest) (*dto.BudgetResponse, error) {
+	scope, err := uc.buildBudgetScope(ctx, req.CategoryID, req.UserID, req.DepartmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	periodFrom, periodTo, amount, err := parseBudgetTerms(req.PeriodFrom, req.PeriodTo, req.Amount, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	budget, err := entity.NewBudget(uc.clock, req.Name, scope, periodFrom, periodTo, amount)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.budgetRepo.Save(ctx, budget); err != nil {
+		return nil, errors.NewApplicationError(errors.BudgetCreationFailed, "予算の作成に失敗しました")
+	}
+
+	return buildBudgetResponse(budget), nil
+}
+
+// GetBudget 予算を取得
+func (uc *BudgetUseCase) GetBudget(ctx context.Context, budgetID string) (*dto.BudgetResponse, error) {
+	budget, err := uc.findBudget(ctx, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildBudgetResponse(budget), nil
+}
+
+// GetAllBudgets 全ての予算を取得
+func (uc *BudgetUseCase) GetAllBudgets(ctx context.Context) ([]*dto.BudgetResponse, error) {
+	budgets, err := uc.budgetRepo.FindAll(ctx)
+	if err != nil {
+		return nil, errors.NewApplicationError("BUDGET_FETCH_FAILED", "予算一覧の取得に失敗しました")
+	}
+
+	responses := make([]*dto.BudgetResponse, len(budgets))
+	for i, budget := range budgets {
+		responses[i] = buildBudgetResponse(budget)
+	}
+
+	return responses, nil
+}
+
+// UpdateBudget 予算を更新
+func (uc *BudgetUseCase) UpdateBudget(ctx context.Context, budgetID string, req *dto.UpdateBudgetRequest) (*dto.BudgetResponse, error) {
+	budget, err := uc.findBudget(ctx, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, err := uc.buildBudgetScope(ctx, req.CategoryID, req.UserID, req.DepartmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	periodFrom, periodTo, amount, err := parseBudgetTerms(req.PeriodFrom, req.PeriodTo, req.Amount, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := budget.Update(req.Name, scope, periodFrom, periodTo, amount, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.budgetRepo.Update(ctx, budget); err != nil {
+		return nil, errors.NewApplicationError(errors.BudgetUpdateFailed, "予算の更新に失敗しました")
+	}
+
+	return buildBudgetResponse(budget), nil
+}
+
+// DeleteBudget 予算を削除
+func (uc *BudgetUseCase) DeleteBudget(ctx context.Context, budgetID string) error {
+	budget, err := uc.findBudget(ctx, budgetID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.budgetRepo.Delete(ctx, budget.ID()); err != nil {
+		return errors.NewApplicationError(errors.BudgetDeletionFailed, "予算の削除に失敗しました")
+	}
+
+	return nil
+}
+
+// GetBudgetStatus 予算の消化状況を取得
+func (uc *BudgetUseCase) GetBudgetStatus(ctx context.Context, budgetID string) (*dto.BudgetStatusResponse, error) {
+	budget, err := uc.findBudget(ctx, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := budgetUsage(ctx, uc.expenseRepo, uc.userRepo, budget)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildBudgetStatusResponse(budget, usage), nil
+}
+
+// GetBudgetStatuses 期間に指定日（省略時は今日）を含む予算の消化状況の一覧を取得
+func (uc *BudgetUseCase) GetBudgetStatuses(ctx context.Context, req *dto.BudgetStatusRequest) ([]*dto.BudgetStatusResponse, error) {
+	date := valueobject.DateOf(uc.clock.Now())
+	if req.Date != "" {
+		parsed, err := valueobject.ParseDate(req.Date)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		date = parsed
+	}
+
+	budgets, err := uc.budgetRepo.FindByDate(ctx, date)
+	if err != nil {
+		return nil, errors.NewApplicationError("BUDGET_FETCH_FAILED", "予算一覧の取得に失敗しました")
+	}
+
+	responses := make([]*dto.BudgetStatusResponse, len(budgets))
+	for i, budget := range budgets {
+		usage, err := budgetUsage(ctx, uc.expenseRepo, uc.userRepo, budget)
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = buildBudgetStatusResponse(budget, usage)
+	}
+
+	return responses, nil
+}
+
+// findBudget IDで予算を検索
+func (uc *BudgetUseCase) findBudget(ctx context.Context, budgetID string) (*entity.Budget, error) {
+	id, err := valueobject.NewBudgetID(budgetID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	budget, err := uc.budgetRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.BudgetNotFound, "予算が見つかりません")
+	}
+
+	return budget, nil
+}
+
+// buildBudgetScope リクエストの対象のカテゴリ・ユーザー・部署の存在を確認して予算の対象を作成
+func (uc *BudgetUseCase) buildBudgetScope(ctx context.Context, categoryID, userID, departmentID string) (*entity.BudgetScope, error) {
+	var cid *valueobject.CategoryID
+	if categoryID != "" {
+		id, err := valueobject.NewCategoryID(categoryID)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		if _, err := uc.categoryRepo.FindByID(ctx, id); err != nil {
+			return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
+		}
+		cid = id
+	}
+
+	var uid *valueobject.UserID
+	if userID != "" {
+		id, err := valueobject.NewUserID(userID)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		if _, err := uc.userRepo.FindByID(ctx, id); err != nil {
+			return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+		}
+		uid = id
+	}
+
+	var did *valueobject.DepartmentID
+	if departmentID != "" {
+		id, err := valueobject.NewDepartmentID(departmentID)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		if _, err := uc.departmentRepo.FindByID(ctx, id); err != nil {
+			return nil, errors.NewApplicationError(errors.DepartmentNotFound, "部署が見つかりません")
+		}
+		did = id
+	}
+
+	scope, err := entity.NewBudgetScope(cid, uid, did)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	return scope, nil
+}
+
+// parseBudgetTerms リクエストの期間と予算額を変換
+func parseBudgetTerms(from, to string, amount float64, currency string) (valueobject.Date, valueobject.Date, *valueobject.Money, error) {
+	periodFrom, err := valueobject.ParseDate(from)
+	if err != nil {
+		return valueobject.Date{}, valueobject.Date{}, nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	periodTo, err := valueobject.ParseDate(to)
+	if err != nil {
+		return valueobject.Date{}, valueobject.Date{}, nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	money, err := valueobject.NewMoney(amount, currency)
+	if err != nil {
+		return valueobject.Date{}, valueobject.Date{}, nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	return periodFrom, periodTo, money, nil
+}
+
+// budgetUsage 予算の期間と対象に含まれる経費を集計して消化状況を計算
+func budgetUsage(ctx context.Context, expenseRepo repository.ExpenseRepository, userRepo repository.UserRepository, budget *entity.Budget) (*entity.BudgetUsage, error) {
+	scope := budget.Scope()
+	filter := repository.ExpenseFilter{
+		UserID:     scope.UserID(),
+		CategoryID: scope.CategoryID(),
+		DateFrom:   budget.PeriodFrom(),
+		DateTo:     budget.PeriodTo(),
+	}
+
+	// 部署の判定に使う申請者は集計中だけ保持する
+	users := make(map[string]*entity.User)
+	expenses := make([]*entity.Expense, 0)
+	err := expenseRepo.Iterate(ctx, filter, func(expense *entity.Expense) error {
+		var user *entity.User
+		if scope.DepartmentID() != nil {
+			user = findUserCached(ctx, userRepo, expense.UserID(), users)
+		}
+		if budget.Covers(expense, user) {
+			expenses = append(expenses, expense)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.NewApplicationError("EXPENSE_FETCH_FAILED", "経費一覧の取得に失敗しました")
+	}
+
+	usage, err := budget.Usage(expenses)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	return usage, nil
+}
+
+// checkBudgets 経費を申請すると残額を超える予算があれば警告を返す（予算が未設定の場合は何もしない）
+// 経費をまとめて申請する場合は、同時に申請する先の経費（pending）を申請中として加えた残額と比べる
+func checkBudgets(ctx context.Context, budgetRepo repository.BudgetRepository, expenseRepo repository.ExpenseRepository, userRepo repository.UserRepository, expense *entity.Expense, pending []*entity.Expense) ([]string, error) {
+	if budgetRepo == nil {
+		return nil, nil
+	}
+
+	budgets, err := budgetRepo.FindByDate(ctx, expense.Date())
+	if err != nil {
+		return nil, errors.NewApplicationError("BUDGET_FETCH_FAILED", "予算一覧の取得に失敗しました")
+	}
+
+	user, err := userRepo.FindByID(ctx, expense.UserID())
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	users := map[string]*entity.User{user.ID().String(): user}
+	var warnings []string
+	for _, budget := range budgets {
+		if !budget.Covers(expense, user) {
+			continue
+		}
+
+		usage, err := budgetUsage(ctx, expenseRepo, userRepo, budget)
+		if err != nil {
+			return nil, err
+		}
+
+		amount := budget.CoveredAmount(expense)
+		if !usage.IsComparable(amount) {
+			warnings = append(warnings, "予算「"+budget.Name()+"」と異なる通貨（"+amount.Currency()+"）の経費のため、予算の残額と比べられません")
+			continue
+		}
+
+		for _, other := range pending {
+			otherAmount := budget.CoveredAmount(other)
+			if !budget.Covers(other, findUserCached(ctx, userRepo, other.UserID(), users)) || !usage.IsComparable(otherAmount) {
+				continue
+			}
+			if usage, err = usage.WithCommitted(otherAmount); err != nil {
+				return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+			}
+		}
+
+		if usage.WouldExceed(amount) {
+			remaining := strconv.FormatFloat(math.Max(usage.Remaining(), 0), 'f', -1, 64) + " " + budget.Amount().Currency()
+			warnings = append(warnings, "この経費を申請すると予算「"+budget.Name()+"」の残額（"+remaining+"）を超えます")
+		}
+	}
+
+	return warnings, nil
+}
+
+// findUserCached ユーザーを取得（削除済みのユーザーはnil）
+func findUserCached(ctx context.Context, userRepo repository.UserRepository, userID *valueobject.UserID, users map[string]*entity.User) *entity.User {
+	if user, ok := users[userID.String()]; ok {
+		return user
+	}
+
+	user, err := userRepo.FindByID(ctx, userID)
+	if err != nil {
+		user = nil
+	}
+	users[userID.String()] = user
+	return user
+}
+
+// buildBudgetResponse 予算レスポンスを構築
+func buildBudgetResponse(budget *entity.Budget) *dto.BudgetResponse {
+	response := &dto.BudgetResponse{
+		ID:         budget.ID().String(),
+		Name:       budget.Name(),
+		PeriodFrom: budget.PeriodFrom().String(),
+		PeriodTo:   budget.PeriodTo().String(),
+		Amount:     budget.Amount().Amount(),
+		Currency:   budget.Amount().Currency(),
+		CreatedAt:  budget.CreatedAt(),
+		UpdatedAt:  budget.UpdatedAt(),
+	}
+
+	if budget.Scope().CategoryID() != nil {
+		response.CategoryID = budget.Scope().CategoryID().String()
+	}
+
+	if budget.Scope().UserID() != nil {
+		response.UserID = budget.Scope().UserID().String()
+	}
+
+	if budget.Scope().DepartmentID() != nil {
+		response.DepartmentID = budget.Scope().DepartmentID().String()
+	}
+
+	return response
+}
+
+// buildBudgetStatusResponse 予算の消化状況レスポンスを構築（消化率は小数点以下1桁に丸める）
+func buildBudgetStatusResponse(budget *entity.Budget, usage *entity.BudgetUsage) *dto.BudgetStatusResponse {
+	response := &dto.BudgetStatusResponse{
+		Budget:          buildBudgetResponse(budget),
+		Committed:       usage.Committed().Amount(),
+		Actual:          usage.Actual().Amount(),
+		Remaining:       math.Round(usage.Remaining()*100) / 100,
+		UtilizationRate: math.Round(usage.UtilizationRate()*1000) / 10,
+		Exceeded:        usage.IsExceeded(),
+	}
+
+	for _, excluded := range usage.Excluded() {
+		response.Excluded = append(response.Excluded, &dto.BudgetExcludedAmountResponse{
+			Amount:   excluded.Amount(),
+			Currency: excluded.Currency(),
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/infrastructure/persistence"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetUseCase(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	budgetRepo := persistence.NewMemoryBudgetRepository()
+	departmentRepo := persistence.NewMemoryDepartmentRepository()
+
+	// ユースケースを初期化
+	useCase := NewBudgetUseCase(budgetRepo, expenseRepo, userRepo, categoryRepo, departmentRepo, fakeClock)
+	expenseUseCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock, WithBudgetRepository(budgetRepo))
+
+	// テスト用のユーザーとカテゴリを作成
+	sales, _ := entity.NewDepartment(fakeClock, "SALES", "営業部")
+	require.NoError(t, departmentRepo.Save(ctx, sales))
+
+	member, _ := entity.NewUser(fakeClock, "山田太郎", "yamada@example.com")
+	member.ChangeDepartment(sales.ID(), fakeClock.Now())
+	require.NoError(t, userRepo.Save(ctx, member))
+	other, _ := entity.NewUser(fakeClock, "鈴木一郎", "suzuki@example.com")
+	require.NoError(t, userRepo.Save(ctx, other))
+
+	category, _ := entity.NewCategory(fakeClock, "交通費", "", "")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	today := fakeClock.Now()
+	createExpense := func(userID string, amount float64) *dto.ExpenseResponse {
+		expense, err := expenseUseCase.CreateExpense(ctx, userID, &dto.CreateExpenseRequest{
+			CategoryID: category.ID().String(), Amount: amount, Title: "タクシー代", Date: today.Format("2006-01-02"),
+		})
+		require.NoError(t, err)
+		return expense
+	}
+
+	budget, err := useCase.CreateBudget(ctx, &dto.CreateBudgetRequest{
+		Name:         "営業部交通費",
+		CategoryID:   category.ID().String(),
+		DepartmentID: sales.ID().String(),
+		PeriodFrom:   today.AddDate(0, 0, -7).Format("2006-01-02"),
+		PeriodTo:     today.AddDate(0, 0, 7).Format("2006-01-02"),
+		Amount:       10000,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "JPY", budget.Currency)
+
+	t.Run("申請中と承認済みの経費を集計する", func(t *testing.T) {
+		approved := createExpense(member.ID().String(), 4000)
+		_, err := expenseUseCase.SubmitExpense(ctx, approved.ID)
+		require.NoError(t, err)
+		_, err = expenseUseCase.ApproveExpense(ctx, approved.ID)
+		require.NoError(t, err)
+
+		submitted := createExpense(member.ID().String(), 3000)
+		_, err = expenseUseCase.SubmitExpense(ctx, submitted.ID)
+		require.NoError(t, err)
+
+		// 部署外のユーザーの経費と下書きは集計しない
+		outside := createExpense(other.ID().String(), 5000)
+		_, err = expenseUseCase.SubmitExpense(ctx, outside.ID)
+		require.NoError(t, err)
+		createExpense(member.ID().String(), 9000)
+
+		status, err := useCase.GetBudgetStatus(ctx, budget.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 3000.0, status.Committed)
+		assert.Equal(t, 4000.0, status.Actual)
+		assert.Equal(t, 3000.0, status.Remaining)
+		assert.Equal(t, 70.0, status.UtilizationRate)
+		assert.False(t, status.Exceeded)
+
+		statuses, err := useCase.GetBudgetStatuses(ctx, &dto.BudgetStatusRequest{})
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		assert.Equal(t, budget.ID, statuses[0].Budget.ID)
+
+		// 期間外の日付では対象の予算がない
+		statuses, err = useCase.GetBudgetStatuses(ctx, &dto.BudgetStatusRequest{Date: today.AddDate(0, 1, 0).Format("2006-01-02")})
+		require.NoError(t, err)
+		assert.Empty(t, statuses)
+	})
+
+	t.Run("残額を超える経費の申請は警告付きで受け付ける", func(t *testing.T) {
+		within := createExpense(member.ID().String(), 1000)
+		response, err := expenseUseCase.SubmitExpense(ctx, within.ID)
+		require.NoError(t, err)
+		assert.Empty(t, response.Warnings)
+
+		over := createExpense(member.ID().String(), 2500)
+		response, err = expenseUseCase.SubmitExpense(ctx, over.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "submitted", response.Status)
+		require.Len(t, response.Warnings, 1)
+		assert.Contains(t, response.Warnings[0], "営業部交通費")
+		assert.Contains(t, response.Warnings[0], "2000 JPY")
+
+		status, err := useCase.GetBudgetStatus(ctx, budget.ID)
+		require.NoError(t, err)
+		assert.True(t, status.Exceeded)
+		assert.Equal(t, -500.0, status.Remaining)
+	})
+
+	t.Run("予算と異なる通貨の経費は残額と比べずに警告し、集計外の金額として返す", func(t *testing.T) {
+		foreign, err := expenseUseCase.CreateExpense(ctx, member.ID().String(), &dto.CreateExpenseRequest{
+			CategoryID: category.ID().String(), Amount: 30, Currency: "USD", Title: "海外のタクシー代", Date: today.Format("2006-01-02"),
+		})
+		require.NoError(t, err)
+		response, err := expenseUseCase.SubmitExpense(ctx, foreign.ID)
+		require.NoError(t, err)
+		require.Len(t, response.Warnings, 1)
+		assert.Contains(t, response.Warnings[0], "異なる通貨（USD）")
+
+		status, err := useCase.GetBudgetStatus(ctx, budget.ID)
+		require.NoError(t, err)
+		require.Len(t, status.Excluded, 1)
+		assert.Equal(t, 30.0, status.Excluded[0].Amount)
+		assert.Equal(t, "USD", status.Excluded[0].Currency)
+		assert.Equal(t, -500.0, status.Remaining)
+	})
+
+	t.Run("対象のない予算は作成できない", func(t *testing.T) {
+		_, err := useCase.CreateBudget(ctx, &dto.CreateBudgetRequest{
+			Name: "対象なし", PeriodFrom: today.Format("2006-01-02"), PeriodTo: today.Format("2006-01-02"), Amount: 1000,
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/pkg/errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cardStatementLine 明細ファイルから読み取った1件の利用明細
+type cardStatementLine struct {
+	line        int
+	externalID  string
+	date        time.Time
+	vendor      string
+	amount      float64
+	description string
+	skipReason  string // 取り込まない場合の理由
+}
+
+// defaultCardDateFormats 日付形式が指定されていない場合に試す形式
+var defaultCardDateFormats = []string{"2006-01-02", "2006/01/02", "2006/1/2"}
+
+// parseCardStatementCSV 列の対応に従ってCSVの利用明細を読み取る
+func parseCardStatementCSV(content string, mapping *dto.CardStatementMappingRequest) ([]*cardStatementLine, error) {
+	if mapping == nil {
+		return nil, errors.NewApplicationError(errors.InvalidCardStatement, "CSVの列の対応が必要です")
+	}
+
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(content, "\ufeff")))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var header []string
+	if !mapping.NoHeader {
+		record, err := reader.Read()
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.InvalidCardStatement, "CSVのヘッダーを読み取れません")
+		}
+		header = record
+	}
+
+	columns := make(map[string]int)
+	for name, column := range map[string]string{
+		"date":           mapping.Date,
+		"vendor":         mapping.Vendor,
+		"amount":         mapping.Amount,
+		"description":    mapping.Description,
+		"transaction_id": mapping.TransactionID,
+	} {
+		if column == "" {
+			continue
+		}
+
+		index, err := resolveCardColumn(header, column)
+		if err != nil {
+			return nil, err
+		}
+		columns[name] = index
+	}
+
+	dateFormats := defaultCardDateFormats
+	if mapping.DateFormat != "" {
+		dateFormats = []string{toGoDateLayout(mapping.DateFormat)}
+	}
+
+	lines := make([]*cardStatementLine, 0)
+	occurrences := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.InvalidCardStatement, "CSVを読み取れません: "+err.Error())
+		}
+
+		// 空白だけの行は無視
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		lineNumber, _ := reader.FieldPos(0)
+		line := &cardStatementLine{line: lineNumber}
+		lines = append(lines, line)
+
+		field := func(name string) string {
+			index, ok := columns[name]
+			if !ok || index >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[index])
+		}
+
+		line.vendor = field("vendor")
+		line.description = field("description")
+
+		line.date, err = parseStatementDate(field("date"), dateFormats)
+		if err != nil {
+			line.skipReason = "利用日を読み取れません: " + field("date")
+			continue
+		}
+
+		amount, err := parseStatementAmount(field("amount"))
+		if err != nil {
+			line.skipReason = "利用金額を読み取れません: " + field("amount")
+			continue
+		}
+		if mapping.NegateAmount {
+			amount = -amount
+		}
+		if amount <= 0 {
+			line.skipReason = "入金・返金の明細は取り込み対象外です"
+			continue
+		}
+		line.amount = amount
+
+		// 識別子の列がない場合は行の内容から生成（同じ内容の行は出現順で区別）
+		line.externalID = field("transaction_id")
+		if line.externalID == "" {
+			key := strings.Join([]string{line.date.Format("2006-01-02"), line.vendor, strconv.FormatFloat(line.amount, 'f', 2, 64), line.description}, "\x00")
+			occurrences[key]++
+			line.externalID = hashStatementLine("csv-", fmt.Sprintf("%s\x00%d", key, occurrences[key]))
+		}
+	}
+
+	return lines, nil
+}
+
+// ofxTransactionPattern OFXの取引ブロック
+var ofxTransactionPattern = regexp.MustCompile(`(?is)<STMTTRN>(.*?)</STMTTRN>`)
+
+// ofxElementPattern OFXの要素（SGML形式の閉じタグ省略にも対応）
+var ofxElementPattern = regexp.MustCompile(`(?i)<([A-Z0-9.]+)>([^<\r\n]*)`)
+
+// parseCardStatementOFX OFXの利用明細を読み取る
+// 利用額は負の値（出金）で記載されるため、正の値は入金・返金として取り込まない
+func parseCardStatementOFX(content string) ([]*cardStatementLine, string, error) {
+	blocks := ofxTransactionPattern.FindAllStringSubmatch(content, -1)
+	if len(blocks) == 0 {
+		if !strings.Contains(strings.ToUpper(content), "<OFX>") {
+			return nil, "", errors.NewApplicationError(errors.InvalidCardStatement, "OFX形式の明細ではありません")
+		}
+		return []*cardStatementLine{}, ofxCurrency(content), nil
+	}
+
+	lines := make([]*cardStatementLine, len(blocks))
+	for i, block := range blocks {
+		elements := make(map[string]string)
+		for _, match := range ofxElementPattern.FindAllStringSubmatch(block[1], -1) {
+			elements[strings.ToUpper(match[1])] = strings.TrimSpace(match[2])
+		}
+
+		line := &cardStatementLine{
+			line:        i + 1,
+			externalID:  elements["FITID"],
+			vendor:      elements["NAME"],
+			description: elements["MEMO"],
+		}
+		lines[i] = line
+
+		if line.externalID == "" {
+			line.skipReason = "取引の識別子（FITID）がありません"
+			continue
+		}
+
+		// DTPOSTEDは YYYYMMDD に時刻やタイムゾーンが続く形式
+		posted := elements["DTPOSTED"]
+		if len(posted) < 8 {
+			line.skipReason = "利用日を読み取れません: " + posted
+			continue
+		}
+		date, err := time.Parse("20060102", posted[:8])
+		if err != nil {
+			line.skipReason = "利用日を読み取れません: " + posted
+			continue
+		}
+		line.date = date
+
+		amount, err := parseStatementAmount(elements["TRNAMT"])
+		if err != nil {
+			line.skipReason = "利用金額を読み取れません: " + elements["TRNAMT"]
+			continue
+		}
+		if amount >= 0 {
+			line.skipReason = "入金・返金の明細は取り込み対象外です"
+			continue
+		}
+		line.amount = -amount
+	}
+
+	return lines, ofxCurrency(content), nil
+}
+
+// ofxCurrency OFXの既定通貨（CURDEF）を取得
+func ofxCurrency(content string) string {
+	for _, match := range ofxElementPattern.FindAllStringSubmatch(content, -1) {
+		if strings.EqualFold(match[1], "CURDEF") {
+			return strings.TrimSpace(match[2])
+		}
+	}
+	return ""
+}
+
+// resolveCardColumn ヘッダー名または1始まりの列番号から列の位置を求める
+func resolveCardColumn(header []string, column string) (int, error) {
+	if number, err := strconv.Atoi(column); err == nil {
+		if number < 1 {
+			return 0, errors.NewApplicationError(errors.InvalidCardStatement, "列番号は1以上である必要があります: "+column)
+		}
+		return number - 1, nil
+	}
+
+	for i, name := range header {
+		if strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")) == column {
+			return i, nil
+		}
+	}
+
+	return 0, errors.NewApplicationError(errors.InvalidCardStatement, "CSVに列が見つかりません: "+column)
+}
+
+// toGoDateLayout YYYY/MM/DD 形式の日付書式をGoのレイアウトに変換
+func toGoDateLayout(format string) string {
+	return strings.NewReplacer("YYYY", "2006", "MM", "01", "DD", "02").Replace(format)
+}
+
+// parseStatementDate いずれかの形式で日付を読み取る
+func parseStatementDate(value string, formats []string) (time.Time, error) {
+	var lastErr error
+	for _, format := range formats {
+		date, err := time.Parse(format, value)
+		if err == nil {
+			return date, nil
+		}
+		lastErr = err
+	}
+	return time.Time{}, lastErr
+}
+
+// parseStatementAmount 通貨記号・桁区切りを除いて金額を読み取る
+func parseStatementAmount(value string) (float64, error) {
+	value = strings.NewReplacer(",", "", "¥", "", "￥", "", "円", "", " ", "").Replace(value)
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return 0, fmt.Errorf("invalid amount: %s", value)
+	}
+	return amount, nil
+}
+
+// hashStatementLine 明細行の内容から識別子を生成
+func hashStatementLine(prefix, value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return prefix + hex.EncodeToString(sum[:16])
+}
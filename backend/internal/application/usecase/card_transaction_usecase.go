@@ -0,0 +1,525 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+)
+
+// CardTransactionUseCase 法人カード利用明細ユースケース
+type CardTransactionUseCase struct {
+	cardRepo     repository.CardTransactionRepository
+	expenseRepo  repository.ExpenseRepository
+	userRepo     repository.UserRepository
+	categoryRepo repository.CategoryRepository
+	periodGuard  *fiscalPeriodGuard
+	clock        clock.Clock
+}
+
+// CardTransactionUseCaseOption CardTransactionUseCaseの任意の依存関係を設定するオプション
+type CardTransactionUseCaseOption func(*CardTransactionUseCase)
+
+// WithCardFiscalPeriods 会計期間を設定（未設定の場合は締め済みの会計期間を確認しない）
+func WithCardFiscalPeriods(calendar *valueobject.FiscalCalendar, periodRepo repository.AccountingPeriodRepository) CardTransactionUseCaseOption {
+	return func(uc *CardTransactionUseCase) {
+		uc.periodGuard = &fiscalPeriodGuard{calendar: calendar, periodRepo: periodRepo, clock: uc.clock}
+	}
+}
+
+// NewCardTransactionUseCase CardTransactionUseCaseのコンストラクタ
+func NewCardTransactionUseCase(
+	cardRepo repository.CardTransactionRepository,
+	expenseRepo repository.ExpenseRepository,
+	userRepo repository.UserRepository,
+	categoryRepo repository.CategoryRepository,
+	clk clock.Clock,
+	opts ...CardTransactionUseCaseOption,
+) *CardTransactionUseCase {
+	uc := &CardTransactionUseCase{
+		cardRepo:     cardRepo,
+		expenseRepo:  expenseRepo,
+		userRepo:     userRepo,
+		categoryRepo: categoryRepo,
+		clock:        clk,
+	}
+
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
+
+// ImportStatement カード会社の利用明細（CSV・OFX）を取り込む
+// 取込済みの明細や入金・返金、読み取れない行は取り込まずに理由を返す
+func (uc *CardTransactionUseCase) ImportStatement(ctx context.Context, userID string, req *dto.ImportCardStatementRequest) (*dto.ImportCardStatementResponse, error) {
+	uid, err := uc.findUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []*cardStatementLine
+	currency := req.Currency
+	switch req.Format {
+	case "csv":
+		lines, err = parseCardStatementCSV(req.Content, req.Mapping)
+	case "ofx":
+		var statementCurrency string
+		lines, statementCurrency, err = parseCardStatementOFX(req.Content)
+		if currency == "" {
+			currency = statementCurrency
+		}
+	default:
+		err = errors.NewApplicationError(errors.InvalidCardStatement, "未対応の明細形式です: "+req.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ImportCardStatementResponse{
+		Transactions: make([]*dto.CardTransactionResponse, 0),
+		Skipped:      make([]*dto.CardStatementLineResponse, 0),
+	}
+	skip := func(line int, reason string) {
+		response.Skipped = append(response.Skipped, &dto.CardStatementLineResponse{Line: line, Reason: reason})
+	}
+
+	seen := make(map[string]bool)
+	for _, line := range lines {
+		if line.skipReason != "" {
+			skip(line.line, line.skipReason)
+			continue
+		}
+
+		if seen[line.externalID] {
+			skip(line.line, "同じ明細がファイル内で重複しています")
+			continue
+		}
+		seen[line.externalID] = true
+
+		if existing, err := uc.cardRepo.FindByExternalID(ctx, uid, line.externalID); err == nil && existing != nil {
+			skip(line.line, "取込済みの明細です")
+			continue
+		}
+
+		amount, err := valueobject.NewMoney(line.amount, currency)
+		if err != nil {
+			skip(line.line, err.Error())
+			continue
+		}
+
+		transaction, err := entity.NewCardTransaction(uc.clock, uid, line.externalID, line.date, line.vendor, amount, line.description)
+		if err != nil {
+			skip(line.line, err.Error())
+			continue
+		}
+
+		if err := uc.cardRepo.Save(ctx, transaction); err != nil {
+			return nil, errors.NewApplicationError(errors.CardTransactionImportFailed, "カード利用明細の取り込みに失敗しました")
+		}
+
+		response.Transactions = append(response.Transactions, buildCardTransactionResponse(transaction))
+	}
+
+	response.ImportedCount = len(response.Transactions)
+	response.SkippedCount = len(response.Skipped)
+
+	return response, nil
+}
+
+// GetCardTransaction カード利用明細を取得
+func (uc *CardTransactionUseCase) GetCardTransaction(ctx context.Context, transactionID string) (*dto.CardTransactionResponse, error) {
+	transaction, err := uc.findCardTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildCardTransactionResponse(transaction), nil
+}
+
+// GetCardTransactionsByUser ユーザーのカード利用明細一覧を取得
+func (uc *CardTransactionUseCase) GetCardTransactionsByUser(ctx context.Context, userID string) ([]*dto.CardTransactionResponse, error) {
+	uid, err := uc.findUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := uc.cardRepo.FindByUserID(ctx, uid)
+	if err != nil {
+		return nil, errors.NewApplicationError("CARD_TRANSACTION_FETCH_FAILED", "カード利用明細一覧の取得に失敗しました")
+	}
+
+	sortCardTransactions(transactions)
+
+	responses := make([]*dto.CardTransactionResponse, len(transactions))
+	for i, transaction := range transactions {
+		responses[i] = buildCardTransactionResponse(transaction)
+	}
+
+	return responses, nil
+}
+
+// GetUnreconciledTransactions ユーザーの未照合のカード利用明細一覧を取得
+func (uc *CardTransactionUseCase) GetUnreconciledTransactions(ctx context.Context, userID string) (*dto.UnreconciledCardTransactionsResponse, error) {
+	uid, err := uc.findUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := uc.cardRepo.FindByUserIDAndStatus(ctx, uid, entity.CardTransactionStatusUnmatched)
+	if err != nil {
+		return nil, errors.NewApplicationError("CARD_TRANSACTION_FETCH_FAILED", "カード利用明細一覧の取得に失敗しました")
+	}
+
+	sortCardTransactions(transactions)
+
+	responses := make([]*dto.CardTransactionResponse, len(transactions))
+	for i, transaction := range transactions {
+		responses[i] = buildCardTransactionResponse(transaction)
+	}
+
+	return &dto.UnreconciledCardTransactionsResponse{
+		UserID:       userID,
+		Transactions: responses,
+		Count:        len(responses),
+	}, nil
+}
+
+// MatchCardTransaction カード利用明細を指定した経費と手動で照合
+func (uc *CardTransactionUseCase) MatchCardTransaction(ctx context.Context, transactionID string, req *dto.MatchCardTransactionRequest) (*dto.CardTransactionResponse, error) {
+	transaction, err := uc.findCardTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if transaction.IsReconciled() {
+		return nil, errors.NewApplicationError(errors.CardTransactionAlreadyMatched, "カード利用明細は既に照合済みです")
+	}
+
+	expenseID, err := valueobject.NewExpenseID(req.ExpenseID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	expense, err := uc.expenseRepo.FindByID(ctx, expenseID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseNotFound, "経費が見つかりません")
+	}
+
+	if expense.Status() == entity.ExpenseStatusRejected {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, "却下された経費とは照合できません")
+	}
+
+	if other, err := uc.cardRepo.FindByExpenseID(ctx, expenseID); err == nil && other != nil {
+		return nil, errors.NewApplicationError(errors.CardTransactionAlreadyMatched, "経費は既に他のカード利用明細と照合されています")
+	}
+
+	if err := transaction.Match(expense, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.cardRepo.Update(ctx, transaction); err != nil {
+		return nil, errors.NewApplicationError(errors.CardTransactionUpdateFailed, "カード利用明細の照合に失敗しました")
+	}
+
+	return buildCardTransactionResponse(transaction), nil
+}
+
+// UnmatchCardTransaction カード利用明細と経費の照合を解除
+func (uc *CardTransactionUseCase) UnmatchCardTransaction(ctx context.Context, transactionID string) (*dto.CardTransactionResponse, error) {
+	transaction, err := uc.findCardTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := transaction.Unmatch(uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.cardRepo.Update(ctx, transaction); err != nil {
+		return nil, errors.NewApplicationError(errors.CardTransactionUpdateFailed, "カード利用明細の照合解除に失敗しました")
+	}
+
+	return buildCardTransactionResponse(transaction), nil
+}
+
+// ReconcileTransactions 未照合のカード利用明細を金額・日付・利用先の類似度で既存の経費と照合
+// カテゴリが指定された場合は、照合できなかった明細から下書きの経費を作成する
+func (uc *CardTransactionUseCase) ReconcileTransactions(ctx context.Context, userID string, req *dto.ReconcileCardTransactionsRequest) (*dto.ReconcileCardTransactionsResponse, error) {
+	uid, err := uc.findUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var category *entity.Category
+	if req.CategoryID != "" {
+		categoryID, err := valueobject.NewCategoryID(req.CategoryID)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		if category, err = uc.categoryRepo.FindByID(ctx, categoryID); err != nil {
+			return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
+		}
+	}
+
+	transactions, err := uc.cardRepo.FindByUserIDAndStatus(ctx, uid, entity.CardTransactionStatusUnmatched)
+	if err != nil {
+		return nil, errors.NewApplicationError("CARD_TRANSACTION_FETCH_FAILED", "カード利用明細一覧の取得に失敗しました")
+	}
+	sortCardTransactions(transactions)
+
+	candidates, err := uc.findMatchCandidates(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ReconcileCardTransactionsResponse{
+		Matched:   make([]*dto.CardReconciliationItemResponse, 0),
+		Created:   make([]*dto.CardReconciliationItemResponse, 0),
+		Unmatched: make([]*dto.CardReconciliationItemResponse, 0),
+	}
+
+	// スコアの高い組み合わせから順に、明細と経費が1対1になるように照合する
+	matched := make(map[string]bool)
+	for _, pair := range rankCardMatches(transactions, candidates) {
+		if matched[pair.transaction.ID().String()] || matched[pair.expense.ID().String()] {
+			continue
+		}
+
+		if err := pair.transaction.Match(pair.expense, uc.clock.Now()); err != nil {
+			continue
+		}
+
+		if err := uc.cardRepo.Update(ctx, pair.transaction); err != nil {
+			return nil, errors.NewApplicationError(errors.CardTransactionUpdateFailed, "カード利用明細の照合に失敗しました")
+		}
+
+		matched[pair.transaction.ID().String()] = true
+		matched[pair.expense.ID().String()] = true
+		response.Matched = append(response.Matched, &dto.CardReconciliationItemResponse{
+			Transaction: buildCardTransactionResponse(pair.transaction),
+			ExpenseID:   pair.expense.ID().String(),
+			Score:       pair.score,
+		})
+	}
+
+	for _, transaction := range transactions {
+		if matched[transaction.ID().String()] {
+			continue
+		}
+
+		if category == nil {
+			response.Unmatched = append(response.Unmatched, &dto.CardReconciliationItemResponse{
+				Transaction: buildCardTransactionResponse(transaction),
+			})
+			continue
+		}
+
+		expense, err := uc.createDraftExpense(ctx, transaction, category)
+		if err != nil {
+			response.Unmatched = append(response.Unmatched, &dto.CardReconciliationItemResponse{
+				Transaction: buildCardTransactionResponse(transaction),
+				Error:       err.Error(),
+			})
+			continue
+		}
+
+		response.Created = append(response.Created, &dto.CardReconciliationItemResponse{
+			Transaction: buildCardTransactionResponse(transaction),
+			ExpenseID:   expense.ID().String(),
+		})
+	}
+
+	return response, nil
+}
+
+// createDraftExpense カード利用明細から下書きの経費を作成して照合
+func (uc *CardTransactionUseCase) createDraftExpense(ctx context.Context, transaction *entity.CardTransaction, category *entity.Category) (*entity.Expense, error) {
+	title := transaction.Vendor()
+	for len(title) > 100 {
+		_, size := utf8.DecodeLastRuneInString(title)
+		title = title[:len(title)-size]
+	}
+
+	description := fmt.Sprintf("法人カード利用明細から作成（利用日: %s）", transaction.TransactionDate().Format("2006-01-02"))
+	if transaction.Description() != "" {
+		description += " " + transaction.Description()
+	}
+	for len(description) > 500 {
+		_, size := utf8.DecodeLastRuneInString(description)
+		description = description[:len(description)-size]
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, transaction.UserID())
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	expense, _, err := entity.NewExpenseInCategory(uc.clock, user, category, transaction.Amount(), title, description, valueobject.DateOf(transaction.TransactionDate()))
+	if err != nil {
+		return nil, err
+	}
+
+	// 利用先を支払先として、同じ利用を手入力した経費との重複を検出できるようにする
+	if err := expense.ChangeReceipt(title, "", uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// 締め済みの会計期間の利用明細からは経費を作成しない
+	if err := uc.periodGuard.ensureOpen(ctx, expense.Date()); err != nil {
+		return nil, err
+	}
+
+	// 経費を保存してから照合する（保存されていない経費と照合済みにならないように）
+	if err := uc.expenseRepo.Save(ctx, expense); err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseCreationFailed, "経費の作成に失敗しました")
+	}
+
+	if err := transaction.Match(expense, uc.clock.Now()); err != nil {
+		uc.discardDraftExpense(ctx, expense)
+		return nil, err
+	}
+
+	if err := uc.cardRepo.Update(ctx, transaction); err != nil {
+		_ = transaction.Unmatch(uc.clock.Now())
+		uc.discardDraftExpense(ctx, expense)
+		return nil, errors.NewApplicationError(errors.CardTransactionUpdateFailed, "カード利用明細の照合に失敗しました")
+	}
+
+	return expense, nil
+}
+
+// discardDraftExpense 照合できなかった明細から作成した下書きの経費を削除（次回の照合で再び作成する）
+func (uc *CardTransactionUseCase) discardDraftExpense(ctx context.Context, expense *entity.Expense) {
+	_ = uc.expenseRepo.Delete(ctx, expense.ID())
+}
+
+// findMatchCandidates 照合候補の経費（却下されておらず、他の明細と照合されていない経費）を取得
+func (uc *CardTransactionUseCase) findMatchCandidates(ctx context.Context, userID *valueobject.UserID) ([]*entity.Expense, error) {
+	expenses, err := uc.expenseRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.NewApplicationError("EXPENSE_FETCH_FAILED", "経費一覧の取得に失敗しました")
+	}
+
+	reconciled, err := uc.cardRepo.FindByUserIDAndStatus(ctx, userID, entity.CardTransactionStatusMatched)
+	if err != nil {
+		return nil, errors.NewApplicationError("CARD_TRANSACTION_FETCH_FAILED", "カード利用明細一覧の取得に失敗しました")
+	}
+
+	linked := make(map[string]bool)
+	for _, transaction := range reconciled {
+		linked[transaction.ExpenseID().String()] = true
+	}
+
+	candidates := make([]*entity.Expense, 0)
+	for _, expense := range expenses {
+		if expense.Status() == entity.ExpenseStatusRejected || linked[expense.ID().String()] {
+			continue
+		}
+		candidates = append(candidates, expense)
+	}
+
+	return candidates, nil
+}
+
+// cardMatch 照合候補の組み合わせ
+type cardMatch struct {
+	transaction *entity.CardTransaction
+	expense     *entity.Expense
+	score       float64
+}
+
+// rankCardMatches 照合スコアが基準以上の組み合わせをスコアの高い順に並べる
+func rankCardMatches(transactions []*entity.CardTransaction, expenses []*entity.Expense) []*cardMatch {
+	matches := make([]*cardMatch, 0)
+	for _, transaction := range transactions {
+		for _, expense := range expenses {
+			score := transaction.MatchScore(expense)
+			if score >= entity.CardMatchThreshold {
+				matches = append(matches, &cardMatch{transaction: transaction, expense: expense, score: score})
+			}
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		if !matches[i].transaction.TransactionDate().Equal(matches[j].transaction.TransactionDate()) {
+			return matches[i].transaction.TransactionDate().Before(matches[j].transaction.TransactionDate())
+		}
+		return matches[i].expense.CreatedAt().Before(matches[j].expense.CreatedAt())
+	})
+
+	return matches
+}
+
+// sortCardTransactions 利用日の順に並べる
+func sortCardTransactions(transactions []*entity.CardTransaction) {
+	sort.SliceStable(transactions, func(i, j int) bool {
+		if !transactions[i].TransactionDate().Equal(transactions[j].TransactionDate()) {
+			return transactions[i].TransactionDate().Before(transactions[j].TransactionDate())
+		}
+		return transactions[i].ImportedAt().Before(transactions[j].ImportedAt())
+	})
+}
+
+// findUserID ユーザーの存在を確認してユーザーIDを取得
+func (uc *CardTransactionUseCase) findUserID(ctx context.Context, userID string) (*valueobject.UserID, error) {
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if _, err := uc.userRepo.FindByID(ctx, uid); err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	return uid, nil
+}
+
+// findCardTransaction IDでカード利用明細を取得
+func (uc *CardTransactionUseCase) findCardTransaction(ctx context.Context, transactionID string) (*entity.CardTransaction, error) {
+	id, err := valueobject.NewCardTransactionID(transactionID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	transaction, err := uc.cardRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.CardTransactionNotFound, "カード利用明細が見つかりません")
+	}
+
+	return transaction, nil
+}
+
+// buildCardTransactionResponse カード利用明細レスポンスを構築
+func buildCardTransactionResponse(transaction *entity.CardTransaction) *dto.CardTransactionResponse {
+	response := &dto.CardTransactionResponse{
+		ID:              transaction.ID().String(),
+		UserID:          transaction.UserID().String(),
+		ExternalID:      transaction.ExternalID(),
+		TransactionDate: transaction.TransactionDate(),
+		Vendor:          transaction.Vendor(),
+		Amount:          transaction.Amount().Amount(),
+		Currency:        transaction.Amount().Currency(),
+		Description:     transaction.Description(),
+		Status:          string(transaction.Status()),
+		ImportedAt:      transaction.ImportedAt(),
+		UpdatedAt:       transaction.UpdatedAt(),
+	}
+
+	if transaction.ExpenseID() != nil {
+		response.ExpenseID = transaction.ExpenseID().String()
+	}
+
+	return response
+}
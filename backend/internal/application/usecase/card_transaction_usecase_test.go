@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/internal/infrastructure/persistence"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardTransactionUseCase_ImportAndReconcile(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	cardRepo := persistence.NewMemoryCardTransactionRepository()
+
+	// ユースケースを初期化
+	useCase := NewCardTransactionUseCase(cardRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	// テスト用のユーザーとカテゴリを作成
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	category, _ := entity.NewCategory(fakeClock, "交通費", "電車・タクシー代", "#FF0000")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	day := func(days int) time.Time {
+		base := fakeClock.Now().AddDate(0, 0, days)
+		return time.Date(base.Year(), base.Month(), base.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	// 既存の経費（タクシー代は利用日の翌日で登録）
+	taxiAmount, _ := valueobject.NewMoney(4400, "JPY")
+	taxi, err := entity.NewExpense(fakeClock, user.ID(), category.ID(), taxiAmount, "東京タクシー", "客先訪問", valueobject.DateOf(day(-9)))
+	require.NoError(t, err)
+	require.NoError(t, expenseRepo.Save(ctx, taxi))
+
+	csvContent := fmt.Sprintf("利用日,利用店名,利用金額,備考\n"+
+		"%s,東京タクシー,\"4,400\",\n"+
+		"%s,ABC書店,1980,書籍\n"+
+		"%s,ABC書店,-1980,返品\n"+
+		"不明,不明な明細,100,\n",
+		day(-10).Format("2006/01/02"), day(-8).Format("2006/01/02"), day(-7).Format("2006/01/02"))
+	mapping := &dto.CardStatementMappingRequest{
+		Date:        "利用日",
+		Vendor:      "利用店名",
+		Amount:      "利用金額",
+		Description: "備考",
+		DateFormat:  "YYYY/MM/DD",
+	}
+
+	t.Run("CSVの取り込み", func(t *testing.T) {
+		result, err := useCase.ImportStatement(ctx, user.ID().String(), &dto.ImportCardStatementRequest{
+			Format:  "csv",
+			Content: csvContent,
+			Mapping: mapping,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, result.ImportedCount)
+		assert.Equal(t, 2, result.SkippedCount)
+		assert.Equal(t, 4400.0, result.Transactions[0].Amount)
+		assert.Equal(t, "JPY", result.Transactions[0].Currency)
+		assert.Equal(t, "unmatched", result.Transactions[0].Status)
+		assert.Equal(t, 4, result.Skipped[0].Line)
+		assert.Equal(t, 5, result.Skipped[1].Line)
+	})
+
+	t.Run("取込済みの明細は再度取り込まない", func(t *testing.T) {
+		result, err := useCase.ImportStatement(ctx, user.ID().String(), &dto.ImportCardStatementRequest{
+			Format:  "csv",
+			Content: csvContent,
+			Mapping: mapping,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.ImportedCount)
+		assert.Equal(t, 4, result.SkippedCount)
+	})
+
+	t.Run("存在しない列を指定した場合はエラー", func(t *testing.T) {
+		result, err := useCase.ImportStatement(ctx, user.ID().String(), &dto.ImportCardStatementRequest{
+			Format:  "csv",
+			Content: csvContent,
+			Mapping: &dto.CardStatementMappingRequest{Date: "日付", Vendor: "利用店名", Amount: "利用金額"},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("OFXの取り込み", func(t *testing.T) {
+		ofxContent := fmt.Sprintf(`OFXHEADER:100
+<OFX>
+<CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>
+<CURDEF>JPY
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>%s120000[+9:JST]
+<TRNAMT>-12000
+<FITID>202401150001
+<NAME>HOTEL OSAKA
+<MEMO>宿泊
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>%s
+<TRNAMT>5000
+<FITID>202401150002
+<NAME>PAYMENT
+</STMTTRN>
+</BANKTRANLIST>
+</CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1>
+</OFX>`, day(-6).Format("20060102"), day(-5).Format("20060102"))
+
+		result, err := useCase.ImportStatement(ctx, user.ID().String(), &dto.ImportCardStatementRequest{
+			Format:  "ofx",
+			Content: ofxContent,
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, 1, result.ImportedCount)
+		assert.Equal(t, "202401150001", result.Transactions[0].ExternalID)
+		assert.Equal(t, "HOTEL OSAKA", result.Transactions[0].Vendor)
+		assert.Equal(t, 12000.0, result.Transactions[0].Amount)
+		assert.Equal(t, 1, result.SkippedCount)
+	})
+
+	t.Run("既存の経費と照合し、照合できない明細は未照合として残る", func(t *testing.T) {
+		result, err := useCase.ReconcileTransactions(ctx, user.ID().String(), &dto.ReconcileCardTransactionsRequest{})
+		require.NoError(t, err)
+
+		require.Len(t, result.Matched, 1)
+		assert.Equal(t, taxi.ID().String(), result.Matched[0].ExpenseID)
+		assert.Equal(t, "matched", result.Matched[0].Transaction.Status)
+		assert.Empty(t, result.Created)
+		assert.Len(t, result.Unmatched, 2)
+
+		unreconciled, err := useCase.GetUnreconciledTransactions(ctx, user.ID().String())
+		require.NoError(t, err)
+		assert.Equal(t, 2, unreconciled.Count)
+	})
+
+	t.Run("照合できない明細から下書きの経費を作成", func(t *testing.T) {
+		result, err := useCase.ReconcileTransactions(ctx, user.ID().String(), &dto.ReconcileCardTransactionsRequest{
+			CategoryID: category.ID().String(),
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, result.Matched)
+		require.Len(t, result.Created, 2)
+		assert.Empty(t, result.Unmatched)
+
+		expense, err := expenseRepo.FindByID(ctx, mustExpenseID(t, result.Created[0].ExpenseID))
+		require.NoError(t, err)
+		assert.Equal(t, entity.ExpenseStatusDraft, expense.Status())
+		assert.Equal(t, "ABC書店", expense.Title())
+		assert.Equal(t, 1980.0, expense.Amount().Amount())
+
+		unreconciled, err := useCase.GetUnreconciledTransactions(ctx, user.ID().String())
+		require.NoError(t, err)
+		assert.Equal(t, 0, unreconciled.Count)
+	})
+
+	t.Run("照合済みの経費は他の明細と手動で照合できない", func(t *testing.T) {
+		amount, _ := valueobject.NewMoney(4400, "JPY")
+		transaction, err := entity.NewCardTransaction(fakeClock, user.ID(), "MANUAL-001", day(-9), "東京タクシー", amount, "")
+		require.NoError(t, err)
+		require.NoError(t, cardRepo.Save(ctx, transaction))
+
+		result, err := useCase.MatchCardTransaction(ctx, transaction.ID().String(), &dto.MatchCardTransactionRequest{
+			ExpenseID: taxi.ID().String(),
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func mustExpenseID(t *testing.T, value string) *valueobject.ExpenseID {
+	t.Helper()
+
+	id, err := valueobject.NewExpenseID(value)
+	require.NoError(t, err)
+	return id
+}
+
+// failingSaveExpenseRepository 経費の保存に失敗するテスト用リポジトリ
+type failingSaveExpenseRepository struct {
+	*persistence.MemoryExpenseRepository
+}
+
+func (r *failingSaveExpenseRepository) Save(ctx context.Context, expense *entity.Expense) error {
+	return fmt.Errorf("storage unavailable")
+}
+
+func TestCardTransactionUseCase_ReconcileExpenseSaveFailure(t *testing.T) {
+	ctx := context.Background()
+
+	// リポジトリを初期化（経費の保存は常に失敗する）
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := &failingSaveExpenseRepository{persistence.NewMemoryExpenseRepository()}
+	cardRepo := persistence.NewMemoryCardTransactionRepository()
+
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	useCase := NewCardTransactionUseCase(cardRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	category, _ := entity.NewCategory(fakeClock, "消耗品費", "文房具など", "#00FF00")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	amount, _ := valueobject.NewMoney(1980, "JPY")
+	transaction, err := entity.NewCardTransaction(fakeClock, user.ID(), "CARD-001", time.Date(2026, 4, 8, 0, 0, 0, 0, time.UTC), "ABC書店", amount, "")
+	require.NoError(t, err)
+	require.NoError(t, cardRepo.Save(ctx, transaction))
+
+	result, err := useCase.ReconcileTransactions(ctx, user.ID().String(), &dto.ReconcileCardTransactionsRequest{
+		CategoryID: category.ID().String(),
+	})
+	require.NoError(t, err)
+
+	// 経費を保存できなかった明細は未照合のまま残る
+	assert.Empty(t, result.Created)
+	require.Len(t, result.Unmatched, 1)
+	assert.NotEmpty(t, result.Unmatched[0].Error)
+	assert.Equal(t, entity.CardTransactionStatusUnmatched, transaction.Status())
+	assert.Nil(t, transaction.ExpenseID())
+}
@@ -3,23 +3,27 @@ package usecase
 import (
 	"context"
 	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
 	"expense-management-system/internal/domain/entity"
 	"expense-management-system/internal/domain/repository"
 	"expense-management-system/internal/domain/valueobject"
 	"expense-management-system/pkg/errors"
+	"time"
 )
 
 // CategoryUseCase カテゴリユースケース
 type CategoryUseCase struct {
 	categoryRepo repository.CategoryRepository
 	expenseRepo  repository.ExpenseRepository
+	clock        clock.Clock
 }
 
 // NewCategoryUseCase CategoryUseCaseのコンストラクタ
-func NewCategoryUseCase(categoryRepo repository.CategoryRepository, expenseRepo repository.ExpenseRepository) *CategoryUseCase {
+func NewCategoryUseCase(categoryRepo repository.CategoryRepository, expenseRepo repository.ExpenseRepository, clk clock.Clock) *CategoryUseCase {
 	return &CategoryUseCase{
 		categoryRepo: categoryRepo,
 		expenseRepo:  expenseRepo,
+		clock:        clk,
 	}
 }
 
@@ -32,24 +36,30 @@ func (uc *CategoryUseCase) CreateCategory(ctx context.Context, req *dto.CreateCa
 	}
 
 	// 新しいカテゴリを作成
-	category, err := entity.NewCategory(req.Name, req.Description, req.Color)
+	category, err := entity.NewCategory(uc.clock, req.Name, req.Description, req.Color)
 	if err != nil {
 		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
 	}
 
+	if err := changeAccountMapping(category, req.Accounting, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := changeDatePolicy(category, req.DatePolicy, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := changeSpendingRules(category, req.Rules, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+	category.ChangeEntertainment(req.Entertainment, uc.clock.Now())
+
 	// カテゴリを保存
 	if err := uc.categoryRepo.Save(ctx, category); err != nil {
 		return nil, errors.NewApplicationError(errors.CategoryCreationFailed, "カテゴリの作成に失敗しました")
 	}
 
-	return &dto.CategoryResponse{
-		ID:          category.ID().String(),
-		Name:        category.Name(),
-		Description: category.Description(),
-		Color:       category.Color(),
-		CreatedAt:   category.CreatedAt(),
-		UpdatedAt:   category.UpdatedAt(),
-	}, nil
+	return buildCategoryResponse(category), nil
 }
 
 // GetCategory カテゴリを取得
@@ -64,14 +74,7 @@ func (uc *CategoryUseCase) GetCategory(ctx context.Context, categoryID string) (
 		return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
 	}
 
-	return &dto.CategoryResponse{
-		ID:          category.ID().String(),
-		Name:        category.Name(),
-		Description: category.Description(),
-		Color:       category.Color(),
-		CreatedAt:   category.CreatedAt(),
-		UpdatedAt:   category.UpdatedAt(),
-	}, nil
+	return buildCategoryResponse(category), nil
 }
 
 // UpdateCategory カテゴリを更新
@@ -95,23 +98,29 @@ func (uc *CategoryUseCase) UpdateCategory(ctx context.Context, categoryID string
 	}
 
 	// カテゴリ情報を更新
-	if err := category.Update(req.Name, req.Description, req.Color); err != nil {
+	if err := category.Update(req.Name, req.Description, req.Color, uc.clock.Now()); err != nil {
 		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
 	}
 
+	if err := changeAccountMapping(category, req.Accounting, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := changeDatePolicy(category, req.DatePolicy, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := changeSpendingRules(category, req.Rules, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+	category.ChangeEntertainment(req.Entertainment, uc.clock.Now())
+
 	// カテゴリを保存
 	if err := uc.categoryRepo.Update(ctx, category); err != nil {
 		return nil, errors.NewApplicationError(errors.CategoryUpdateFailed, "カテゴリの更新に失敗しました")
 	}
 
-	return &dto.CategoryResponse{
-		ID:          category.ID().String(),
-		Name:        category.Name(),
-		Description: category.Description(),
-		Color:       category.Color(),
-		CreatedAt:   category.CreatedAt(),
-		UpdatedAt:   category.UpdatedAt(),
-	}, nil
+	return buildCategoryResponse(category), nil
 }
 
 // DeleteCategory カテゴリを削除
@@ -156,15 +165,169 @@ func (uc *CategoryUseCase) GetAllCategories(ctx context.Context) ([]*dto.Categor
 
 	responses := make([]*dto.CategoryResponse, len(categories))
 	for i, category := range categories {
-		responses[i] = &dto.CategoryResponse{
-			ID:          category.ID().String(),
-			Name:        category.Name(),
-			Description: category.Description(),
-			Color:       category.Color(),
-			CreatedAt:   category.CreatedAt(),
-			UpdatedAt:   category.UpdatedAt(),
-		}
+		responses[i] = buildCategoryResponse(category)
 	}
 
 	return responses, nil
 }
+
+// changeAccountMapping リクエストの仕訳の対応をカテゴリに設定（nilの場合は解除）
+func changeAccountMapping(category *entity.Category, req *dto.AccountMappingRequest, now time.Time) error {
+	if req == nil {
+		category.ChangeAccountMapping(nil, now)
+		return nil
+	}
+
+	mapping, err := valueobject.NewAccountMapping(req.DebitAccount, req.TaxCode, req.Department)
+	if err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	category.ChangeAccountMapping(mapping, now)
+	return nil
+}
+
+// changeDatePolicy リクエストの経費日付として認める期間をカテゴリに設定（nilの場合は既定の期間）
+func changeDatePolicy(category *entity.Category, req *dto.DatePolicyRequest, now time.Time) error {
+	if req == nil {
+		category.ChangeDatePolicy(nil, now)
+		return nil
+	}
+
+	policy, err := valueobject.NewExpenseDatePolicy(req.MaxAgeDays, req.FutureDays, req.Enforcement)
+	if err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	category.ChangeDatePolicy(policy, now)
+	return nil
+}
+
+// changeSpendingRules リクエストの支出規程のルールをカテゴリに設定（空の場合は解除）
+func changeSpendingRules(category *entity.Category, reqs []dto.SpendingRuleRequest, now time.Time) error {
+	rules := make([]*valueobject.SpendingRule, 0, len(reqs))
+	for _, req := range reqs {
+		rule, err := newSpendingRule(req)
+		if err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := category.ChangeSpendingRules(rules, now); err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+	return nil
+}
+
+// newSpendingRule リクエストのルールの種類に応じて支出規程のルールを作成
+func newSpendingRule(req dto.SpendingRuleRequest) (*valueobject.SpendingRule, error) {
+	switch valueobject.SpendingRuleType(req.Type) {
+	case valueobject.SpendingRuleMaxAmount, valueobject.SpendingRuleMaxPerHead:
+		currency := req.Currency
+		if currency == "" {
+			currency = "JPY"
+		}
+		maxAmount, err := valueobject.NewMoney(req.MaxAmount, currency)
+		if err != nil {
+			return nil, err
+		}
+		if req.Type == string(valueobject.SpendingRuleMaxPerHead) {
+			return valueobject.NewMaxPerHeadRule(maxAmount, req.Enforcement)
+		}
+		return valueobject.NewMaxAmountRule(maxAmount, req.Enforcement)
+	case valueobject.SpendingRuleRequiredFields:
+		return valueobject.NewRequiredFieldsRule(req.Fields, req.Enforcement)
+	case valueobject.SpendingRuleWeekdays:
+		return valueobject.NewWeekdaysRule(req.Weekdays, req.Enforcement)
+	case valueobject.SpendingRuleTimeWindow:
+		from, err := valueobject.ParseTimeOfDay(req.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := valueobject.ParseTimeOfDay(req.To)
+		if err != nil {
+			return nil, err
+		}
+		return valueobject.NewTimeWindowRule(from, to, req.Enforcement)
+	case valueobject.SpendingRuleCurrency:
+		return valueobject.NewCurrencyRule(req.Currencies, req.Enforcement)
+	case valueobject.SpendingRuleMinDistance:
+		return valueobject.NewMinDistanceRule(req.MinDistanceKm, req.Enforcement)
+	case valueobject.SpendingRuleAnyOf:
+		rules := make([]*valueobject.SpendingRule, 0, len(req.Rules))
+		for _, child := range req.Rules {
+			rule, err := newSpendingRule(child)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+		}
+		return valueobject.NewAnyOfRule(rules, req.Enforcement)
+	default:
+		return nil, errors.NewDomainError(errors.InvalidSpendingRule, "無効なルールの種類です: "+req.Type)
+	}
+}
+
+// buildCategoryResponse カテゴリレスポンスを構築
+func buildCategoryResponse(category *entity.Category) *dto.CategoryResponse {
+	response := &dto.CategoryResponse{
+		ID:          category.ID().String(),
+		Name:        category.Name(),
+		Description: category.Description(),
+		Color:       category.Color(),
+		CreatedAt:   category.CreatedAt(),
+		UpdatedAt:   category.UpdatedAt(),
+
+		Entertainment: category.IsEntertainment(),
+	}
+
+	if mapping := category.AccountMapping(); mapping != nil {
+		response.Accounting = &dto.AccountMappingResponse{
+			DebitAccount: mapping.DebitAccount(),
+			TaxCode:      string(mapping.TaxCode()),
+			Department:   mapping.Department(),
+		}
+	}
+
+	if policy := category.DatePolicy(); policy != nil {
+		response.DatePolicy = &dto.DatePolicyResponse{
+			MaxAgeDays:  policy.MaxAgeDays(),
+			FutureDays:  policy.FutureDays(),
+			Enforcement: string(policy.Enforcement()),
+		}
+	}
+
+	for _, rule := range category.SpendingRules() {
+		response.Rules = append(response.Rules, buildSpendingRuleResponse(rule))
+	}
+
+	return response
+}
+
+// buildSpendingRuleResponse 支出規程のルールレスポンスを構築
+func buildSpendingRuleResponse(rule *valueobject.SpendingRule) dto.SpendingRuleResponse {
+	response := dto.SpendingRuleResponse{
+		Type:          string(rule.Type()),
+		Enforcement:   string(rule.Enforcement()),
+		Fields:        rule.Fields(),
+		Currencies:    rule.Currencies(),
+		MinDistanceKm: rule.MinDistanceKm(),
+	}
+	switch rule.Type() {
+	case valueobject.SpendingRuleMaxAmount, valueobject.SpendingRuleMaxPerHead:
+		response.MaxAmount = rule.MaxAmount().Amount()
+		response.Currency = rule.MaxAmount().Currency()
+	case valueobject.SpendingRuleWeekdays:
+		response.Weekdays = rule.Weekdays()
+	case valueobject.SpendingRuleTimeWindow:
+		from, to := rule.TimeWindow()
+		response.From = from.String()
+		response.To = to.String()
+	case valueobject.SpendingRuleAnyOf:
+		for _, child := range rule.Rules() {
+			response.Rules = append(response.Rules, buildSpendingRuleResponse(child))
+		}
+	}
+	return response
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// costCenterCharges 経費の負担先のコストセンターを解決する
+// 按分のない経費は申請者の既定のコストセンターに全額を負担させる
+// ユーザーの既定・コストセンターは出力や集計の間だけ保持する
+type costCenterCharges struct {
+	userRepo       repository.UserRepository
+	costCenterRepo repository.CostCenterRepository
+	defaults       map[string]*valueobject.CostCenterID
+	costCenters    map[string]*entity.CostCenter
+}
+
+// newCostCenterCharges costCenterChargesのコンストラクタ（costCenterRepoはnilでもよい）
+func newCostCenterCharges(userRepo repository.UserRepository, costCenterRepo repository.CostCenterRepository) *costCenterCharges {
+	return &costCenterCharges{
+		userRepo:       userRepo,
+		costCenterRepo: costCenterRepo,
+		defaults:       make(map[string]*valueobject.CostCenterID),
+		costCenters:    make(map[string]*entity.CostCenter),
+	}
+}
+
+// Allocations 経費の負担先ごとの按分を取得（按分も申請者の既定もない場合は空）
+func (c *costCenterCharges) Allocations(ctx context.Context, expense *entity.Expense) []*entity.CostAllocation {
+	key := expense.UserID().String()
+	defaultID, ok := c.defaults[key]
+	if !ok {
+		if user, err := c.userRepo.FindByID(ctx, expense.UserID()); err == nil {
+			defaultID = user.CostCenterID()
+		}
+		c.defaults[key] = defaultID
+	}
+
+	return expense.ChargedAllocations(defaultID)
+}
+
+// CostCenter コストセンターを取得（削除済み、またはリポジトリがない場合はnil）
+func (c *costCenterCharges) CostCenter(ctx context.Context, costCenterID *valueobject.CostCenterID) *entity.CostCenter {
+	if c.costCenterRepo == nil {
+		return nil
+	}
+
+	if costCenter, ok := c.costCenters[costCenterID.String()]; ok {
+		return costCenter
+	}
+
+	costCenter, err := c.costCenterRepo.FindByID(ctx, costCenterID)
+	if err != nil {
+		costCenter = nil
+	}
+	c.costCenters[costCenterID.String()] = costCenter
+	return costCenter
+}
@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+)
+
+// CostCenterUseCase コストセンターユースケース
+type CostCenterUseCase struct {
+	costCenterRepo repository.CostCenterRepository
+	departmentRepo repository.DepartmentRepository
+	userRepo       repository.UserRepository
+	expenseRepo    repository.ExpenseRepository
+	clock          clock.Clock
+}
+
+// NewCostCenterUseCase CostCenterUseCaseのコンストラクタ
+func NewCostCenterUseCase(costCenterRepo repository.CostCenterRepository, departmentRepo repository.DepartmentRepository, userRepo repository.UserRepository, expenseRepo repository.ExpenseRepository, clk clock.Clock) *CostCenterUseCase {
+	return &CostCenterUseCase{
+		costCenterRepo: costCenterRepo,
+		departmentRepo: departmentRepo,
+		userRepo:       userRepo,
+		expenseRepo:    expenseRepo,
+		clock:          clk,
+	}
+}
+
+// CreateCostCenter コストセンターを作成
+func (uc *CostCenterUseCase) CreateCostCenter(ctx context.Context, req *dto.CreateCostCenterRequest) (*dto.CostCenterResponse, error) {
+	// コストセンターコードの重複チェック
+	existing, err := uc.costCenterRepo.FindByCode(ctx, strings.TrimSpace(req.Code))
+	if err == nil && existing != nil {
+		return nil, errors.NewApplicationError(errors.CostCenterCodeExists, "このコストセンターコードは既に使用されています")
+	}
+
+	departmentID, err := uc.findDepartmentID(ctx, req.DepartmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	costCenter, err := entity.NewCostCenter(uc.clock, req.Code, req.Name, departmentID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.costCenterRepo.Save(ctx, costCenter); err != nil {
+		return nil, errors.NewApplicationError(errors.CostCenterCreationFailed, "コストセンターの作成に失敗しました")
+	}
+
+	return buildCostCenterResponse(costCenter), nil
+}
+
+// GetCostCenter コストセンターを取得
+func (uc *CostCenterUseCase) GetCostCenter(ctx context.Context, costCenterID string) (*dto.CostCenterResponse, error) {
+	costCenter, err := uc.findCostCenter(ctx, costCenterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildCostCenterResponse(costCenter), nil
+}
+
+// GetCostCenters コストセンターの一覧を取得（部署を指定した場合は所属するコストセンターのみ）
+func (uc *CostCenterUseCase) GetCostCenters(ctx context.Context, req *dto.CostCenterListRequest) ([]*dto.CostCenterResponse, error) {
+	var costCenters []*entity.CostCenter
+	if req.DepartmentID != "" {
+		departmentID, err := uc.findDepartmentID(ctx, req.DepartmentID)
+		if err != nil {
+			return nil, err
+		}
+
+		costCenters, err = uc.costCenterRepo.FindByDepartmentID(ctx, departmentID)
+		if err != nil {
+			return nil, errors.NewApplicationError("COST_CENTER_FETCH_FAILED", "コストセンター一覧の取得に失敗しました")
+		}
+	} else {
+		var err error
+		costCenters, err = uc.costCenterRepo.FindAll(ctx)
+		if err != nil {
+			return nil, errors.NewApplicationError("COST_CENTER_FETCH_FAILED", "コストセンター一覧の取得に失敗しました")
+		}
+	}
+
+	responses := make([]*dto.CostCenterResponse, len(costCenters))
+	for i, costCenter := range costCenters {
+		responses[i] = buildCostCenterResponse(costCenter)
+	}
+
+	return responses, nil
+}
+
+// UpdateCostCenter コストセンターを更新
+func (uc *CostCenterUseCase) UpdateCostCenter(ctx context.Context, costCenterID string, req *dto.UpdateCostCenterRequest) (*dto.CostCenterResponse, error) {
+	costCenter, err := uc.findCostCenter(ctx, costCenterID)
+	if err != nil {
+		return nil, err
+	}
+
+	// コストセンターコードの重複チェック（自分以外で同じコードが存在するか）
+	existing, err := uc.costCenterRepo.FindByCode(ctx, strings.TrimSpace(req.Code))
+	if err == nil && existing != nil && !existing.ID().Equals(costCenter.ID()) {
+		return nil, errors.NewApplicationError(errors.CostCenterCodeExists, "このコストセンターコードは既に使用されています")
+	}
+
+	departmentID, err := uc.findDepartmentID(ctx, req.DepartmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := costCenter.Update(req.Code, req.Name, departmentID, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.costCenterRepo.Update(ctx, costCenter); err != nil {
+		return nil, errors.NewApplicationError(errors.CostCenterUpdateFailed, "コストセンターの更新に失敗しました")
+	}
+
+	return buildCostCenterResponse(costCenter), nil
+}
+
+// DeleteCostCenter コストセンターを削除（ユーザーの既定や経費の按分で使用されている場合は削除できない）
+func (uc *CostCenterUseCase) DeleteCostCenter(ctx context.Context, costCenterID string) error {
+	costCenter, err := uc.findCostCenter(ctx, costCenterID)
+	if err != nil {
+		return err
+	}
+
+	users, err := uc.userRepo.FindAll(ctx)
+	if err != nil {
+		return errors.NewApplicationError(errors.CostCenterDeletionFailed, "コストセンターの使用状況チェックに失敗しました")
+	}
+	for _, user := range users {
+		if costCenter.ID().Equals(user.CostCenterID()) {
+			return errors.NewApplicationError(errors.CostCenterInUse, "このコストセンターはユーザーの既定のコストセンターに設定されているため削除できません")
+		}
+	}
+
+	inUse := false
+	err = uc.expenseRepo.Iterate(ctx, repository.ExpenseFilter{}, func(expense *entity.Expense) error {
+		for _, allocation := range expense.Allocations() {
+			if costCenter.ID().Equals(allocation.CostCenterID()) {
+				inUse = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.NewApplicationError(errors.CostCenterDeletionFailed, "コストセンターの使用状況チェックに失敗しました")
+	}
+	if inUse {
+		return errors.NewApplicationError(errors.CostCenterInUse, "このコストセンターは経費の按分で使用されているため削除できません")
+	}
+
+	if err := uc.costCenterRepo.Delete(ctx, costCenter.ID()); err != nil {
+		return errors.NewApplicationError(errors.CostCenterDeletionFailed, "コストセンターの削除に失敗しました")
+	}
+
+	return nil
+}
+
+// findCostCenter IDでコストセンターを検索
+func (uc *CostCenterUseCase) findCostCenter(ctx context.Context, costCenterID string) (*entity.CostCenter, error) {
+	id, err := valueobject.NewCostCenterID(costCenterID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	costCenter, err := uc.costCenterRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.CostCenterNotFound, "コストセンターが見つかりません")
+	}
+
+	return costCenter, nil
+}
+
+// findDepartmentID 部署IDを検証して部署の存在を確認
+func (uc *CostCenterUseCase) findDepartmentID(ctx context.Context, departmentID string) (*valueobject.DepartmentID, error) {
+	id, err := valueobject.NewDepartmentID(departmentID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if _, err := uc.departmentRepo.FindByID(ctx, id); err != nil {
+		return nil, errors.NewApplicationError(errors.DepartmentNotFound, "部署が見つかりません")
+	}
+
+	return id, nil
+}
+
+// buildCostCenterResponse コストセンターレスポンスを構築
+func buildCostCenterResponse(costCenter *entity.CostCenter) *dto.CostCenterResponse {
+	return &dto.CostCenterResponse{
+		ID:           costCenter.ID().String(),
+		Code:         costCenter.Code(),
+		Name:         costCenter.Name(),
+		DepartmentID: costCenter.DepartmentID().String(),
+		CreatedAt:    costCenter.CreatedAt(),
+		UpdatedAt:    costCenter.UpdatedAt(),
+	}
+}
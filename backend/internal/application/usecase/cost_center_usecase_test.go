@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/infrastructure/persistence"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostCenterUseCase(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	expenseReportRepo := persistence.NewMemoryExpenseReportRepository()
+	budgetRepo := persistence.NewMemoryBudgetRepository()
+	departmentRepo := persistence.NewMemoryDepartmentRepository()
+	costCenterRepo := persistence.NewMemoryCostCenterRepository()
+
+	// ユースケースを初期化
+	departmentUseCase := NewDepartmentUseCase(departmentRepo, costCenterRepo, userRepo, budgetRepo, fakeClock)
+	useCase := NewCostCenterUseCase(costCenterRepo, departmentRepo, userRepo, expenseRepo, fakeClock)
+	userUseCase := NewUserUseCase(userRepo, departmentRepo, costCenterRepo, fakeClock)
+	categoryUseCase := NewCategoryUseCase(categoryRepo, expenseRepo, fakeClock)
+	expenseUseCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock, WithCostCenterRepository(costCenterRepo))
+	reportUseCase := NewExpenseReportUseCase(expenseReportRepo, expenseRepo, userRepo, categoryRepo, fakeClock, WithReportCostCenterRepository(costCenterRepo))
+
+	department, err := departmentUseCase.CreateDepartment(ctx, &dto.CreateDepartmentRequest{Code: "SALES", Name: "営業部"})
+	require.NoError(t, err)
+
+	_, err = departmentUseCase.CreateDepartment(ctx, &dto.CreateDepartmentRequest{Code: "SALES", Name: "第二営業部"})
+	assert.Error(t, err, "部署コードの重複はエラー")
+
+	east, err := useCase.CreateCostCenter(ctx, &dto.CreateCostCenterRequest{Code: "S-EAST", Name: "東日本営業", DepartmentID: department.ID})
+	require.NoError(t, err)
+	west, err := useCase.CreateCostCenter(ctx, &dto.CreateCostCenterRequest{Code: "S-WEST", Name: "西日本営業", DepartmentID: department.ID})
+	require.NoError(t, err)
+
+	user, err := userUseCase.CreateUser(ctx, &dto.CreateUserRequest{
+		Name: "山田太郎", Email: "yamada@example.com", DepartmentID: department.ID, CostCenterID: east.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, east.ID, user.CostCenterID)
+
+	category, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{
+		Name:       "交通費",
+		Accounting: &dto.AccountMappingRequest{DebitAccount: "旅費交通費", Department: "営業部"},
+	})
+	require.NoError(t, err)
+
+	today := fakeClock.Now()
+	createExpense := func(amount float64, allocations []dto.CostAllocationRequest) (*dto.ExpenseResponse, error) {
+		return expenseUseCase.CreateExpense(ctx, user.ID, &dto.CreateExpenseRequest{
+			CategoryID: category.ID, Amount: amount, Title: "出張交通費", Date: today.Format("2006-01-02"),
+			Allocations: allocations,
+		})
+	}
+
+	t.Run("部署を指定してコストセンターを取得", func(t *testing.T) {
+		costCenters, err := useCase.GetCostCenters(ctx, &dto.CostCenterListRequest{DepartmentID: department.ID})
+		require.NoError(t, err)
+		require.Len(t, costCenters, 2)
+		assert.Equal(t, "S-EAST", costCenters[0].Code)
+
+		_, err = useCase.CreateCostCenter(ctx, &dto.CreateCostCenterRequest{Code: "S-EAST", Name: "重複", DepartmentID: department.ID})
+		assert.Error(t, err)
+	})
+
+	split, err := createExpense(10000, []dto.CostAllocationRequest{
+		{CostCenterID: east.ID, Amount: 3000},
+		{CostCenterID: west.ID, Percentage: 100},
+	})
+	require.NoError(t, err)
+	unallocated, err := createExpense(2000, nil)
+	require.NoError(t, err)
+
+	t.Run("経費をコストセンターに按分する", func(t *testing.T) {
+		require.Len(t, split.Allocations, 2)
+		assert.Equal(t, 3000.0, split.Allocations[0].Amount)
+		assert.Equal(t, 7000.0, split.Allocations[1].Amount)
+		assert.Equal(t, 100.0, split.Allocations[1].Percentage)
+
+		_, err := createExpense(10000, []dto.CostAllocationRequest{{CostCenterID: east.ID, Percentage: 50}})
+		assert.Error(t, err, "割合の合計が100%でない按分はエラー")
+		_, err = createExpense(10000, []dto.CostAllocationRequest{{CostCenterID: east.ID, Percentage: 50, Amount: 5000}})
+		assert.Error(t, err, "割合と金額の両方を指定した按分はエラー")
+	})
+
+	t.Run("経費レポートにコストセンターごとの負担額を含める", func(t *testing.T) {
+		report, err := reportUseCase.CreateExpenseReport(ctx, user.ID, &dto.CreateExpenseReportRequest{
+			Title:       "出張精算",
+			PeriodStart: today.AddDate(0, 0, -1).Format("2006-01-02"),
+			PeriodEnd:   today.AddDate(0, 0, 1).Format("2006-01-02"),
+			ExpenseIDs:  []string{split.ID, unallocated.ID},
+		})
+		require.NoError(t, err)
+
+		totals := make(map[string]float64)
+		for _, total := range report.CostCenters {
+			totals[total.Code] = total.Amount
+		}
+		assert.Equal(t, map[string]float64{"S-EAST": 5000, "S-WEST": 7000}, totals)
+	})
+
+	t.Run("仕訳は負担先ごとの行に分け、部門にコストセンターのコードを出力する", func(t *testing.T) {
+		for _, id := range []string{split.ID, unallocated.ID} {
+			_, err := expenseUseCase.SubmitExpense(ctx, id)
+			require.NoError(t, err)
+			_, err = expenseUseCase.ApproveExpense(ctx, id)
+			require.NoError(t, err)
+		}
+
+		exporter, err := expenseUseCase.ExportJournal(ctx, &dto.ExportJournalRequest{
+			Software: "freee",
+			DateFrom: today.AddDate(0, 0, -1).Format("2006-01-02"),
+			DateTo:   today.AddDate(0, 0, 1).Format("2006-01-02"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, exporter.Count())
+
+		var buf bytes.Buffer
+		require.NoError(t, exporter.Write(&buf))
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+
+		lines := make(map[string][]string)
+		for _, record := range records[1:] {
+			lines[record[8]+" "+record[14]] = record
+		}
+		require.Contains(t, lines, "S-EAST 3000")
+		require.Contains(t, lines, "S-WEST 7000")
+		require.Contains(t, lines, "S-EAST 2000")
+		assert.Equal(t, lines["S-EAST 3000"][1], lines["S-WEST 7000"][1], "按分した経費は同じ伝票番号")
+		assert.Equal(t, "636", lines["S-WEST 7000"][16])
+	})
+
+	t.Run("使用中のコストセンターと部署は削除できない", func(t *testing.T) {
+		assert.Error(t, useCase.DeleteCostCenter(ctx, east.ID), "ユーザーの既定のコストセンター")
+		assert.Error(t, useCase.DeleteCostCenter(ctx, west.ID), "経費の按分で使用中")
+		assert.Error(t, departmentUseCase.DeleteDepartment(ctx, department.ID), "コストセンターが所属している")
+
+		unused, err := useCase.CreateCostCenter(ctx, &dto.CreateCostCenterRequest{Code: "S-HQ", Name: "本社営業", DepartmentID: department.ID})
+		require.NoError(t, err)
+		require.NoError(t, useCase.DeleteCostCenter(ctx, unused.ID))
+	})
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+)
+
+// DepartmentUseCase 部署ユースケース
+type DepartmentUseCase struct {
+	departmentRepo repository.DepartmentRepository
+	costCenterRepo repository.CostCenterRepository
+	userRepo       repository.UserRepository
+	budgetRepo     repository.BudgetRepository
+	clock          clock.Clock
+}
+
+// NewDepartmentUseCase DepartmentUseCaseのコンストラクタ
+func NewDepartmentUseCase(departmentRepo repository.DepartmentRepository, costCenterRepo repository.CostCenterRepository, userRepo repository.UserRepository, budgetRepo repository.BudgetRepository, clk clock.Clock) *DepartmentUseCase {
+	return &DepartmentUseCase{
+		departmentRepo: departmentRepo,
+		costCenterRepo: costCenterRepo,
+		userRepo:       userRepo,
+		budgetRepo:     budgetRepo,
+		clock:          clk,
+	}
+}
+
+// CreateDepartment 部署を作成
+func (uc *DepartmentUseCase) CreateDepartment(ctx context.Context, req *dto.CreateDepartmentRequest) (*dto.DepartmentResponse, error) {
+	// 部署コードの重複チェック
+	existing, err := uc.departmentRepo.FindByCode(ctx, strings.TrimSpace(req.Code))
+	if err == nil && existing != nil {
+		return nil, errors.NewApplicationError(errors.DepartmentCodeExists, "この部署コードは既に使用されています")
+	}
+
+	department, err := entity.NewDepartment(uc.clock, req.Code, req.Name)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.departmentRepo.Save(ctx, department); err != nil {
+		return nil, errors.NewApplicationError(errors.DepartmentCreationFailed, "部署の作成に失敗しました")
+	}
+
+	return buildDepartmentResponse(department), nil
+}
+
+// GetDepartment 部署を取得
+func (uc *DepartmentUseCase) GetDepartment(ctx context.Context, departmentID string) (*dto.DepartmentResponse, error) {
+	department, err := uc.findDepartment(ctx, departmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildDepartmentResponse(department), nil
+}
+
+// GetAllDepartments 全ての部署を取得
+func (uc *DepartmentUseCase) GetAllDepartments(ctx context.Context) ([]*dto.DepartmentResponse, error) {
+	departments, err := uc.departmentRepo.FindAll(ctx)
+	if err != nil {
+		return nil, errors.NewApplicationError("DEPARTMENT_FETCH_FAILED", "部署一覧の取得に失敗しました")
+	}
+
+	responses := make([]*dto.DepartmentResponse, len(departments))
+	for i, department := range departments {
+		responses[i] = buildDepartmentResponse(department)
+	}
+
+	return responses, nil
+}
+
+// UpdateDepartment 部署を更新
+func (uc *DepartmentUseCase) UpdateDepartment(ctx context.Context, departmentID string, req *dto.UpdateDepartmentRequest) (*dto.DepartmentResponse, error) {
+	department, err := uc.findDepartment(ctx, departmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 部署コードの重複チェック（自分以外で同じコードが存在するか）
+	existing, err := uc.departmentRepo.FindByCode(ctx, strings.TrimSpace(req.Code))
+	if err == nil && existing != nil && !existing.ID().Equals(department.ID()) {
+		return nil, errors.NewApplicationError(errors.DepartmentCodeExists, "この部署コードは既に使用されています")
+	}
+
+	if err := department.Update(req.Code, req.Name, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.departmentRepo.Update(ctx, department); err != nil {
+		return nil, errors.NewApplicationError(errors.DepartmentUpdateFailed, "部署の更新に失敗しました")
+	}
+
+	return buildDepartmentResponse(department), nil
+}
+
+// DeleteDepartment 部署を削除（コストセンター・ユーザー・予算から参照されている場合は削除できない）
+func (uc *DepartmentUseCase) DeleteDepartment(ctx context.Context, departmentID string) error {
+	department, err := uc.findDepartment(ctx, departmentID)
+	if err != nil {
+		return err
+	}
+
+	costCenters, err := uc.costCenterRepo.FindByDepartmentID(ctx, department.ID())
+	if err != nil {
+		return errors.NewApplicationError(errors.DepartmentDeletionFailed, "部署の使用状況チェックに失敗しました")
+	}
+	if len(costCenters) > 0 {
+		return errors.NewApplicationError(errors.DepartmentInUse, "この部署にはコストセンターが所属しているため削除できません")
+	}
+
+	users, err := uc.userRepo.FindAll(ctx)
+	if err != nil {
+		return errors.NewApplicationError(errors.DepartmentDeletionFailed, "部署の使用状況チェックに失敗しました")
+	}
+	for _, user := range users {
+		if department.ID().Equals(user.DepartmentID()) {
+			return errors.NewApplicationError(errors.DepartmentInUse, "この部署にはユーザーが所属しているため削除できません")
+		}
+	}
+
+	budgets, err := uc.budgetRepo.FindAll(ctx)
+	if err != nil {
+		return errors.NewApplicationError(errors.DepartmentDeletionFailed, "部署の使用状況チェックに失敗しました")
+	}
+	for _, budget := range budgets {
+		if department.ID().Equals(budget.Scope().DepartmentID()) {
+			return errors.NewApplicationError(errors.DepartmentInUse, "この部署は予算の対象に使用されているため削除できません")
+		}
+	}
+
+	if err := uc.departmentRepo.Delete(ctx, department.ID()); err != nil {
+		return errors.NewApplicationError(errors.DepartmentDeletionFailed, "部署の削除に失敗しました")
+	}
+
+	return nil
+}
+
+// findDepartment IDで部署を検索
+func (uc *DepartmentUseCase) findDepartment(ctx context.Context, departmentID string) (*entity.Department, error) {
+	id, err := valueobject.NewDepartmentID(departmentID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	department, err := uc.departmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.DepartmentNotFound, "部署が見つかりません")
+	}
+
+	return department, nil
+}
+
+// buildDepartmentResponse 部署レスポンスを構築
+func buildDepartmentResponse(department *entity.Department) *dto.DepartmentResponse {
+	return &dto.DepartmentResponse{
+		ID:        department.ID().String(),
+		Code:      department.Code(),
+		Name:      department.Name(),
+		CreatedAt: department.CreatedAt(),
+		UpdatedAt: department.UpdatedAt(),
+	}
+}
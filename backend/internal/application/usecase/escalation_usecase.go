@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/event"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"time"
+)
+
+// EscalationUseCase 承認待ち経費のエスカレーションユースケース
+type EscalationUseCase struct {
+	expenseRepo repository.ExpenseRepository
+	userRepo    repository.UserRepository
+	publisher   event.Publisher
+	sla         time.Duration
+	clock       clock.Clock
+}
+
+// NewEscalationUseCase EscalationUseCaseのコンストラクタ
+func NewEscalationUseCase(
+	expenseRepo repository.ExpenseRepository,
+	userRepo repository.UserRepository,
+	publisher event.Publisher,
+	sla time.Duration,
+	clk clock.Clock,
+) *EscalationUseCase {
+	return &EscalationUseCase{
+		expenseRepo: expenseRepo,
+		userRepo:    userRepo,
+		publisher:   publisher,
+		sla:         sla,
+		clock:       clk,
+	}
+}
+
+// EscalateStaleExpenses SLAを超えて申請済みのままの経費を上長の階層に沿って回付
+// 経費ごとの保存・通知の失敗は結果に記録し、残りの経費の処理を継続する
+func (uc *EscalationUseCase) EscalateStaleExpenses(ctx context.Context) (*dto.EscalationResult, error) {
+	expenses, err := uc.expenseRepo.FindByStatus(ctx, entity.ExpenseStatusSubmitted)
+	if err != nil {
+		return nil, errors.NewApplicationError("EXPENSE_FETCH_FAILED", "経費一覧の取得に失敗しました")
+	}
+
+	result := &dto.EscalationResult{Failures: make([]*dto.EscalationFailure, 0)}
+	now := uc.clock.Now()
+
+	for _, expense := range expenses {
+		if !expense.IsStale(now, uc.sla) {
+			continue
+		}
+
+		// 階層の最上位に達している場合は据え置き
+		nextApproverID := uc.nextApprover(ctx, expense)
+		if nextApproverID == nil || nextApproverID.Equals(expense.UserID()) {
+			result.SkippedCount++
+			continue
+		}
+
+		fromApproverID := ""
+		if expense.ApproverID() != nil {
+			fromApproverID = expense.ApproverID().String()
+		}
+
+		// 保存に失敗した経費を回付済みにしないよう複製に適用する
+		expense := expense.Copy()
+		if err := expense.Escalate(nextApproverID, uc.clock.Now()); err != nil {
+			result.SkippedCount++
+			continue
+		}
+
+		if err := uc.expenseRepo.Update(ctx, expense); err != nil {
+			result.Failures = append(result.Failures, &dto.EscalationFailure{
+				ExpenseID: expense.ID().String(),
+				Message:   "経費のエスカレーションに失敗しました: " + err.Error(),
+			})
+			continue
+		}
+		result.EscalatedCount++
+
+		if err := uc.publisher.Publish(ctx, event.ExpenseEscalated{
+			ExpenseID:      expense.ID().String(),
+			UserID:         expense.UserID().String(),
+			FromApproverID: fromApproverID,
+			ToApproverID:   nextApproverID.String(),
+			Level:          expense.EscalationLevel(),
+			At:             now,
+		}); err != nil {
+			result.Failures = append(result.Failures, &dto.EscalationFailure{
+				ExpenseID: expense.ID().String(),
+				Message:   "エスカレーション通知の送信に失敗しました: " + err.Error(),
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// nextApprover 次の回付先を決定（未割り当てなら申請者の上長、割り当て済みなら承認者の上長）
+func (uc *EscalationUseCase) nextApprover(ctx context.Context, expense *entity.Expense) *valueobject.UserID {
+	current := expense.ApproverID()
+	if current == nil {
+		current = expense.UserID()
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, current)
+	if err != nil {
+		// 承認者が削除されている場合は回付先なしとして扱う
+		return nil
+	}
+
+	return user.ManagerID()
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/event"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/internal/infrastructure/persistence"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingPublisher 発行されたイベントを記録するテスト用Publisher
+type recordingPublisher struct {
+	events []event.Event
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, e event.Event) error {
+	p.events = append(p.events, e)
+	return nil
+}
+
+func TestEscalationUseCase_EscalateStaleExpenses(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	publisher := &recordingPublisher{}
+
+	// ユースケースを初期化（SLAは48時間）
+	useCase := NewEscalationUseCase(expenseRepo, userRepo, publisher, 48*time.Hour, fakeClock)
+
+	// 部長 ← 課長 ← 担当者 の階層を作成
+	director, _ := entity.NewUser(fakeClock, "部長", "director@example.com")
+	require.NoError(t, userRepo.Save(ctx, director))
+
+	manager, _ := entity.NewUser(fakeClock, "課長", "manager@example.com")
+	require.NoError(t, manager.AssignManager(director.ID(), fakeClock.Now()))
+	require.NoError(t, userRepo.Save(ctx, manager))
+
+	member, _ := entity.NewUser(fakeClock, "担当者", "member@example.com")
+	require.NoError(t, member.AssignManager(manager.ID(), fakeClock.Now()))
+	require.NoError(t, userRepo.Save(ctx, member))
+
+	amount, _ := valueobject.NewMoney(1000, "JPY")
+	date := valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -7))
+	newSubmitted := func(approverID *valueobject.UserID, routedAt time.Time) *entity.Expense {
+		expense, err := entity.ReconstructExpense(
+			valueobject.GenerateExpenseID(), member.ID(), valueobject.GenerateCategoryID(), amount,
+			"電車代", "", date, entity.ExpenseStatusSubmitted,
+			approverID, routedAt, routedAt, 0, "", nil, entity.ExpenseKindStandard, nil, time.Time{},
+			nil, 0, "", nil, nil, nil, nil, false, nil, "", "",
+			routedAt, routedAt,
+		)
+		require.NoError(t, err)
+		require.NoError(t, expenseRepo.Save(ctx, expense))
+		return expense
+	}
+
+	stale := newSubmitted(manager.ID(), fakeClock.Now().Add(-72*time.Hour))
+	fresh := newSubmitted(manager.ID(), fakeClock.Now().Add(-time.Hour))
+	top := newSubmitted(director.ID(), fakeClock.Now().Add(-72*time.Hour))
+
+	result, err := useCase.EscalateStaleExpenses(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.EscalatedCount)
+	assert.Equal(t, 1, result.SkippedCount)
+
+	// ユースケースは経費の複製を保存するため、保存済みの経費を取得し直す
+	reload := func(expense *entity.Expense) *entity.Expense {
+		stored, err := expenseRepo.FindByID(ctx, expense.ID())
+		require.NoError(t, err)
+		return stored
+	}
+	stale, fresh, top = reload(stale), reload(fresh), reload(top)
+
+	t.Run("SLA超過の経費は上位の承認者に回付される", func(t *testing.T) {
+		assert.True(t, director.ID().Equals(stale.ApproverID()))
+		assert.Equal(t, 1, stale.EscalationLevel())
+		assert.False(t, stale.IsStale(fakeClock.Now(), 48*time.Hour))
+	})
+
+	t.Run("SLA内の経費は回付されない", func(t *testing.T) {
+		assert.True(t, manager.ID().Equals(fresh.ApproverID()))
+		assert.Equal(t, 0, fresh.EscalationLevel())
+	})
+
+	t.Run("階層の最上位の承認者で滞留している経費は据え置き", func(t *testing.T) {
+		assert.True(t, director.ID().Equals(top.ApproverID()))
+		assert.Equal(t, 0, top.EscalationLevel())
+	})
+
+	t.Run("回付時に通知イベントが発行される", func(t *testing.T) {
+		require.Len(t, publisher.events, 1)
+		escalated, ok := publisher.events[0].(event.ExpenseEscalated)
+		require.True(t, ok)
+		assert.Equal(t, stale.ID().String(), escalated.ExpenseID)
+		assert.Equal(t, manager.ID().String(), escalated.FromApproverID)
+		assert.Equal(t, director.ID().String(), escalated.ToApproverID)
+		assert.Equal(t, 1, escalated.Level)
+	})
+}
+
+// failingPublisher 常にイベントの発行に失敗するテスト用Publisher
+type failingPublisher struct {
+	attempts int
+}
+
+func (p *failingPublisher) Publish(ctx context.Context, e event.Event) error {
+	p.attempts++
+	return fmt.Errorf("notification service unavailable")
+}
+
+func TestEscalationUseCase_EscalateStaleExpenses_PublishFailure(t *testing.T) {
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	publisher := &failingPublisher{}
+
+	// 現在日時を2026年4月10日9時に固定
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	useCase := NewEscalationUseCase(expenseRepo, userRepo, publisher, 48*time.Hour, fakeClock)
+
+	director, _ := entity.NewUser(fakeClock, "部長", "director@example.com")
+	require.NoError(t, userRepo.Save(ctx, director))
+
+	manager, _ := entity.NewUser(fakeClock, "課長", "manager@example.com")
+	require.NoError(t, manager.AssignManager(director.ID(), fakeClock.Now()))
+	require.NoError(t, userRepo.Save(ctx, manager))
+
+	member, _ := entity.NewUser(fakeClock, "担当者", "member@example.com")
+	require.NoError(t, member.AssignManager(manager.ID(), fakeClock.Now()))
+	require.NoError(t, userRepo.Save(ctx, member))
+
+	amount, _ := valueobject.NewMoney(1000, "JPY")
+	routedAt := fakeClock.Now().Add(-72 * time.Hour)
+	expenses := make([]*entity.Expense, 2)
+	for i := range expenses {
+		expense, err := entity.ReconstructExpense(
+			valueobject.GenerateExpenseID(), member.ID(), valueobject.GenerateCategoryID(), amount,
+			"電車代", "", valueobject.DateOf(routedAt), entity.ExpenseStatusSubmitted,
+			manager.ID(), routedAt, routedAt, 0, "", nil, entity.ExpenseKindStandard, nil, time.Time{},
+			nil, 0, "", nil, nil, nil, nil, false, nil, "", "",
+			routedAt, routedAt,
+		)
+		require.NoError(t, err)
+		require.NoError(t, expenseRepo.Save(ctx, expense))
+		expenses[i] = expense
+	}
+
+	// 通知に失敗しても処理を中断せず、全ての経費を回付する
+	result, err := useCase.EscalateStaleExpenses(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.EscalatedCount)
+	assert.Equal(t, 2, publisher.attempts)
+	require.Len(t, result.Failures, 2)
+	failedIDs := []string{result.Failures[0].ExpenseID, result.Failures[1].ExpenseID}
+	for _, expense := range expenses {
+		assert.Contains(t, failedIDs, expense.ID().String())
+		stored, err := expenseRepo.FindByID(ctx, expense.ID())
+		require.NoError(t, err)
+		assert.True(t, director.ID().Equals(stored.ApproverID()))
+	}
+}
+
+func TestEscalationUseCase_EscalateStaleExpenses_UpdateFailure(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化（経費の更新は常に失敗する）
+	userRepo := persistence.NewMemoryUserRepository()
+	expenseRepo := &failingUpdateExpenseRepository{MemoryExpenseRepository: persistence.NewMemoryExpenseRepository(), fail: true}
+	useCase := NewEscalationUseCase(expenseRepo, userRepo, &recordingPublisher{}, 48*time.Hour, fakeClock)
+
+	director, _ := entity.NewUser(fakeClock, "部長", "director@example.com")
+	require.NoError(t, userRepo.Save(ctx, director))
+
+	manager, _ := entity.NewUser(fakeClock, "課長", "manager@example.com")
+	require.NoError(t, manager.AssignManager(director.ID(), fakeClock.Now()))
+	require.NoError(t, userRepo.Save(ctx, manager))
+
+	member, _ := entity.NewUser(fakeClock, "担当者", "member@example.com")
+	require.NoError(t, member.AssignManager(manager.ID(), fakeClock.Now()))
+	require.NoError(t, userRepo.Save(ctx, member))
+
+	amount, _ := valueobject.NewMoney(1000, "JPY")
+	routedAt := fakeClock.Now().Add(-72 * time.Hour)
+	expense, err := entity.ReconstructExpense(
+		valueobject.GenerateExpenseID(), member.ID(), valueobject.GenerateCategoryID(), amount,
+		"電車代", "", valueobject.DateOf(routedAt), entity.ExpenseStatusSubmitted,
+		manager.ID(), routedAt, routedAt, 0, "", nil, entity.ExpenseKindStandard, nil, time.Time{},
+		nil, 0, "", nil, nil, nil, nil, false, nil, "", "",
+		routedAt, routedAt,
+	)
+	require.NoError(t, err)
+	require.NoError(t, expenseRepo.Save(ctx, expense))
+
+	// 保存に失敗した経費は元の承認者のまま、次回のジョブで回付する
+	result, err := useCase.EscalateStaleExpenses(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.EscalatedCount)
+	require.Len(t, result.Failures, 1)
+
+	stored, err := expenseRepo.FindByID(ctx, expense.ID())
+	require.NoError(t, err)
+	assert.True(t, manager.ID().Equals(stored.ApproverID()))
+	assert.Equal(t, 0, stored.EscalationLevel())
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/pkg/errors"
+	"strings"
+)
+
+// findDuplicateExpenses 経費と重複の疑いがある経費を検索（他のユーザーの経費を含む、日付の順）
+// 比較の対象は同じ日付の経費と、領収書ファイルのハッシュが同じ経費
+func findDuplicateExpenses(ctx context.Context, expenseRepo repository.ExpenseRepository, userRepo repository.UserRepository, expense *entity.Expense) ([]*dto.DuplicateExpenseResponse, error) {
+	filters := []repository.ExpenseFilter{{DateFrom: expense.Date(), DateTo: expense.Date()}}
+	if expense.ReceiptHash() != "" {
+		filters = append(filters, repository.ExpenseFilter{ReceiptHash: expense.ReceiptHash()})
+	}
+
+	duplicates := make([]*dto.DuplicateExpenseResponse, 0)
+	seen := make(map[string]bool)
+	users := make(map[string]*entity.User)
+	for _, filter := range filters {
+		err := expenseRepo.Iterate(ctx, filter, func(other *entity.Expense) error {
+			if seen[other.ID().String()] {
+				return nil
+			}
+			seen[other.ID().String()] = true
+
+			reasons := expense.DuplicateReasons(other)
+			if len(reasons) == 0 {
+				return nil
+			}
+
+			duplicates = append(duplicates, buildDuplicateExpenseResponse(other, findUserCached(ctx, userRepo, other.UserID(), users), reasons))
+			return nil
+		})
+		if err != nil {
+			return nil, errors.NewApplicationError("EXPENSE_FETCH_FAILED", "経費一覧の取得に失敗しました")
+		}
+	}
+
+	return duplicates, nil
+}
+
+// duplicateWarnings 重複の疑いがある経費ごとの警告を取得
+func duplicateWarnings(duplicates []*dto.DuplicateExpenseResponse) []string {
+	warnings := make([]string, len(duplicates))
+	for i, duplicate := range duplicates {
+		warnings[i] = duplicate.Message
+	}
+	return warnings
+}
+
+// buildDuplicateExpenseResponse 重複の疑いがある経費レスポンスを構築（申請者が削除済みの場合はuserがnil）
+func buildDuplicateExpenseResponse(expense *entity.Expense, user *entity.User, reasons []entity.DuplicateReason) *dto.DuplicateExpenseResponse {
+	response := &dto.DuplicateExpenseResponse{
+		ExpenseID: expense.ID().String(),
+		UserID:    expense.UserID().String(),
+		Title:     expense.Title(),
+		Amount:    expense.Amount().Amount(),
+		Currency:  expense.Amount().Currency(),
+		Date:      expense.Date().String(),
+		Status:    string(expense.Status()),
+		Reasons:   make([]string, len(reasons)),
+	}
+
+	labels := make([]string, len(reasons))
+	for i, reason := range reasons {
+		response.Reasons[i] = string(reason)
+		labels[i] = reason.Label()
+	}
+
+	owner := ""
+	if user != nil {
+		response.UserName = user.Name()
+		owner = user.Name() + "、"
+	}
+	response.Message = "経費「" + expense.Title() + "」（" + owner + expense.Date().String() + "、" + expense.Status().Label() + "）と重複している可能性があります: " + strings.Join(labels, "、")
+
+	return response
+}
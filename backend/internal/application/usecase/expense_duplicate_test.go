@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/infrastructure/persistence"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpenseUseCase_DuplicateDetection(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+
+	// ユースケースを初期化
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+	userUseCase := NewUserUseCase(userRepo, persistence.NewMemoryDepartmentRepository(), persistence.NewMemoryCostCenterRepository(), fakeClock)
+	categoryUseCase := NewCategoryUseCase(categoryRepo, expenseRepo, fakeClock)
+
+	manager, err := userUseCase.CreateUser(ctx, &dto.CreateUserRequest{Name: "鈴木部長", Email: "suzuki@example.com"})
+	require.NoError(t, err)
+	yamada, err := userUseCase.CreateUser(ctx, &dto.CreateUserRequest{Name: "山田太郎", Email: "yamada@example.com", ManagerID: manager.ID})
+	require.NoError(t, err)
+	sato, err := userUseCase.CreateUser(ctx, &dto.CreateUserRequest{Name: "佐藤花子", Email: "sato@example.com", ManagerID: manager.ID})
+	require.NoError(t, err)
+	category, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{Name: "交通費"})
+	require.NoError(t, err)
+
+	today := fakeClock.Now().Format("2006-01-02")
+	receiptHash := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	taxi, err := useCase.CreateExpense(ctx, yamada.ID, &dto.CreateExpenseRequest{
+		CategoryID: category.ID, Amount: 2400, Title: "タクシー代", Date: today, Vendor: "日本交通", ReceiptHash: receiptHash,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, taxi.SuspectedDuplicates)
+	assert.Empty(t, taxi.Warnings)
+
+	t.Run("同僚が同じタクシー代を作成すると警告を返す", func(t *testing.T) {
+		shared, err := useCase.CreateExpense(ctx, sato.ID, &dto.CreateExpenseRequest{
+			CategoryID: category.ID, Amount: 2400, Title: "客先へのタクシー代", Date: today, Vendor: "日本交通",
+		})
+		require.NoError(t, err)
+		require.Len(t, shared.SuspectedDuplicates, 1)
+
+		duplicate := shared.SuspectedDuplicates[0]
+		assert.Equal(t, taxi.ID, duplicate.ExpenseID)
+		assert.Equal(t, "山田太郎", duplicate.UserName)
+		assert.Equal(t, []string{"same_vendor", "similar_title"}, duplicate.Reasons)
+		require.Len(t, shared.Warnings, 1)
+		assert.Contains(t, shared.Warnings[0], "経費「タクシー代」（山田太郎、")
+	})
+
+	t.Run("同じ領収書ファイルを再び申請すると警告を返す", func(t *testing.T) {
+		again, err := useCase.CreateExpense(ctx, yamada.ID, &dto.CreateExpenseRequest{
+			CategoryID: category.ID, Amount: 2000, Title: "移動費", Date: today, ReceiptHash: receiptHash,
+		})
+		require.NoError(t, err)
+		require.Len(t, again.SuspectedDuplicates, 1)
+		assert.Equal(t, []string{"same_receipt"}, again.SuspectedDuplicates[0].Reasons)
+
+		fakeClock.Advance(time.Minute)
+		submitted, err := useCase.SubmitExpense(ctx, again.ID)
+		require.NoError(t, err)
+		assert.Len(t, submitted.Warnings, 1)
+	})
+
+	t.Run("承認待ち一覧で重複の疑いがある経費を確認できる", func(t *testing.T) {
+		// 承認待ち一覧は申請日時の順
+		fakeClock.Advance(time.Minute)
+		_, err := useCase.SubmitExpense(ctx, taxi.ID)
+		require.NoError(t, err)
+		unique, err := useCase.CreateExpense(ctx, sato.ID, &dto.CreateExpenseRequest{
+			CategoryID: category.ID, Amount: 980, Title: "バス代", Date: today,
+		})
+		require.NoError(t, err)
+		fakeClock.Advance(time.Minute)
+		_, err = useCase.SubmitExpense(ctx, unique.ID)
+		require.NoError(t, err)
+
+		queue, err := useCase.GetApprovalQueue(ctx, manager.ID, false)
+		require.NoError(t, err)
+		require.Len(t, queue, 3)
+		assert.Empty(t, queue[2].SuspectedDuplicates, "重複の疑いがない経費")
+
+		// 申請済みのタクシー代は、同僚の下書きと同じ領収書ファイルの経費の両方と重複の疑いがある
+		queue, err = useCase.GetApprovalQueue(ctx, manager.ID, true)
+		require.NoError(t, err)
+		require.Len(t, queue, 2)
+		assert.Equal(t, taxi.ID, queue[1].ID)
+		assert.Len(t, queue[1].SuspectedDuplicates, 2)
+
+		// 申請者は承認者ではないため一覧は空
+		queue, err = useCase.GetApprovalQueue(ctx, yamada.ID, false)
+		require.NoError(t, err)
+		assert.Empty(t, queue)
+	})
+}
+
+func TestExpenseReportUseCase_SubmitDuplicateWarnings(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	reportRepo := persistence.NewMemoryExpenseReportRepository()
+
+	// ユースケースを初期化
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+	reportUseCase := NewExpenseReportUseCase(reportRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+	userUseCase := NewUserUseCase(userRepo, persistence.NewMemoryDepartmentRepository(), persistence.NewMemoryCostCenterRepository(), fakeClock)
+	categoryUseCase := NewCategoryUseCase(categoryRepo, expenseRepo, fakeClock)
+
+	yamada, err := userUseCase.CreateUser(ctx, &dto.CreateUserRequest{Name: "山田太郎", Email: "yamada@example.com"})
+	require.NoError(t, err)
+	sato, err := userUseCase.CreateUser(ctx, &dto.CreateUserRequest{Name: "佐藤花子", Email: "sato@example.com"})
+	require.NoError(t, err)
+	category, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{Name: "交通費"})
+	require.NoError(t, err)
+
+	today := fakeClock.Now().Format("2006-01-02")
+	_, err = useCase.CreateExpense(ctx, yamada.ID, &dto.CreateExpenseRequest{
+		CategoryID: category.ID, Amount: 2400, Title: "タクシー代", Date: today, Vendor: "日本交通",
+	})
+	require.NoError(t, err)
+
+	// 同僚が同じタクシー代をレポートに含めて申請する
+	shared, err := useCase.CreateExpense(ctx, sato.ID, &dto.CreateExpenseRequest{
+		CategoryID: category.ID, Amount: 2400, Title: "客先へのタクシー代", Date: today, Vendor: "日本交通",
+	})
+	require.NoError(t, err)
+	report, err := reportUseCase.CreateExpenseReport(ctx, sato.ID, &dto.CreateExpenseReportRequest{
+		Title: "客先訪問", PeriodStart: fakeClock.Now().AddDate(0, 0, -1).Format("2006-01-02"), PeriodEnd: fakeClock.Now().Format("2006-01-02"),
+		ExpenseIDs: []string{shared.ID},
+	})
+	require.NoError(t, err)
+
+	result, err := reportUseCase.SubmitExpenseReport(ctx, report.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "submitted", result.Status)
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0], "経費「客先へのタクシー代」: 経費「タクシー代」（山田太郎、")
+}
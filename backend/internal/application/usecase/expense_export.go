@@ -0,0 +1,422 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"expense-management-system/pkg/xlsx"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// expenseExportColumns エクスポートする列の見出し
+var expenseExportColumns = []string{
+	"経費ID", "日付", "申請者ID", "申請者", "カテゴリ", "件名", "説明", "通貨",
+	"金額（税込）", "税抜金額", "消費税額", "税率（%）", "ステータス", "申請日時", "承認者",
+	"参加人数", "1人当たり金額", "会議費・交際費区分", "参加者", "コストセンター",
+	"明細",
+}
+
+// ExpenseExport 検索条件を検証済みの経費エクスポート
+// ヘッダーを送信する前に条件の誤りを返せるように、検証と出力を分けている
+type ExpenseExport struct {
+	uc       *ExpenseUseCase
+	filter   repository.ExpenseFilter
+	format   string
+	encoding string
+}
+
+// exportRowWriter エクスポートの形式ごとの行の書き出し
+type exportRowWriter interface {
+	WriteRow(values []interface{}) error
+	Close() error
+}
+
+// ExportExpenses 検索条件を検証し、経費のエクスポートを準備
+func (uc *ExpenseUseCase) ExportExpenses(ctx context.Context, req *dto.ExportExpensesRequest) (*ExpenseExport, error) {
+	filter, err := buildExpenseFilter(&req.ExpenseListRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &ExpenseExport{
+		uc:       uc,
+		filter:   filter,
+		format:   req.Format,
+		encoding: req.Encoding,
+	}
+	if export.format == "" {
+		export.format = "csv"
+	}
+	if export.encoding == "" {
+		export.encoding = "utf-8"
+	}
+
+	return export, nil
+}
+
+// ContentType 出力するファイルのContent-Type
+func (e *ExpenseExport) ContentType() string {
+	return exportContentType(e.format, e.encoding)
+}
+
+// exportContentType 形式と文字コードに応じたContent-Type
+func exportContentType(format, charset string) string {
+	if format == "xlsx" {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	if charset == "shift_jis" {
+		return "text/csv; charset=Shift_JIS"
+	}
+	return "text/csv; charset=UTF-8"
+}
+
+// FileName 出力するファイル名
+func (e *ExpenseExport) FileName() string {
+	return "expenses_" + e.uc.clock.Now().Format("20060102") + "." + e.format
+}
+
+// Write 条件に一致する経費を1件ずつ書き出す
+func (e *ExpenseExport) Write(ctx context.Context, w io.Writer) error {
+	writer, err := newExportRowWriter(w, e.format, e.encoding, "経費")
+	if err != nil {
+		return err
+	}
+
+	header := make([]interface{}, len(expenseExportColumns))
+	for i, column := range expenseExportColumns {
+		header[i] = column
+	}
+	if err := writer.WriteRow(header); err != nil {
+		return err
+	}
+
+	// 申請者の名前・カテゴリ・コストセンターは出力中だけ保持する
+	userNames := make(map[string]string)
+	categories := make(map[string]*entity.Category)
+	charges := newCostCenterCharges(e.uc.userRepo, e.uc.costCenterRepo)
+
+	err = e.uc.expenseRepo.Iterate(ctx, e.filter, func(expense *entity.Expense) error {
+		row, err := e.uc.buildExpenseExportRow(ctx, expense, userNames, categories, charges)
+		if err != nil {
+			return err
+		}
+		return writer.WriteRow(row)
+	})
+	if err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+// newExportRowWriter 形式と文字コードに応じた行の書き出しを作成（sheetはxlsxのシート名）
+func newExportRowWriter(w io.Writer, format, charset, sheet string) (exportRowWriter, error) {
+	if format == "xlsx" {
+		return xlsx.NewStreamWriter(w, sheet)
+	}
+
+	switch charset {
+	case "utf-8-bom":
+		// ExcelでUTF-8として開けるようにBOMを付ける
+		if _, err := io.WriteString(w, "\ufeff"); err != nil {
+			return nil, err
+		}
+	case "shift_jis":
+		// Shift_JISで表せない文字は「?」に置き換える
+		encoder := encoding.ReplaceUnsupported(japanese.ShiftJIS.NewEncoder())
+		encoded := transform.NewWriter(w, encoder)
+		return &csvRowWriter{writer: csv.NewWriter(encoded), closer: encoded}, nil
+	}
+
+	return &csvRowWriter{writer: csv.NewWriter(w)}, nil
+}
+
+// buildExpenseFilter 経費一覧の検索条件をリポジトリの検索条件に変換
+func buildExpenseFilter(req *dto.ExpenseListRequest) (repository.ExpenseFilter, error) {
+	dateFrom, dateTo, err := parseDatePeriod(req.DateFrom, req.DateTo)
+	if err != nil {
+		return repository.ExpenseFilter{}, err
+	}
+
+	filter := repository.ExpenseFilter{
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+	}
+
+	if req.UserID != "" {
+		userID, err := valueobject.NewUserID(req.UserID)
+		if err != nil {
+			return filter, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		filter.UserID = userID
+	}
+
+	if req.CategoryID != "" {
+		categoryID, err := valueobject.NewCategoryID(req.CategoryID)
+		if err != nil {
+			return filter, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		filter.CategoryID = categoryID
+	}
+
+	if req.Status != "" {
+		status := entity.ExpenseStatus(req.Status)
+		switch status {
+		case entity.ExpenseStatusDraft, entity.ExpenseStatusSubmitted, entity.ExpenseStatusApproved, entity.ExpenseStatusRejected:
+			filter.Status = status
+		default:
+			return filter, errors.NewApplicationError(errors.ValidationFailed, "無効なステータスです")
+		}
+	}
+
+	return filter, nil
+}
+
+// parseDatePeriod 期間の文字列（YYYY-MM-DD）を変換（省略した場合はゼロ値）
+func parseDatePeriod(dateFrom, dateTo string) (valueobject.Date, valueobject.Date, error) {
+	var from, to valueobject.Date
+	var err error
+	if dateFrom != "" {
+		if from, err = valueobject.ParseDate(dateFrom); err != nil {
+			return from, to, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+	}
+	if dateTo != "" {
+		if to, err = valueobject.ParseDate(dateTo); err != nil {
+			return from, to, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+	}
+
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return from, to, errors.NewApplicationError(errors.ValidationFailed, "date_to は date_from 以降の日付である必要があります")
+	}
+	return from, to, nil
+}
+
+// buildExpenseExportRow 経費をエクスポートの1行に変換
+func (uc *ExpenseUseCase) buildExpenseExportRow(ctx context.Context, expense *entity.Expense, userNames map[string]string, categories map[string]*entity.Category, charges *costCenterCharges) ([]interface{}, error) {
+	category := uc.exportCategory(ctx, expense.CategoryID(), categories)
+
+	// 明細に分けた経費は明細ごとの税率で税抜金額と消費税額を計算して合計する
+	net, err := valueobject.NewMoney(0, expense.Amount().Currency())
+	if err != nil {
+		return nil, err
+	}
+	tax := net
+	var rate interface{}
+	for i, line := range expense.Lines() {
+		lineRate := lineTaxRate(expense, line, uc.exportCategory(ctx, line.CategoryID(), categories))
+		breakdown, err := valueobject.NewTaxBreakdown(line.Amount(), lineRate)
+		if err != nil {
+			return nil, err
+		}
+		if net, err = net.Add(breakdown.Net()); err != nil {
+			return nil, err
+		}
+		if tax, err = tax.Add(breakdown.Tax()); err != nil {
+			return nil, err
+		}
+
+		// 税率が明細ごとに異なる場合は税率を空欄にする
+		rounded := math.Round(lineRate*10000) / 100
+		if i == 0 {
+			rate = rounded
+		} else if rate != rounded {
+			rate = nil
+		}
+	}
+
+	categoryName := ""
+	if category != nil {
+		categoryName = category.Name()
+	}
+
+	var submittedAt interface{}
+	if !expense.SubmittedAt().IsZero() {
+		submittedAt = expense.SubmittedAt().Format("2006-01-02 15:04:05")
+	}
+
+	var approverName interface{}
+	if expense.ApproverID() != nil {
+		approverName = uc.exportUserName(ctx, expense.ApproverID(), userNames)
+	}
+
+	var attendeeCount, perHeadAmount interface{}
+	if perHead := expense.PerHeadAmount(); perHead != nil {
+		attendeeCount = float64(len(expense.Attendees()))
+		perHeadAmount = perHead.Amount()
+	}
+
+	return []interface{}{
+		expense.ID().String(),
+		expense.Date().Time(),
+		expense.UserID().String(),
+		uc.exportUserName(ctx, expense.UserID(), userNames),
+		categoryName,
+		expense.Title(),
+		expense.Description(),
+		expense.Amount().Currency(),
+		expense.Amount().Amount(),
+		net.Amount(),
+		tax.Amount(),
+		rate,
+		expense.Status().Label(),
+		submittedAt,
+		approverName,
+		attendeeCount,
+		perHeadAmount,
+		expense.EntertainmentClass(uc.entertainmentCategory(ctx, categories)).Label(),
+		formatAttendees(expense.Attendees()),
+		formatCostAllocations(ctx, charges, expense),
+		uc.formatLineItems(ctx, expense, categories),
+	}, nil
+}
+
+// formatLineItems 明細を「カテゴリ名 金額」の形式で「、」区切りにする（明細のない経費は空）
+// 削除済みのカテゴリはIDを出力する
+func (uc *ExpenseUseCase) formatLineItems(ctx context.Context, expense *entity.Expense, categories map[string]*entity.Category) string {
+	lineItems := expense.LineItems()
+	values := make([]string, len(lineItems))
+	for i, lineItem := range lineItems {
+		label := lineItem.CategoryID().String()
+		if category := uc.exportCategory(ctx, lineItem.CategoryID(), categories); category != nil {
+			label = category.Name()
+		}
+		values[i] = label + " " + strconv.FormatFloat(lineItem.Amount().Amount(), 'f', -1, 64)
+	}
+	return strings.Join(values, "、")
+}
+
+// formatCostAllocations 負担先のコストセンターを「コード 名前 金額」の形式で「、」区切りにする
+// 削除済みのコストセンターはIDを出力する
+func formatCostAllocations(ctx context.Context, charges *costCenterCharges, expense *entity.Expense) string {
+	allocations := charges.Allocations(ctx, expense)
+	values := make([]string, len(allocations))
+	for i, allocation := range allocations {
+		label := allocation.CostCenterID().String()
+		if costCenter := charges.CostCenter(ctx, allocation.CostCenterID()); costCenter != nil {
+			label = costCenter.Code() + " " + costCenter.Name()
+		}
+		values[i] = label + " " + strconv.FormatFloat(allocation.Amount().Amount(), 'f', -1, 64)
+	}
+	return strings.Join(values, "、")
+}
+
+// formatAttendees 参加者を「氏名（会社名）」の形式で「、」区切りにする（社内の参加者は氏名のみ）
+func formatAttendees(attendees []*entity.Attendee) string {
+	names := make([]string, len(attendees))
+	for i, attendee := range attendees {
+		names[i] = attendee.Name()
+		if attendee.IsExternal() {
+			names[i] += "（" + attendee.Company() + "）"
+		}
+	}
+	return strings.Join(names, "、")
+}
+
+// exportUserName ユーザー名を取得（削除済みのユーザーは空）
+func (uc *ExpenseUseCase) exportUserName(ctx context.Context, userID *valueobject.UserID, names map[string]string) string {
+	if name, ok := names[userID.String()]; ok {
+		return name
+	}
+
+	name := ""
+	if user, err := uc.userRepo.FindByID(ctx, userID); err == nil {
+		name = user.Name()
+	}
+	names[userID.String()] = name
+	return name
+}
+
+// exportCategory カテゴリを取得（削除済みのカテゴリはnil）
+func (uc *ExpenseUseCase) exportCategory(ctx context.Context, categoryID *valueobject.CategoryID, categories map[string]*entity.Category) *entity.Category {
+	if category, ok := categories[categoryID.String()]; ok {
+		return category
+	}
+
+	category, err := uc.categoryRepo.FindByID(ctx, categoryID)
+	if err != nil {
+		category = nil
+	}
+	categories[categoryID.String()] = category
+	return category
+}
+
+// entertainmentCategory カテゴリが飲食・接待のカテゴリかどうかの判定（削除済みのカテゴリは対象外）
+func (uc *ExpenseUseCase) entertainmentCategory(ctx context.Context, categories map[string]*entity.Category) func(*valueobject.CategoryID) bool {
+	return func(categoryID *valueobject.CategoryID) bool {
+		category := uc.exportCategory(ctx, categoryID, categories)
+		return category != nil && category.IsEntertainment()
+	}
+}
+
+// lineTaxRate 経費の明細に適用する消費税率
+// 金額は税込とし、明細の税区分（未設定の場合はカテゴリの税区分、それも未設定の場合は標準税率）を適用する
+// 日本円以外の経費（海外での支払い）は消費税の対象外とする
+func lineTaxRate(expense *entity.Expense, line *entity.ExpenseLineItem, category *entity.Category) float64 {
+	if expense.Amount().Currency() != "JPY" {
+		return 0
+	}
+	if line.TaxCode() != "" {
+		return line.TaxCode().Rate()
+	}
+	if category != nil && category.AccountMapping() != nil {
+		return category.AccountMapping().TaxCode().Rate()
+	}
+	return valueobject.StandardConsumptionTaxRate
+}
+
+// csvRowWriter CSVの行の書き出し
+type csvRowWriter struct {
+	writer *csv.Writer
+	closer io.Closer // 文字コードの変換など、最後に閉じる必要がある書き込み先
+}
+
+// WriteRow 1行を書き出す
+func (w *csvRowWriter) WriteRow(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, value := range values {
+		switch v := value.(type) {
+		case nil:
+		case string:
+			record[i] = escapeCSVFormula(v)
+		case float64:
+			record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		case time.Time:
+			record[i] = v.Format("2006-01-02")
+		}
+	}
+	return w.writer.Write(record)
+}
+
+// Close バッファに残った行を書き出す
+func (w *csvRowWriter) Close() error {
+	w.writer.Flush()
+	if err := w.writer.Error(); err != nil {
+		return err
+	}
+	if w.closer != nil {
+		return w.closer.Close()
+	}
+	return nil
+}
+
+// escapeCSVFormula 表計算ソフトで数式として解釈される値の先頭に「'」を付ける
+func escapeCSVFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/internal/infrastructure/persistence"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestExpenseUseCase_ExportExpenses(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+
+	// ユースケースを初期化
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	// テスト用のユーザーとカテゴリを作成
+	user, _ := entity.NewUser(fakeClock, "山田太郎", "yamada@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	category, _ := entity.NewCategory(fakeClock, "会議費", "打合せの飲食代", "#00FF00")
+	category.ChangeEntertainment(true, fakeClock.Now())
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	today := fakeClock.Now().Truncate(24 * time.Hour)
+	createExpense := func(amount float64, currency, title string, date time.Time) *entity.Expense {
+		money, err := valueobject.NewMoney(amount, currency)
+		require.NoError(t, err)
+		expense, err := entity.NewExpense(fakeClock, user.ID(), category.ID(), money, title, "", valueobject.DateOf(date))
+		require.NoError(t, err)
+		require.NoError(t, expenseRepo.Save(ctx, expense))
+		return expense
+	}
+
+	createExpense(1000, "JPY", "=SUM(A1:A2)", today.AddDate(0, 0, -2))
+	createExpense(50, "USD", "海外出張の昼食", today.AddDate(0, 0, -1))
+	submitted := createExpense(3300, "JPY", "取引先との打合せ", today)
+	host, _ := entity.NewAttendee("山田太郎", "", entity.AttendeeTypeInternal)
+	guest, _ := entity.NewAttendee("佐藤花子", "株式会社サンプル", entity.AttendeeTypeExternal)
+	require.NoError(t, submitted.ChangeAttendees([]*entity.Attendee{host, guest}, fakeClock.Now()))
+	require.NoError(t, submitted.Submit(fakeClock.Now()))
+
+	export := func(req *dto.ExportExpensesRequest) []byte {
+		exporter, err := useCase.ExportExpenses(ctx, req)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, exporter.Write(ctx, &buf))
+		return buf.Bytes()
+	}
+
+	t.Run("CSVを日付の順に出力", func(t *testing.T) {
+		records, err := csv.NewReader(bytes.NewReader(export(&dto.ExportExpensesRequest{}))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+
+		assert.Equal(t, expenseExportColumns, records[0])
+
+		// 数式として解釈される値は先頭に「'」を付ける
+		assert.Equal(t, "'=SUM(A1:A2)", records[1][5])
+		assert.Equal(t, "山田太郎", records[1][3])
+		assert.Equal(t, "会議費", records[1][4])
+		assert.Equal(t, "1000", records[1][8])
+		assert.Equal(t, "910", records[1][9])
+		assert.Equal(t, "90", records[1][10])
+		assert.Equal(t, "10", records[1][11])
+		assert.Equal(t, "下書き", records[1][12])
+
+		// 日本円以外の経費は消費税の対象外
+		assert.Equal(t, "USD", records[2][7])
+		assert.Equal(t, "0", records[2][10])
+
+		assert.Equal(t, "申請済み", records[3][12])
+		assert.NotEmpty(t, records[3][13])
+
+		// 参加者のいる飲食費は1人当たりの金額と会議費・交際費の区分を出力
+		assert.Equal(t, "", records[1][17])
+		assert.Equal(t, "2", records[3][15])
+		assert.Equal(t, "1650", records[3][16])
+		assert.Equal(t, "会議費", records[3][17])
+		assert.Equal(t, "山田太郎、佐藤花子（株式会社サンプル）", records[3][18])
+	})
+
+	t.Run("検索条件で絞り込む", func(t *testing.T) {
+		data := export(&dto.ExportExpensesRequest{
+			ExpenseListRequest: dto.ExpenseListRequest{
+				Status:   "draft",
+				DateFrom: today.AddDate(0, 0, -1).Format("2006-01-02"),
+				DateTo:   today.AddDate(0, 0, -1).Format("2006-01-02"),
+			},
+		})
+
+		records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, "海外出張の昼食", records[1][5])
+	})
+
+	t.Run("Shift_JISとBOM付きUTF-8で出力", func(t *testing.T) {
+		data := export(&dto.ExportExpensesRequest{Encoding: "shift_jis"})
+		decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(data)
+		require.NoError(t, err)
+		assert.Contains(t, string(decoded), "取引先との打合せ")
+
+		data = export(&dto.ExportExpensesRequest{Encoding: "utf-8-bom"})
+		assert.True(t, bytes.HasPrefix(data, []byte{0xEF, 0xBB, 0xBF}))
+	})
+
+	t.Run("XLSXで出力", func(t *testing.T) {
+		exporter, err := useCase.ExportExpenses(ctx, &dto.ExportExpensesRequest{Format: "xlsx"})
+		require.NoError(t, err)
+		assert.Contains(t, exporter.ContentType(), "spreadsheetml")
+
+		var buf bytes.Buffer
+		require.NoError(t, exporter.Write(ctx, &buf))
+
+		reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+
+		var sheet string
+		for _, file := range reader.File {
+			if file.Name == "xl/worksheets/sheet1.xml" {
+				f, err := file.Open()
+				require.NoError(t, err)
+				content, err := io.ReadAll(f)
+				require.NoError(t, err)
+				sheet = string(content)
+			}
+		}
+
+		assert.Contains(t, sheet, "取引先との打合せ")
+		assert.Contains(t, sheet, `<v>3300</v>`)
+		assert.Contains(t, sheet, `=SUM(A1:A2)`)
+	})
+
+	t.Run("不正な検索条件はエラー", func(t *testing.T) {
+		_, err := useCase.ExportExpenses(ctx, &dto.ExportExpensesRequest{
+			ExpenseListRequest: dto.ExpenseListRequest{Status: "unknown"},
+		})
+		assert.Error(t, err)
+
+		_, err = useCase.ExportExpenses(ctx, &dto.ExportExpensesRequest{
+			ExpenseListRequest: dto.ExpenseListRequest{DateFrom: today.Format("2006-01-02"), DateTo: today.AddDate(0, 0, -1).Format("2006-01-02")},
+		})
+		assert.Error(t, err)
+
+		_, err = useCase.ExportExpenses(ctx, &dto.ExportExpensesRequest{
+			ExpenseListRequest: dto.ExpenseListRequest{DateFrom: "2026/04/01"},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,372 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// maxExpenseImportRows 一度に取り込める最大行数
+const maxExpenseImportRows = 10000
+
+// ExpenseImportUseCase 経費の一括取込ユースケース
+type ExpenseImportUseCase struct {
+	expenseRepo  repository.ExpenseRepository
+	userRepo     repository.UserRepository
+	categoryRepo repository.CategoryRepository
+	periodGuard  *fiscalPeriodGuard
+	clock        clock.Clock
+}
+
+// ExpenseImportUseCaseOption ExpenseImportUseCaseの任意の依存関係を設定するオプション
+type ExpenseImportUseCaseOption func(*ExpenseImportUseCase)
+
+// WithImportFiscalPeriods 会計期間を設定（未設定の場合は締め済みの会計期間を確認しない）
+func WithImportFiscalPeriods(calendar *valueobject.FiscalCalendar, periodRepo repository.AccountingPeriodRepository) ExpenseImportUseCaseOption {
+	return func(uc *ExpenseImportUseCase) {
+		uc.periodGuard = &fiscalPeriodGuard{calendar: calendar, periodRepo: periodRepo, clock: uc.clock}
+	}
+}
+
+// NewExpenseImportUseCase ExpenseImportUseCaseのコンストラクタ
+func NewExpenseImportUseCase(
+	expenseRepo repository.ExpenseRepository,
+	userRepo repository.UserRepository,
+	categoryRepo repository.CategoryRepository,
+	clk clock.Clock,
+	opts ...ExpenseImportUseCaseOption,
+) *ExpenseImportUseCase {
+	uc := &ExpenseImportUseCase{
+		expenseRepo:  expenseRepo,
+		userRepo:     userRepo,
+		categoryRepo: categoryRepo,
+		clock:        clk,
+	}
+
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
+
+// ImportExpenses CSVの全ての行を経費として検証し、コミット指定時は全ての行をまとめて下書きで作成
+// 1行でも検証エラーがある場合はいずれの経費も作成しない
+func (uc *ExpenseImportUseCase) ImportExpenses(ctx context.Context, req *dto.ImportExpensesRequest) (*dto.ImportExpensesResponse, error) {
+	content, err := decodeImportContent(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var defaultUser *entity.User
+	if req.UserID != "" {
+		defaultUser, err = uc.findUser(ctx, req.UserID)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません: "+req.UserID)
+		}
+	} else if req.Mapping.User == "" {
+		return nil, errors.NewApplicationError(errors.InvalidImportFile, "user_id またはユーザーの列の指定が必要です")
+	}
+
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.InvalidImportFile, "CSVのヘッダーを読み取れません")
+	}
+
+	columns, err := resolveImportColumns(header, req.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	dateFormats := defaultCardDateFormats
+	if req.Mapping.DateFormat != "" {
+		dateFormats = []string{toGoDateLayout(req.Mapping.DateFormat)}
+	}
+
+	response := &dto.ImportExpensesResponse{
+		DryRun: !req.Commit,
+		Errors: make([]*dto.ExpenseImportErrorResponse, 0),
+	}
+
+	users := make(map[string]*entity.User)
+	categories := make(map[string]*entity.Category)
+	expenses := make([]*entity.Expense, 0)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.InvalidImportFile, "CSVを読み取れません: "+err.Error())
+		}
+
+		// 空白だけの行は無視
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		response.TotalRows++
+		if response.TotalRows > maxExpenseImportRows {
+			return nil, errors.NewApplicationError(errors.InvalidImportFile, "一度に取り込める行数は10000行までです")
+		}
+
+		line, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			index, ok := columns[name]
+			if !ok || index >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[index])
+		}
+
+		rowErrors := make([]*dto.ExpenseImportErrorResponse, 0)
+		addError := func(name, message string) {
+			rowErrors = append(rowErrors, &dto.ExpenseImportErrorResponse{
+				Line:    line,
+				Column:  importColumnName(req.Mapping, name),
+				Message: message,
+			})
+		}
+
+		// ユーザー（列の値がない場合は既定のユーザー）
+		user := defaultUser
+		if value := field("user"); value != "" {
+			if _, cached := users[value]; !cached {
+				users[value], _ = uc.findUser(ctx, value)
+			}
+			user = users[value]
+			if user == nil {
+				addError("user", "ユーザーが見つかりません: "+value)
+			}
+		} else if user == nil {
+			addError("user", "ユーザーは必須です")
+		}
+
+		// カテゴリ
+		var category *entity.Category
+		if value := field("category"); value != "" {
+			if _, cached := categories[value]; !cached {
+				categories[value], _ = uc.findCategory(ctx, value)
+			}
+			category = categories[value]
+			if category == nil {
+				addError("category", "カテゴリが見つかりません: "+value)
+			}
+		} else {
+			addError("category", "カテゴリは必須です")
+		}
+
+		// 金額と通貨
+		currency := req.Currency
+		if value := field("currency"); value != "" {
+			currency = value
+		}
+
+		var amount *valueobject.Money
+		if value, parseErr := parseStatementAmount(field("amount")); parseErr != nil {
+			addError("amount", "金額を読み取れません: "+field("amount"))
+		} else if amount, err = valueobject.NewMoney(value, currency); err != nil {
+			addError("amount", domainErrorMessage(err))
+		}
+
+		// 日付
+		statementDate, dateErr := parseStatementDate(field("date"), dateFormats)
+		date := valueobject.DateOf(statementDate)
+		if dateErr != nil {
+			addError("date", "日付を読み取れません: "+field("date"))
+		}
+
+		// 全ての項目を読み取れた行は経費のルールで検証する
+		if len(rowErrors) == 0 {
+			expense, _, err := entity.NewExpenseInCategory(uc.clock, user, category, amount, field("title"), field("description"), date)
+			if err != nil {
+				addError(expenseErrorColumn(err), domainErrorMessage(err))
+			} else if err := uc.periodGuard.ensureOpen(ctx, date); err != nil {
+				// 締め済みの会計期間の行は検証エラーとし、会計期間を確認できない場合は取込を中止する
+				if !isFiscalPeriodClosed(err) {
+					return nil, err
+				}
+				addError("date", err.(*errors.ApplicationError).Message)
+			} else {
+				expenses = append(expenses, expense)
+			}
+		}
+
+		response.Errors = append(response.Errors, rowErrors...)
+		if len(rowErrors) > 0 {
+			response.InvalidRows++
+		}
+	}
+
+	if response.TotalRows == 0 {
+		return nil, errors.NewApplicationError(errors.InvalidImportFile, "取り込む行がありません")
+	}
+
+	response.ValidRows = len(expenses)
+
+	if !req.Commit || response.InvalidRows > 0 {
+		return response, nil
+	}
+
+	// 全ての行をまとめて作成
+	if err := uc.expenseRepo.SaveAll(ctx, expenses); err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseImportFailed, "経費の一括作成に失敗しました")
+	}
+
+	response.Committed = true
+	response.ExpenseIDs = make([]string, len(expenses))
+	for i, expense := range expenses {
+		response.ExpenseIDs[i] = expense.ID().String()
+	}
+
+	return response, nil
+}
+
+// findUser ユーザーIDまたはメールアドレスでユーザーを検索
+func (uc *ExpenseImportUseCase) findUser(ctx context.Context, value string) (*entity.User, error) {
+	if strings.Contains(value, "@") {
+		return uc.userRepo.FindByEmail(ctx, value)
+	}
+
+	id, err := valueobject.NewUserID(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.FindByID(ctx, id)
+}
+
+// findCategory カテゴリIDまたはカテゴリ名でカテゴリを検索
+func (uc *ExpenseImportUseCase) findCategory(ctx context.Context, value string) (*entity.Category, error) {
+	if _, err := uuid.Parse(value); err == nil {
+		id, err := valueobject.NewCategoryID(value)
+		if err != nil {
+			return nil, err
+		}
+		return uc.categoryRepo.FindByID(ctx, id)
+	}
+
+	return uc.categoryRepo.FindByName(ctx, value)
+}
+
+// decodeImportContent 取り込むCSVをUTF-8の文字列に変換
+func decodeImportContent(req *dto.ImportExpensesRequest) (string, error) {
+	if req.ContentBase64 == "" {
+		return strings.TrimPrefix(req.Content, "\ufeff"), nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(req.ContentBase64)
+	if err != nil {
+		return "", errors.NewApplicationError(errors.InvalidImportFile, "content_base64 をデコードできません")
+	}
+
+	// 文字コードの指定がない場合、UTF-8として正しくなければShift_JISとみなす
+	encoding := req.Encoding
+	if encoding == "" {
+		encoding = "utf-8"
+		if !utf8.Valid(data) {
+			encoding = "shift_jis"
+		}
+	}
+
+	if encoding == "shift_jis" {
+		data, err = japanese.ShiftJIS.NewDecoder().Bytes(data)
+		if err != nil {
+			return "", errors.NewApplicationError(errors.InvalidImportFile, "Shift_JISとして読み取れません")
+		}
+	}
+
+	return strings.TrimPrefix(string(data), "\ufeff"), nil
+}
+
+// resolveImportColumns ヘッダー名から各項目の列の位置を求める
+func resolveImportColumns(header []string, mapping *dto.ExpenseImportMappingRequest) (map[string]int, error) {
+	positions := make(map[string]int)
+	for i, name := range header {
+		positions[strings.TrimSpace(name)] = i
+	}
+
+	columns := make(map[string]int)
+	for _, name := range []string{"user", "category", "amount", "currency", "title", "description", "date"} {
+		column := importColumnName(mapping, name)
+		if column == "" {
+			continue
+		}
+
+		index, ok := positions[column]
+		if !ok {
+			return nil, errors.NewApplicationError(errors.InvalidImportFile, "CSVに列が見つかりません: "+column)
+		}
+		columns[name] = index
+	}
+
+	return columns, nil
+}
+
+// importColumnName 項目に対応するCSVのヘッダー名を取得
+func importColumnName(mapping *dto.ExpenseImportMappingRequest, name string) string {
+	switch name {
+	case "user":
+		return mapping.User
+	case "category":
+		return mapping.Category
+	case "amount":
+		return mapping.Amount
+	case "currency":
+		return mapping.Currency
+	case "title":
+		return mapping.Title
+	case "description":
+		return mapping.Description
+	case "date":
+		return mapping.Date
+	default:
+		return ""
+	}
+}
+
+// expenseErrorColumn 経費の検証エラーの原因となった項目を求める
+func expenseErrorColumn(err error) string {
+	domainErr, ok := err.(*errors.DomainError)
+	if !ok {
+		return ""
+	}
+
+	switch domainErr.Code {
+	case errors.InvalidExpenseAmount:
+		return "amount"
+	case "INVALID_EXPENSE_TITLE":
+		return "title"
+	case "INVALID_EXPENSE_DESCRIPTION":
+		return "description"
+	case errors.InvalidExpenseDate:
+		return "date"
+	default:
+		return ""
+	}
+}
+
+// domainErrorMessage ドメインエラーの場合はメッセージ部分を取得
+func domainErrorMessage(err error) string {
+	if domainErr, ok := err.(*errors.DomainError); ok {
+		return domainErr.Message
+	}
+	return err.Error()
+}
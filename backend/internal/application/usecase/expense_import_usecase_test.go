@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/infrastructure/persistence"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestExpenseImportUseCase_ImportExpenses(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+
+	// ユースケースを初期化
+	useCase := NewExpenseImportUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	// テスト用のユーザーとカテゴリを作成
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	other, _ := entity.NewUser(fakeClock, "別のユーザー", "other@example.com")
+	require.NoError(t, userRepo.Save(ctx, other))
+
+	category, _ := entity.NewCategory(fakeClock, "交通費", "電車・バス代", "#FF0000")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	mapping := &dto.ExpenseImportMappingRequest{
+		User:     "申請者",
+		Category: "カテゴリ",
+		Amount:   "金額",
+		Title:    "件名",
+		Date:     "日付",
+	}
+
+	today := fakeClock.Now().Format("2006/01/02")
+	validCSV := fmt.Sprintf("申請者,カテゴリ,金額,件名,日付\n"+
+		"test@example.com,交通費,\"1,200\",新宿→品川,%[1]s\n"+
+		"other@example.com,交通費,580,品川→大崎,%[1]s\n", today)
+
+	t.Run("ドライランでは行ごとの検証エラーを返し経費を作成しない", func(t *testing.T) {
+		content := fmt.Sprintf("申請者,カテゴリ,金額,件名,日付\n"+
+			"test@example.com,交通費,1200,新宿→品川,%[1]s\n"+
+			"unknown@example.com,交通費,500,渋谷→品川,%[1]s\n"+
+			"test@example.com,会議費,abc,,2024/13/40\n"+
+			"test@example.com,交通費,300,,%[1]s\n", today)
+
+		result, err := useCase.ImportExpenses(ctx, &dto.ImportExpensesRequest{
+			Content: content,
+			Mapping: mapping,
+		})
+		require.NoError(t, err)
+
+		assert.True(t, result.DryRun)
+		assert.False(t, result.Committed)
+		assert.Equal(t, 4, result.TotalRows)
+		assert.Equal(t, 1, result.ValidRows)
+		assert.Equal(t, 3, result.InvalidRows)
+		assert.Empty(t, result.ExpenseIDs)
+
+		require.Len(t, result.Errors, 5)
+		assert.Equal(t, 3, result.Errors[0].Line)
+		assert.Equal(t, "申請者", result.Errors[0].Column)
+		assert.Equal(t, 4, result.Errors[1].Line)
+		assert.Equal(t, "カテゴリ", result.Errors[1].Column)
+		assert.Equal(t, "金額", result.Errors[2].Column)
+		assert.Equal(t, "日付", result.Errors[3].Column)
+		assert.Equal(t, 5, result.Errors[4].Line)
+		assert.Equal(t, "件名", result.Errors[4].Column)
+
+		expenses, err := expenseRepo.FindAll(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, expenses)
+	})
+
+	t.Run("検証エラーがある場合はコミットしても経費を作成しない", func(t *testing.T) {
+		content := validCSV + "test@example.com,交通費,-100,返金," + today + "\n"
+
+		result, err := useCase.ImportExpenses(ctx, &dto.ImportExpensesRequest{
+			Content: content,
+			Mapping: mapping,
+			Commit:  true,
+		})
+		require.NoError(t, err)
+
+		assert.False(t, result.DryRun)
+		assert.False(t, result.Committed)
+		assert.Equal(t, 2, result.ValidRows)
+		assert.Equal(t, 1, result.InvalidRows)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, "金額", result.Errors[0].Column)
+
+		expenses, err := expenseRepo.FindAll(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, expenses)
+	})
+
+	t.Run("全ての行が正しい場合はコミットで下書きの経費を作成", func(t *testing.T) {
+		result, err := useCase.ImportExpenses(ctx, &dto.ImportExpensesRequest{
+			Content: "\ufeff" + validCSV,
+			Mapping: mapping,
+			Commit:  true,
+		})
+		require.NoError(t, err)
+
+		assert.True(t, result.Committed)
+		assert.Equal(t, 2, result.ValidRows)
+		require.Len(t, result.ExpenseIDs, 2)
+
+		expenses, err := expenseRepo.FindByUserID(ctx, user.ID())
+		require.NoError(t, err)
+		require.Len(t, expenses, 1)
+		assert.Equal(t, entity.ExpenseStatusDraft, expenses[0].Status())
+		assert.Equal(t, 1200.0, expenses[0].Amount().Amount())
+		assert.Equal(t, "JPY", expenses[0].Amount().Currency())
+
+		expenses, err = expenseRepo.FindByUserID(ctx, other.ID())
+		require.NoError(t, err)
+		assert.Len(t, expenses, 1)
+	})
+
+	t.Run("Shift_JISのCSVを取り込む", func(t *testing.T) {
+		content := fmt.Sprintf("カテゴリ,金額,件名,日付\n交通費,800,東京→横浜,%s\n", today)
+		encoded, err := japanese.ShiftJIS.NewEncoder().String(content)
+		require.NoError(t, err)
+
+		result, err := useCase.ImportExpenses(ctx, &dto.ImportExpensesRequest{
+			ContentBase64: base64.StdEncoding.EncodeToString([]byte(encoded)),
+			UserID:        user.ID().String(),
+			Mapping: &dto.ExpenseImportMappingRequest{
+				Category: "カテゴリ",
+				Amount:   "金額",
+				Title:    "件名",
+				Date:     "日付",
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, result.ValidRows)
+		assert.Empty(t, result.Errors)
+	})
+
+	t.Run("CSVに指定した列がない場合はエラー", func(t *testing.T) {
+		_, err := useCase.ImportExpenses(ctx, &dto.ImportExpensesRequest{
+			Content: "申請者,カテゴリ,金額,件名\n",
+			Mapping: mapping,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("申請者を特定できない場合はエラー", func(t *testing.T) {
+		_, err := useCase.ImportExpenses(ctx, &dto.ImportExpensesRequest{
+			Content: validCSV,
+			Mapping: &dto.ExpenseImportMappingRequest{
+				Category: "カテゴリ",
+				Amount:   "金額",
+				Title:    "件名",
+				Date:     "日付",
+			},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/infrastructure/persistence"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpenseUseCase_LineItems(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	reportRepo := persistence.NewMemoryExpenseReportRepository()
+
+	// ユースケースを初期化
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+	reportUseCase := NewExpenseReportUseCase(reportRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+	userUseCase := NewUserUseCase(userRepo, persistence.NewMemoryDepartmentRepository(), persistence.NewMemoryCostCenterRepository(), fakeClock)
+	categoryUseCase := NewCategoryUseCase(categoryRepo, expenseRepo, fakeClock)
+
+	user, err := userUseCase.CreateUser(ctx, &dto.CreateUserRequest{Name: "山田太郎", Email: "yamada@example.com"})
+	require.NoError(t, err)
+
+	hotel, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{
+		Name:       "宿泊費",
+		Accounting: &dto.AccountMappingRequest{DebitAccount: "旅費交通費"},
+	})
+	require.NoError(t, err)
+	meal, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{
+		Name:          "食事代",
+		Accounting:    &dto.AccountMappingRequest{DebitAccount: "会議費", TaxCode: "reduced"},
+		Rules:         []dto.SpendingRuleRequest{{Type: "max_amount", Enforcement: "exception", MaxAmount: 1500}},
+		Entertainment: true,
+	})
+	require.NoError(t, err)
+	parking, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{
+		Name:       "駐車場代",
+		Accounting: &dto.AccountMappingRequest{DebitAccount: "旅費交通費"},
+	})
+	require.NoError(t, err)
+
+	today := fakeClock.Now().Truncate(24 * time.Hour)
+	createHotelExpense := func() *dto.ExpenseResponse {
+		expense, err := useCase.CreateExpense(ctx, user.ID, &dto.CreateExpenseRequest{
+			CategoryID: hotel.ID, Amount: 15000, Title: "大阪出張のホテル代", Date: today.Format("2006-01-02"),
+		})
+		require.NoError(t, err)
+		return expense
+	}
+
+	t.Run("下書きの経費を明細に分ける", func(t *testing.T) {
+		expense := createHotelExpense()
+
+		split, err := useCase.SplitExpense(ctx, expense.ID, &dto.SplitExpenseRequest{LineItems: []dto.LineItemRequest{
+			{CategoryID: hotel.ID, Amount: 12000, Description: "宿泊"},
+			{CategoryID: meal.ID, Amount: 2000, Description: "朝食"},
+			{CategoryID: parking.ID, Amount: 1000, TaxCode: "standard", Description: "駐車場"},
+		}})
+		require.NoError(t, err)
+		require.Len(t, split.LineItems, 3)
+		assert.Equal(t, "standard", split.LineItems[2].TaxCode)
+
+		// 明細のカテゴリの支出規程の違反を返す
+		require.Len(t, split.PolicyViolations, 1)
+		assert.Contains(t, split.PolicyViolations[0].Message, "明細「朝食」")
+
+		// 理由がなければ申請できない
+		_, err = useCase.SubmitExpense(ctx, expense.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("明細の合計が一致しない、またはカテゴリが存在しない場合はエラー", func(t *testing.T) {
+		expense := createHotelExpense()
+
+		_, err := useCase.SplitExpense(ctx, expense.ID, &dto.SplitExpenseRequest{LineItems: []dto.LineItemRequest{
+			{CategoryID: hotel.ID, Amount: 12000},
+			{CategoryID: meal.ID, Amount: 1000},
+		}})
+		assert.Error(t, err)
+
+		_, err = useCase.SplitExpense(ctx, expense.ID, &dto.SplitExpenseRequest{LineItems: []dto.LineItemRequest{
+			{CategoryID: hotel.ID, Amount: 14000},
+			{CategoryID: "00000000-0000-0000-0000-000000000000", Amount: 1000},
+		}})
+		assert.Error(t, err)
+
+		// 申請済みの経費は分けられない
+		_, err = useCase.SubmitExpense(ctx, expense.ID)
+		require.NoError(t, err)
+		_, err = useCase.SplitExpense(ctx, expense.ID, &dto.SplitExpenseRequest{LineItems: []dto.LineItemRequest{
+			{CategoryID: hotel.ID, Amount: 14000},
+			{CategoryID: parking.ID, Amount: 1000},
+		}})
+		assert.Error(t, err)
+	})
+
+	createItemizedExpense := func() *dto.ExpenseResponse {
+		expense, err := useCase.CreateExpense(ctx, user.ID, &dto.CreateExpenseRequest{
+			CategoryID: hotel.ID, Amount: 15000, Title: "名古屋出張のホテル代", Date: today.Format("2006-01-02"),
+			LineItems: []dto.LineItemRequest{
+				{CategoryID: hotel.ID, Amount: 12900, Description: "宿泊"},
+				{CategoryID: meal.ID, Amount: 1080, Description: "朝食"},
+				{CategoryID: parking.ID, Amount: 1020, Description: "駐車場"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, expense.PolicyViolations)
+		return expense
+	}
+
+	t.Run("経費レポートは明細のカテゴリごとに集計する", func(t *testing.T) {
+		expense := createItemizedExpense()
+		report, err := reportUseCase.CreateExpenseReport(ctx, user.ID, &dto.CreateExpenseReportRequest{
+			Title: "名古屋出張", PeriodStart: today.AddDate(0, 0, -1).Format("2006-01-02"), PeriodEnd: today.AddDate(0, 0, 1).Format("2006-01-02"),
+			ExpenseIDs: []string{expense.ID},
+		})
+		require.NoError(t, err)
+		require.Len(t, report.Categories, 3)
+		assert.Equal(t, "宿泊費", report.Categories[0].Name)
+		assert.Equal(t, 12900.0, report.Categories[0].Amount)
+		assert.Equal(t, 1080.0, report.Categories[1].Amount)
+	})
+
+	t.Run("会議費・交際費の区分は飲食・接待のカテゴリの明細の金額で判定する", func(t *testing.T) {
+		expense, err := useCase.CreateExpense(ctx, user.ID, &dto.CreateExpenseRequest{
+			CategoryID: hotel.ID, Amount: 30000, Title: "福岡出張のホテル代", Date: today.Format("2006-01-02"),
+			LineItems: []dto.LineItemRequest{
+				{CategoryID: hotel.ID, Amount: 27580, Description: "宿泊"},
+				{CategoryID: meal.ID, Amount: 1400, Description: "朝食会"},
+				{CategoryID: parking.ID, Amount: 1020, Description: "駐車場"},
+			},
+			Attendees: []dto.AttendeeRequest{
+				{Name: "山田太郎", Type: "internal"},
+				{Name: "佐藤花子", Company: "株式会社サンプル", Type: "external"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 15000.0, expense.PerHeadAmount)
+		assert.Equal(t, "meeting", expense.EntertainmentClass)
+
+		fetched, err := useCase.GetExpense(ctx, expense.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "meeting", fetched.EntertainmentClass)
+	})
+
+	t.Run("更新の検証に失敗した場合は保存済みの明細を変更しない", func(t *testing.T) {
+		expense := createItemizedExpense()
+
+		_, err := useCase.UpdateExpense(ctx, expense.ID, &dto.UpdateExpenseRequest{
+			CategoryID: hotel.ID, Amount: 16000, Title: "名古屋出張のホテル代", Date: today.Format("2006-01-02"),
+			LineItems: []dto.LineItemRequest{
+				{CategoryID: hotel.ID, Amount: 12900},
+				{CategoryID: meal.ID, Amount: 1080},
+			},
+		})
+		assert.Error(t, err)
+
+		stored, err := useCase.GetExpense(ctx, expense.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 15000.0, stored.Amount)
+		assert.Len(t, stored.LineItems, 3)
+	})
+
+	expense := createItemizedExpense()
+	_, err = useCase.SubmitExpense(ctx, expense.ID)
+	require.NoError(t, err)
+	_, err = useCase.ApproveExpense(ctx, expense.ID)
+	require.NoError(t, err)
+
+	t.Run("仕訳は明細ごとの勘定科目と税区分で1伝票にまとめる", func(t *testing.T) {
+		exporter, err := useCase.ExportJournal(ctx, &dto.ExportJournalRequest{
+			Software: "freee", DateFrom: today.AddDate(0, 0, -1).Format("2006-01-02"), DateTo: today.Format("2006-01-02"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, exporter.Count())
+
+		var buf bytes.Buffer
+		require.NoError(t, exporter.Write(&buf))
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+		assert.Contains(t, records[2], "会議費")
+		assert.Contains(t, records[2], "課対仕入8%（軽）")
+		assert.Contains(t, records[2], "80")
+		assert.Equal(t, records[1][1], records[3][1], "伝票番号は同じ")
+	})
+}
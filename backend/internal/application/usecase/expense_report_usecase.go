@@ -0,0 +1,608 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/event"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+)
+
+// ExpenseReportUseCase 経費レポートユースケース
+type ExpenseReportUseCase struct {
+	reportRepo     repository.ExpenseReportRepository
+	expenseRepo    repository.ExpenseRepository
+	userRepo       repository.UserRepository
+	categoryRepo   repository.CategoryRepository
+	costCenterRepo repository.CostCenterRepository
+	budgetRepo     repository.BudgetRepository
+	publisher      event.Publisher
+	periodGuard    *fiscalPeriodGuard
+	clock          clock.Clock
+}
+
+// ExpenseReportUseCaseOption ExpenseReportUseCaseの任意の依存関係を設定するオプション
+type ExpenseReportUseCaseOption func(*ExpenseReportUseCase)
+
+// WithReportEventPublisher ドメインイベントの発行先を設定（未設定の場合は経費の承認を通知しない）
+func WithReportEventPublisher(publisher event.Publisher) ExpenseReportUseCaseOption {
+	return func(uc *ExpenseReportUseCase) {
+		uc.publisher = publisher
+	}
+}
+
+// WithReportFiscalPeriods 会計期間を設定（未設定の場合は締め済みの会計期間を確認しない）
+func WithReportFiscalPeriods(calendar *valueobject.FiscalCalendar, periodRepo repository.AccountingPeriodRepository) ExpenseReportUseCaseOption {
+	return func(uc *ExpenseReportUseCase) {
+		uc.periodGuard = &fiscalPeriodGuard{calendar: calendar, periodRepo: periodRepo, clock: uc.clock}
+	}
+}
+
+// WithReportCostCenterRepository コストセンターのリポジトリを設定（未設定の場合は負担額にコード・名前を含めない）
+func WithReportCostCenterRepository(costCenterRepo repository.CostCenterRepository) ExpenseReportUseCaseOption {
+	return func(uc *ExpenseReportUseCase) {
+		uc.costCenterRepo = costCenterRepo
+	}
+}
+
+// WithReportBudgetRepository 予算のリポジトリを設定（未設定の場合は予算の超過を確認しない）
+func WithReportBudgetRepository(budgetRepo repository.BudgetRepository) ExpenseReportUseCaseOption {
+	return func(uc *ExpenseReportUseCase) {
+		uc.budgetRepo = budgetRepo
+	}
+}
+
+// NewExpenseReportUseCase ExpenseReportUseCaseのコンストラクタ
+func NewExpenseReportUseCase(
+	reportRepo repository.ExpenseReportRepository,
+	expenseRepo repository.ExpenseRepository,
+	userRepo repository.UserRepository,
+	categoryRepo repository.CategoryRepository,
+	clk clock.Clock,
+	opts ...ExpenseReportUseCaseOption,
+) *ExpenseReportUseCase {
+	uc := &ExpenseReportUseCase{
+		reportRepo:   reportRepo,
+		expenseRepo:  expenseRepo,
+		userRepo:     userRepo,
+		categoryRepo: categoryRepo,
+		clock:        clk,
+	}
+
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
+
+// CreateExpenseReport 経費レポートを作成
+func (uc *ExpenseReportUseCase) CreateExpenseReport(ctx context.Context, userID string, req *dto.CreateExpenseReportRequest) (*dto.ExpenseReportResponse, error) {
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// ユーザーの存在確認
+	if _, err := uc.userRepo.FindByID(ctx, uid); err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	expenseIDs, err := parseExpenseIDs(req.ExpenseIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	periodStart, periodEnd, err := parseDateRange(req.PeriodStart, req.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := entity.NewExpenseReport(uc.clock, uid, req.Title, periodStart, periodEnd, expenseIDs)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	expenses, err := uc.loadExpenses(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.validateMembership(ctx, report, expenses); err != nil {
+		return nil, err
+	}
+
+	if err := uc.reportRepo.Save(ctx, report); err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseReportCreationFailed, "経費レポートの作成に失敗しました")
+	}
+
+	return uc.buildExpenseReportResponse(ctx, report, expenses)
+}
+
+// GetExpenseReport 経費レポートを取得
+func (uc *ExpenseReportUseCase) GetExpenseReport(ctx context.Context, reportID string) (*dto.ExpenseReportResponse, error) {
+	report, err := uc.findReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := uc.loadExpenses(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.buildExpenseReportResponse(ctx, report, expenses)
+}
+
+// GetExpenseReportsByUser ユーザーの経費レポート一覧を取得
+func (uc *ExpenseReportUseCase) GetExpenseReportsByUser(ctx context.Context, userID string) ([]*dto.ExpenseReportResponse, error) {
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// ユーザーの存在確認
+	if _, err := uc.userRepo.FindByID(ctx, uid); err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	reports, err := uc.reportRepo.FindByOwnerID(ctx, uid)
+	if err != nil {
+		return nil, errors.NewApplicationError("EXPENSE_REPORT_FETCH_FAILED", "経費レポート一覧の取得に失敗しました")
+	}
+
+	responses := make([]*dto.ExpenseReportResponse, len(reports))
+	for i, report := range reports {
+		expenses, err := uc.loadExpenses(ctx, report)
+		if err != nil {
+			return nil, err
+		}
+
+		responses[i], err = uc.buildExpenseReportResponse(ctx, report, expenses)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return responses, nil
+}
+
+// UpdateExpenseReport 経費レポートを更新
+func (uc *ExpenseReportUseCase) UpdateExpenseReport(ctx context.Context, reportID string, req *dto.UpdateExpenseReportRequest) (*dto.ExpenseReportResponse, error) {
+	stored, err := uc.findReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 含める経費の検証に失敗した場合に保存済みのレポートを変更しないよう、複製に変更を反映して検証してから保存する
+	report := stored.Copy()
+
+	expenseIDs, err := parseExpenseIDs(req.ExpenseIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	periodStart, periodEnd, err := parseDateRange(req.PeriodStart, req.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := report.UpdateDetails(req.Title, periodStart, periodEnd, expenseIDs, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	expenses, err := uc.loadExpenses(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.validateMembership(ctx, report, expenses); err != nil {
+		return nil, err
+	}
+
+	if err := uc.reportRepo.Update(ctx, report); err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseReportUpdateFailed, "経費レポートの更新に失敗しました")
+	}
+
+	return uc.buildExpenseReportResponse(ctx, report, expenses)
+}
+
+// DeleteExpenseReport 経費レポートを削除（含まれる経費は削除しない）
+func (uc *ExpenseReportUseCase) DeleteExpenseReport(ctx context.Context, reportID string) error {
+	report, err := uc.findReport(ctx, reportID)
+	if err != nil {
+		return err
+	}
+
+	if !report.CanDelete() {
+		return errors.NewApplicationError(errors.ValidationFailed, "申請中または承認済みの経費レポートは削除できません")
+	}
+
+	if err := uc.reportRepo.Delete(ctx, report.ID()); err != nil {
+		return errors.NewApplicationError(errors.ExpenseReportDeletionFailed, "経費レポートの削除に失敗しました")
+	}
+
+	return nil
+}
+
+// SubmitExpenseReport 経費レポートを申請（含まれる全ての経費を申請）
+func (uc *ExpenseReportUseCase) SubmitExpenseReport(ctx context.Context, reportID string) (*dto.ExpenseReportResponse, error) {
+	return uc.changeReportStatus(ctx, reportID, actionSubmit)
+}
+
+// ApproveExpenseReport 経費レポートを承認（含まれる全ての経費を承認）
+func (uc *ExpenseReportUseCase) ApproveExpenseReport(ctx context.Context, reportID string) (*dto.ExpenseReportResponse, error) {
+	return uc.changeReportStatus(ctx, reportID, actionApprove)
+}
+
+// RejectExpenseReport 経費レポートを却下（含まれる全ての経費を却下）
+func (uc *ExpenseReportUseCase) RejectExpenseReport(ctx context.Context, reportID string) (*dto.ExpenseReportResponse, error) {
+	return uc.changeReportStatus(ctx, reportID, actionReject)
+}
+
+// changeReportStatus 経費レポートと含まれる全ての経費のステータスを変更
+// 全ての経費が遷移可能であることを確認してから複製を変更してまとめて保存し、一部の経費だけが遷移することはない
+func (uc *ExpenseReportUseCase) changeReportStatus(ctx context.Context, reportID string, action string) (*dto.ExpenseReportResponse, error) {
+	stored, err := uc.findReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	storedExpenses, err := uc.loadExpenses(ctx, stored)
+	if err != nil {
+		return nil, err
+	}
+
+	// 途中で失敗した場合に保存済みのレポートと経費を変更しないよう、複製で確認・遷移してから保存する
+	report := stored.Copy()
+	expenses := make([]*entity.Expense, len(storedExpenses))
+	for i, expense := range storedExpenses {
+		expenses[i] = expense.Copy()
+	}
+
+	// 事前に全ての経費の遷移可否を確認
+	var warnings []string
+	for i, expense := range expenses {
+		var ok bool
+		switch action {
+		case actionSubmit:
+			ok = expense.CanSubmit()
+		case actionApprove:
+			ok = expense.CanApprove()
+		case actionReject:
+			ok = expense.CanReject()
+		}
+		if !ok {
+			return nil, errors.NewApplicationError(errors.ValidationFailed,
+				"経費「"+expense.Title()+"」は現在のステータス（"+string(expense.Status())+"）から変更できません")
+		}
+
+		// 申請時点の今日を基準に、カテゴリの経費日付として認める期間を検証（警告は申請を妨げない）
+		if action == actionSubmit {
+			warning, err := checkExpenseDate(ctx, uc.userRepo, uc.categoryRepo, expense, uc.clock.Now())
+			if err != nil {
+				if _, ok := err.(*errors.DomainError); ok {
+					return nil, errors.NewApplicationError(errors.ValidationFailed, "経費「"+expense.Title()+"」: "+err.Error())
+				}
+				return nil, err
+			}
+			if warning != "" {
+				warnings = append(warnings, "経費「"+expense.Title()+"」: "+warning)
+			}
+
+			// カテゴリの支出規程を検証し、理由が入力された違反は規程外の経費として記録
+			if err := acceptPolicyViolations(ctx, uc.categoryRepo, expense); err != nil {
+				if _, ok := err.(*errors.DomainError); ok {
+					return nil, errors.NewApplicationError(errors.ValidationFailed, "経費「"+expense.Title()+"」: "+err.Error())
+				}
+				return nil, err
+			}
+
+			// レポートの先の経費を申請中として加えた残額で予算の超過を確認（警告は申請を妨げない）
+			budgetWarnings, err := checkBudgets(ctx, uc.budgetRepo, uc.expenseRepo, uc.userRepo, expense, expenses[:i])
+			if err != nil {
+				return nil, err
+			}
+			for _, warning := range budgetWarnings {
+				warnings = append(warnings, "経費「"+expense.Title()+"」: "+warning)
+			}
+
+			// 重複の疑いがある経費を警告（承認者は承認待ち一覧で確認する）
+			duplicates, err := findDuplicateExpenses(ctx, uc.expenseRepo, uc.userRepo, expense)
+			if err != nil {
+				return nil, err
+			}
+			for _, warning := range duplicateWarnings(duplicates) {
+				warnings = append(warnings, "経費「"+expense.Title()+"」: "+warning)
+			}
+		}
+
+		// 締め済みの会計期間の経費を含むレポートは申請・承認・却下できない
+		if err := uc.periodGuard.ensureOpen(ctx, expense.Date()); err != nil {
+			return nil, err
+		}
+	}
+
+	// レポートのステータス変更
+	var transitionErr error
+	switch action {
+	case actionSubmit:
+		transitionErr = report.Submit(uc.clock.Now())
+	case actionApprove:
+		transitionErr = report.Approve(uc.clock.Now())
+	case actionReject:
+		transitionErr = report.Reject(uc.clock.Now())
+	default:
+		transitionErr = errors.NewDomainError(errors.ValidationFailed, "無効なアクションです")
+	}
+	if transitionErr != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, transitionErr.Error())
+	}
+
+	// 経費のステータス変更
+	for _, expense := range expenses {
+		switch action {
+		case actionSubmit:
+			err = expense.Submit(uc.clock.Now())
+			if err == nil {
+				err = routeToManager(ctx, uc.userRepo, expense, uc.clock.Now())
+			}
+		case actionApprove:
+			err = expense.Approve(uc.clock.Now())
+		case actionReject:
+			err = expense.Reject(uc.clock.Now())
+		}
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+	}
+
+	if err := uc.expenseRepo.UpdateAll(ctx, expenses); err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseUpdateFailed, "経費のステータス更新に失敗しました")
+	}
+
+	if err := uc.reportRepo.Update(ctx, report); err != nil {
+		// 経費だけが遷移した状態にならないよう、保存した経費を元に戻す
+		_ = uc.expenseRepo.UpdateAll(ctx, storedExpenses)
+		return nil, errors.NewApplicationError(errors.ExpenseReportUpdateFailed, "経費レポートのステータス更新に失敗しました")
+	}
+
+	if action == actionApprove {
+		for _, expense := range expenses {
+			if warning := publishExpenseApproved(ctx, uc.publisher, expense); warning != "" {
+				warnings = append(warnings, warning)
+			}
+		}
+	}
+
+	response, err := uc.buildExpenseReportResponse(ctx, report, expenses)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Warnings = warnings
+	return response, nil
+}
+
+// findReport IDで経費レポートを取得
+func (uc *ExpenseReportUseCase) findReport(ctx context.Context, reportID string) (*entity.ExpenseReport, error) {
+	id, err := valueobject.NewExpenseReportID(reportID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	report, err := uc.reportRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseReportNotFound, "経費レポートが見つかりません")
+	}
+
+	return report, nil
+}
+
+// loadExpenses 経費レポートに含まれる経費を取得
+func (uc *ExpenseReportUseCase) loadExpenses(ctx context.Context, report *entity.ExpenseReport) ([]*entity.Expense, error) {
+	ids := report.ExpenseIDs()
+	expenses := make([]*entity.Expense, len(ids))
+
+	for i, id := range ids {
+		expense, err := uc.expenseRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ExpenseNotFound, "経費が見つかりません: "+id.String())
+		}
+		expenses[i] = expense
+	}
+
+	return expenses, nil
+}
+
+// validateMembership 経費レポートに含める経費の条件を検証
+// 所有者が同じで、下書き状態、対象期間内、同一通貨、他のレポートに含まれていないこと
+func (uc *ExpenseReportUseCase) validateMembership(ctx context.Context, report *entity.ExpenseReport, expenses []*entity.Expense) error {
+	currency := ""
+	for _, expense := range expenses {
+		if !expense.UserID().Equals(report.OwnerID()) {
+			return errors.NewApplicationError(errors.ValidationFailed, "他のユーザーの経費は含められません: "+expense.ID().String())
+		}
+
+		if !expense.CanEdit() {
+			return errors.NewApplicationError(errors.ValidationFailed, "下書き状態の経費のみ含められます: "+expense.ID().String())
+		}
+
+		if !report.CoversDate(expense.Date()) {
+			return errors.NewApplicationError(errors.ValidationFailed, "対象期間外の経費は含められません: "+expense.ID().String())
+		}
+
+		if currency == "" {
+			currency = expense.Amount().Currency()
+		} else if currency != expense.Amount().Currency() {
+			return errors.NewApplicationError(errors.ValidationFailed, "異なる通貨の経費は同じレポートに含められません")
+		}
+
+		other, err := uc.reportRepo.FindByExpenseID(ctx, expense.ID())
+		if err == nil && other != nil && !other.ID().Equals(report.ID()) {
+			return errors.NewApplicationError(errors.ExpenseAlreadyInReport, "経費は既に他の経費レポートに含まれています: "+expense.ID().String())
+		}
+	}
+
+	return nil
+}
+
+// buildExpenseReportResponse 経費レポートレスポンスを構築
+func (uc *ExpenseReportUseCase) buildExpenseReportResponse(ctx context.Context, report *entity.ExpenseReport, expenses []*entity.Expense) (*dto.ExpenseReportResponse, error) {
+	owner, err := uc.userRepo.FindByID(ctx, report.OwnerID())
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	// レポートの合計金額（含まれる経費は同一通貨）
+	currency := "JPY"
+	if len(expenses) > 0 {
+		currency = expenses[0].Amount().Currency()
+	}
+	total, err := valueobject.NewMoney(0, currency)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// コストセンターごとの負担額（按分がなく既定のコストセンターもない金額はキーを空にする）
+	costCenterIDs := make([]*valueobject.CostCenterID, 0)
+	costCenterAmounts := make(map[string]*valueobject.Money)
+
+	// カテゴリごとの合計（明細に分けた経費は明細のカテゴリと金額で集計する）
+	categoryIDs := make([]*valueobject.CategoryID, 0)
+	categoryAmounts := make(map[string]*valueobject.Money)
+
+	expenseResponses := make([]*dto.ExpenseResponse, len(expenses))
+	for i, expense := range expenses {
+		category, err := uc.categoryRepo.FindByID(ctx, expense.CategoryID())
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
+		}
+		expenseResponses[i] = buildExpenseResponse(expense, owner, category)
+		appendLineItemDetails(ctx, uc.categoryRepo, expenseResponses[i], expense)
+
+		for _, line := range expense.Lines() {
+			key := line.CategoryID().String()
+			sum, ok := categoryAmounts[key]
+			if !ok {
+				categoryIDs = append(categoryIDs, line.CategoryID())
+				sum, err = valueobject.NewMoney(0, currency)
+				if err != nil {
+					return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+				}
+			}
+			categoryAmounts[key], err = sum.Add(line.Amount())
+			if err != nil {
+				return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+			}
+		}
+
+		total, err = total.Add(expense.Amount())
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		// 按分がなければ申請者の既定のコストセンターに全額を集計する
+		allocations := expense.ChargedAllocations(owner.CostCenterID())
+		if len(allocations) == 0 {
+			allocations = []*entity.CostAllocation{nil}
+		}
+		for _, allocation := range allocations {
+			var costCenterID *valueobject.CostCenterID
+			key, amount := "", expense.Amount()
+			if allocation != nil {
+				costCenterID, key, amount = allocation.CostCenterID(), allocation.CostCenterID().String(), allocation.Amount()
+			}
+
+			sum, ok := costCenterAmounts[key]
+			if !ok {
+				costCenterIDs = append(costCenterIDs, costCenterID)
+				sum, err = valueobject.NewMoney(0, currency)
+				if err != nil {
+					return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+				}
+			}
+			costCenterAmounts[key], err = sum.Add(amount)
+			if err != nil {
+				return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+			}
+		}
+	}
+
+	charges := newCostCenterCharges(uc.userRepo, uc.costCenterRepo)
+	costCenterTotals := make([]*dto.CostCenterTotalResponse, len(costCenterIDs))
+	for i, costCenterID := range costCenterIDs {
+		if costCenterID == nil {
+			costCenterTotals[i] = &dto.CostCenterTotalResponse{Amount: costCenterAmounts[""].Amount()}
+			continue
+		}
+
+		costCenterTotals[i] = &dto.CostCenterTotalResponse{
+			CostCenterID: costCenterID.String(),
+			Amount:       costCenterAmounts[costCenterID.String()].Amount(),
+		}
+		if costCenter := charges.CostCenter(ctx, costCenterID); costCenter != nil {
+			costCenterTotals[i].Code = costCenter.Code()
+			costCenterTotals[i].Name = costCenter.Name()
+		}
+	}
+
+	categoryTotals := make([]*dto.CategoryTotalResponse, len(categoryIDs))
+	for i, categoryID := range categoryIDs {
+		categoryTotals[i] = &dto.CategoryTotalResponse{
+			CategoryID: categoryID.String(),
+			Amount:     categoryAmounts[categoryID.String()].Amount(),
+		}
+		if category, err := uc.categoryRepo.FindByID(ctx, categoryID); err == nil {
+			categoryTotals[i].Name = category.Name()
+		}
+	}
+
+	return &dto.ExpenseReportResponse{
+		ID:          report.ID().String(),
+		OwnerID:     report.OwnerID().String(),
+		Title:       report.Title(),
+		PeriodStart: report.PeriodStart().String(),
+		PeriodEnd:   report.PeriodEnd().String(),
+		Status:      string(report.Status()),
+		Expenses:    expenseResponses,
+		TotalAmount: total.Amount(),
+		Currency:    total.Currency(),
+		CostCenters: costCenterTotals,
+		Categories:  categoryTotals,
+		CreatedAt:   report.CreatedAt(),
+		UpdatedAt:   report.UpdatedAt(),
+	}, nil
+}
+
+// parseExpenseIDs 経費IDの文字列リストを変換
+func parseExpenseIDs(values []string) ([]*valueobject.ExpenseID, error) {
+	ids := make([]*valueobject.ExpenseID, len(values))
+	for i, value := range values {
+		id, err := valueobject.NewExpenseID(value)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		ids[i] = id
+	}
+
+	return ids, nil
+}
+
+// parseDateRange 開始日と終了日の文字列（YYYY-MM-DD）を変換（期間の前後関係はエンティティで検証する）
+func parseDateRange(startDate, endDate string) (valueobject.Date, valueobject.Date, error) {
+	start, err := valueobject.ParseDate(startDate)
+	if err != nil {
+		return valueobject.Date{}, valueobject.Date{}, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	end, err := valueobject.ParseDate(endDate)
+	if err != nil {
+		return valueobject.Date{}, valueobject.Date{}, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	return start, end, nil
+}
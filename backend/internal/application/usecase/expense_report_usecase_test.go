@@ -0,0 +1,374 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/internal/infrastructure/persistence"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpenseReportUseCase_Workflow(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	reportRepo := persistence.NewMemoryExpenseReportRepository()
+
+	// ユースケースを初期化
+	useCase := NewExpenseReportUseCase(reportRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+	expenseUseCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock, WithExpenseReportRepository(reportRepo))
+
+	// テスト用のユーザー、カテゴリ、経費を作成
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	other, _ := entity.NewUser(fakeClock, "別のユーザー", "other@example.com")
+	require.NoError(t, userRepo.Save(ctx, other))
+
+	category, _ := entity.NewCategory(fakeClock, "交通費", "交通費カテゴリ", "#FF0000")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	newExpense := func(owner *entity.User, amount float64, daysAgo int) *entity.Expense {
+		money, _ := valueobject.NewMoney(amount, "JPY")
+		expense, err := entity.NewExpense(fakeClock, owner.ID(), category.ID(), money, "出張交通費", "", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -daysAgo)))
+		require.NoError(t, err)
+		require.NoError(t, expenseRepo.Save(ctx, expense))
+		return expense
+	}
+
+	// 保存済みの経費のステータスを取得（ユースケースは複製を保存するため、作成時の経費は更新されない）
+	storedStatus := func(expense *entity.Expense) entity.ExpenseStatus {
+		stored, err := expenseRepo.FindByID(ctx, expense.ID())
+		require.NoError(t, err)
+		return stored.Status()
+	}
+
+	expense1 := newExpense(user, 1000, 1)
+	expense2 := newExpense(user, 2500, 2)
+	otherExpense := newExpense(other, 500, 1)
+
+	periodStart := fakeClock.Now().AddDate(0, 0, -7).Format("2006-01-02")
+	periodEnd := fakeClock.Now().Format("2006-01-02")
+
+	var reportID string
+
+	t.Run("経費レポート作成と合計金額", func(t *testing.T) {
+		result, err := useCase.CreateExpenseReport(ctx, user.ID().String(), &dto.CreateExpenseReportRequest{
+			Title:       "大阪出張",
+			PeriodStart: periodStart,
+			PeriodEnd:   periodEnd,
+			ExpenseIDs:  []string{expense1.ID().String(), expense2.ID().String()},
+		})
+		require.NoError(t, err)
+
+		reportID = result.ID
+		assert.Equal(t, "draft", result.Status)
+		assert.Equal(t, periodStart, result.PeriodStart)
+		assert.Equal(t, periodEnd, result.PeriodEnd)
+		assert.Len(t, result.Expenses, 2)
+		assert.Equal(t, 3500.0, result.TotalAmount)
+		assert.Equal(t, "JPY", result.Currency)
+	})
+
+	t.Run("対象期間は暦日で指定し、終了日の経費を含められる", func(t *testing.T) {
+		today := newExpense(user, 300, 0)
+		result, err := useCase.CreateExpenseReport(ctx, user.ID().String(), &dto.CreateExpenseReportRequest{
+			Title:       "日帰り出張",
+			PeriodStart: "2026-04-10",
+			PeriodEnd:   "2026-04-10",
+			ExpenseIDs:  []string{today.ID().String()},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2026-04-10", result.PeriodStart)
+		assert.Equal(t, "2026-04-10", result.PeriodEnd)
+		require.NoError(t, useCase.DeleteExpenseReport(ctx, result.ID))
+
+		_, err = useCase.CreateExpenseReport(ctx, user.ID().String(), &dto.CreateExpenseReportRequest{
+			Title:       "日帰り出張",
+			PeriodStart: "2026/04/10",
+			PeriodEnd:   "2026-04-10",
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("他のユーザーの経費は含められない", func(t *testing.T) {
+		result, err := useCase.CreateExpenseReport(ctx, user.ID().String(), &dto.CreateExpenseReportRequest{
+			Title:       "不正なレポート",
+			PeriodStart: periodStart,
+			PeriodEnd:   periodEnd,
+			ExpenseIDs:  []string{otherExpense.ID().String()},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("他のレポートに含まれる経費は含められない", func(t *testing.T) {
+		result, err := useCase.CreateExpenseReport(ctx, user.ID().String(), &dto.CreateExpenseReportRequest{
+			Title:       "重複レポート",
+			PeriodStart: periodStart,
+			PeriodEnd:   periodEnd,
+			ExpenseIDs:  []string{expense1.ID().String()},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("更新に失敗した場合は保存済みのレポートを変更しない", func(t *testing.T) {
+		result, err := useCase.UpdateExpenseReport(ctx, reportID, &dto.UpdateExpenseReportRequest{
+			Title:       "大阪出張（変更）",
+			PeriodStart: periodStart,
+			PeriodEnd:   periodEnd,
+			ExpenseIDs:  []string{expense1.ID().String(), expense2.ID().String(), otherExpense.ID().String()},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		report, err := useCase.GetExpenseReport(ctx, reportID)
+		require.NoError(t, err)
+		assert.Equal(t, "大阪出張", report.Title)
+		assert.Len(t, report.Expenses, 2)
+
+		_, err = reportRepo.FindByExpenseID(ctx, otherExpense.ID())
+		assert.Error(t, err)
+	})
+
+	t.Run("対象期間外の経費は含められない", func(t *testing.T) {
+		old := newExpense(user, 800, 30)
+		result, err := useCase.CreateExpenseReport(ctx, user.ID().String(), &dto.CreateExpenseReportRequest{
+			Title:       "期間外レポート",
+			PeriodStart: periodStart,
+			PeriodEnd:   periodEnd,
+			ExpenseIDs:  []string{old.ID().String()},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("レポートに含まれる経費は個別に削除・申請できない", func(t *testing.T) {
+		err := expenseUseCase.DeleteExpense(ctx, expense1.ID().String())
+		assert.Error(t, err)
+
+		_, err = expenseUseCase.SubmitExpense(ctx, expense1.ID().String())
+		assert.Error(t, err)
+
+		bulk, err := expenseUseCase.BulkSubmitExpenses(ctx, &dto.BulkExpenseActionRequest{ExpenseIDs: []string{expense2.ID().String()}})
+		require.NoError(t, err)
+		assert.Equal(t, 1, bulk.FailedCount)
+		assert.Equal(t, "EXPENSE_ALREADY_IN_REPORT", bulk.Results[0].Error.Code)
+
+		assert.Equal(t, entity.ExpenseStatusDraft, storedStatus(expense1))
+		assert.Equal(t, entity.ExpenseStatusDraft, storedStatus(expense2))
+		report, err := useCase.GetExpenseReport(ctx, reportID)
+		require.NoError(t, err)
+		assert.Len(t, report.Expenses, 2)
+	})
+
+	t.Run("申請すると全ての経費が申請済みになる", func(t *testing.T) {
+		result, err := useCase.SubmitExpenseReport(ctx, reportID)
+		require.NoError(t, err)
+
+		assert.Equal(t, "submitted", result.Status)
+		assert.Equal(t, entity.ExpenseStatusSubmitted, storedStatus(expense1))
+		assert.Equal(t, entity.ExpenseStatusSubmitted, storedStatus(expense2))
+	})
+
+	t.Run("遷移できない経費が含まれる場合はいずれの経費も変更されない", func(t *testing.T) {
+		// 1件だけ個別に却下しておく
+		rejected, err := expenseRepo.FindByID(ctx, expense2.ID())
+		require.NoError(t, err)
+		require.NoError(t, rejected.Reject(fakeClock.Now()))
+		require.NoError(t, expenseRepo.Update(ctx, rejected))
+
+		result, err := useCase.ApproveExpenseReport(ctx, reportID)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		assert.Equal(t, entity.ExpenseStatusSubmitted, storedStatus(expense1))
+		report, err := useCase.GetExpenseReport(ctx, reportID)
+		require.NoError(t, err)
+		assert.Equal(t, "submitted", report.Status)
+	})
+
+	t.Run("申請中のレポートは削除できない", func(t *testing.T) {
+		err := useCase.DeleteExpenseReport(ctx, reportID)
+		assert.Error(t, err)
+	})
+}
+
+func TestExpenseReportUseCase_SubmitFailureKeepsStoredExpenses(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	reportRepo := persistence.NewMemoryExpenseReportRepository()
+	useCase := NewExpenseReportUseCase(reportRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	// 1000円を超える場合は理由の入力が必要なカテゴリ
+	category, _ := entity.NewCategory(fakeClock, "交際費", "交際費カテゴリ", "#FF0000")
+	maxAmount, _ := valueobject.NewMoney(1000, "JPY")
+	rule, err := valueobject.NewMaxAmountRule(maxAmount, "exception")
+	require.NoError(t, err)
+	require.NoError(t, category.ChangeSpendingRules([]*valueobject.SpendingRule{rule}, fakeClock.Now()))
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	newExpense := func(title, justification string) *entity.Expense {
+		money, _ := valueobject.NewMoney(2000, "JPY")
+		expense, err := entity.NewExpense(fakeClock, user.ID(), category.ID(), money, title, "", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -1)))
+		require.NoError(t, err)
+		if justification != "" {
+			require.NoError(t, expense.ChangePolicyJustification(justification, fakeClock.Now()))
+		}
+		require.NoError(t, expenseRepo.Save(ctx, expense))
+		return expense
+	}
+
+	justified := newExpense("取引先との会食", "先方の役員が同席したため")
+	unjustified := newExpense("社内懇親会", "")
+
+	report, err := useCase.CreateExpenseReport(ctx, user.ID().String(), &dto.CreateExpenseReportRequest{
+		Title:       "4月の交際費",
+		PeriodStart: fakeClock.Now().AddDate(0, 0, -7).Format("2006-01-02"),
+		PeriodEnd:   fakeClock.Now().Format("2006-01-02"),
+		ExpenseIDs:  []string{justified.ID().String(), unjustified.ID().String()},
+	})
+	require.NoError(t, err)
+
+	result, err := useCase.SubmitExpenseReport(ctx, report.ID)
+	assert.Error(t, err)
+	assert.Nil(t, result)
+
+	// 先に確認した経費の規程違反も記録されない
+	stored, err := expenseRepo.FindByID(ctx, justified.ID())
+	require.NoError(t, err)
+	assert.Equal(t, entity.ExpenseStatusDraft, stored.Status())
+	assert.Empty(t, stored.PolicyViolations())
+
+	storedReport, err := useCase.GetExpenseReport(ctx, report.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "draft", storedReport.Status)
+}
+
+func TestExpenseReportUseCase_SubmitWarnings(t *testing.T) {
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	reportRepo := persistence.NewMemoryExpenseReportRepository()
+
+	// 現在日時を2026年4月10日に固定
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	useCase := NewExpenseReportUseCase(reportRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	// 30日より前の日付は遅延申請の警告
+	category, _ := entity.NewCategory(fakeClock, "出張費", "", "")
+	policy, err := valueobject.NewExpenseDatePolicy(30, 0, "warning")
+	require.NoError(t, err)
+	category.ChangeDatePolicy(policy, fakeClock.Now())
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	newExpense := func(title string, date valueobject.Date) *entity.Expense {
+		money, _ := valueobject.NewMoney(1000, "JPY")
+		expense, err := entity.NewExpense(fakeClock, user.ID(), category.ID(), money, title, "", date)
+		require.NoError(t, err)
+		require.NoError(t, expenseRepo.Save(ctx, expense))
+		return expense
+	}
+
+	late := newExpense("2月の新幹線代", valueobject.DateOf(time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC)))
+	recent := newExpense("4月の新幹線代", valueobject.DateOf(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)))
+
+	report, err := useCase.CreateExpenseReport(ctx, user.ID().String(), &dto.CreateExpenseReportRequest{
+		Title:       "出張",
+		PeriodStart: "2026-02-01",
+		PeriodEnd:   "2026-04-10",
+		ExpenseIDs:  []string{late.ID().String(), recent.ID().String()},
+	})
+	require.NoError(t, err)
+
+	t.Run("経費の遅延申請の警告をレポートのレスポンスに含める", func(t *testing.T) {
+		result, err := useCase.SubmitExpenseReport(ctx, report.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "submitted", result.Status)
+
+		require.Len(t, result.Warnings, 1)
+		assert.Contains(t, result.Warnings[0], "2月の新幹線代")
+		assert.Contains(t, result.Warnings[0], "遅延申請")
+	})
+}
+
+func TestExpenseReportUseCase_SubmitBudgetWarnings(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	reportRepo := persistence.NewMemoryExpenseReportRepository()
+	budgetRepo := persistence.NewMemoryBudgetRepository()
+
+	// ユースケースを初期化
+	useCase := NewExpenseReportUseCase(reportRepo, expenseRepo, userRepo, categoryRepo, fakeClock, WithReportBudgetRepository(budgetRepo))
+
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+	category, _ := entity.NewCategory(fakeClock, "出張費", "", "")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	today := valueobject.DateOf(fakeClock.Now())
+	scope, err := entity.NewBudgetScope(category.ID(), nil, nil)
+	require.NoError(t, err)
+	budgetAmount, _ := valueobject.NewMoney(5000, "JPY")
+	budget, err := entity.NewBudget(fakeClock, "出張費予算", scope, today.AddDays(-7), today, budgetAmount)
+	require.NoError(t, err)
+	require.NoError(t, budgetRepo.Save(ctx, budget))
+
+	newExpense := func(title string, amount float64) *entity.Expense {
+		money, _ := valueobject.NewMoney(amount, "JPY")
+		expense, err := entity.NewExpense(fakeClock, user.ID(), category.ID(), money, title, "", today)
+		require.NoError(t, err)
+		require.NoError(t, expenseRepo.Save(ctx, expense))
+		return expense
+	}
+
+	// それぞれは残額内でも、レポートの経費の合計は残額を超える
+	train := newExpense("新幹線代", 3000)
+	hotel := newExpense("宿泊費", 3000)
+
+	report, err := useCase.CreateExpenseReport(ctx, user.ID().String(), &dto.CreateExpenseReportRequest{
+		Title:       "出張",
+		PeriodStart: fakeClock.Now().AddDate(0, 0, -7).Format("2006-01-02"),
+		PeriodEnd:   fakeClock.Now().Format("2006-01-02"),
+		ExpenseIDs:  []string{train.ID().String(), hotel.ID().String()},
+	})
+	require.NoError(t, err)
+
+	result, err := useCase.SubmitExpenseReport(ctx, report.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "submitted", result.Status)
+
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0], "経費「宿泊費」")
+	assert.Contains(t, result.Warnings[0], "出張費予算")
+	assert.Contains(t, result.Warnings[0], "2000 JPY")
+}
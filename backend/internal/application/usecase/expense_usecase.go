@@ -3,17 +3,81 @@ package usecase
 import (
 	"context"
 	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
 	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/event"
 	"expense-management-system/internal/domain/repository"
 	"expense-management-system/internal/domain/valueobject"
 	"expense-management-system/pkg/errors"
+	"sort"
+	"time"
 )
 
 // ExpenseUseCase 経費ユースケース
 type ExpenseUseCase struct {
-	expenseRepo  repository.ExpenseRepository
-	userRepo     repository.UserRepository
-	categoryRepo repository.CategoryRepository
+	expenseRepo     repository.ExpenseRepository
+	userRepo        repository.UserRepository
+	categoryRepo    repository.CategoryRepository
+	reportRepo      repository.ExpenseReportRepository
+	tripRequestRepo repository.TripRequestRepository
+	budgetRepo      repository.BudgetRepository
+	costCenterRepo  repository.CostCenterRepository
+	projectRepo     repository.ProjectRepository
+	publisher       event.Publisher
+	periodGuard     *fiscalPeriodGuard
+	clock           clock.Clock
+}
+
+// ExpenseUseCaseOption ExpenseUseCaseの任意の依存関係を設定するオプション
+type ExpenseUseCaseOption func(*ExpenseUseCase)
+
+// WithExpenseReportRepository 経費レポートリポジトリを設定（未設定の場合は経費レポートに含まれる経費を個別に削除・申請・承認・却下できる）
+func WithExpenseReportRepository(reportRepo repository.ExpenseReportRepository) ExpenseUseCaseOption {
+	return func(uc *ExpenseUseCase) {
+		uc.reportRepo = reportRepo
+	}
+}
+
+// WithTripRequestRepository 出張申請リポジトリを設定（未設定の場合は出張申請を参照できない）
+func WithTripRequestRepository(tripRequestRepo repository.TripRequestRepository) ExpenseUseCaseOption {
+	return func(uc *ExpenseUseCase) {
+		uc.tripRequestRepo = tripRequestRepo
+	}
+}
+
+// WithBudgetRepository 予算リポジトリを設定（未設定の場合は申請時に予算の残額を確認しない）
+func WithBudgetRepository(budgetRepo repository.BudgetRepository) ExpenseUseCaseOption {
+	return func(uc *ExpenseUseCase) {
+		uc.budgetRepo = budgetRepo
+	}
+}
+
+// WithCostCenterRepository コストセンターリポジトリを設定（未設定の場合は経費をコストセンターに按分できない）
+func WithCostCenterRepository(costCenterRepo repository.CostCenterRepository) ExpenseUseCaseOption {
+	return func(uc *ExpenseUseCase) {
+		uc.costCenterRepo = costCenterRepo
+	}
+}
+
+// WithProjectRepository プロジェクトリポジトリを設定（未設定の場合は経費をプロジェクトに関連付けできない）
+func WithProjectRepository(projectRepo repository.ProjectRepository) ExpenseUseCaseOption {
+	return func(uc *ExpenseUseCase) {
+		uc.projectRepo = projectRepo
+	}
+}
+
+// WithEventPublisher ドメインイベントの発行先を設定（未設定の場合は承認・支払いを通知しない）
+func WithEventPublisher(publisher event.Publisher) ExpenseUseCaseOption {
+	return func(uc *ExpenseUseCase) {
+		uc.publisher = publisher
+	}
+}
+
+// WithFiscalPeriods 会計期間を設定（未設定の場合は締め済みの会計期間を確認しない）
+func WithFiscalPeriods(calendar *valueobject.FiscalCalendar, periodRepo repository.AccountingPeriodRepository) ExpenseUseCaseOption {
+	return func(uc *ExpenseUseCase) {
+		uc.periodGuard = &fiscalPeriodGuard{calendar: calendar, periodRepo: periodRepo, clock: uc.clock}
+	}
 }
 
 // NewExpenseUseCase ExpenseUseCaseのコンストラクタ
@@ -21,12 +85,21 @@ func NewExpenseUseCase(
 	expenseRepo repository.ExpenseRepository,
 	userRepo repository.UserRepository,
 	categoryRepo repository.CategoryRepository,
+	clk clock.Clock,
+	opts ...ExpenseUseCaseOption,
 ) *ExpenseUseCase {
-	return &ExpenseUseCase{
+	uc := &ExpenseUseCase{
 		expenseRepo:  expenseRepo,
 		userRepo:     userRepo,
 		categoryRepo: categoryRepo,
+		clock:        clk,
+	}
+
+	for _, opt := range opts {
+		opt(uc)
 	}
+
+	return uc
 }
 
 // CreateExpense 経費を作成
@@ -55,19 +128,80 @@ func (uc *ExpenseUseCase) CreateExpense(ctx context.Context, userID string, req
 		return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
 	}
 
-	// 金額の作成
-	currency := req.Currency
-	if currency == "" {
-		currency = "JPY"
-	}
-	amount, err := valueobject.NewMoney(req.Amount, currency)
+	// 経費日付（YYYY-MM-DD）の検証
+	date, err := valueobject.ParseDate(req.Date)
 	if err != nil {
 		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
 	}
 
-	// 新しい経費を作成
-	expense, err := entity.NewExpense(uid, cid, amount, req.Title, req.Description, req.Date)
-	if err != nil {
+	// 新しい経費を作成（走行距離精算の場合は金額を走行距離から計算）
+	// カテゴリの経費日付として認める期間は利用者のタイムゾーンで検証する
+	var expense *entity.Expense
+	var warning string
+	if req.Mileage != nil {
+		mileage, err := newMileage(req.Mileage)
+		if err != nil {
+			return nil, err
+		}
+
+		expense, warning, err = entity.NewMileageExpenseInCategory(uc.clock, user, category, mileage, req.Title, req.Description, date)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+	} else {
+		// 金額の作成
+		currency := req.Currency
+		if currency == "" {
+			currency = "JPY"
+		}
+		amount, err := valueobject.NewMoney(req.Amount, currency)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		expense, warning, err = entity.NewExpenseInCategory(uc.clock, user, category, amount, req.Title, req.Description, date)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+	}
+
+	// 締め済みの会計期間の経費は作成できない
+	if err := uc.periodGuard.ensureOpen(ctx, expense.Date()); err != nil {
+		return nil, err
+	}
+
+	// 出張申請の関連付け
+	if err := linkTripRequest(ctx, uc.tripRequestRepo, expense, req.TripRequestID, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// 支出規程の判定に使う利用時刻と、規程外の経費として申請する理由
+	if err := changePolicyDetails(expense, req.Time, req.DistanceKm, req.PolicyJustification, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// 飲食を伴う経費の参加者
+	if err := changeAttendees(expense, req.Attendees, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// コストセンターへの按分
+	if err := changeAllocations(ctx, uc.costCenterRepo, expense, req.Allocations, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// 顧客向けプロジェクトと請求対象かどうか
+	if err := changeProject(ctx, uc.projectRepo, expense, req.ProjectID, req.Billable, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// カテゴリの異なる明細
+	if err := changeLineItems(ctx, uc.categoryRepo, expense, req.LineItems, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// 重複の検出に使う支払先と領収書ファイルのハッシュ
+	if err := expense.ChangeReceipt(req.Vendor, req.ReceiptHash, uc.clock.Now()); err != nil {
 		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
 	}
 
@@ -76,7 +210,18 @@ func (uc *ExpenseUseCase) CreateExpense(ctx context.Context, userID string, req
 		return nil, errors.NewApplicationError(errors.ExpenseCreationFailed, "経費の作成に失敗しました")
 	}
 
-	return uc.buildExpenseResponse(expense, user, category), nil
+	// 同じ領収書の二重申請や、同僚による同じ経費の申請を検出（警告は保存を妨げない）
+	duplicates, err := findDuplicateExpenses(ctx, uc.expenseRepo, uc.userRepo, expense)
+	if err != nil {
+		return nil, err
+	}
+
+	response := buildExpenseResponse(expense, user, category)
+	appendLineItemDetails(ctx, uc.categoryRepo, response, expense)
+	response.SuspectedDuplicates = duplicates
+	response.Warnings = appendWarning(response.Warnings, warning)
+	response.Warnings = append(response.Warnings, duplicateWarnings(duplicates)...)
+	return response, nil
 }
 
 // GetExpense 経費を取得
@@ -102,7 +247,9 @@ func (uc *ExpenseUseCase) GetExpense(ctx context.Context, expenseID string) (*dt
 		return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
 	}
 
-	return uc.buildExpenseResponse(expense, user, category), nil
+	response := buildExpenseResponse(expense, user, category)
+	appendLineItemDetails(ctx, uc.categoryRepo, response, expense)
+	return response, nil
 }
 
 // UpdateExpense 経費を更新
@@ -112,11 +259,28 @@ func (uc *ExpenseUseCase) UpdateExpense(ctx context.Context, expenseID string, r
 		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
 	}
 
-	expense, err := uc.expenseRepo.FindByID(ctx, id)
+	stored, err := uc.expenseRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, errors.NewApplicationError(errors.ExpenseNotFound, "経費が見つかりません")
 	}
 
+	// 検証に失敗した場合に保存済みの経費が途中まで変更されないよう、複製に変更を反映して全て検証してから保存する
+	expense := stored.Copy()
+
+	// 経費日付（YYYY-MM-DD）の検証
+	date, err := valueobject.ParseDate(req.Date)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// 変更前・変更後の日付のどちらかが締め済みの会計期間の場合は更新できない
+	if err := uc.periodGuard.ensureOpen(ctx, expense.Date()); err != nil {
+		return nil, err
+	}
+	if err := uc.periodGuard.ensureOpen(ctx, date); err != nil {
+		return nil, err
+	}
+
 	// カテゴリIDの検証
 	cid, err := valueobject.NewCategoryID(req.CategoryID)
 	if err != nil {
@@ -129,35 +293,135 @@ func (uc *ExpenseUseCase) UpdateExpense(ctx context.Context, expenseID string, r
 		return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
 	}
 
-	// 金額の作成
-	currency := req.Currency
-	if currency == "" {
-		currency = "JPY"
-	}
-	amount, err := valueobject.NewMoney(req.Amount, currency)
+	// ユーザー情報を取得
+	user, err := uc.userRepo.FindByID(ctx, expense.UserID())
 	if err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	// 按分と明細は変更後の金額で指定し直すため、詳細の更新前に複製から解除する
+	if err := expense.ChangeAllocations(nil, uc.clock.Now()); err != nil {
 		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
 	}
+	if err := expense.ChangeLineItems(nil, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// 経費情報を更新（走行距離精算の金額は走行距離の変更時のみ再計算）
+	if req.Mileage != nil {
+		mileage, err := newMileage(req.Mileage)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := expense.UpdateMileage(cid, mileage, req.Title, req.Description, date, uc.clock.Now()); err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+	} else {
+		// 金額の作成
+		currency := req.Currency
+		if currency == "" {
+			currency = "JPY"
+		}
+		amount, err := valueobject.NewMoney(req.Amount, currency)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		if err := expense.UpdateDetails(cid, amount, req.Title, req.Description, date, uc.clock.Now()); err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+	}
 
-	// 経費情報を更新
-	err = expense.UpdateDetails(cid, amount, req.Title, req.Description, req.Date)
+	// カテゴリの経費日付として認める期間を利用者のタイムゾーンで検証
+	warning, err := category.CheckExpenseDate(user, expense.Date(), uc.clock.Now())
 	if err != nil {
 		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
 	}
 
+	// 出張申請の関連付け
+	if err := linkTripRequest(ctx, uc.tripRequestRepo, expense, req.TripRequestID, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// 支出規程の判定に使う利用時刻と、規程外の経費として申請する理由
+	if err := changePolicyDetails(expense, req.Time, req.DistanceKm, req.PolicyJustification, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// 飲食を伴う経費の参加者
+	if err := changeAttendees(expense, req.Attendees, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// コストセンターへの按分
+	if err := changeAllocations(ctx, uc.costCenterRepo, expense, req.Allocations, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// 顧客向けプロジェクトと請求対象かどうか
+	if err := changeProject(ctx, uc.projectRepo, expense, req.ProjectID, req.Billable, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// カテゴリの異なる明細
+	if err := changeLineItems(ctx, uc.categoryRepo, expense, req.LineItems, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// 重複の検出に使う支払先と領収書ファイルのハッシュ
+	if err := expense.ChangeReceipt(req.Vendor, req.ReceiptHash, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
 	// 経費を保存
 	err = uc.expenseRepo.Update(ctx, expense)
 	if err != nil {
 		return nil, errors.NewApplicationError(errors.ExpenseUpdateFailed, "経費の更新に失敗しました")
 	}
 
-	// ユーザー情報を取得
-	user, err := uc.userRepo.FindByID(ctx, expense.UserID())
+	// 同じ領収書の二重申請や、同僚による同じ経費の申請を検出（警告は保存を妨げない）
+	duplicates, err := findDuplicateExpenses(ctx, uc.expenseRepo, uc.userRepo, expense)
 	if err != nil {
-		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+		return nil, err
 	}
 
-	return uc.buildExpenseResponse(expense, user, category), nil
+	response := buildExpenseResponse(expense, user, category)
+	appendLineItemDetails(ctx, uc.categoryRepo, response, expense)
+	response.SuspectedDuplicates = duplicates
+	response.Warnings = appendWarning(response.Warnings, warning)
+	response.Warnings = append(response.Warnings, duplicateWarnings(duplicates)...)
+	return response, nil
+}
+
+// SplitExpense 下書きの経費をカテゴリの異なる明細に分ける（明細の金額の合計は経費の金額）
+func (uc *ExpenseUseCase) SplitExpense(ctx context.Context, expenseID string, req *dto.SplitExpenseRequest) (*dto.ExpenseResponse, error) {
+	id, err := valueobject.NewExpenseID(expenseID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	stored, err := uc.expenseRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseNotFound, "経費が見つかりません")
+	}
+
+	// 締め済みの会計期間の経費は変更できない
+	if err := uc.periodGuard.ensureOpen(ctx, stored.Date()); err != nil {
+		return nil, err
+	}
+
+	// 明細の検証に失敗した場合に保存済みの経費を変更しないよう複製に適用する
+	expense := stored.Copy()
+	if err := changeLineItems(ctx, uc.categoryRepo, expense, req.LineItems, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.expenseRepo.Update(ctx, expense); err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseUpdateFailed, "経費の更新に失敗しました")
+	}
+
+	return uc.buildExpenseResponseWithRelations(ctx, expense)
 }
 
 // DeleteExpense 経費を削除
@@ -176,6 +440,26 @@ func (uc *ExpenseUseCase) DeleteExpense(ctx context.Context, expenseID string) e
 		return errors.NewApplicationError(errors.ExpenseNotFound, "経費が見つかりません")
 	}
 
+	expense, err := uc.expenseRepo.FindByID(ctx, id)
+	if err != nil {
+		return errors.NewApplicationError(errors.ExpenseNotFound, "経費が見つかりません")
+	}
+
+	// 経費レポートに含まれる経費は、経費レポートから外してから削除する
+	if report := uc.findContainingReport(ctx, expense); report != nil {
+		return errors.NewApplicationError(errors.ExpenseAlreadyInReport, "経費レポート「"+report.Title()+"」に含まれる経費は削除できません")
+	}
+
+	// 申請中・承認済みの経費は承認の記録と仕訳を残すため削除できない
+	if !expense.CanDelete() {
+		return errors.NewApplicationError(errors.ExpenseNotDeletable, "下書きまたは却下された経費のみ削除できます")
+	}
+
+	// 締め済みの会計期間の経費は削除できない
+	if err := uc.periodGuard.ensureOpen(ctx, expense.Date()); err != nil {
+		return err
+	}
+
 	if err := uc.expenseRepo.Delete(ctx, id); err != nil {
 		return errors.NewApplicationError(errors.ExpenseDeletionFailed, "経費の削除に失敗しました")
 	}
@@ -234,21 +518,96 @@ func (uc *ExpenseUseCase) GetExpensesByUserAndStatus(ctx context.Context, userID
 	return uc.buildExpenseListResponse(ctx, expenses, user)
 }
 
+// GetApprovalQueue 承認者の承認待ちの経費一覧を申請の古い順に取得
+// 経費ごとに重複の疑いがある経費を含める（duplicatesOnlyの場合は重複の疑いがある経費のみ）
+func (uc *ExpenseUseCase) GetApprovalQueue(ctx context.Context, approverID string, duplicatesOnly bool) ([]*dto.ExpenseResponse, error) {
+	uid, err := valueobject.NewUserID(approverID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// 承認者の存在確認
+	if _, err := uc.userRepo.FindByID(ctx, uid); err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	submitted, err := uc.expenseRepo.FindByStatus(ctx, entity.ExpenseStatusSubmitted)
+	if err != nil {
+		return nil, errors.NewApplicationError("EXPENSE_FETCH_FAILED", "経費一覧の取得に失敗しました")
+	}
+
+	expenses := make([]*entity.Expense, 0)
+	for _, expense := range submitted {
+		if expense.ApproverID() != nil && expense.ApproverID().Equals(uid) {
+			expenses = append(expenses, expense)
+		}
+	}
+	sort.SliceStable(expenses, func(i, j int) bool {
+		return expenses[i].SubmittedAt().Before(expenses[j].SubmittedAt())
+	})
+
+	responses := make([]*dto.ExpenseResponse, 0, len(expenses))
+	users := make(map[string]*entity.User)
+	for _, expense := range expenses {
+		duplicates, err := findDuplicateExpenses(ctx, uc.expenseRepo, uc.userRepo, expense)
+		if err != nil {
+			return nil, err
+		}
+		if duplicatesOnly && len(duplicates) == 0 {
+			continue
+		}
+
+		category, err := uc.categoryRepo.FindByID(ctx, expense.CategoryID())
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
+		}
+
+		response := buildExpenseResponse(expense, findUserCached(ctx, uc.userRepo, expense.UserID(), users), category)
+		appendLineItemDetails(ctx, uc.categoryRepo, response, expense)
+		response.SuspectedDuplicates = duplicates
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
 // SubmitExpense 経費を申請
 func (uc *ExpenseUseCase) SubmitExpense(ctx context.Context, expenseID string) (*dto.ExpenseResponse, error) {
-	return uc.changeExpenseStatus(ctx, expenseID, "submit")
+	return uc.changeExpenseStatus(ctx, expenseID, actionSubmit)
 }
 
 // ApproveExpense 経費を承認
 func (uc *ExpenseUseCase) ApproveExpense(ctx context.Context, expenseID string) (*dto.ExpenseResponse, error) {
-	return uc.changeExpenseStatus(ctx, expenseID, "approve")
+	return uc.changeExpenseStatus(ctx, expenseID, actionApprove)
 }
 
 // RejectExpense 経費を却下
 func (uc *ExpenseUseCase) RejectExpense(ctx context.Context, expenseID string) (*dto.ExpenseResponse, error) {
-	return uc.changeExpenseStatus(ctx, expenseID, "reject")
+	return uc.changeExpenseStatus(ctx, expenseID, actionReject)
+}
+
+// BulkSubmitExpenses 経費を一括申請
+func (uc *ExpenseUseCase) BulkSubmitExpenses(ctx context.Context, req *dto.BulkExpenseActionRequest) (*dto.BulkExpenseActionResponse, error) {
+	return uc.bulkChangeExpenseStatus(ctx, actionSubmit, req)
 }
 
+// BulkApproveExpenses 経費を一括承認
+func (uc *ExpenseUseCase) BulkApproveExpenses(ctx context.Context, req *dto.BulkExpenseActionRequest) (*dto.BulkExpenseActionResponse, error) {
+	return uc.bulkChangeExpenseStatus(ctx, actionApprove, req)
+}
+
+// BulkRejectExpenses 経費を一括却下
+func (uc *ExpenseUseCase) BulkRejectExpenses(ctx context.Context, req *dto.BulkExpenseActionRequest) (*dto.BulkExpenseActionResponse, error) {
+	return uc.bulkChangeExpenseStatus(ctx, actionReject, req)
+}
+
+// ステータス変更のアクション
+const (
+	actionSubmit  = "submit"
+	actionApprove = "approve"
+	actionReject  = "reject"
+)
+
 // changeExpenseStatus 経費のステータスを変更
 func (uc *ExpenseUseCase) changeExpenseStatus(ctx context.Context, expenseID string, action string) (*dto.ExpenseResponse, error) {
 	id, err := valueobject.NewExpenseID(expenseID)
@@ -256,39 +615,350 @@ func (uc *ExpenseUseCase) changeExpenseStatus(ctx context.Context, expenseID str
 		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
 	}
 
-	expense, err := uc.expenseRepo.FindByID(ctx, id)
+	stored, err := uc.expenseRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, errors.NewApplicationError(errors.ExpenseNotFound, "経費が見つかりません")
 	}
 
-	// ステータス変更
+	// ステータス変更（失敗した場合に保存済みの経費を変更しないよう複製に適用する）
+	expense := stored.Copy()
+	warnings, err := uc.applyStatusAction(ctx, expense, action, "")
+	if err != nil {
+		if _, ok := err.(*errors.DomainError); ok {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		return nil, err
+	}
+
+	// 経費を保存
+	err = uc.expenseRepo.Update(ctx, expense)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseUpdateFailed, "経費のステータス更新に失敗しました")
+	}
+
+	if action == actionApprove {
+		if warning := publishExpenseApproved(ctx, uc.publisher, expense); warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	response, err := uc.buildExpenseResponseWithRelations(ctx, expense)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Warnings = warnings
+	return response, nil
+}
+
+// bulkChangeExpenseStatus 複数の経費のステータスを変更（失敗した経費があっても残りの処理を継続）
+func (uc *ExpenseUseCase) bulkChangeExpenseStatus(ctx context.Context, action string, req *dto.BulkExpenseActionRequest) (*dto.BulkExpenseActionResponse, error) {
+	response := &dto.BulkExpenseActionResponse{
+		Action:  action,
+		Results: make([]*dto.BulkExpenseActionResult, len(req.ExpenseIDs)),
+	}
+
+	for i, expenseID := range req.ExpenseIDs {
+		result := &dto.BulkExpenseActionResult{ExpenseID: expenseID}
+
+		expense, err := uc.changeExpenseStatusForBulk(ctx, expenseID, action, req.Comment)
+		if err != nil {
+			result.Error = toBulkItemError(err)
+			response.FailedCount++
+		} else {
+			result.Success = true
+			result.Expense = expense
+			response.SucceededCount++
+		}
+
+		response.Results[i] = result
+	}
+
+	return response, nil
+}
+
+// changeExpenseStatusForBulk 一括処理の1件分のステータス変更（ドメインエラーをそのまま返す）
+func (uc *ExpenseUseCase) changeExpenseStatusForBulk(ctx context.Context, expenseID string, action string, comment string) (*dto.ExpenseResponse, error) {
+	id, err := valueobject.NewExpenseID(expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := uc.expenseRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 失敗した場合に保存済みの経費を変更しないよう複製に適用する
+	expense := stored.Copy()
+	warnings, err := uc.applyStatusAction(ctx, expense, action, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.expenseRepo.Update(ctx, expense); err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseUpdateFailed, "経費のステータス更新に失敗しました")
+	}
+
+	if action == actionApprove {
+		if warning := publishExpenseApproved(ctx, uc.publisher, expense); warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	response, err := uc.buildExpenseResponseWithRelations(ctx, expense)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Warnings = warnings
+	return response, nil
+}
+
+// applyStatusAction 経費にステータス変更アクションを適用し、警告があれば返す
+// 失敗した場合も経費を途中まで変更するため、保存済みの経費の複製に適用する
+func (uc *ExpenseUseCase) applyStatusAction(ctx context.Context, expense *entity.Expense, action string, comment string) ([]string, error) {
+	// 経費レポートに含まれる経費は、経費とレポートのステータスを揃えるためレポート単位で変更する
+	if report := uc.findContainingReport(ctx, expense); report != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseAlreadyInReport, "経費レポート「"+report.Title()+"」に含まれる経費は経費レポート単位で申請・承認・却下してください")
+	}
+
+	// 締め済みの会計期間の経費は申請・承認・却下できない
+	if err := uc.periodGuard.ensureOpen(ctx, expense.Date()); err != nil {
+		return nil, err
+	}
+
+	// コメントはステータスを変更する前に検証する
+	if comment != "" {
+		if err := expense.SetComment(comment, uc.clock.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	var warnings []string
+
 	switch action {
-	case "submit":
-		err = expense.Submit()
+	case actionSubmit:
+		// 申請時点の今日を基準に、カテゴリの経費日付として認める期間を検証
+		warning, err := checkExpenseDate(ctx, uc.userRepo, uc.categoryRepo, expense, uc.clock.Now())
 		if err != nil {
-			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+			return nil, err
 		}
-	case "approve":
-		err = expense.Approve()
+		warnings = appendWarning(warnings, warning)
+
+		// カテゴリの支出規程を検証し、理由が入力された違反は規程外の経費として記録
+		if err := acceptPolicyViolations(ctx, uc.categoryRepo, expense); err != nil {
+			return nil, err
+		}
+
+		// 申請前の残額で予算の超過を確認（警告は申請を妨げない）
+		budgetWarnings, err := checkBudgets(ctx, uc.budgetRepo, uc.expenseRepo, uc.userRepo, expense, nil)
 		if err != nil {
-			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+			return nil, err
 		}
-	case "reject":
-		err = expense.Reject()
+		warnings = append(warnings, budgetWarnings...)
+
+		// 重複の疑いがある経費を警告（承認者は承認待ち一覧で確認する）
+		duplicates, err := findDuplicateExpenses(ctx, uc.expenseRepo, uc.userRepo, expense)
 		if err != nil {
-			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+			return nil, err
+		}
+		warnings = append(warnings, duplicateWarnings(duplicates)...)
+
+		if err := expense.Submit(uc.clock.Now()); err != nil {
+			return nil, err
+		}
+		if err := routeToManager(ctx, uc.userRepo, expense, uc.clock.Now()); err != nil {
+			return nil, err
+		}
+	case actionApprove:
+		if err := expense.Approve(uc.clock.Now()); err != nil {
+			return nil, err
+		}
+	case actionReject:
+		if err := expense.Reject(uc.clock.Now()); err != nil {
+			return nil, err
 		}
 	default:
 		return nil, errors.NewApplicationError(errors.ValidationFailed, "無効なアクションです")
 	}
 
-	// 経費を保存
-	err = uc.expenseRepo.Update(ctx, expense)
+	return warnings, nil
+}
+
+// findContainingReport 経費を含む経費レポートを取得（含まれていない場合やリポジトリが未設定の場合はnil）
+func (uc *ExpenseUseCase) findContainingReport(ctx context.Context, expense *entity.Expense) *entity.ExpenseReport {
+	if uc.reportRepo == nil {
+		return nil
+	}
+
+	report, err := uc.reportRepo.FindByExpenseID(ctx, expense.ID())
 	if err != nil {
-		return nil, errors.NewApplicationError(errors.ExpenseUpdateFailed, "経費のステータス更新に失敗しました")
+		return nil
+	}
+	return report
+}
+
+// checkExpenseDate 経費のカテゴリの経費日付として認める期間を、申請者のタイムゾーンで検証
+func checkExpenseDate(ctx context.Context, userRepo repository.UserRepository, categoryRepo repository.CategoryRepository, expense *entity.Expense, now time.Time) (string, error) {
+	user, err := userRepo.FindByID(ctx, expense.UserID())
+	if err != nil {
+		return "", errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
 	}
 
-	// ユーザーとカテゴリ情報を取得
+	category, err := categoryRepo.FindByID(ctx, expense.CategoryID())
+	if err != nil {
+		return "", errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
+	}
+
+	return category.CheckExpenseDate(user, expense.Date(), now)
+}
+
+// acceptPolicyViolations 経費と明細のカテゴリの支出規程を検証し、申請できる違反を経費に記録
+func acceptPolicyViolations(ctx context.Context, categoryRepo repository.CategoryRepository, expense *entity.Expense) error {
+	category, err := categoryRepo.FindByID(ctx, expense.CategoryID())
+	if err != nil {
+		return errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
+	}
+
+	others, err := lineCategories(ctx, categoryRepo, expense)
+	if err != nil {
+		return err
+	}
+
+	violations := category.EvaluateSpendingRules(expense)
+	for _, other := range others {
+		violations = append(violations, other.EvaluateSpendingRules(expense)...)
+	}
+
+	return expense.AcceptPolicyViolations(violations)
+}
+
+// lineCategories 明細のカテゴリのうち、経費のカテゴリ以外のものを明細の順に取得
+func lineCategories(ctx context.Context, categoryRepo repository.CategoryRepository, expense *entity.Expense) ([]*entity.Category, error) {
+	seen := map[string]bool{expense.CategoryID().String(): true}
+	categories := make([]*entity.Category, 0)
+	for _, lineItem := range expense.LineItems() {
+		if seen[lineItem.CategoryID().String()] {
+			continue
+		}
+		seen[lineItem.CategoryID().String()] = true
+
+		category, err := categoryRepo.FindByID(ctx, lineItem.CategoryID())
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.CategoryNotFound, "明細のカテゴリが見つかりません")
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, nil
+}
+
+// appendLineItemDetails 明細に分けた経費のレスポンスに、明細のカテゴリで判定する内容を追加
+// 下書きの経費は経費のカテゴリ以外の明細の支出規程の違反を追加し、参加者のいる経費は飲食・接待のカテゴリの明細で会議費・交際費の区分を判定し直す
+func appendLineItemDetails(ctx context.Context, categoryRepo repository.CategoryRepository, response *dto.ExpenseResponse, expense *entity.Expense) {
+	if !expense.IsItemized() {
+		return
+	}
+
+	// 削除済みのカテゴリの明細は申請時にエラーとなるため、ここでは判定しない
+	categories := make(map[string]*entity.Category)
+	for _, lineItem := range expense.LineItems() {
+		if _, ok := categories[lineItem.CategoryID().String()]; ok {
+			continue
+		}
+
+		category, err := categoryRepo.FindByID(ctx, lineItem.CategoryID())
+		if err != nil {
+			category = nil
+		}
+		categories[lineItem.CategoryID().String()] = category
+
+		if category == nil || expense.Status() != entity.ExpenseStatusDraft || category.ID().Equals(expense.CategoryID()) {
+			continue
+		}
+		for _, violation := range category.EvaluateSpendingRules(expense) {
+			response.PolicyViolations = append(response.PolicyViolations, buildPolicyViolationResponse(violation))
+		}
+	}
+
+	if len(expense.Attendees()) > 0 {
+		response.EntertainmentClass = string(expense.EntertainmentClass(func(categoryID *valueobject.CategoryID) bool {
+			category := categories[categoryID.String()]
+			return category != nil && category.IsEntertainment()
+		}))
+	}
+}
+
+// PayExpense 承認済みの経費を支払済みにする
+func (uc *ExpenseUseCase) PayExpense(ctx context.Context, expenseID string, req *dto.PayExpenseRequest) (*dto.ExpenseResponse, error) {
+	id, err := valueobject.NewExpenseID(expenseID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	stored, err := uc.expenseRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseNotFound, "経費が見つかりません")
+	}
+
+	paidAt := uc.clock.Now()
+	if req.PaidAt != nil {
+		paidAt = *req.PaidAt
+	}
+
+	// 保存に失敗した場合に保存済みの経費を支払済みにしないよう複製に適用する
+	expense := stored.Copy()
+	if err := expense.MarkPaid(paidAt, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.expenseRepo.Update(ctx, expense); err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseUpdateFailed, "経費の支払いの登録に失敗しました")
+	}
+
+	response, err := uc.buildExpenseResponseWithRelations(ctx, expense)
+	if err != nil {
+		return nil, err
+	}
+
+	// 支払いは保存済みのため、通知（仕訳の作成など）に失敗しても警告にとどめる
+	if warning := publishExpenseEvent(ctx, uc.publisher, expense, event.ExpensePaid{
+		ExpenseID: expense.ID().String(),
+		UserID:    expense.UserID().String(),
+		At:        expense.PaidAt(),
+	}, "支払い"); warning != "" {
+		response.Warnings = append(response.Warnings, warning)
+	}
+
+	return response, nil
+}
+
+// publishExpenseApproved 経費の承認を通知し、失敗した場合は警告を返す（発行先が未設定の場合は何もしない）
+func publishExpenseApproved(ctx context.Context, publisher event.Publisher, expense *entity.Expense) string {
+	return publishExpenseEvent(ctx, publisher, expense, event.ExpenseApproved{
+		ExpenseID: expense.ID().String(),
+		UserID:    expense.UserID().String(),
+		At:        expense.UpdatedAt(),
+	}, "承認")
+}
+
+// publishExpenseEvent 経費のイベントを通知し、失敗した場合は警告を返す
+// 経費の変更は保存済みのため、失敗しても処理は失敗にしない（仕訳は定期ジョブで再作成する）
+func publishExpenseEvent(ctx context.Context, publisher event.Publisher, expense *entity.Expense, e event.Event, action string) string {
+	if publisher == nil {
+		return ""
+	}
+
+	if err := publisher.Publish(ctx, e); err != nil {
+		return "経費「" + expense.Title() + "」の" + action + "の通知に失敗しました。仕訳は後で自動的に作成されます"
+	}
+
+	return ""
+}
+
+// buildExpenseResponseWithRelations ユーザーとカテゴリ情報を取得して経費レスポンスを構築
+func (uc *ExpenseUseCase) buildExpenseResponseWithRelations(ctx context.Context, expense *entity.Expense) (*dto.ExpenseResponse, error) {
 	user, err := uc.userRepo.FindByID(ctx, expense.UserID())
 	if err != nil {
 		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
@@ -299,31 +969,361 @@ func (uc *ExpenseUseCase) changeExpenseStatus(ctx context.Context, expenseID str
 		return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
 	}
 
-	return uc.buildExpenseResponse(expense, user, category), nil
+	response := buildExpenseResponse(expense, user, category)
+	appendLineItemDetails(ctx, uc.categoryRepo, response, expense)
+	return response, nil
+}
+
+// linkTripRequest 出張申請を検証して経費に関連付け（空文字の場合は解除）
+func linkTripRequest(ctx context.Context, tripRequestRepo repository.TripRequestRepository, expense *entity.Expense, tripRequestID string, now time.Time) error {
+	if tripRequestID == "" {
+		if err := expense.LinkTripRequest(nil, now); err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		return nil
+	}
+
+	if tripRequestRepo == nil {
+		return errors.NewApplicationError(errors.ValidationFailed, "出張申請は利用できません")
+	}
+
+	tid, err := valueobject.NewTripRequestID(tripRequestID)
+	if err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	tripRequest, err := tripRequestRepo.FindByID(ctx, tid)
+	if err != nil {
+		return errors.NewApplicationError(errors.TripRequestNotFound, "出張申請が見つかりません")
+	}
+
+	if !tripRequest.UserID().Equals(expense.UserID()) {
+		return errors.NewApplicationError(errors.ValidationFailed, "他のユーザーの出張申請は参照できません")
+	}
+
+	if !tripRequest.IsApproved() {
+		return errors.NewApplicationError(errors.TripRequestNotApproved, "承認済みの出張申請のみ参照できます")
+	}
+
+	if tripRequest.EstimatedCost().Currency() != expense.Amount().Currency() {
+		return errors.NewApplicationError(errors.ValidationFailed, "出張申請と異なる通貨の経費は関連付けできません")
+	}
+
+	if err := expense.LinkTripRequest(tid, now); err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	return nil
+}
+
+// changePolicyDetails 利用時刻（空文字の場合は解除）、移動距離と規程外の経費として申請する理由を経費に設定
+func changePolicyDetails(expense *entity.Expense, timeOfDay string, distanceKm float64, justification string, now time.Time) error {
+	var t *valueobject.TimeOfDay
+	if timeOfDay != "" {
+		parsed, err := valueobject.ParseTimeOfDay(timeOfDay)
+		if err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		t = &parsed
+	}
+
+	if err := expense.ChangeTimeOfDay(t, now); err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := expense.ChangeDistanceKm(distanceKm, now); err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := expense.ChangePolicyJustification(justification, now); err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	return nil
+}
+
+// changeAttendees リクエストの参加者を経費に設定（空の場合は解除）
+func changeAttendees(expense *entity.Expense, reqs []dto.AttendeeRequest, now time.Time) error {
+	attendees := make([]*entity.Attendee, 0, len(reqs))
+	for _, req := range reqs {
+		attendee, err := entity.NewAttendee(req.Name, req.Company, entity.AttendeeType(req.Type))
+		if err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		attendees = append(attendees, attendee)
+	}
+
+	if err := expense.ChangeAttendees(attendees, now); err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+	return nil
+}
+
+// changeAllocations リクエストのコストセンターへの按分を経費に設定（空の場合は解除）
+func changeAllocations(ctx context.Context, costCenterRepo repository.CostCenterRepository, expense *entity.Expense, reqs []dto.CostAllocationRequest, now time.Time) error {
+	if len(reqs) > 0 && costCenterRepo == nil {
+		return errors.NewApplicationError(errors.ValidationFailed, "コストセンターへの按分は利用できません")
+	}
+
+	allocations := make([]*entity.CostAllocation, 0, len(reqs))
+	for _, req := range reqs {
+		id, err := valueobject.NewCostCenterID(req.CostCenterID)
+		if err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		if _, err := costCenterRepo.FindByID(ctx, id); err != nil {
+			return errors.NewApplicationError(errors.CostCenterNotFound, "コストセンターが見つかりません")
+		}
+
+		allocation, err := newCostAllocation(id, req, expense.Amount().Currency())
+		if err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		allocations = append(allocations, allocation)
+	}
+
+	if err := expense.ChangeAllocations(allocations, now); err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+	return nil
+}
+
+// changeProject リクエストのプロジェクトを経費に設定（空の場合は解除）
+// プロジェクトの期間外の日付の経費は関連付けできない
+func changeProject(ctx context.Context, projectRepo repository.ProjectRepository, expense *entity.Expense, projectID string, billable bool, now time.Time) error {
+	if projectID == "" {
+		if err := expense.ChangeProject(nil, billable, now); err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		return nil
+	}
+
+	if projectRepo == nil {
+		return errors.NewApplicationError(errors.ValidationFailed, "プロジェクトへの関連付けは利用できません")
+	}
+
+	id, err := valueobject.NewProjectID(projectID)
+	if err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	project, err := projectRepo.FindByID(ctx, id)
+	if err != nil {
+		return errors.NewApplicationError(errors.ProjectNotFound, "プロジェクトが見つかりません")
+	}
+
+	if !project.IsActiveOn(expense.Date()) {
+		return errors.NewApplicationError(errors.ProjectNotActive, "経費の日付がプロジェクト「"+project.Name()+"」の期間外です")
+	}
+
+	if err := expense.ChangeProject(id, billable, now); err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+	return nil
+}
+
+// changeLineItems リクエストの明細を経費に設定（空の場合は解除）
+func changeLineItems(ctx context.Context, categoryRepo repository.CategoryRepository, expense *entity.Expense, reqs []dto.LineItemRequest, now time.Time) error {
+	lineItems := make([]*entity.ExpenseLineItem, 0, len(reqs))
+	for _, req := range reqs {
+		cid, err := valueobject.NewCategoryID(req.CategoryID)
+		if err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		if _, err := categoryRepo.FindByID(ctx, cid); err != nil {
+			return errors.NewApplicationError(errors.CategoryNotFound, "明細のカテゴリが見つかりません")
+		}
+
+		amount, err := valueobject.NewMoney(req.Amount, expense.Amount().Currency())
+		if err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		lineItem, err := entity.NewExpenseLineItem(cid, amount, valueobject.TaxCode(req.TaxCode), req.Description)
+		if err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		lineItems = append(lineItems, lineItem)
+	}
+
+	if err := expense.ChangeLineItems(lineItems, now); err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+	return nil
+}
+
+// newCostAllocation リクエストから割合または金額で指定した按分を作成
+func newCostAllocation(costCenterID *valueobject.CostCenterID, req dto.CostAllocationRequest, currency string) (*entity.CostAllocation, error) {
+	if (req.Percentage > 0) == (req.Amount > 0) {
+		return nil, errors.NewDomainError(errors.InvalidCostAllocation, "按分は割合と金額のどちらか一方で指定してください")
+	}
+
+	if req.Percentage > 0 {
+		return entity.NewPercentageAllocation(costCenterID, req.Percentage)
+	}
+
+	amount, err := valueobject.NewMoney(req.Amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	return entity.NewAmountAllocation(costCenterID, amount)
+}
+
+// appendWarning 警告があれば追加
+func appendWarning(warnings []string, warning string) []string {
+	if warning == "" {
+		return warnings
+	}
+	return append(warnings, warning)
+}
+
+// newMileage リクエストから走行距離精算の明細を作成
+func newMileage(req *dto.MileageRequest) (*entity.Mileage, error) {
+	mileage, err := entity.NewMileage(req.Origin, req.Destination, req.DistanceKm, entity.VehicleType(req.VehicleType))
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	return mileage, nil
+}
+
+// routeToManager 申請された経費を申請者の上長に回付（上長未設定の場合は未割り当てのまま）
+func routeToManager(ctx context.Context, userRepo repository.UserRepository, expense *entity.Expense, now time.Time) error {
+	owner, err := userRepo.FindByID(ctx, expense.UserID())
+	if err != nil {
+		return errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	if owner.ManagerID() == nil {
+		return nil
+	}
+
+	if err := expense.AssignApprover(owner.ManagerID(), now); err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	return nil
 }
 
 // buildExpenseResponse 経費レスポンスを構築
-func (uc *ExpenseUseCase) buildExpenseResponse(expense *entity.Expense, user *entity.User, category *entity.Category) *dto.ExpenseResponse {
-	return &dto.ExpenseResponse{
-		ID:         expense.ID().String(),
-		UserID:     expense.UserID().String(),
-		CategoryID: expense.CategoryID().String(),
-		Category: &dto.CategoryResponse{
-			ID:          category.ID().String(),
-			Name:        category.Name(),
-			Description: category.Description(),
-			Color:       category.Color(),
-			CreatedAt:   category.CreatedAt(),
-			UpdatedAt:   category.UpdatedAt(),
-		},
+func buildExpenseResponse(expense *entity.Expense, user *entity.User, category *entity.Category) *dto.ExpenseResponse {
+	response := &dto.ExpenseResponse{
+		ID:          expense.ID().String(),
+		UserID:      expense.UserID().String(),
+		CategoryID:  expense.CategoryID().String(),
+		Category:    buildCategoryResponse(category),
 		Amount:      expense.Amount().Amount(),
 		Currency:    expense.Amount().Currency(),
 		Title:       expense.Title(),
 		Description: expense.Description(),
-		Date:        expense.Date(),
+		Date:        expense.Date().String(),
 		Status:      string(expense.Status()),
 		CreatedAt:   expense.CreatedAt(),
 		UpdatedAt:   expense.UpdatedAt(),
+
+		EscalationLevel: expense.EscalationLevel(),
+		Comment:         expense.Comment(),
+		Kind:            string(expense.Kind()),
+	}
+
+	if mileage := expense.Mileage(); mileage != nil {
+		response.Mileage = &dto.MileageResponse{
+			Origin:      mileage.Origin(),
+			Destination: mileage.Destination(),
+			DistanceKm:  mileage.DistanceKm(),
+			VehicleType: string(mileage.VehicleType()),
+		}
+
+		if rate, err := entity.FindMileageRate(mileage.VehicleType(), expense.Date().Time()); err == nil {
+			response.Mileage.RatePerKm = rate.RatePerKm()
+		}
+	}
+
+	if expense.TripRequestID() != nil {
+		response.TripRequestID = expense.TripRequestID().String()
+	}
+
+	if expense.ApproverID() != nil {
+		response.ApproverID = expense.ApproverID().String()
+	}
+
+	if !expense.SubmittedAt().IsZero() {
+		submittedAt := expense.SubmittedAt()
+		response.SubmittedAt = &submittedAt
+	}
+
+	if expense.IsPaid() {
+		paidAt := expense.PaidAt()
+		response.PaidAt = &paidAt
+	}
+
+	if expense.TimeOfDay() != nil {
+		response.Time = expense.TimeOfDay().String()
+	}
+	response.DistanceKm = expense.DistanceKm()
+	response.PolicyJustification = expense.PolicyJustification()
+	response.PolicyException = expense.IsPolicyException()
+
+	for _, attendee := range expense.Attendees() {
+		response.Attendees = append(response.Attendees, dto.AttendeeResponse{
+			Name:    attendee.Name(),
+			Company: attendee.Company(),
+			Type:    string(attendee.Type()),
+		})
+	}
+	if perHead := expense.PerHeadAmount(); perHead != nil {
+		response.AttendeeCount = len(expense.Attendees())
+		response.PerHeadAmount = perHead.Amount()
+		response.EntertainmentClass = string(expense.EntertainmentClass(func(categoryID *valueobject.CategoryID) bool {
+			return category != nil && category.ID().Equals(categoryID) && category.IsEntertainment()
+		}))
+	}
+
+	for _, allocation := range expense.Allocations() {
+		response.Allocations = append(response.Allocations, dto.CostAllocationResponse{
+			CostCenterID: allocation.CostCenterID().String(),
+			Percentage:   allocation.Percentage(),
+			Amount:       allocation.Amount().Amount(),
+		})
+	}
+
+	if expense.ProjectID() != nil {
+		response.ProjectID = expense.ProjectID().String()
+	}
+	response.Billable = expense.IsBillable()
+
+	response.Vendor = expense.Vendor()
+	response.ReceiptHash = expense.ReceiptHash()
+
+	for _, lineItem := range expense.LineItems() {
+		response.LineItems = append(response.LineItems, dto.LineItemResponse{
+			CategoryID:  lineItem.CategoryID().String(),
+			Amount:      lineItem.Amount().Amount(),
+			TaxCode:     string(lineItem.TaxCode()),
+			Description: lineItem.Description(),
+		})
+	}
+
+	// 下書きは現在の支出規程での判定結果、申請後は申請時に記録した違反を返す
+	violations := expense.PolicyViolations()
+	if expense.Status() == entity.ExpenseStatusDraft && category != nil {
+		violations = category.EvaluateSpendingRules(expense)
+	}
+	for _, violation := range violations {
+		response.PolicyViolations = append(response.PolicyViolations, buildPolicyViolationResponse(violation))
+	}
+
+	return response
+}
+
+// buildPolicyViolationResponse 支出規程の違反レスポンスを構築
+func buildPolicyViolationResponse(violation *valueobject.PolicyViolation) *dto.PolicyViolationResponse {
+	return &dto.PolicyViolationResponse{
+		Rule:        string(violation.Rule()),
+		Enforcement: string(violation.Enforcement()),
+		Message:     violation.Message(),
 	}
 }
 
@@ -338,8 +1338,21 @@ func (uc *ExpenseUseCase) buildExpenseListResponse(ctx context.Context, expenses
 			return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
 		}
 
-		responses[i] = uc.buildExpenseResponse(expense, user, category)
+		responses[i] = buildExpenseResponse(expense, user, category)
+		appendLineItemDetails(ctx, uc.categoryRepo, responses[i], expense)
 	}
 
 	return responses, nil
 }
+
+// toBulkItemError エラーを一括処理の項目エラーに変換
+func toBulkItemError(err error) *dto.BulkItemError {
+	switch e := err.(type) {
+	case *errors.DomainError:
+		return &dto.BulkItemError{Code: e.Code, Message: e.Message}
+	case *errors.ApplicationError:
+		return &dto.BulkItemError{Code: e.Code, Message: e.Message}
+	default:
+		return &dto.BulkItemError{Code: "INTERNAL_SERVER_ERROR", Message: err.Error()}
+	}
+}
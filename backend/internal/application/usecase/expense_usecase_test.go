@@ -3,9 +3,12 @@ package usecase
 import (
 	"context"
 	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
 	"expense-management-system/internal/domain/entity"
 	"expense-management-system/internal/domain/valueobject"
 	"expense-management-system/internal/infrastructure/persistence"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +17,7 @@ import (
 )
 
 func TestExpenseUseCase_CreateExpense(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
 	ctx := context.Background()
 
 	// リポジトリを初期化
@@ -22,14 +26,14 @@ func TestExpenseUseCase_CreateExpense(t *testing.T) {
 	expenseRepo := persistence.NewMemoryExpenseRepository()
 
 	// ユースケースを初期化
-	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo)
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
 
 	// テスト用のユーザーとカテゴリを作成
-	user, _ := entity.NewUser("テストユーザー", "test@example.com")
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
 	err := userRepo.Save(ctx, user)
 	require.NoError(t, err)
 
-	category, _ := entity.NewCategory("交通費", "交通費カテゴリ", "#FF0000")
+	category, _ := entity.NewCategory(fakeClock, "交通費", "交通費カテゴリ", "#FF0000")
 	err = categoryRepo.Save(ctx, category)
 	require.NoError(t, err)
 
@@ -48,7 +52,7 @@ func TestExpenseUseCase_CreateExpense(t *testing.T) {
 				Currency:    "JPY",
 				Title:       "電車代",
 				Description: "営業訪問のための電車代",
-				Date:        time.Now().AddDate(0, 0, -1),
+				Date:        fakeClock.Now().AddDate(0, 0, -1).Format("2006-01-02"),
 			},
 			wantErr: false,
 		},
@@ -61,7 +65,7 @@ func TestExpenseUseCase_CreateExpense(t *testing.T) {
 				Currency:    "JPY",
 				Title:       "電車代",
 				Description: "営業訪問のための電車代",
-				Date:        time.Now().AddDate(0, 0, -1),
+				Date:        fakeClock.Now().AddDate(0, 0, -1).Format("2006-01-02"),
 			},
 			wantErr: true,
 		},
@@ -74,7 +78,7 @@ func TestExpenseUseCase_CreateExpense(t *testing.T) {
 				Currency:    "JPY",
 				Title:       "電車代",
 				Description: "営業訪問のための電車代",
-				Date:        time.Now().AddDate(0, 0, -1),
+				Date:        fakeClock.Now().AddDate(0, 0, -1).Format("2006-01-02"),
 			},
 			wantErr: true,
 		},
@@ -87,7 +91,7 @@ func TestExpenseUseCase_CreateExpense(t *testing.T) {
 				Currency:    "JPY",
 				Title:       "電車代",
 				Description: "営業訪問のための電車代",
-				Date:        time.Now().AddDate(0, 0, -1),
+				Date:        fakeClock.Now().AddDate(0, 0, -1).Format("2006-01-02"),
 			},
 			wantErr: true,
 		},
@@ -100,7 +104,7 @@ func TestExpenseUseCase_CreateExpense(t *testing.T) {
 				Currency:    "JPY",
 				Title:       "",
 				Description: "営業訪問のための電車代",
-				Date:        time.Now().AddDate(0, 0, -1),
+				Date:        fakeClock.Now().AddDate(0, 0, -1).Format("2006-01-02"),
 			},
 			wantErr: true,
 		},
@@ -127,6 +131,7 @@ func TestExpenseUseCase_CreateExpense(t *testing.T) {
 }
 
 func TestExpenseUseCase_SubmitExpense(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
 	ctx := context.Background()
 
 	// リポジトリを初期化
@@ -135,19 +140,19 @@ func TestExpenseUseCase_SubmitExpense(t *testing.T) {
 	expenseRepo := persistence.NewMemoryExpenseRepository()
 
 	// ユースケースを初期化
-	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo)
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
 
 	// テスト用のユーザー、カテゴリ、経費を作成
-	user, _ := entity.NewUser("テストユーザー", "test@example.com")
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
 	err := userRepo.Save(ctx, user)
 	require.NoError(t, err)
 
-	category, _ := entity.NewCategory("交通費", "交通費カテゴリ", "#FF0000")
+	category, _ := entity.NewCategory(fakeClock, "交通費", "交通費カテゴリ", "#FF0000")
 	err = categoryRepo.Save(ctx, category)
 	require.NoError(t, err)
 
 	amount, _ := valueobject.NewMoney(1000, "JPY")
-	expense, _ := entity.NewExpense(user.ID(), category.ID(), amount, "電車代", "営業訪問", time.Now().AddDate(0, 0, -1))
+	expense, _ := entity.NewExpense(fakeClock, user.ID(), category.ID(), amount, "電車代", "営業訪問", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -1)))
 	err = expenseRepo.Save(ctx, expense)
 	require.NoError(t, err)
 
@@ -185,6 +190,7 @@ func TestExpenseUseCase_SubmitExpense(t *testing.T) {
 }
 
 func TestExpenseUseCase_ApproveExpense(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
 	ctx := context.Background()
 
 	// リポジトリを初期化
@@ -193,22 +199,22 @@ func TestExpenseUseCase_ApproveExpense(t *testing.T) {
 	expenseRepo := persistence.NewMemoryExpenseRepository()
 
 	// ユースケースを初期化
-	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo)
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
 
 	// テスト用のユーザー、カテゴリ、経費を作成
-	user, _ := entity.NewUser("テストユーザー", "test@example.com")
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
 	err := userRepo.Save(ctx, user)
 	require.NoError(t, err)
 
-	category, _ := entity.NewCategory("交通費", "交通費カテゴリ", "#FF0000")
+	category, _ := entity.NewCategory(fakeClock, "交通費", "交通費カテゴリ", "#FF0000")
 	err = categoryRepo.Save(ctx, category)
 	require.NoError(t, err)
 
 	amount, _ := valueobject.NewMoney(1000, "JPY")
-	expense, _ := entity.NewExpense(user.ID(), category.ID(), amount, "電車代", "営業訪問", time.Now().AddDate(0, 0, -1))
+	expense, _ := entity.NewExpense(fakeClock, user.ID(), category.ID(), amount, "電車代", "営業訪問", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -1)))
 
 	// 経費を申請状態にする
-	err = expense.Submit()
+	err = expense.Submit(fakeClock.Now())
 	require.NoError(t, err)
 
 	err = expenseRepo.Save(ctx, expense)
@@ -220,9 +226,39 @@ func TestExpenseUseCase_ApproveExpense(t *testing.T) {
 		assert.NotNil(t, result)
 		assert.Equal(t, "approved", result.Status)
 	})
+
+	t.Run("一括承認のコメントは文字数で検証し、不正な場合は経費を変更しない", func(t *testing.T) {
+		newSubmitted := func() *entity.Expense {
+			expense, _ := entity.NewExpense(fakeClock, user.ID(), category.ID(), amount, "電車代", "", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -1)))
+			require.NoError(t, expense.Submit(fakeClock.Now()))
+			require.NoError(t, expenseRepo.Save(ctx, expense))
+			return expense
+		}
+
+		// 500文字の日本語のコメントは承認できる
+		accepted := newSubmitted()
+		result, err := useCase.BulkApproveExpenses(ctx, &dto.BulkExpenseActionRequest{
+			ExpenseIDs: []string{accepted.ID().String()}, Comment: strings.Repeat("確", 500),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.SucceededCount)
+
+		rejected := newSubmitted()
+		result, err = useCase.BulkApproveExpenses(ctx, &dto.BulkExpenseActionRequest{
+			ExpenseIDs: []string{rejected.ID().String()}, Comment: strings.Repeat("確", 501),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.FailedCount)
+
+		stored, err := expenseRepo.FindByID(ctx, rejected.ID())
+		require.NoError(t, err)
+		assert.Equal(t, entity.ExpenseStatusSubmitted, stored.Status())
+		assert.Empty(t, stored.Comment())
+	})
 }
 
 func TestExpenseUseCase_GetExpensesByUser(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
 	ctx := context.Background()
 
 	// リポジトリを初期化
@@ -231,25 +267,25 @@ func TestExpenseUseCase_GetExpensesByUser(t *testing.T) {
 	expenseRepo := persistence.NewMemoryExpenseRepository()
 
 	// ユースケースを初期化
-	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo)
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
 
 	// テスト用のユーザーとカテゴリを作成
-	user, _ := entity.NewUser("テストユーザー", "test@example.com")
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
 	err := userRepo.Save(ctx, user)
 	require.NoError(t, err)
 
-	category, _ := entity.NewCategory("交通費", "交通費カテゴリ", "#FF0000")
+	category, _ := entity.NewCategory(fakeClock, "交通費", "交通費カテゴリ", "#FF0000")
 	err = categoryRepo.Save(ctx, category)
 	require.NoError(t, err)
 
 	// テスト用の経費を複数作成
 	amount1, _ := valueobject.NewMoney(1000, "JPY")
-	expense1, _ := entity.NewExpense(user.ID(), category.ID(), amount1, "電車代1", "営業訪問1", time.Now().AddDate(0, 0, -1))
+	expense1, _ := entity.NewExpense(fakeClock, user.ID(), category.ID(), amount1, "電車代1", "営業訪問1", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -1)))
 	err = expenseRepo.Save(ctx, expense1)
 	require.NoError(t, err)
 
 	amount2, _ := valueobject.NewMoney(2000, "JPY")
-	expense2, _ := entity.NewExpense(user.ID(), category.ID(), amount2, "電車代2", "営業訪問2", time.Now().AddDate(0, 0, -2))
+	expense2, _ := entity.NewExpense(fakeClock, user.ID(), category.ID(), amount2, "電車代2", "営業訪問2", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -2)))
 	err = expenseRepo.Save(ctx, expense2)
 	require.NoError(t, err)
 
@@ -282,3 +318,456 @@ func TestExpenseUseCase_GetExpensesByUser(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+func TestExpenseUseCase_SubmitExpense_RoutesToManager(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+
+	// ユースケースを初期化
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	// 上長が設定されたユーザーとカテゴリ、経費を作成
+	manager, _ := entity.NewUser(fakeClock, "上長", "manager@example.com")
+	require.NoError(t, userRepo.Save(ctx, manager))
+
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, user.AssignManager(manager.ID(), fakeClock.Now()))
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	category, _ := entity.NewCategory(fakeClock, "交通費", "交通費カテゴリ", "#FF0000")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	amount, _ := valueobject.NewMoney(1000, "JPY")
+	expense, _ := entity.NewExpense(fakeClock, user.ID(), category.ID(), amount, "電車代", "営業訪問", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -1)))
+	require.NoError(t, expenseRepo.Save(ctx, expense))
+
+	result, err := useCase.SubmitExpense(ctx, expense.ID().String())
+	require.NoError(t, err)
+	assert.Equal(t, "submitted", result.Status)
+	assert.Equal(t, manager.ID().String(), result.ApproverID)
+	assert.NotNil(t, result.SubmittedAt)
+}
+
+func TestExpenseUseCase_DatePolicy(t *testing.T) {
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+
+	// 現在日時を日本時間の2026年4月1日9時に固定
+	jst := time.FixedZone("JST", 9*60*60)
+	fakeClock := clock.NewFake(time.Date(2026, 4, 1, 9, 0, 0, 0, jst))
+
+	// ユースケースを初期化
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+	categoryUseCase := NewCategoryUseCase(categoryRepo, expenseRepo, fakeClock)
+
+	// テスト用のユーザーとカテゴリを作成
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	standard, _ := entity.NewCategory(fakeClock, "交通費", "", "")
+	require.NoError(t, categoryRepo.Save(ctx, standard))
+
+	// 事前に予約する出張は30日後まで、90日より前の日付は遅延申請の警告
+	travel, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{
+		Name:       "出張費",
+		DatePolicy: &dto.DatePolicyRequest{MaxAgeDays: 90, FutureDays: 30, Enforcement: "warning"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, travel.DatePolicy)
+	assert.Equal(t, 30, travel.DatePolicy.FutureDays)
+	assert.Equal(t, "warning", travel.DatePolicy.Enforcement)
+
+	create := func(categoryID string, date string) (*dto.ExpenseResponse, error) {
+		return useCase.CreateExpense(ctx, user.ID().String(), &dto.CreateExpenseRequest{
+			CategoryID: categoryID,
+			Amount:     1000,
+			Title:      "新幹線代",
+			Date:       date,
+		})
+	}
+
+	t.Run("既定の期間では未来の日付と1年より前の日付はエラー", func(t *testing.T) {
+		_, err := create(standard.ID().String(), "2026-04-02")
+		assert.Error(t, err)
+
+		_, err = create(standard.ID().String(), "2025-03-31")
+		assert.Error(t, err)
+
+		expense, err := create(standard.ID().String(), "2025-04-01")
+		require.NoError(t, err)
+		assert.Equal(t, fakeClock.Now(), expense.CreatedAt)
+	})
+
+	t.Run("カテゴリの許容日数内の未来の日付は作成できる", func(t *testing.T) {
+		expense, err := create(travel.ID, "2026-05-01")
+		require.NoError(t, err)
+		assert.Empty(t, expense.Warnings)
+
+		_, err = create(travel.ID, "2026-05-02")
+		assert.Error(t, err)
+	})
+
+	t.Run("古さの上限を超える日付は警告付きで作成・申請できる", func(t *testing.T) {
+		expense, err := create(travel.ID, "2025-12-31")
+		require.NoError(t, err)
+		require.Len(t, expense.Warnings, 1)
+		assert.Contains(t, expense.Warnings[0], "遅延申請")
+
+		submitted, err := useCase.SubmitExpense(ctx, expense.ID)
+		require.NoError(t, err)
+		assert.Len(t, submitted.Warnings, 1)
+	})
+
+	t.Run("作成後に日付が変わると申請時に警告される", func(t *testing.T) {
+		fakeClock.Set(time.Date(2026, 4, 1, 9, 0, 0, 0, jst))
+		expense, err := create(travel.ID, "2026-01-01")
+		require.NoError(t, err)
+		assert.Empty(t, expense.Warnings)
+
+		fakeClock.Advance(24 * time.Hour)
+		submitted, err := useCase.SubmitExpense(ctx, expense.ID)
+		require.NoError(t, err)
+		assert.Len(t, submitted.Warnings, 1)
+		assert.Equal(t, fakeClock.Now(), submitted.UpdatedAt)
+	})
+
+	t.Run("期間を解除すると既定の期間に戻る", func(t *testing.T) {
+		updated, err := categoryUseCase.UpdateCategory(ctx, travel.ID, &dto.UpdateCategoryRequest{Name: "出張費"})
+		require.NoError(t, err)
+		assert.Nil(t, updated.DatePolicy)
+
+		_, err = create(travel.ID, "2026-04-15")
+		assert.Error(t, err)
+	})
+}
+
+func TestExpenseUseCase_SpendingRules(t *testing.T) {
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+
+	// 現在日時を日本時間の2026年4月6日（月）9時に固定
+	jst := time.FixedZone("JST", 9*60*60)
+	fakeClock := clock.NewFake(time.Date(2026, 4, 6, 9, 0, 0, 0, jst))
+
+	// ユースケースを初期化
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+	categoryUseCase := NewCategoryUseCase(categoryRepo, expenseRepo, fakeClock)
+
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	// 平日の22時〜5時のみ、1万円を超える場合は理由を入力すれば規程外の経費として申請できる
+	taxi, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{
+		Name: "深夜タクシー",
+		Rules: []dto.SpendingRuleRequest{
+			{Type: "weekdays", Weekdays: []string{"mon", "tue", "wed", "thu", "fri"}},
+			{Type: "time_window", From: "22:00", To: "05:00"},
+			{Type: "max_amount", MaxAmount: 10000, Enforcement: "exception"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, taxi.Rules, 3)
+	assert.Equal(t, "22:00", taxi.Rules[1].From)
+	assert.Equal(t, "JPY", taxi.Rules[2].Currency)
+	assert.Equal(t, "block", taxi.Rules[0].Enforcement)
+
+	create := func(amount float64, date, timeOfDay, justification string) (*dto.ExpenseResponse, error) {
+		return useCase.CreateExpense(ctx, user.ID().String(), &dto.CreateExpenseRequest{
+			CategoryID:          taxi.ID,
+			Amount:              amount,
+			Title:               "タクシー代",
+			Date:                date,
+			Time:                timeOfDay,
+			PolicyJustification: justification,
+		})
+	}
+
+	t.Run("違反があっても下書きは作成でき、違反内容が返る", func(t *testing.T) {
+		expense, err := create(3000, "2026-04-04", "", "")
+		require.NoError(t, err)
+		require.Len(t, expense.PolicyViolations, 2)
+		assert.Equal(t, "weekdays", expense.PolicyViolations[0].Rule)
+		assert.Equal(t, "time_window", expense.PolicyViolations[1].Rule)
+
+		// 申請できない違反がある場合は申請できない
+		_, err = useCase.SubmitExpense(ctx, expense.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("不正な時刻はエラー", func(t *testing.T) {
+		_, err := create(3000, "2026-04-03", "25:00", "")
+		assert.Error(t, err)
+	})
+
+	t.Run("規程外の経費は理由の入力が必要", func(t *testing.T) {
+		expense, err := create(12000, "2026-04-03", "23:30", "")
+		require.NoError(t, err)
+		require.Len(t, expense.PolicyViolations, 1)
+		assert.Equal(t, "exception", expense.PolicyViolations[0].Enforcement)
+
+		_, err = useCase.SubmitExpense(ctx, expense.ID)
+		assert.Error(t, err)
+
+		_, err = useCase.UpdateExpense(ctx, expense.ID, &dto.UpdateExpenseRequest{
+			CategoryID:          taxi.ID,
+			Amount:              12000,
+			Title:               "タクシー代",
+			Date:                "2026-04-03",
+			Time:                "23:30",
+			PolicyJustification: "終電後まで顧客対応が続いたため",
+		})
+		require.NoError(t, err)
+
+		submitted, err := useCase.SubmitExpense(ctx, expense.ID)
+		require.NoError(t, err)
+		assert.True(t, submitted.PolicyException)
+		assert.Equal(t, "23:30", submitted.Time)
+		assert.Len(t, submitted.PolicyViolations, 1)
+	})
+
+	t.Run("規程内の経費は理由なしで申請できる", func(t *testing.T) {
+		expense, err := create(3000, "2026-04-03", "04:30", "")
+		require.NoError(t, err)
+		assert.Empty(t, expense.PolicyViolations)
+
+		submitted, err := useCase.SubmitExpense(ctx, expense.ID)
+		require.NoError(t, err)
+		assert.False(t, submitted.PolicyException)
+	})
+
+	t.Run("いずれかの条件を満たせば規程内", func(t *testing.T) {
+		// 22時〜5時または2km以上のタクシーのみ
+		shortRide, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{
+			Name: "タクシー",
+			Rules: []dto.SpendingRuleRequest{{
+				Type: "any_of",
+				Rules: []dto.SpendingRuleRequest{
+					{Type: "time_window", From: "22:00", To: "05:00"},
+					{Type: "min_distance", MinDistanceKm: 2},
+				},
+			}},
+		})
+		require.NoError(t, err)
+		require.Len(t, shortRide.Rules, 1)
+		require.Len(t, shortRide.Rules[0].Rules, 2)
+		assert.Equal(t, 2.0, shortRide.Rules[0].Rules[1].MinDistanceKm)
+
+		createRide := func(timeOfDay string, distanceKm float64) *dto.ExpenseResponse {
+			expense, err := useCase.CreateExpense(ctx, user.ID().String(), &dto.CreateExpenseRequest{
+				CategoryID: shortRide.ID,
+				Amount:     1500,
+				Title:      "タクシー代",
+				Date:       "2026-04-03",
+				Time:       timeOfDay,
+				DistanceKm: distanceKm,
+			})
+			require.NoError(t, err)
+			return expense
+		}
+
+		assert.Empty(t, createRide("23:00", 0).PolicyViolations)
+		daytime := createRide("14:00", 3.5)
+		assert.Empty(t, daytime.PolicyViolations)
+		assert.Equal(t, 3.5, daytime.DistanceKm)
+
+		violations := createRide("14:00", 1.2).PolicyViolations
+		require.Len(t, violations, 1)
+		assert.Equal(t, "any_of", violations[0].Rule)
+		assert.Contains(t, violations[0].Message, "1.2 km")
+
+		_, err = useCase.CreateExpense(ctx, user.ID().String(), &dto.CreateExpenseRequest{
+			CategoryID: shortRide.ID, Amount: 1500, Title: "タクシー代", Date: "2026-04-03", DistanceKm: 20000,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("不正なルールはエラー", func(t *testing.T) {
+		_, err := categoryUseCase.UpdateCategory(ctx, taxi.ID, &dto.UpdateCategoryRequest{
+			Name:  "深夜タクシー",
+			Rules: []dto.SpendingRuleRequest{{Type: "time_window", From: "22:00"}},
+		})
+		assert.Error(t, err)
+
+		_, err = categoryUseCase.UpdateCategory(ctx, taxi.ID, &dto.UpdateCategoryRequest{
+			Name:  "深夜タクシー",
+			Rules: []dto.SpendingRuleRequest{{Type: "any_of", Rules: []dto.SpendingRuleRequest{{Type: "min_distance", MinDistanceKm: 2}}}},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestExpenseUseCase_Attendees(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+
+	// ユースケースを初期化
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	category, _ := entity.NewCategory(fakeClock, "飲食費", "", "")
+	category.ChangeEntertainment(true, fakeClock.Now())
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	taxi, _ := entity.NewCategory(fakeClock, "タクシー代", "", "")
+	require.NoError(t, categoryRepo.Save(ctx, taxi))
+
+	date := fakeClock.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	attendees := []dto.AttendeeRequest{
+		{Name: "山田太郎", Type: "internal"},
+		{Name: "佐藤花子", Company: "株式会社サンプル", Type: "external"},
+		{Name: "鈴木一郎", Company: "株式会社サンプル", Type: "external"},
+	}
+
+	t.Run("参加者から1人当たりの金額と区分を計算", func(t *testing.T) {
+		expense, err := useCase.CreateExpense(ctx, user.ID().String(), &dto.CreateExpenseRequest{
+			CategoryID: category.ID().String(),
+			Amount:     24000,
+			Title:      "取引先との会食",
+			Date:       date,
+			Attendees:  attendees,
+		})
+		require.NoError(t, err)
+		require.Len(t, expense.Attendees, 3)
+		assert.Equal(t, "株式会社サンプル", expense.Attendees[1].Company)
+		assert.Equal(t, 3, expense.AttendeeCount)
+		assert.Equal(t, 8000.0, expense.PerHeadAmount)
+		assert.Equal(t, "meeting", expense.EntertainmentClass)
+
+		// 参加者を減らすと1人当たりの金額が上限を超えて交際費になる
+		updated, err := useCase.UpdateExpense(ctx, expense.ID, &dto.UpdateExpenseRequest{
+			CategoryID: category.ID().String(),
+			Amount:     24000,
+			Title:      "取引先との会食",
+			Date:       date,
+			Attendees:  attendees[:2],
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 12000.0, updated.PerHeadAmount)
+		assert.Equal(t, "entertainment", updated.EntertainmentClass)
+
+		// 参加者を省略すると解除される
+		updated, err = useCase.UpdateExpense(ctx, expense.ID, &dto.UpdateExpenseRequest{
+			CategoryID: category.ID().String(),
+			Amount:     24000,
+			Title:      "取引先との会食",
+			Date:       date,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, updated.Attendees)
+		assert.Empty(t, updated.EntertainmentClass)
+	})
+
+	t.Run("飲食・接待以外のカテゴリの経費は区分しない", func(t *testing.T) {
+		// 取引先と同乗したタクシー代は会議費・交際費の区分の対象外
+		expense, err := useCase.CreateExpense(ctx, user.ID().String(), &dto.CreateExpenseRequest{
+			CategoryID: taxi.ID().String(),
+			Amount:     3000,
+			Title:      "取引先と同乗したタクシー代",
+			Date:       date,
+			Attendees:  attendees,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, expense.AttendeeCount)
+		assert.Empty(t, expense.EntertainmentClass)
+	})
+
+	t.Run("会社名のない社外の参加者はエラー", func(t *testing.T) {
+		_, err := useCase.CreateExpense(ctx, user.ID().String(), &dto.CreateExpenseRequest{
+			CategoryID: category.ID().String(),
+			Amount:     5000,
+			Title:      "会食",
+			Date:       date,
+			Attendees:  []dto.AttendeeRequest{{Name: "佐藤花子", Type: "external"}},
+		})
+		assert.Error(t, err)
+	})
+}
+
+// failingUpdateExpenseRepository 経費の更新に失敗するテスト用リポジトリ
+type failingUpdateExpenseRepository struct {
+	*persistence.MemoryExpenseRepository
+	fail bool
+}
+
+func (r *failingUpdateExpenseRepository) Update(ctx context.Context, expense *entity.Expense) error {
+	if r.fail {
+		return fmt.Errorf("storage unavailable")
+	}
+	return r.MemoryExpenseRepository.Update(ctx, expense)
+}
+
+func TestExpenseUseCase_UpdateFailureKeepsStoredExpense(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化（経費の更新は失敗させる）
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := &failingUpdateExpenseRepository{MemoryExpenseRepository: persistence.NewMemoryExpenseRepository(), fail: true}
+
+	// ユースケースを初期化
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	category, _ := entity.NewCategory(fakeClock, "交通費", "交通費カテゴリ", "#FF0000")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	amount, _ := valueobject.NewMoney(1000, "JPY")
+	newExpense := func(approved bool) *entity.Expense {
+		expense, err := entity.NewExpense(fakeClock, user.ID(), category.ID(), amount, "電車代", "", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -1)))
+		require.NoError(t, err)
+		if approved {
+			require.NoError(t, expense.Submit(fakeClock.Now()))
+			require.NoError(t, expense.Approve(fakeClock.Now()))
+		}
+		require.NoError(t, expenseRepo.Save(ctx, expense))
+		return expense
+	}
+
+	t.Run("明細に分けられなかった経費は変更されない", func(t *testing.T) {
+		expense := newExpense(false)
+		_, err := useCase.SplitExpense(ctx, expense.ID().String(), &dto.SplitExpenseRequest{LineItems: []dto.LineItemRequest{
+			{CategoryID: category.ID().String(), Amount: 600},
+			{CategoryID: category.ID().String(), Amount: 400},
+		}})
+		assert.Error(t, err)
+
+		stored, err := expenseRepo.FindByID(ctx, expense.ID())
+		require.NoError(t, err)
+		assert.Empty(t, stored.LineItems())
+	})
+
+	t.Run("支払いを登録できなかった経費は承認済みのまま", func(t *testing.T) {
+		expense := newExpense(true)
+
+		_, err := useCase.PayExpense(ctx, expense.ID().String(), &dto.PayExpenseRequest{})
+		assert.Error(t, err)
+
+		stored, err := expenseRepo.FindByID(ctx, expense.ID())
+		require.NoError(t, err)
+		assert.Equal(t, entity.ExpenseStatusApproved, stored.Status())
+		assert.True(t, stored.PaidAt().IsZero())
+	})
+}
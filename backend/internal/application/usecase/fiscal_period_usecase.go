@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+)
+
+// FiscalPeriodUseCase 会計期間の締め・再開ユースケース
+type FiscalPeriodUseCase struct {
+	calendar   *valueobject.FiscalCalendar
+	periodRepo repository.AccountingPeriodRepository
+	userRepo   repository.UserRepository
+	clock      clock.Clock
+}
+
+// NewFiscalPeriodUseCase FiscalPeriodUseCaseのコンストラクタ
+func NewFiscalPeriodUseCase(
+	calendar *valueobject.FiscalCalendar,
+	periodRepo repository.AccountingPeriodRepository,
+	userRepo repository.UserRepository,
+	clk clock.Clock,
+) *FiscalPeriodUseCase {
+	return &FiscalPeriodUseCase{
+		calendar:   calendar,
+		periodRepo: periodRepo,
+		userRepo:   userRepo,
+		clock:      clk,
+	}
+}
+
+// GetFiscalYear 会計年度の会計期間と締めの状態を第1期から順に取得
+func (uc *FiscalPeriodUseCase) GetFiscalYear(ctx context.Context, req *dto.FiscalYearRequest) (*dto.FiscalYearResponse, error) {
+	fiscalYear := req.FiscalYear
+	if fiscalYear == 0 {
+		fiscalYear = uc.calendar.PeriodOf(valueobject.DateOf(uc.clock.Now())).FiscalYear()
+	}
+
+	periods := uc.calendar.PeriodsOf(fiscalYear)
+	response := &dto.FiscalYearResponse{
+		FiscalYear: fiscalYear,
+		StartMonth: int(uc.calendar.StartMonth()),
+		Periods:    make([]*dto.FiscalPeriodResponse, len(periods)),
+	}
+
+	for i, period := range periods {
+		accountingPeriod, err := findAccountingPeriod(ctx, uc.periodRepo, period, uc.clock)
+		if err != nil {
+			return nil, err
+		}
+		response.Periods[i] = buildFiscalPeriodResponse(accountingPeriod)
+	}
+
+	return response, nil
+}
+
+// ClosePeriod 会計期間を締める（経理担当者・管理者のみ）
+func (uc *FiscalPeriodUseCase) ClosePeriod(ctx context.Context, periodKey string, req *dto.FiscalPeriodActionRequest) (*dto.FiscalPeriodResponse, error) {
+	accountingPeriod, user, err := uc.prepareAction(ctx, periodKey, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.CanCloseFiscalPeriod() {
+		return nil, errors.NewApplicationError(errors.PermissionDenied, "会計期間を締められるのは経理担当者または管理者のみです")
+	}
+
+	if err := accountingPeriod.Close(user, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.periodRepo.Save(ctx, accountingPeriod); err != nil {
+		return nil, errors.NewApplicationError(errors.FiscalPeriodUpdateFailed, "会計期間の締めに失敗しました")
+	}
+
+	return buildFiscalPeriodResponse(accountingPeriod), nil
+}
+
+// ReopenPeriod 締め済みの会計期間を再開する（管理者のみ）
+func (uc *FiscalPeriodUseCase) ReopenPeriod(ctx context.Context, periodKey string, req *dto.FiscalPeriodActionRequest) (*dto.FiscalPeriodResponse, error) {
+	accountingPeriod, user, err := uc.prepareAction(ctx, periodKey, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsAdmin() {
+		return nil, errors.NewApplicationError(errors.PermissionDenied, "締め済みの会計期間を再開できるのは管理者のみです")
+	}
+
+	if err := accountingPeriod.Reopen(user, req.Reason, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.periodRepo.Save(ctx, accountingPeriod); err != nil {
+		return nil, errors.NewApplicationError(errors.FiscalPeriodUpdateFailed, "会計期間の再開に失敗しました")
+	}
+
+	return buildFiscalPeriodResponse(accountingPeriod), nil
+}
+
+// prepareAction 締め・再開の対象の会計期間と操作するユーザーを取得
+func (uc *FiscalPeriodUseCase) prepareAction(ctx context.Context, periodKey string, req *dto.FiscalPeriodActionRequest) (*entity.AccountingPeriod, *entity.User, error) {
+	period, err := uc.calendar.ParsePeriod(periodKey)
+	if err != nil {
+		return nil, nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	uid, err := valueobject.NewUserID(req.UserID)
+	if err != nil {
+		return nil, nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, uid)
+	if err != nil {
+		return nil, nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	accountingPeriod, err := findAccountingPeriod(ctx, uc.periodRepo, period, uc.clock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accountingPeriod, user, nil
+}
+
+// findAccountingPeriod 会計期間の締めの状態を取得（一度も締めていない会計期間は未締め）
+func findAccountingPeriod(ctx context.Context, periodRepo repository.AccountingPeriodRepository, period valueobject.FiscalPeriod, clk clock.Clock) (*entity.AccountingPeriod, error) {
+	accountingPeriod, err := periodRepo.FindByPeriod(ctx, period)
+	if err == nil {
+		return accountingPeriod, nil
+	}
+
+	if domainErr, ok := err.(*errors.DomainError); ok && domainErr.Code == errors.AccountingPeriodNotFound {
+		return entity.NewAccountingPeriod(clk, period), nil
+	}
+
+	return nil, errors.NewApplicationError("FISCAL_PERIOD_FETCH_FAILED", "会計期間の取得に失敗しました")
+}
+
+// fiscalPeriodGuard 締め済みの会計期間に属する経費の変更を防ぐ
+// nilの場合は会計期間を確認しない
+type fiscalPeriodGuard struct {
+	calendar   *valueobject.FiscalCalendar
+	periodRepo repository.AccountingPeriodRepository
+	clock      clock.Clock
+}
+
+// ensureOpen 経費日付（暦日）の属する会計期間が締め済みの場合はエラー
+func (g *fiscalPeriodGuard) ensureOpen(ctx context.Context, date valueobject.Date) error {
+	if g == nil {
+		return nil
+	}
+
+	period := g.calendar.PeriodOf(date)
+	accountingPeriod, err := findAccountingPeriod(ctx, g.periodRepo, period, g.clock)
+	if err != nil {
+		return err
+	}
+
+	if accountingPeriod.IsClosed() {
+		return errors.NewApplicationError(errors.FiscalPeriodClosed, "会計期間 "+period.Key()+" は締め済みのため経費を変更できません")
+	}
+
+	return nil
+}
+
+// isFiscalPeriodClosed 締め済みの会計期間によるエラーかどうか（会計期間の取得の失敗などは含まない）
+func isFiscalPeriodClosed(err error) bool {
+	appErr, ok := err.(*errors.ApplicationError)
+	return ok && appErr.Code == errors.FiscalPeriodClosed
+}
+
+// buildFiscalPeriodResponse 会計期間レスポンスを構築
+func buildFiscalPeriodResponse(accountingPeriod *entity.AccountingPeriod) *dto.FiscalPeriodResponse {
+	period := accountingPeriod.Period()
+	response := &dto.FiscalPeriodResponse{
+		Period:       period.Key(),
+		FiscalYear:   period.FiscalYear(),
+		Number:       period.Number(),
+		StartDate:    period.Start(),
+		EndDate:      period.End(),
+		Status:       string(accountingPeriod.Status()),
+		ReopenReason: accountingPeriod.ReopenReason(),
+	}
+
+	if accountingPeriod.ClosedBy() != nil {
+		closedAt := accountingPeriod.ClosedAt()
+		response.ClosedBy = accountingPeriod.ClosedBy().String()
+		response.ClosedAt = &closedAt
+	}
+
+	if accountingPeriod.ReopenedBy() != nil {
+		reopenedAt := accountingPeriod.ReopenedAt()
+		response.ReopenedBy = accountingPeriod.ReopenedBy().String()
+		response.ReopenedAt = &reopenedAt
+	}
+
+	return response
+}
@@ -0,0 +1,285 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/internal/infrastructure/persistence"
+	"expense-management-system/pkg/errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFiscalPeriodUseCase(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	expenseReportRepo := persistence.NewMemoryExpenseReportRepository()
+	periodRepo := persistence.NewMemoryAccountingPeriodRepository()
+
+	// ユースケースを初期化（4月開始の会計年度）
+	calendar, err := valueobject.NewFiscalCalendar(4)
+	require.NoError(t, err)
+	useCase := NewFiscalPeriodUseCase(calendar, periodRepo, userRepo, fakeClock)
+	expenseUseCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock, WithFiscalPeriods(calendar, periodRepo))
+	reportUseCase := NewExpenseReportUseCase(expenseReportRepo, expenseRepo, userRepo, categoryRepo, fakeClock, WithReportFiscalPeriods(calendar, periodRepo))
+
+	// テスト用のユーザーとカテゴリを作成
+	newUser := func(name, email string, role entity.UserRole) *entity.User {
+		user, _ := entity.NewUser(fakeClock, name, email)
+		require.NoError(t, user.ChangeRole(role, fakeClock.Now()))
+		require.NoError(t, userRepo.Save(ctx, user))
+		return user
+	}
+	member := newUser("山田太郎", "yamada@example.com", entity.UserRoleMember)
+	finance := newUser("経理花子", "keiri@example.com", entity.UserRoleFinance)
+	admin := newUser("管理次郎", "admin@example.com", entity.UserRoleAdmin)
+
+	category, _ := entity.NewCategory(fakeClock, "交通費", "", "")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	// 前月の経費（下書き・申請済み）を作成
+	now := fakeClock.Now()
+	lastMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local).AddDate(0, -1, 0)
+	periodKey := lastMonth.Format("2006-01")
+
+	draft, err := expenseUseCase.CreateExpense(ctx, member.ID().String(), &dto.CreateExpenseRequest{
+		CategoryID: category.ID().String(), Amount: 500, Title: "バス代", Date: lastMonth.Format("2006-01-02"),
+	})
+	require.NoError(t, err)
+
+	unreported, err := expenseUseCase.CreateExpense(ctx, member.ID().String(), &dto.CreateExpenseRequest{
+		CategoryID: category.ID().String(), Amount: 200, Title: "駐輪場代", Date: lastMonth.Format("2006-01-02"),
+	})
+	require.NoError(t, err)
+
+	submitted, err := expenseUseCase.CreateExpense(ctx, member.ID().String(), &dto.CreateExpenseRequest{
+		CategoryID: category.ID().String(), Amount: 1000, Title: "電車代", Date: lastMonth.AddDate(0, 0, 1).Format("2006-01-02"),
+	})
+	require.NoError(t, err)
+	_, err = expenseUseCase.SubmitExpense(ctx, submitted.ID)
+	require.NoError(t, err)
+
+	report, err := reportUseCase.CreateExpenseReport(ctx, member.ID().String(), &dto.CreateExpenseReportRequest{
+		Title: "前月分", PeriodStart: lastMonth.Format("2006-01-02"), PeriodEnd: lastMonth.AddDate(0, 1, -1).Format("2006-01-02"), ExpenseIDs: []string{draft.ID},
+	})
+	require.NoError(t, err)
+	_, err = reportUseCase.SubmitExpenseReport(ctx, report.ID)
+	require.NoError(t, err)
+
+	draftReport, err := reportUseCase.CreateExpenseReport(ctx, member.ID().String(), &dto.CreateExpenseReportRequest{
+		Title: "前月分（未申請）", PeriodStart: lastMonth.Format("2006-01-02"), PeriodEnd: lastMonth.AddDate(0, 1, -1).Format("2006-01-02"), ExpenseIDs: []string{unreported.ID},
+	})
+	require.NoError(t, err)
+
+	assertPeriodClosed := func(t *testing.T, err error) {
+		require.Error(t, err)
+		appErr, ok := err.(*errors.ApplicationError)
+		require.True(t, ok)
+		assert.Equal(t, errors.FiscalPeriodClosed, appErr.Code)
+	}
+
+	t.Run("一般の従業員は会計期間を締められない", func(t *testing.T) {
+		_, err := useCase.ClosePeriod(ctx, periodKey, &dto.FiscalPeriodActionRequest{UserID: member.ID().String()})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errors.PermissionDenied)
+	})
+
+	t.Run("経理担当者が会計期間を締める", func(t *testing.T) {
+		period, err := useCase.ClosePeriod(ctx, periodKey, &dto.FiscalPeriodActionRequest{UserID: finance.ID().String()})
+		require.NoError(t, err)
+		assert.Equal(t, "closed", period.Status)
+		assert.Equal(t, finance.ID().String(), period.ClosedBy)
+
+		// 締め済みの会計期間を再度締めることはできない
+		_, err = useCase.ClosePeriod(ctx, periodKey, &dto.FiscalPeriodActionRequest{UserID: finance.ID().String()})
+		assert.Error(t, err)
+	})
+
+	t.Run("締め済みの会計期間の経費は作成・更新・承認・削除できない", func(t *testing.T) {
+		_, err := expenseUseCase.CreateExpense(ctx, member.ID().String(), &dto.CreateExpenseRequest{
+			CategoryID: category.ID().String(), Amount: 300, Title: "駐輪場代", Date: lastMonth.Format("2006-01-02"),
+		})
+		assertPeriodClosed(t, err)
+
+		_, err = expenseUseCase.UpdateExpense(ctx, draft.ID, &dto.UpdateExpenseRequest{
+			CategoryID: category.ID().String(), Amount: 600, Title: "バス代", Date: now.Format("2006-01-02"),
+		})
+		assertPeriodClosed(t, err)
+
+		_, err = expenseUseCase.ApproveExpense(ctx, submitted.ID)
+		assertPeriodClosed(t, err)
+
+		result, err := expenseUseCase.BulkApproveExpenses(ctx, &dto.BulkExpenseActionRequest{ExpenseIDs: []string{submitted.ID}})
+		require.NoError(t, err)
+		assert.Equal(t, errors.FiscalPeriodClosed, result.Results[0].Error.Code)
+
+		_, err = reportUseCase.ApproveExpenseReport(ctx, report.ID)
+		assertPeriodClosed(t, err)
+
+		// 経費レポートの申請・却下も同じ
+		_, err = reportUseCase.RejectExpenseReport(ctx, report.ID)
+		assertPeriodClosed(t, err)
+		_, err = reportUseCase.SubmitExpenseReport(ctx, draftReport.ID)
+		assertPeriodClosed(t, err)
+
+		assertPeriodClosed(t, expenseUseCase.DeleteExpense(ctx, unreported.ID))
+
+		// 今月の経費を締め済みの会計期間の日付に変更することもできない
+		current, err := expenseUseCase.CreateExpense(ctx, member.ID().String(), &dto.CreateExpenseRequest{
+			CategoryID: category.ID().String(), Amount: 300, Title: "駐輪場代", Date: now.Format("2006-01-02"),
+		})
+		require.NoError(t, err)
+		_, err = expenseUseCase.UpdateExpense(ctx, current.ID, &dto.UpdateExpenseRequest{
+			CategoryID: category.ID().String(), Amount: 300, Title: "駐輪場代", Date: lastMonth.Format("2006-01-02"),
+		})
+		assertPeriodClosed(t, err)
+	})
+
+	t.Run("会計期間の再開は管理者のみ", func(t *testing.T) {
+		_, err := useCase.ReopenPeriod(ctx, periodKey, &dto.FiscalPeriodActionRequest{UserID: finance.ID().String()})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errors.PermissionDenied)
+
+		period, err := useCase.ReopenPeriod(ctx, periodKey, &dto.FiscalPeriodActionRequest{UserID: admin.ID().String(), Reason: "計上漏れの修正"})
+		require.NoError(t, err)
+		assert.Equal(t, "open", period.Status)
+		assert.Equal(t, "計上漏れの修正", period.ReopenReason)
+
+		// 再開後は承認できる
+		_, err = expenseUseCase.ApproveExpense(ctx, submitted.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("会計年度の会計期間一覧", func(t *testing.T) {
+		_, err := useCase.ClosePeriod(ctx, periodKey, &dto.FiscalPeriodActionRequest{UserID: admin.ID().String()})
+		require.NoError(t, err)
+
+		fiscalYear := calendar.PeriodOf(valueobject.DateOf(lastMonth)).FiscalYear()
+		response, err := useCase.GetFiscalYear(ctx, &dto.FiscalYearRequest{FiscalYear: fiscalYear})
+		require.NoError(t, err)
+		require.Len(t, response.Periods, 12)
+		assert.Equal(t, 4, response.StartMonth)
+
+		for _, period := range response.Periods {
+			if period.Period == periodKey {
+				assert.Equal(t, "closed", period.Status)
+				assert.NotNil(t, period.ReopenedAt)
+			} else {
+				assert.Equal(t, "open", period.Status)
+			}
+		}
+	})
+
+	t.Run("開始前の会計期間や不正な形式は締められない", func(t *testing.T) {
+		_, err := useCase.ClosePeriod(ctx, now.AddDate(0, 2, 0).Format("2006-01"), &dto.FiscalPeriodActionRequest{UserID: admin.ID().String()})
+		assert.Error(t, err)
+
+		_, err = useCase.ClosePeriod(ctx, "2024/04", &dto.FiscalPeriodActionRequest{UserID: admin.ID().String()})
+		assert.Error(t, err)
+	})
+}
+
+func TestFiscalPeriodUseCase_GeneratedExpenses(t *testing.T) {
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	periodRepo := persistence.NewMemoryAccountingPeriodRepository()
+
+	// 2026年4月を締めた状態で、締め済みの会計期間の日付の経費を取り込む
+	fakeClock := clock.NewFake(time.Date(2026, 5, 15, 9, 0, 0, 0, time.UTC))
+	calendar, err := valueobject.NewFiscalCalendar(4)
+	require.NoError(t, err)
+	useCase := NewFiscalPeriodUseCase(calendar, periodRepo, userRepo, fakeClock)
+
+	user, _ := entity.NewUser(fakeClock, "山田太郎", "yamada@example.com")
+	require.NoError(t, user.ChangeRole(entity.UserRoleFinance, fakeClock.Now()))
+	require.NoError(t, user.ChangeGrade("manager", fakeClock.Now()))
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	category, _ := entity.NewCategory(fakeClock, "交通費", "", "")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	_, err = useCase.ClosePeriod(ctx, "2026-04", &dto.FiscalPeriodActionRequest{UserID: user.ID().String()})
+	require.NoError(t, err)
+
+	t.Run("一括取込は締め済みの会計期間の行を検証エラーにする", func(t *testing.T) {
+		importUseCase := NewExpenseImportUseCase(expenseRepo, userRepo, categoryRepo, fakeClock, WithImportFiscalPeriods(calendar, periodRepo))
+
+		result, err := importUseCase.ImportExpenses(ctx, &dto.ImportExpensesRequest{
+			Content: "カテゴリ,金額,件名,日付\n交通費,300,バス代,2026/04/10\n交通費,500,電車代,2026/05/10\n",
+			UserID:  user.ID().String(),
+			Mapping: &dto.ExpenseImportMappingRequest{Category: "カテゴリ", Amount: "金額", Title: "件名", Date: "日付"},
+			Commit:  true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.InvalidRows)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, "日付", result.Errors[0].Column)
+		assert.Contains(t, result.Errors[0].Message, "2026-04")
+	})
+
+	t.Run("ICカードの利用履歴は締め済みの会計期間の行を取り込まない", func(t *testing.T) {
+		rideRepo := persistence.NewMemoryTransitRideRepository()
+		transitUseCase := NewTransitUseCase(rideRepo, expenseRepo, userRepo, categoryRepo, fakeClock, WithTransitFiscalPeriods(calendar, periodRepo))
+
+		result, err := transitUseCase.ImportICCardHistory(ctx, user.ID().String(), &dto.ImportTransitHistoryRequest{
+			Content: "利用日,種別,入場駅,出場駅,残額,差額\n2026/04/30,運賃,新宿,品川,4820,-180\n",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.ErrorCount)
+		assert.Contains(t, result.Lines[0].Reason, errors.FiscalPeriodClosed)
+	})
+
+	t.Run("カード利用明細から締め済みの会計期間の経費を作成しない", func(t *testing.T) {
+		cardRepo := persistence.NewMemoryCardTransactionRepository()
+		cardUseCase := NewCardTransactionUseCase(cardRepo, expenseRepo, userRepo, categoryRepo, fakeClock, WithCardFiscalPeriods(calendar, periodRepo))
+
+		_, err := cardUseCase.ImportStatement(ctx, user.ID().String(), &dto.ImportCardStatementRequest{
+			Format:  "csv",
+			Content: fmt.Sprintf("利用日,利用店名,利用金額\n%s,東京タクシー,4400\n", "2026/04/28"),
+			Mapping: &dto.CardStatementMappingRequest{Date: "利用日", Vendor: "利用店名", Amount: "利用金額", DateFormat: "YYYY/MM/DD"},
+		})
+		require.NoError(t, err)
+
+		result, err := cardUseCase.ReconcileTransactions(ctx, user.ID().String(), &dto.ReconcileCardTransactionsRequest{CategoryID: category.ID().String()})
+		require.NoError(t, err)
+		assert.Empty(t, result.Created)
+		require.Len(t, result.Unmatched, 1)
+		assert.Contains(t, result.Unmatched[0].Error, errors.FiscalPeriodClosed)
+	})
+
+	t.Run("締め済みの会計期間の日を含む日当は作成しない", func(t *testing.T) {
+		rateRepo := persistence.NewMemoryPerDiemRateRepository()
+		perDiemUseCase := NewPerDiemUseCase(rateRepo, expenseRepo, userRepo, categoryRepo, persistence.NewMemoryTripRequestRepository(), fakeClock, WithPerDiemFiscalPeriods(calendar, periodRepo))
+		_, err := perDiemUseCase.SavePerDiemRate(ctx, &dto.PerDiemRateRequest{DestinationClass: "domestic", Grade: "manager", DailyAmount: 3000, Currency: "JPY"})
+		require.NoError(t, err)
+
+		_, err = perDiemUseCase.GeneratePerDiemExpenses(ctx, user.ID().String(), &dto.GeneratePerDiemRequest{
+			DestinationClass: "domestic",
+			CategoryID:       category.ID().String(),
+			DepartureAt:      time.Date(2026, 4, 30, 9, 0, 0, 0, time.UTC),
+			ReturnAt:         time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errors.FiscalPeriodClosed)
+	})
+
+	// いずれの経路でも経費は作成されない（一括取込は1行でも検証エラーがあれば全ての行を作成しない）
+	expenses, err := expenseRepo.FindByUserID(ctx, user.ID())
+	require.NoError(t, err)
+	assert.Empty(t, expenses)
+}
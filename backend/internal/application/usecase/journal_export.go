@@ -0,0 +1,341 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// defaultCreditAccount 貸方勘定科目の既定値（従業員が立て替えた経費の未払い）
+const defaultCreditAccount = "未払金"
+
+// journalLine 仕訳の1行（コストセンターに按分した経費は負担先ごとに1行とし、伝票番号は経費ごとに同じ）
+type journalLine struct {
+	number          int
+	date            time.Time
+	debitAccount    string
+	debitDepartment string
+	taxCode         valueobject.TaxCode
+	amount          float64 // 税込金額
+	tax             float64
+	creditAccount   string
+	summary         string
+}
+
+// journalFormat 会計ソフトごとの仕訳インポートCSVの形式
+type journalFormat struct {
+	header    []string // nilの場合は見出しの行を出力しない
+	shiftJIS  bool
+	taxLabels map[valueobject.TaxCode]string
+	row       func(line *journalLine, debitTax, creditTax string) []string
+}
+
+// journalFormats 会計ソフトごとの仕訳インポートCSVの形式
+var journalFormats = map[string]*journalFormat{
+	// freee会計の仕訳帳インポート
+	"freee": {
+		header: []string{
+			"日付", "伝票番号", "決算整理仕訳",
+			"借方勘定科目", "借方科目コード", "借方補助科目", "借方取引先", "借方取引先コード", "借方部門", "借方品目", "借方メモタグ",
+			"借方セグメント1", "借方セグメント2", "借方セグメント3", "借方金額", "借方税区分", "借方税額",
+			"貸方勘定科目", "貸方科目コード", "貸方補助科目", "貸方取引先", "貸方取引先コード", "貸方部門", "貸方品目", "貸方メモタグ",
+			"貸方セグメント1", "貸方セグメント2", "貸方セグメント3", "貸方金額", "貸方税区分", "貸方税額",
+			"摘要",
+		},
+		taxLabels: map[valueobject.TaxCode]string{
+			valueobject.TaxCodeStandard:   "課対仕入10%",
+			valueobject.TaxCodeReduced:    "課対仕入8%（軽）",
+			valueobject.TaxCodeNonTaxable: "非課仕入",
+			valueobject.TaxCodeOutOfScope: "対象外",
+		},
+		row: func(line *journalLine, debitTax, creditTax string) []string {
+			return []string{
+				line.date.Format("2006/01/02"), strconv.Itoa(line.number), "",
+				line.debitAccount, "", "", "", "", line.debitDepartment, "", "",
+				"", "", "", formatJournalAmount(line.amount), debitTax, formatJournalAmount(line.tax),
+				line.creditAccount, "", "", "", "", "", "", "",
+				"", "", "", formatJournalAmount(line.amount), creditTax, "0",
+				line.summary,
+			}
+		},
+	},
+	// マネーフォワード クラウド会計の仕訳帳インポート
+	"moneyforward": {
+		header: []string{
+			"取引No", "取引日",
+			"借方勘定科目", "借方補助科目", "借方部門", "借方取引先", "借方税区分", "借方インボイス", "借方金額(円)", "借方税額",
+			"貸方勘定科目", "貸方補助科目", "貸方部門", "貸方取引先", "貸方税区分", "貸方インボイス", "貸方金額(円)", "貸方税額",
+			"摘要", "仕訳メモ", "タグ", "MF仕訳タイプ", "決算整理仕訳",
+		},
+		shiftJIS: true,
+		taxLabels: map[valueobject.TaxCode]string{
+			valueobject.TaxCodeStandard:   "課税仕入 10%",
+			valueobject.TaxCodeReduced:    "課税仕入 (軽)8%",
+			valueobject.TaxCodeNonTaxable: "非課税仕入",
+			valueobject.TaxCodeOutOfScope: "対象外",
+		},
+		row: func(line *journalLine, debitTax, creditTax string) []string {
+			return []string{
+				strconv.Itoa(line.number), line.date.Format("2006/01/02"),
+				line.debitAccount, "", line.debitDepartment, "", debitTax, "", formatJournalAmount(line.amount), formatJournalAmount(line.tax),
+				line.creditAccount, "", "", "", creditTax, "", formatJournalAmount(line.amount), "0",
+				line.summary, "", "", "", "",
+			}
+		},
+	},
+	// 弥生会計の仕訳日記帳インポート（見出しなし・25列固定）
+	"yayoi": {
+		shiftJIS: true,
+		taxLabels: map[valueobject.TaxCode]string{
+			valueobject.TaxCodeStandard:   "課対仕入内10%",
+			valueobject.TaxCodeReduced:    "課対仕入内軽減8%",
+			valueobject.TaxCodeNonTaxable: "非課仕入",
+			valueobject.TaxCodeOutOfScope: "対象外",
+		},
+		row: func(line *journalLine, debitTax, creditTax string) []string {
+			return []string{
+				"2000", strconv.Itoa(line.number), "", line.date.Format("2006/01/02"),
+				line.debitAccount, "", line.debitDepartment, debitTax, formatJournalAmount(line.amount), formatJournalAmount(line.tax),
+				line.creditAccount, "", "", creditTax, formatJournalAmount(line.amount), "0",
+				line.summary, "", "", "0", "", "", "0", "0", "no",
+			}
+		},
+	},
+}
+
+// JournalExport 会計ソフトの仕訳インポート形式で出力する仕訳
+type JournalExport struct {
+	software string
+	format   *journalFormat
+	dateFrom valueobject.Date
+	dateTo   valueobject.Date
+	lines    []*journalLine
+}
+
+// ExportJournal 期間内の承認済みの経費から仕訳を作成
+// 仕訳の対応が未設定のカテゴリ、または日本円以外の経費がある場合は出力しない
+func (uc *ExpenseUseCase) ExportJournal(ctx context.Context, req *dto.ExportJournalRequest) (*JournalExport, error) {
+	format, ok := journalFormats[req.Software]
+	if !ok {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, "対応していない会計ソフトです: "+req.Software)
+	}
+
+	dateFrom, dateTo, err := parseDatePeriod(req.DateFrom, req.DateTo)
+	if err != nil {
+		return nil, err
+	}
+	if dateFrom.IsZero() || dateTo.IsZero() {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, "date_from と date_to を指定してください")
+	}
+
+	creditAccount := strings.TrimSpace(req.CreditAccount)
+	if creditAccount == "" {
+		creditAccount = defaultCreditAccount
+	}
+
+	filter := repository.ExpenseFilter{
+		Status:   entity.ExpenseStatusApproved,
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+	}
+
+	userNames := make(map[string]string)
+	categories := make(map[string]*entity.Category)
+	charges := newCostCenterCharges(uc.userRepo, uc.costCenterRepo)
+	unmapped := make(map[string]bool)
+	foreignCount := 0
+	number := 0
+	lines := make([]*journalLine, 0)
+
+	err = uc.expenseRepo.Iterate(ctx, filter, func(expense *entity.Expense) error {
+		// 明細に分けた経費は明細ごとのカテゴリの仕訳の対応を使う
+		expenseLines := expense.Lines()
+		mappings := make([]*valueobject.AccountMapping, len(expenseLines))
+		for i, expenseLine := range expenseLines {
+			category := uc.exportCategory(ctx, expenseLine.CategoryID(), categories)
+			if category == nil || category.AccountMapping() == nil {
+				name := expenseLine.CategoryID().String()
+				if category != nil {
+					name = category.Name()
+				}
+				unmapped[name] = true
+				continue
+			}
+			mappings[i] = category.AccountMapping()
+		}
+		for _, mapping := range mappings {
+			if mapping == nil {
+				return nil
+			}
+		}
+
+		if expense.Amount().Currency() != "JPY" {
+			foreignCount++
+			return nil
+		}
+
+		// 飲食費は会議費・交際費の区分と人数を摘要に残す
+		summary := expense.Title() + "（" + uc.exportUserName(ctx, expense.UserID(), userNames) + "）"
+		if class := expense.EntertainmentClass(uc.entertainmentCategory(ctx, categories)); class != entity.EntertainmentClassNone {
+			summary += " " + class.Label() + " " + strconv.Itoa(len(expense.Attendees())) + "名"
+		}
+
+		// 明細の行は同じ伝票番号にまとめる
+		number++
+		allocations := charges.Allocations(ctx, expense)
+		for i, expenseLine := range expenseLines {
+			mapping := mappings[i]
+			taxCode := mapping.TaxCode()
+			if expenseLine.TaxCode() != "" {
+				taxCode = expenseLine.TaxCode()
+			}
+
+			// 負担先のコストセンターがない経費はカテゴリの部門で1行にする
+			if len(allocations) == 0 {
+				line, err := newJournalLine(number, expense, expenseLine.Amount(), mapping, taxCode, mapping.Department(), creditAccount, summary)
+				if err != nil {
+					return err
+				}
+				lines = append(lines, line)
+				continue
+			}
+
+			// 明細の金額を按分した金額の比率で負担先に割り当てる
+			amounts, err := entity.ProrateAllocations(expenseLine.Amount(), expense.Amount(), allocations)
+			if err != nil {
+				return err
+			}
+			for j, allocation := range allocations {
+				if amounts[j].Amount() == 0 {
+					continue
+				}
+
+				department := mapping.Department()
+				if costCenter := charges.CostCenter(ctx, allocation.CostCenterID()); costCenter != nil {
+					department = costCenter.Code()
+				}
+
+				line, err := newJournalLine(number, expense, amounts[j], mapping, taxCode, department, creditAccount, summary)
+				if err != nil {
+					return err
+				}
+				lines = append(lines, line)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.NewApplicationError("EXPENSE_FETCH_FAILED", "経費一覧の取得に失敗しました")
+	}
+
+	if len(unmapped) > 0 {
+		names := make([]string, 0, len(unmapped))
+		for name := range unmapped {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, errors.NewApplicationError(errors.JournalExportNotAllowed, "仕訳の対応が設定されていないカテゴリがあります: "+strings.Join(names, ", "))
+	}
+
+	if foreignCount > 0 {
+		return nil, errors.NewApplicationError(errors.JournalExportNotAllowed, "日本円以外の経費は仕訳に出力できません: "+strconv.Itoa(foreignCount)+"件")
+	}
+
+	return &JournalExport{
+		software: req.Software,
+		format:   format,
+		dateFrom: dateFrom,
+		dateTo:   dateTo,
+		lines:    lines,
+	}, nil
+}
+
+// newJournalLine 経費の明細（按分した場合は負担先の金額）から仕訳の1行を作成
+func newJournalLine(number int, expense *entity.Expense, amount *valueobject.Money, mapping *valueobject.AccountMapping, taxCode valueobject.TaxCode, department, creditAccount, summary string) (*journalLine, error) {
+	breakdown, err := valueobject.NewTaxBreakdown(amount, taxCode.Rate())
+	if err != nil {
+		return nil, err
+	}
+
+	return &journalLine{
+		number:          number,
+		date:            expense.Date().Time(),
+		debitAccount:    mapping.DebitAccount(),
+		debitDepartment: department,
+		taxCode:         taxCode,
+		amount:          breakdown.Gross().Amount(),
+		tax:             breakdown.Tax().Amount(),
+		creditAccount:   creditAccount,
+		summary:         summary,
+	}, nil
+}
+
+// Count 仕訳の件数
+func (e *JournalExport) Count() int {
+	return len(e.lines)
+}
+
+// ContentType 出力するファイルのContent-Type
+func (e *JournalExport) ContentType() string {
+	if e.format.shiftJIS {
+		return "text/csv; charset=Shift_JIS"
+	}
+	return "text/csv; charset=UTF-8"
+}
+
+// FileName 出力するファイル名
+func (e *JournalExport) FileName() string {
+	return "journal_" + e.software + "_" + e.dateFrom.Time().Format("20060102") + "_" + e.dateTo.Time().Format("20060102") + ".csv"
+}
+
+// Write 仕訳を会計ソフトの仕訳インポート形式で書き出す
+func (e *JournalExport) Write(w io.Writer) error {
+	var closer io.Closer
+	if e.format.shiftJIS {
+		encoded := transform.NewWriter(w, encoding.ReplaceUnsupported(japanese.ShiftJIS.NewEncoder()))
+		w, closer = encoded, encoded
+	}
+
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = true
+
+	if e.format.header != nil {
+		if err := writer.Write(e.format.header); err != nil {
+			return err
+		}
+	}
+
+	creditTax := e.format.taxLabels[valueobject.TaxCodeOutOfScope]
+	for _, line := range e.lines {
+		if err := writer.Write(e.format.row(line, e.format.taxLabels[line.taxCode], creditTax)); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+	if closer != nil {
+		return closer.Close()
+	}
+	return nil
+}
+
+// formatJournalAmount 仕訳の金額を文字列に変換
+func formatJournalAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/internal/infrastructure/persistence"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestExpenseUseCase_ExportJournal(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+
+	// ユースケースを初期化
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+	categoryUseCase := NewCategoryUseCase(categoryRepo, expenseRepo, fakeClock)
+
+	// テスト用のユーザーとカテゴリを作成
+	user, _ := entity.NewUser(fakeClock, "山田太郎", "yamada@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	transport, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{
+		Name:       "交通費",
+		Accounting: &dto.AccountMappingRequest{DebitAccount: "旅費交通費", Department: "営業部"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "standard", transport.Accounting.TaxCode)
+
+	meal, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{
+		Name:       "会議費",
+		Accounting: &dto.AccountMappingRequest{DebitAccount: "会議費", TaxCode: "reduced"},
+	})
+	require.NoError(t, err)
+
+	today := fakeClock.Now().Truncate(24 * time.Hour)
+	createApproved := func(categoryID string, amount float64, title string, date time.Time) *entity.Expense {
+		cid, _ := valueobject.NewCategoryID(categoryID)
+		money, _ := valueobject.NewMoney(amount, "JPY")
+		expense, err := entity.NewExpense(fakeClock, user.ID(), cid, money, title, "", valueobject.DateOf(date))
+		require.NoError(t, err)
+		require.NoError(t, expense.Submit(fakeClock.Now()))
+		require.NoError(t, expense.Approve(fakeClock.Now()))
+		require.NoError(t, expenseRepo.Save(ctx, expense))
+		return expense
+	}
+
+	createApproved(transport.ID, 1100, "電車代", today.AddDate(0, 0, -2))
+	createApproved(meal.ID, 1080, "打合せの弁当", today.AddDate(0, 0, -1))
+
+	// 承認されていない経費は対象外
+	cid, _ := valueobject.NewCategoryID(transport.ID)
+	money, _ := valueobject.NewMoney(500, "JPY")
+	draft, _ := entity.NewExpense(fakeClock, user.ID(), cid, money, "バス代", "", valueobject.DateOf(today))
+	require.NoError(t, expenseRepo.Save(ctx, draft))
+
+	export := func(software string) []byte {
+		exporter, err := useCase.ExportJournal(ctx, &dto.ExportJournalRequest{
+			Software: software,
+			DateFrom: today.AddDate(0, 0, -7).Format("2006-01-02"),
+			DateTo:   today.Format("2006-01-02"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, exporter.Count())
+
+		var buf bytes.Buffer
+		require.NoError(t, exporter.Write(&buf))
+		return buf.Bytes()
+	}
+
+	t.Run("freeeの仕訳帳インポート形式", func(t *testing.T) {
+		records, err := csv.NewReader(bytes.NewReader(export("freee"))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+
+		assert.Equal(t, "日付", records[0][0])
+		assert.Equal(t, today.AddDate(0, 0, -2).Format("2006/01/02"), records[1][0])
+		assert.Equal(t, "旅費交通費", records[1][3])
+		assert.Equal(t, "営業部", records[1][8])
+		assert.Equal(t, "1100", records[1][14])
+		assert.Equal(t, "課対仕入10%", records[1][15])
+		assert.Equal(t, "100", records[1][16])
+		assert.Equal(t, "未払金", records[1][17])
+		assert.Equal(t, "電車代（山田太郎）", records[1][31])
+
+		assert.Equal(t, "課対仕入8%（軽）", records[2][15])
+		assert.Equal(t, "80", records[2][16])
+	})
+
+	t.Run("マネーフォワードと弥生会計はShift_JIS", func(t *testing.T) {
+		decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(export("moneyforward"))
+		require.NoError(t, err)
+		records, err := csv.NewReader(bytes.NewReader(decoded)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, "課税仕入 10%", records[1][6])
+		assert.Equal(t, "1100", records[1][8])
+
+		decoded, err = japanese.ShiftJIS.NewDecoder().Bytes(export("yayoi"))
+		require.NoError(t, err)
+		records, err = csv.NewReader(bytes.NewReader(decoded)).ReadAll()
+		require.NoError(t, err)
+
+		// 弥生会計は見出しなしの25列
+		require.Len(t, records, 2)
+		assert.Len(t, records[0], 25)
+		assert.Equal(t, "2000", records[0][0])
+		assert.Equal(t, "課対仕入内10%", records[0][7])
+		assert.Equal(t, "対象外", records[0][13])
+	})
+
+	t.Run("仕訳の対応が未設定のカテゴリがある場合はエラー", func(t *testing.T) {
+		other, _ := entity.NewCategory(fakeClock, "雑費", "", "")
+		require.NoError(t, categoryRepo.Save(ctx, other))
+		createApproved(other.ID().String(), 300, "文房具", today)
+
+		_, err := useCase.ExportJournal(ctx, &dto.ExportJournalRequest{
+			Software: "freee",
+			DateFrom: today.AddDate(0, 0, -7).Format("2006-01-02"),
+			DateTo:   today.Format("2006-01-02"),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "雑費")
+	})
+
+	t.Run("仕訳の対応を解除", func(t *testing.T) {
+		updated, err := categoryUseCase.UpdateCategory(ctx, meal.ID, &dto.UpdateCategoryRequest{Name: "会議費"})
+		require.NoError(t, err)
+		assert.Nil(t, updated.Accounting)
+	})
+}
@@ -0,0 +1,370 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/event"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"math"
+	"sort"
+	"time"
+)
+
+// 仕訳に使用する勘定科目
+const (
+	accruedExpensesAccount = "未払費用"
+	bankAccount            = "普通預金"
+	inputTaxAccount        = "仮払消費税"
+)
+
+// LedgerUseCase 経費の承認・支払いから複式簿記の仕訳を作成する元帳ユースケース
+type LedgerUseCase struct {
+	journalRepo  repository.JournalEntryRepository
+	expenseRepo  repository.ExpenseRepository
+	categoryRepo repository.CategoryRepository
+	clock        clock.Clock
+}
+
+// NewLedgerUseCase LedgerUseCaseのコンストラクタ
+func NewLedgerUseCase(
+	journalRepo repository.JournalEntryRepository,
+	expenseRepo repository.ExpenseRepository,
+	categoryRepo repository.CategoryRepository,
+	clk clock.Clock,
+) *LedgerUseCase {
+	return &LedgerUseCase{
+		journalRepo:  journalRepo,
+		expenseRepo:  expenseRepo,
+		categoryRepo: categoryRepo,
+		clock:        clk,
+	}
+}
+
+// HandleEvent 経費の承認・支払いのイベントを受けて仕訳を作成
+// 同じ経費の同じイベントを複数回受け取っても仕訳は1件だけ作成する
+func (uc *LedgerUseCase) HandleEvent(ctx context.Context, e event.Event) error {
+	switch ev := e.(type) {
+	case event.ExpenseApproved:
+		return uc.postExpenseApproval(ctx, ev.ExpenseID)
+	case event.ExpensePaid:
+		return uc.postExpensePayment(ctx, ev.ExpenseID, ev.At)
+	}
+
+	return nil
+}
+
+// PostMissingEntries 承認・支払いの仕訳が作成されていない経費の仕訳を作成
+// 承認・支払いの通知時に仕訳の作成に失敗した経費を定期的に再試行するためのもので、失敗した経費があっても残りの処理を継続する
+func (uc *LedgerUseCase) PostMissingEntries(ctx context.Context) (*dto.LedgerPostingResult, error) {
+	result := &dto.LedgerPostingResult{Failures: []*dto.LedgerPostingFailure{}}
+
+	err := uc.expenseRepo.Iterate(ctx, repository.ExpenseFilter{Status: entity.ExpenseStatusApproved}, func(expense *entity.Expense) error {
+		expenseID := expense.ID().String()
+
+		if !uc.hasEntry(ctx, entity.JournalEntrySourceExpenseApproval, expenseID) {
+			if err := uc.postExpenseApproval(ctx, expenseID); err != nil {
+				result.Failures = append(result.Failures, &dto.LedgerPostingFailure{ExpenseID: expenseID, Message: err.Error()})
+				return nil
+			}
+			result.PostedCount++
+		}
+
+		// 支払いの仕訳は承認の仕訳の後に作成する
+		if expense.IsPaid() && !uc.hasEntry(ctx, entity.JournalEntrySourceExpensePayment, expenseID) {
+			if err := uc.postExpensePayment(ctx, expenseID, expense.PaidAt()); err != nil {
+				result.Failures = append(result.Failures, &dto.LedgerPostingFailure{ExpenseID: expenseID, Message: err.Error()})
+				return nil
+			}
+			result.PostedCount++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.LedgerPostingFailed, "仕訳が未作成の経費の取得に失敗しました")
+	}
+
+	return result, nil
+}
+
+// hasEntry 発生元の仕訳が作成済みかどうか
+func (uc *LedgerUseCase) hasEntry(ctx context.Context, source entity.JournalEntrySource, sourceID string) bool {
+	_, err := uc.journalRepo.FindBySource(ctx, source, sourceID)
+	return err == nil
+}
+
+// postExpenseApproval 承認した経費の費用を計上（借方: 費用科目・仮払消費税 / 貸方: 未払費用）
+func (uc *LedgerUseCase) postExpenseApproval(ctx context.Context, expenseID string) error {
+	expense, err := uc.findExpense(ctx, expenseID)
+	if err != nil {
+		return err
+	}
+
+	if uc.hasEntry(ctx, entity.JournalEntrySourceExpenseApproval, expenseID) {
+		return nil
+	}
+
+	// 明細に分けた経費は明細ごとに費用科目へ計上し、消費税額は仮払消費税の1行にまとめる
+	specs := make([]journalLineSpec, 0, len(expense.Lines())+2)
+	tax, err := valueobject.NewMoney(0, expense.Amount().Currency())
+	if err != nil {
+		return errors.NewApplicationError(errors.LedgerPostingFailed, err.Error())
+	}
+	for _, line := range expense.Lines() {
+		category, err := uc.categoryRepo.FindByID(ctx, line.CategoryID())
+		if err != nil {
+			return errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
+		}
+
+		// 仕訳の対応が未設定のカテゴリはカテゴリ名を勘定科目とする
+		debitAccount, department := category.Name(), ""
+		if mapping := category.AccountMapping(); mapping != nil {
+			debitAccount, department = mapping.DebitAccount(), mapping.Department()
+		}
+
+		breakdown, err := valueobject.NewTaxBreakdown(line.Amount(), lineTaxRate(expense, line, category))
+		if err != nil {
+			return errors.NewApplicationError(errors.LedgerPostingFailed, err.Error())
+		}
+		if tax, err = tax.Add(breakdown.Tax()); err != nil {
+			return errors.NewApplicationError(errors.LedgerPostingFailed, err.Error())
+		}
+
+		specs = append(specs, journalLineSpec{debitAccount, department, entity.JournalSideDebit, breakdown.Net()})
+	}
+
+	specs = append(specs,
+		journalLineSpec{inputTaxAccount, "", entity.JournalSideDebit, tax},
+		journalLineSpec{accruedExpensesAccount, "", entity.JournalSideCredit, expense.Amount()},
+	)
+
+	return uc.post(ctx, expense.Date(), expense.Title(), entity.JournalEntrySourceExpenseApproval, expenseID, specs)
+}
+
+// postExpensePayment 支払った経費の未払費用を消し込む（借方: 未払費用 / 貸方: 普通預金）
+func (uc *LedgerUseCase) postExpensePayment(ctx context.Context, expenseID string, paidAt time.Time) error {
+	expense, err := uc.findExpense(ctx, expenseID)
+	if err != nil {
+		return err
+	}
+
+	if uc.hasEntry(ctx, entity.JournalEntrySourceExpensePayment, expenseID) {
+		return nil
+	}
+
+	specs := []journalLineSpec{
+		{accruedExpensesAccount, "", entity.JournalSideDebit, expense.Amount()},
+		{bankAccount, "", entity.JournalSideCredit, expense.Amount()},
+	}
+
+	return uc.post(ctx, valueobject.DateOf(paidAt), expense.Title(), entity.JournalEntrySourceExpensePayment, expenseID, specs)
+}
+
+// journalLineSpec 作成する仕訳の明細行
+type journalLineSpec struct {
+	account    string
+	department string
+	side       entity.JournalSide
+	amount     *valueobject.Money
+}
+
+// post 明細行から仕訳を作成して保存（金額が0の行は省く）
+func (uc *LedgerUseCase) post(ctx context.Context, date valueobject.Date, description string, source entity.JournalEntrySource, sourceID string, specs []journalLineSpec) error {
+	lines := make([]*entity.JournalLine, 0, len(specs))
+	for _, spec := range specs {
+		if spec.amount.Amount() == 0 {
+			continue
+		}
+
+		line, err := entity.NewJournalLine(spec.account, spec.department, spec.side, spec.amount)
+		if err != nil {
+			return errors.NewApplicationError(errors.LedgerPostingFailed, err.Error())
+		}
+		lines = append(lines, line)
+	}
+
+	entry, err := entity.NewJournalEntry(uc.clock, date, description, source, sourceID, lines)
+	if err != nil {
+		return errors.NewApplicationError(errors.LedgerPostingFailed, err.Error())
+	}
+
+	if err := uc.journalRepo.Save(ctx, entry); err != nil {
+		return errors.NewApplicationError(errors.LedgerPostingFailed, "仕訳の保存に失敗しました")
+	}
+
+	return nil
+}
+
+// findExpense IDで経費を取得
+func (uc *LedgerUseCase) findExpense(ctx context.Context, expenseID string) (*entity.Expense, error) {
+	id, err := valueobject.NewExpenseID(expenseID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	expense, err := uc.expenseRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseNotFound, "経費が見つかりません")
+	}
+
+	return expense, nil
+}
+
+// GetJournalEntries 期間内の仕訳を計上日の順に取得
+func (uc *LedgerUseCase) GetJournalEntries(ctx context.Context, req *dto.LedgerPeriodRequest) (*dto.JournalEntryListResponse, error) {
+	dateFrom, dateTo, entries, err := uc.findEntries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.JournalEntryResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = buildJournalEntryResponse(entry)
+	}
+
+	return &dto.JournalEntryListResponse{
+		DateFrom: dateFrom.String(),
+		DateTo:   dateTo.String(),
+		Entries:  responses,
+		Count:    len(responses),
+	}, nil
+}
+
+// GetTrialBalance 期間内の仕訳を勘定科目・通貨ごとに集計した試算表を取得
+func (uc *LedgerUseCase) GetTrialBalance(ctx context.Context, req *dto.LedgerPeriodRequest) (*dto.TrialBalanceResponse, error) {
+	dateFrom, dateTo, entries, err := uc.findEntries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 浮動小数点の誤差を避けるため、1/100単位の整数で集計する
+	type balance struct {
+		debit, credit int64
+	}
+	type accountKey struct {
+		account, currency string
+	}
+	accounts := make(map[accountKey]*balance)
+	totals := make(map[string]*balance)
+
+	for _, entry := range entries {
+		for _, line := range entry.Lines() {
+			currency := line.Amount().Currency()
+			key := accountKey{line.Account(), currency}
+			if accounts[key] == nil {
+				accounts[key] = &balance{}
+			}
+			if totals[currency] == nil {
+				totals[currency] = &balance{}
+			}
+
+			cents := toCents(line.Amount().Amount())
+			if line.Side() == entity.JournalSideDebit {
+				accounts[key].debit += cents
+				totals[currency].debit += cents
+			} else {
+				accounts[key].credit += cents
+				totals[currency].credit += cents
+			}
+		}
+	}
+
+	response := &dto.TrialBalanceResponse{
+		DateFrom: dateFrom.String(),
+		DateTo:   dateTo.String(),
+		Accounts: make([]*dto.TrialBalanceAccountResponse, 0, len(accounts)),
+		Totals:   make([]*dto.TrialBalanceTotalResponse, 0, len(totals)),
+		Balanced: true,
+	}
+
+	for key, b := range accounts {
+		response.Accounts = append(response.Accounts, &dto.TrialBalanceAccountResponse{
+			Account:  key.account,
+			Currency: key.currency,
+			Debit:    fromCents(b.debit),
+			Credit:   fromCents(b.credit),
+			Balance:  fromCents(b.debit - b.credit),
+		})
+	}
+	sort.Slice(response.Accounts, func(i, j int) bool {
+		if response.Accounts[i].Currency != response.Accounts[j].Currency {
+			return response.Accounts[i].Currency < response.Accounts[j].Currency
+		}
+		return response.Accounts[i].Account < response.Accounts[j].Account
+	})
+
+	for currency, b := range totals {
+		response.Totals = append(response.Totals, &dto.TrialBalanceTotalResponse{
+			Currency: currency,
+			Debit:    fromCents(b.debit),
+			Credit:   fromCents(b.credit),
+		})
+		if b.debit != b.credit {
+			response.Balanced = false
+		}
+	}
+	sort.Slice(response.Totals, func(i, j int) bool {
+		return response.Totals[i].Currency < response.Totals[j].Currency
+	})
+
+	return response, nil
+}
+
+// findEntries 期間を検証して期間内の仕訳を取得
+func (uc *LedgerUseCase) findEntries(ctx context.Context, req *dto.LedgerPeriodRequest) (valueobject.Date, valueobject.Date, []*entity.JournalEntry, error) {
+	dateFrom, dateTo, err := parseDatePeriod(req.DateFrom, req.DateTo)
+	if err != nil {
+		return dateFrom, dateTo, nil, err
+	}
+	if dateFrom.IsZero() || dateTo.IsZero() {
+		return dateFrom, dateTo, nil, errors.NewApplicationError(errors.ValidationFailed, "date_from と date_to を指定してください")
+	}
+
+	entries, err := uc.journalRepo.FindByDateRange(ctx, dateFrom, dateTo)
+	if err != nil {
+		return dateFrom, dateTo, nil, errors.NewApplicationError("JOURNAL_ENTRY_FETCH_FAILED", "仕訳の取得に失敗しました")
+	}
+
+	return dateFrom, dateTo, entries, nil
+}
+
+// buildJournalEntryResponse 仕訳レスポンスを構築
+func buildJournalEntryResponse(entry *entity.JournalEntry) *dto.JournalEntryResponse {
+	lines := make([]*dto.JournalLineResponse, len(entry.Lines()))
+	for i, line := range entry.Lines() {
+		lines[i] = &dto.JournalLineResponse{
+			Account:    line.Account(),
+			Department: line.Department(),
+		}
+		if line.Side() == entity.JournalSideDebit {
+			lines[i].Debit = line.Amount().Amount()
+		} else {
+			lines[i].Credit = line.Amount().Amount()
+		}
+	}
+
+	return &dto.JournalEntryResponse{
+		ID:          entry.ID().String(),
+		Date:        entry.Date().String(),
+		Description: entry.Description(),
+		Source:      string(entry.Source()),
+		SourceID:    entry.SourceID(),
+		Currency:    entry.Currency(),
+		Lines:       lines,
+		CreatedAt:   entry.CreatedAt(),
+	}
+}
+
+// toCents 金額を1/100単位の整数に変換
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// fromCents 1/100単位の整数を金額に変換
+func fromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/event"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/internal/infrastructure/messaging"
+	"expense-management-system/internal/infrastructure/persistence"
+	"expense-management-system/pkg/errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedgerUseCase(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	expenseReportRepo := persistence.NewMemoryExpenseReportRepository()
+	journalRepo := persistence.NewMemoryJournalEntryRepository()
+
+	// 経費の承認・支払いのイベントで仕訳を作成するように購読
+	ledgerUseCase := NewLedgerUseCase(journalRepo, expenseRepo, categoryRepo, fakeClock)
+	publisher := messaging.NewInMemoryPublisher()
+	publisher.Subscribe(event.ExpenseApprovedEvent, ledgerUseCase.HandleEvent)
+	publisher.Subscribe(event.ExpensePaidEvent, ledgerUseCase.HandleEvent)
+
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock, WithEventPublisher(publisher))
+	reportUseCase := NewExpenseReportUseCase(expenseReportRepo, expenseRepo, userRepo, categoryRepo, fakeClock, WithReportEventPublisher(publisher))
+	categoryUseCase := NewCategoryUseCase(categoryRepo, expenseRepo, fakeClock)
+
+	// テスト用のユーザーとカテゴリを作成
+	user, _ := entity.NewUser(fakeClock, "山田太郎", "yamada@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	transport, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{
+		Name:       "交通費",
+		Accounting: &dto.AccountMappingRequest{DebitAccount: "旅費交通費", Department: "営業部"},
+	})
+	require.NoError(t, err)
+
+	// 仕訳の対応が未設定のカテゴリはカテゴリ名を勘定科目とする
+	supplies, _ := entity.NewCategory(fakeClock, "消耗品費", "", "")
+	require.NoError(t, categoryRepo.Save(ctx, supplies))
+
+	today := fakeClock.Now().Truncate(24 * time.Hour)
+	period := &dto.LedgerPeriodRequest{DateFrom: today.AddDate(0, 0, -7).Format("2006-01-02"), DateTo: today.Format("2006-01-02")}
+
+	createSubmitted := func(categoryID string, amount float64, currency, title string) *entity.Expense {
+		cid, _ := valueobject.NewCategoryID(categoryID)
+		money, _ := valueobject.NewMoney(amount, currency)
+		expense, err := entity.NewExpense(fakeClock, user.ID(), cid, money, title, "", valueobject.DateOf(today.AddDate(0, 0, -1)))
+		require.NoError(t, err)
+		require.NoError(t, expense.Submit(fakeClock.Now()))
+		require.NoError(t, expenseRepo.Save(ctx, expense))
+		return expense
+	}
+
+	t.Run("承認時に費用を計上する仕訳を作成", func(t *testing.T) {
+		expense := createSubmitted(transport.ID, 1100, "JPY", "電車代")
+
+		_, err := useCase.ApproveExpense(ctx, expense.ID().String())
+		require.NoError(t, err)
+
+		entry, err := journalRepo.FindBySource(ctx, entity.JournalEntrySourceExpenseApproval, expense.ID().String())
+		require.NoError(t, err)
+
+		response := buildJournalEntryResponse(entry)
+		require.Len(t, response.Lines, 3)
+		assert.Equal(t, "旅費交通費", response.Lines[0].Account)
+		assert.Equal(t, "営業部", response.Lines[0].Department)
+		assert.Equal(t, 1000.0, response.Lines[0].Debit)
+		assert.Equal(t, "仮払消費税", response.Lines[1].Account)
+		assert.Equal(t, 100.0, response.Lines[1].Debit)
+		assert.Equal(t, "未払費用", response.Lines[2].Account)
+		assert.Equal(t, 1100.0, response.Lines[2].Credit)
+
+		// 同じイベントを再度受け取っても仕訳は増えない
+		require.NoError(t, ledgerUseCase.HandleEvent(ctx, event.ExpenseApproved{ExpenseID: expense.ID().String()}))
+		entries, err := ledgerUseCase.GetJournalEntries(ctx, period)
+		require.NoError(t, err)
+		assert.Equal(t, 1, entries.Count)
+	})
+
+	t.Run("支払時に未払費用を消し込む仕訳を作成", func(t *testing.T) {
+		expense := createSubmitted(supplies.ID().String(), 330, "JPY", "文房具")
+		_, err := useCase.ApproveExpense(ctx, expense.ID().String())
+		require.NoError(t, err)
+
+		paidAt := today
+		response, err := useCase.PayExpense(ctx, expense.ID().String(), &dto.PayExpenseRequest{PaidAt: &paidAt})
+		require.NoError(t, err)
+		require.NotNil(t, response.PaidAt)
+
+		approval, err := journalRepo.FindBySource(ctx, entity.JournalEntrySourceExpenseApproval, expense.ID().String())
+		require.NoError(t, err)
+		assert.Equal(t, "消耗品費", approval.Lines()[0].Account())
+
+		payment, err := journalRepo.FindBySource(ctx, entity.JournalEntrySourceExpensePayment, expense.ID().String())
+		require.NoError(t, err)
+		assert.Equal(t, valueobject.DateOf(paidAt), payment.Date())
+
+		lines := buildJournalEntryResponse(payment).Lines
+		require.Len(t, lines, 2)
+		assert.Equal(t, "未払費用", lines[0].Account)
+		assert.Equal(t, 330.0, lines[0].Debit)
+		assert.Equal(t, "普通預金", lines[1].Account)
+		assert.Equal(t, 330.0, lines[1].Credit)
+
+		// 二重の支払いはエラー
+		_, err = useCase.PayExpense(ctx, expense.ID().String(), &dto.PayExpenseRequest{})
+		require.Error(t, err)
+		domainErr, ok := err.(*errors.DomainError)
+		require.True(t, ok)
+		assert.Equal(t, "EXPENSE_PAY_NOT_ALLOWED", domainErr.Code)
+
+		// 仕訳を作成した経費は削除できない
+		err = useCase.DeleteExpense(ctx, expense.ID().String())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errors.ExpenseNotDeletable)
+	})
+
+	t.Run("一括承認と経費レポートの承認でも仕訳を作成", func(t *testing.T) {
+		bulk := createSubmitted(transport.ID, 500, "JPY", "バス代")
+		result, err := useCase.BulkApproveExpenses(ctx, &dto.BulkExpenseActionRequest{ExpenseIDs: []string{bulk.ID().String()}})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.SucceededCount)
+
+		_, err = journalRepo.FindBySource(ctx, entity.JournalEntrySourceExpenseApproval, bulk.ID().String())
+		assert.NoError(t, err)
+
+		// 日本円以外の経費は消費税の対象外
+		cid, _ := valueobject.NewCategoryID(transport.ID)
+		money, _ := valueobject.NewMoney(20, "USD")
+		foreign, err := entity.NewExpense(fakeClock, user.ID(), cid, money, "海外出張のタクシー", "", valueobject.DateOf(today.AddDate(0, 0, -1)))
+		require.NoError(t, err)
+		require.NoError(t, expenseRepo.Save(ctx, foreign))
+
+		report, err := reportUseCase.CreateExpenseReport(ctx, user.ID().String(), &dto.CreateExpenseReportRequest{
+			Title:       "海外出張",
+			PeriodStart: today.AddDate(0, 0, -7).Format("2006-01-02"),
+			PeriodEnd:   today.Format("2006-01-02"),
+			ExpenseIDs:  []string{foreign.ID().String()},
+		})
+		require.NoError(t, err)
+		_, err = reportUseCase.SubmitExpenseReport(ctx, report.ID)
+		require.NoError(t, err)
+		_, err = reportUseCase.ApproveExpenseReport(ctx, report.ID)
+		require.NoError(t, err)
+
+		entry, err := journalRepo.FindBySource(ctx, entity.JournalEntrySourceExpenseApproval, foreign.ID().String())
+		require.NoError(t, err)
+		assert.Len(t, entry.Lines(), 2)
+		assert.Equal(t, "USD", entry.Currency())
+	})
+
+	t.Run("試算表は通貨ごとに借方と貸方の合計が一致", func(t *testing.T) {
+		balance, err := ledgerUseCase.GetTrialBalance(ctx, period)
+		require.NoError(t, err)
+		assert.True(t, balance.Balanced)
+
+		accounts := make(map[string]*dto.TrialBalanceAccountResponse)
+		for _, account := range balance.Accounts {
+			accounts[account.Currency+":"+account.Account] = account
+		}
+
+		// 未払費用は支払済みの分だけ消し込まれている
+		accrued := accounts["JPY:未払費用"]
+		require.NotNil(t, accrued)
+		assert.Equal(t, 330.0, accrued.Debit)
+		assert.Equal(t, 1930.0, accrued.Credit)
+		assert.Equal(t, -1600.0, accrued.Balance)
+
+		assert.Equal(t, -330.0, accounts["JPY:普通預金"].Balance)
+		assert.Equal(t, 20.0, accounts["USD:旅費交通費"].Debit)
+
+		require.Len(t, balance.Totals, 2)
+		assert.Equal(t, "JPY", balance.Totals[0].Currency)
+		assert.Equal(t, balance.Totals[0].Debit, balance.Totals[0].Credit)
+		assert.Equal(t, 2260.0, balance.Totals[0].Debit)
+	})
+
+	t.Run("期間外の仕訳は含めない", func(t *testing.T) {
+		entries, err := ledgerUseCase.GetJournalEntries(ctx, &dto.LedgerPeriodRequest{
+			DateFrom: today.AddDate(0, 0, -30).Format("2006-01-02"),
+			DateTo:   today.AddDate(0, 0, -20).Format("2006-01-02"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, entries.Count)
+
+		_, err = ledgerUseCase.GetTrialBalance(ctx, &dto.LedgerPeriodRequest{DateFrom: today.Format("2006-01-02"), DateTo: today.AddDate(0, 0, -1).Format("2006-01-02")})
+		assert.Error(t, err)
+	})
+}
+
+// failingJournalEntryRepository 仕訳の保存に失敗するテスト用リポジトリ
+type failingJournalEntryRepository struct {
+	*persistence.MemoryJournalEntryRepository
+	fail bool
+}
+
+func (r *failingJournalEntryRepository) Save(ctx context.Context, entry *entity.JournalEntry) error {
+	if r.fail {
+		return fmt.Errorf("storage unavailable")
+	}
+	return r.MemoryJournalEntryRepository.Save(ctx, entry)
+}
+
+func TestLedgerUseCase_PostMissingEntries(t *testing.T) {
+	ctx := context.Background()
+
+	// リポジトリを初期化（仕訳の保存は失敗する状態から始める）
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	journalRepo := &failingJournalEntryRepository{MemoryJournalEntryRepository: persistence.NewMemoryJournalEntryRepository(), fail: true}
+
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ledgerUseCase := NewLedgerUseCase(journalRepo, expenseRepo, categoryRepo, fakeClock)
+	publisher := messaging.NewInMemoryPublisher()
+	publisher.Subscribe(event.ExpenseApprovedEvent, ledgerUseCase.HandleEvent)
+	publisher.Subscribe(event.ExpensePaidEvent, ledgerUseCase.HandleEvent)
+
+	useCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock, WithEventPublisher(publisher))
+
+	user, _ := entity.NewUser(fakeClock, "山田太郎", "yamada@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+	category, _ := entity.NewCategory(fakeClock, "消耗品費", "", "")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	money, _ := valueobject.NewMoney(330, "JPY")
+	expense, err := entity.NewExpense(fakeClock, user.ID(), category.ID(), money, "文房具", "", valueobject.DateOf(fakeClock.Now().AddDate(0, 0, -1)))
+	require.NoError(t, err)
+	require.NoError(t, expense.Submit(fakeClock.Now()))
+	require.NoError(t, expenseRepo.Save(ctx, expense))
+
+	t.Run("仕訳を作成できなくても承認・支払いは成功し警告を返す", func(t *testing.T) {
+		approved, err := useCase.ApproveExpense(ctx, expense.ID().String())
+		require.NoError(t, err)
+		assert.Equal(t, "approved", approved.Status)
+		require.Len(t, approved.Warnings, 1)
+		assert.Contains(t, approved.Warnings[0], "文房具")
+
+		fakeClock.Advance(24 * time.Hour)
+		paidAt := fakeClock.Now().AddDate(0, 0, -1)
+		paid, err := useCase.PayExpense(ctx, expense.ID().String(), &dto.PayExpenseRequest{PaidAt: &paidAt})
+		require.NoError(t, err)
+		require.NotNil(t, paid.PaidAt)
+		assert.Equal(t, fakeClock.Now(), paid.UpdatedAt)
+		assert.Len(t, paid.Warnings, 1)
+
+		// 失敗した経費は結果に含め、次回に再試行する
+		result, err := ledgerUseCase.PostMissingEntries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.PostedCount)
+		require.Len(t, result.Failures, 1)
+		assert.Equal(t, expense.ID().String(), result.Failures[0].ExpenseID)
+	})
+
+	t.Run("未作成の承認・支払いの仕訳を作成", func(t *testing.T) {
+		journalRepo.fail = false
+
+		result, err := ledgerUseCase.PostMissingEntries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.PostedCount)
+		assert.Empty(t, result.Failures)
+
+		payment, err := journalRepo.FindBySource(ctx, entity.JournalEntrySourceExpensePayment, expense.ID().String())
+		require.NoError(t, err)
+		stored, err := expenseRepo.FindByID(ctx, expense.ID())
+		require.NoError(t, err)
+		assert.Equal(t, valueobject.DateOf(stored.PaidAt()), payment.Date())
+
+		// 作成済みの仕訳は再度作成しない
+		result, err = ledgerUseCase.PostMissingEntries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.PostedCount)
+	})
+}
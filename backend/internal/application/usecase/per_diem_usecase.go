@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PerDiemUseCase 日当ユースケース
+type PerDiemUseCase struct {
+	rateRepo        repository.PerDiemRateRepository
+	expenseRepo     repository.ExpenseRepository
+	userRepo        repository.UserRepository
+	categoryRepo    repository.CategoryRepository
+	tripRequestRepo repository.TripRequestRepository
+	periodGuard     *fiscalPeriodGuard
+	clock           clock.Clock
+}
+
+// PerDiemUseCaseOption PerDiemUseCaseの任意の依存関係を設定するオプション
+type PerDiemUseCaseOption func(*PerDiemUseCase)
+
+// WithPerDiemFiscalPeriods 会計期間を設定（未設定の場合は締め済みの会計期間を確認しない）
+func WithPerDiemFiscalPeriods(calendar *valueobject.FiscalCalendar, periodRepo repository.AccountingPeriodRepository) PerDiemUseCaseOption {
+	return func(uc *PerDiemUseCase) {
+		uc.periodGuard = &fiscalPeriodGuard{calendar: calendar, periodRepo: periodRepo, clock: uc.clock}
+	}
+}
+
+// NewPerDiemUseCase PerDiemUseCaseのコンストラクタ
+func NewPerDiemUseCase(
+	rateRepo repository.PerDiemRateRepository,
+	expenseRepo repository.ExpenseRepository,
+	userRepo repository.UserRepository,
+	categoryRepo repository.CategoryRepository,
+	tripRequestRepo repository.TripRequestRepository,
+	clk clock.Clock,
+	opts ...PerDiemUseCaseOption,
+) *PerDiemUseCase {
+	uc := &PerDiemUseCase{
+		rateRepo:        rateRepo,
+		expenseRepo:     expenseRepo,
+		userRepo:        userRepo,
+		categoryRepo:    categoryRepo,
+		tripRequestRepo: tripRequestRepo,
+		clock:           clk,
+	}
+
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
+
+// GetPerDiemRates 日当単価表を取得
+func (uc *PerDiemUseCase) GetPerDiemRates(ctx context.Context) ([]*dto.PerDiemRateResponse, error) {
+	rates, err := uc.rateRepo.FindAll(ctx)
+	if err != nil {
+		return nil, errors.NewApplicationError("PER_DIEM_RATE_FETCH_FAILED", "日当単価表の取得に失敗しました")
+	}
+
+	// 地域区分・職能等級の順に並べる
+	sort.Slice(rates, func(i, j int) bool {
+		if rates[i].DestinationClass() != rates[j].DestinationClass() {
+			return rates[i].DestinationClass() < rates[j].DestinationClass()
+		}
+		return rates[i].Grade() < rates[j].Grade()
+	})
+
+	responses := make([]*dto.PerDiemRateResponse, len(rates))
+	for i, rate := range rates {
+		responses[i] = buildPerDiemRateResponse(rate)
+	}
+
+	return responses, nil
+}
+
+// SavePerDiemRate 日当単価を登録（既存の単価は上書き）
+func (uc *PerDiemUseCase) SavePerDiemRate(ctx context.Context, req *dto.PerDiemRateRequest) (*dto.PerDiemRateResponse, error) {
+	dailyAmount, err := valueobject.NewMoney(req.DailyAmount, req.Currency)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	rate, err := entity.NewPerDiemRate(uc.clock, req.DestinationClass, req.Grade, dailyAmount)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.rateRepo.Save(ctx, rate); err != nil {
+		return nil, errors.NewApplicationError(errors.PerDiemRateUpdateFailed, "日当単価の登録に失敗しました")
+	}
+
+	return buildPerDiemRateResponse(rate), nil
+}
+
+// DeletePerDiemRate 日当単価を削除
+func (uc *PerDiemUseCase) DeletePerDiemRate(ctx context.Context, destinationClass, grade string) error {
+	if _, err := uc.rateRepo.FindByClassAndGrade(ctx, destinationClass, grade); err != nil {
+		return errors.NewApplicationError(errors.PerDiemRateNotFound, "日当単価が見つかりません")
+	}
+
+	if err := uc.rateRepo.Delete(ctx, destinationClass, grade); err != nil {
+		return errors.NewApplicationError(errors.PerDiemRateDeletionFailed, "日当単価の削除に失敗しました")
+	}
+
+	return nil
+}
+
+// GeneratePerDiemExpenses 出張期間・地域区分・ユーザーの職能等級から日当の経費を下書きで作成
+// 日当のカテゴリの経費（却下を除く）が既にある日は作成しないため、同じ出張で再び呼び出しても日当は二重にならない
+func (uc *PerDiemUseCase) GeneratePerDiemExpenses(ctx context.Context, userID string, req *dto.GeneratePerDiemRequest) (*dto.GeneratePerDiemResponse, error) {
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, uid)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	if user.Grade() == "" {
+		return nil, errors.NewApplicationError(errors.UserGradeNotSet, "ユーザーの職能等級が設定されていません")
+	}
+
+	cid, err := valueobject.NewCategoryID(req.CategoryID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	category, err := uc.categoryRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
+	}
+
+	destinationClass := strings.TrimSpace(req.DestinationClass)
+	rate, err := uc.rateRepo.FindByClassAndGrade(ctx, destinationClass, user.Grade())
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.PerDiemRateNotFound, "該当する日当単価が登録されていません")
+	}
+
+	days, err := rate.Calculate(req.DepartureAt, req.ReturnAt)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	generated, err := uc.findGeneratedDates(ctx, uid, cid, days)
+	if err != nil {
+		return nil, err
+	}
+
+	// 全ての日の経費を検証してから保存する
+	expenses := make([]*entity.Expense, 0, len(days))
+	created := make([]*entity.PerDiemDay, 0, len(days))
+	skippedDates := make([]string, 0)
+	total, _ := valueobject.NewMoney(0, rate.DailyAmount().Currency())
+	for _, day := range days {
+		date := valueobject.DateOf(day.Date)
+		if generated[date.String()] {
+			skippedDates = append(skippedDates, date.String())
+			continue
+		}
+
+		title := fmt.Sprintf("日当 %s", day.Date.Format("2006-01-02"))
+		if day.HalfDay {
+			title += "（半日）"
+		}
+		description := fmt.Sprintf("出張旅費規程による日当（地域区分: %s / 職能等級: %s）", rate.DestinationClass(), rate.Grade())
+
+		expense, _, err := entity.NewExpenseInCategory(uc.clock, user, category, day.Amount, title, description, date)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		// 締め済みの会計期間の日を含む場合はいずれの日の経費も作成しない
+		if err := uc.periodGuard.ensureOpen(ctx, date); err != nil {
+			return nil, err
+		}
+
+		if err := linkTripRequest(ctx, uc.tripRequestRepo, expense, req.TripRequestID, uc.clock.Now()); err != nil {
+			return nil, err
+		}
+
+		total, err = total.Add(day.Amount)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		expenses = append(expenses, expense)
+		created = append(created, day)
+	}
+
+	response := &dto.GeneratePerDiemResponse{
+		DestinationClass: rate.DestinationClass(),
+		Grade:            rate.Grade(),
+		DailyAmount:      rate.DailyAmount().Amount(),
+		Currency:         rate.DailyAmount().Currency(),
+		Days:             make([]*dto.PerDiemDayResponse, len(created)),
+		TotalAmount:      total.Amount(),
+		Expenses:         make([]*dto.ExpenseResponse, len(expenses)),
+		SkippedDates:     skippedDates,
+	}
+
+	for i, expense := range expenses {
+		if err := uc.expenseRepo.Save(ctx, expense); err != nil {
+			return nil, errors.NewApplicationError(errors.ExpenseCreationFailed, "日当の経費の作成に失敗しました")
+		}
+
+		response.Days[i] = &dto.PerDiemDayResponse{
+			Date:    created[i].Date,
+			HalfDay: created[i].HalfDay,
+			Amount:  created[i].Amount.Amount(),
+		}
+		response.Expenses[i] = buildExpenseResponse(expense, user, category)
+	}
+
+	return response, nil
+}
+
+// findGeneratedDates 出張期間のうち、日当のカテゴリの経費（却下を除く）が既にある日付を取得
+func (uc *PerDiemUseCase) findGeneratedDates(ctx context.Context, userID *valueobject.UserID, categoryID *valueobject.CategoryID, days []*entity.PerDiemDay) (map[string]bool, error) {
+	generated := make(map[string]bool)
+	if len(days) == 0 {
+		return generated, nil
+	}
+
+	filter := repository.ExpenseFilter{
+		UserID:     userID,
+		CategoryID: categoryID,
+		DateFrom:   valueobject.DateOf(days[0].Date),
+		DateTo:     valueobject.DateOf(days[len(days)-1].Date),
+	}
+	err := uc.expenseRepo.Iterate(ctx, filter, func(expense *entity.Expense) error {
+		if expense.CategoryID().Equals(categoryID) && expense.Status() != entity.ExpenseStatusRejected {
+			generated[expense.Date().String()] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.NewApplicationError("EXPENSE_FETCH_FAILED", "経費一覧の取得に失敗しました")
+	}
+
+	return generated, nil
+}
+
+// buildPerDiemRateResponse 日当単価レスポンスを構築
+func buildPerDiemRateResponse(rate *entity.PerDiemRate) *dto.PerDiemRateResponse {
+	return &dto.PerDiemRateResponse{
+		DestinationClass: rate.DestinationClass(),
+		Grade:            rate.Grade(),
+		DailyAmount:      rate.DailyAmount().Amount(),
+		Currency:         rate.DailyAmount().Currency(),
+		UpdatedAt:        rate.UpdatedAt(),
+	}
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/infrastructure/persistence"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerDiemUseCase_GeneratePerDiemExpenses(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	tripRequestRepo := persistence.NewMemoryTripRequestRepository()
+	rateRepo := persistence.NewMemoryPerDiemRateRepository()
+
+	// ユースケースを初期化
+	useCase := NewPerDiemUseCase(rateRepo, expenseRepo, userRepo, categoryRepo, tripRequestRepo, fakeClock)
+
+	// テスト用のユーザーとカテゴリを作成
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, user.ChangeGrade("manager", fakeClock.Now()))
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	noGradeUser, _ := entity.NewUser(fakeClock, "等級未設定ユーザー", "nograde@example.com")
+	require.NoError(t, userRepo.Save(ctx, noGradeUser))
+
+	category, _ := entity.NewCategory(fakeClock, "日当", "出張日当", "#FF0000")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	_, err := useCase.SavePerDiemRate(ctx, &dto.PerDiemRateRequest{
+		DestinationClass: "domestic",
+		Grade:            "manager",
+		DailyAmount:      3000,
+		Currency:         "JPY",
+	})
+	require.NoError(t, err)
+
+	base := fakeClock.Now().AddDate(0, 0, -5)
+	at := func(days, hour int) time.Time {
+		return time.Date(base.Year(), base.Month(), base.Day()+days, hour, 0, 0, 0, time.Local)
+	}
+
+	t.Run("出発日・帰着日の半日扱い", func(t *testing.T) {
+		// 13時出発（半日）、中日（全日）、10時帰着（半日）
+		result, err := useCase.GeneratePerDiemExpenses(ctx, user.ID().String(), &dto.GeneratePerDiemRequest{
+			DestinationClass: "domestic",
+			CategoryID:       category.ID().String(),
+			DepartureAt:      at(0, 13),
+			ReturnAt:         at(2, 10),
+		})
+		require.NoError(t, err)
+
+		require.Len(t, result.Days, 3)
+		assert.True(t, result.Days[0].HalfDay)
+		assert.Equal(t, 1500.0, result.Days[0].Amount)
+		assert.False(t, result.Days[1].HalfDay)
+		assert.Equal(t, 3000.0, result.Days[1].Amount)
+		assert.True(t, result.Days[2].HalfDay)
+		assert.Equal(t, 6000.0, result.TotalAmount)
+
+		require.Len(t, result.Expenses, 3)
+		for _, expense := range result.Expenses {
+			assert.Equal(t, "draft", expense.Status)
+		}
+	})
+
+	t.Run("午前出発・午後帰着は全日扱い", func(t *testing.T) {
+		result, err := useCase.GeneratePerDiemExpenses(ctx, user.ID().String(), &dto.GeneratePerDiemRequest{
+			DestinationClass: "domestic",
+			CategoryID:       category.ID().String(),
+			DepartureAt:      at(3, 8),
+			ReturnAt:         at(4, 18),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 6000.0, result.TotalAmount)
+	})
+
+	t.Run("同じ出張で再び作成しても日当は二重にならない", func(t *testing.T) {
+		result, err := useCase.GeneratePerDiemExpenses(ctx, user.ID().String(), &dto.GeneratePerDiemRequest{
+			DestinationClass: "domestic",
+			CategoryID:       category.ID().String(),
+			DepartureAt:      at(0, 13),
+			ReturnAt:         at(2, 10),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result.Expenses)
+		assert.Len(t, result.SkippedDates, 3)
+		assert.Equal(t, 0.0, result.TotalAmount)
+
+		expenses, err := expenseRepo.FindByUserID(ctx, user.ID())
+		require.NoError(t, err)
+		assert.Len(t, expenses, 5)
+	})
+
+	t.Run("職能等級が未設定の場合はエラー", func(t *testing.T) {
+		result, err := useCase.GeneratePerDiemExpenses(ctx, noGradeUser.ID().String(), &dto.GeneratePerDiemRequest{
+			DestinationClass: "domestic",
+			CategoryID:       category.ID().String(),
+			DepartureAt:      at(0, 9),
+			ReturnAt:         at(0, 18),
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("日当単価が未登録の地域区分はエラー", func(t *testing.T) {
+		result, err := useCase.GeneratePerDiemExpenses(ctx, user.ID().String(), &dto.GeneratePerDiemRequest{
+			DestinationClass: "overseas",
+			CategoryID:       category.ID().String(),
+			DepartureAt:      at(0, 9),
+			ReturnAt:         at(0, 18),
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,384 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ProjectUseCase プロジェクトユースケース
+type ProjectUseCase struct {
+	projectRepo  repository.ProjectRepository
+	expenseRepo  repository.ExpenseRepository
+	userRepo     repository.UserRepository
+	categoryRepo repository.CategoryRepository
+	clock        clock.Clock
+}
+
+// NewProjectUseCase ProjectUseCaseのコンストラクタ
+func NewProjectUseCase(projectRepo repository.ProjectRepository, expenseRepo repository.ExpenseRepository, userRepo repository.UserRepository, categoryRepo repository.CategoryRepository, clk clock.Clock) *ProjectUseCase {
+	return &ProjectUseCase{
+		projectRepo:  projectRepo,
+		expenseRepo:  expenseRepo,
+		userRepo:     userRepo,
+		categoryRepo: categoryRepo,
+		clock:        clk,
+	}
+}
+
+// CreateProject プロジェクトを作成
+func (uc *ProjectUseCase) CreateProject(ctx context.Context, req *dto.CreateProjectRequest) (*dto.ProjectResponse, error) {
+	// プロジェクトコードの重複チェック
+	existing, err := uc.projectRepo.FindByCode(ctx, strings.TrimSpace(req.Code))
+	if err == nil && existing != nil {
+		return nil, errors.NewApplicationError(errors.ProjectCodeExists, "このプロジェクトコードは既に使用されています")
+	}
+
+	startDate, endDate, budget, err := parseProjectTerms(req.StartDate, req.EndDate, req.Budget, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := entity.NewProject(uc.clock, req.Code, req.Name, req.Client, startDate, endDate, budget)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.projectRepo.Save(ctx, project); err != nil {
+		return nil, errors.NewApplicationError(errors.ProjectCreationFailed, "プロジェクトの作成に失敗しました")
+	}
+
+	return buildProjectResponse(project), nil
+}
+
+// GetProject プロジェクトを取得
+func (uc *ProjectUseCase) GetProject(ctx context.Context, projectID string) (*dto.ProjectResponse, error) {
+	project, err := uc.findProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildProjectResponse(project), nil
+}
+
+// GetAllProjects 全てのプロジェクトを取得
+func (uc *ProjectUseCase) GetAllProjects(ctx context.Context) ([]*dto.ProjectResponse, error) {
+	projects, err := uc.projectRepo.FindAll(ctx)
+	if err != nil {
+		return nil, errors.NewApplicationError("PROJECT_FETCH_FAILED", "プロジェクト一覧の取得に失敗しました")
+	}
+
+	responses := make([]*dto.ProjectResponse, len(projects))
+	for i, project := range projects {
+		responses[i] = buildProjectResponse(project)
+	}
+
+	return responses, nil
+}
+
+// UpdateProject プロジェクトを更新
+func (uc *ProjectUseCase) UpdateProject(ctx context.Context, projectID string, req *dto.UpdateProjectRequest) (*dto.ProjectResponse, error) {
+	project, err := uc.findProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// プロジェクトコードの重複チェック（自分以外で同じコードが存在するか）
+	existing, err := uc.projectRepo.FindByCode(ctx, strings.TrimSpace(req.Code))
+	if err == nil && existing != nil && !existing.ID().Equals(project.ID()) {
+		return nil, errors.NewApplicationError(errors.ProjectCodeExists, "このプロジェクトコードは既に使用されています")
+	}
+
+	startDate, endDate, budget, err := parseProjectTerms(req.StartDate, req.EndDate, req.Budget, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := project.Update(req.Code, req.Name, req.Client, startDate, endDate, budget, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.projectRepo.Update(ctx, project); err != nil {
+		return nil, errors.NewApplicationError(errors.ProjectUpdateFailed, "プロジェクトの更新に失敗しました")
+	}
+
+	return buildProjectResponse(project), nil
+}
+
+// DeleteProject プロジェクトを削除（経費が関連付けられている場合は削除できない）
+func (uc *ProjectUseCase) DeleteProject(ctx context.Context, projectID string) error {
+	project, err := uc.findProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	inUse := false
+	err = uc.expenseRepo.Iterate(ctx, repository.ExpenseFilter{}, func(expense *entity.Expense) error {
+		if project.ID().Equals(expense.ProjectID()) {
+			inUse = true
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.NewApplicationError(errors.ProjectDeletionFailed, "プロジェクトの使用状況チェックに失敗しました")
+	}
+	if inUse {
+		return errors.NewApplicationError(errors.ProjectInUse, "このプロジェクトには経費が関連付けられているため削除できません")
+	}
+
+	if err := uc.projectRepo.Delete(ctx, project.ID()); err != nil {
+		return errors.NewApplicationError(errors.ProjectDeletionFailed, "プロジェクトの削除に失敗しました")
+	}
+
+	return nil
+}
+
+// GetBillableSummary プロジェクトの請求対象経費を集計
+// 承認済み（支払済みを含む）で請求対象の経費に上乗せ率を適用し、通貨ごとに合計する
+func (uc *ProjectUseCase) GetBillableSummary(ctx context.Context, projectID string, req *dto.BillableSummaryRequest) (*dto.BillableSummaryResponse, error) {
+	project, err := uc.findProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	dateFrom, dateTo, err := parseDatePeriod(req.DateFrom, req.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &dto.BillableSummaryResponse{
+		Project:          buildProjectResponse(project),
+		DateFrom:         req.DateFrom,
+		DateTo:           req.DateTo,
+		MarkupPercentage: req.MarkupPercentage,
+		Expenses:         make([]*dto.BillableExpenseResponse, 0),
+		Totals:           make([]*dto.BillableTotalResponse, 0),
+	}
+
+	// 予算の消化額は期間や請求対象かどうかにかかわらず、予算と同じ通貨の承認済みの経費を集計する
+	var spent *valueobject.Money
+	if budget := project.Budget(); budget != nil {
+		spent, _ = valueobject.NewMoney(0, budget.Currency())
+	}
+
+	type billableTotal struct {
+		count                  int
+		amount, markup, billed *valueobject.Money
+	}
+	totals := make(map[string]*billableTotal)
+	userNames := make(map[string]string)
+	categoryNames := make(map[string]string)
+
+	filter := repository.ExpenseFilter{Status: entity.ExpenseStatusApproved}
+	err = uc.expenseRepo.Iterate(ctx, filter, func(expense *entity.Expense) error {
+		if !project.ID().Equals(expense.ProjectID()) {
+			return nil
+		}
+
+		if spent != nil && expense.Amount().Currency() == spent.Currency() {
+			var err error
+			if spent, err = spent.Add(expense.Amount()); err != nil {
+				return err
+			}
+		}
+
+		if !expense.IsBillable() {
+			return nil
+		}
+		if !dateFrom.IsZero() && expense.Date().Before(dateFrom) {
+			return nil
+		}
+		if !dateTo.IsZero() && expense.Date().After(dateTo) {
+			return nil
+		}
+
+		markup, billed, err := entity.ApplyMarkup(expense.Amount(), req.MarkupPercentage)
+		if err != nil {
+			return err
+		}
+
+		summary.Expenses = append(summary.Expenses, &dto.BillableExpenseResponse{
+			ExpenseID:    expense.ID().String(),
+			Date:         expense.Date().String(),
+			UserID:       expense.UserID().String(),
+			UserName:     uc.billableUserName(ctx, expense.UserID(), userNames),
+			CategoryName: uc.billableCategoryName(ctx, expense.CategoryID(), categoryNames),
+			Title:        expense.Title(),
+			Currency:     expense.Amount().Currency(),
+			Amount:       expense.Amount().Amount(),
+			Markup:       markup.Amount(),
+			BilledAmount: billed.Amount(),
+		})
+
+		currency := expense.Amount().Currency()
+		total, ok := totals[currency]
+		if !ok {
+			total = &billableTotal{amount: expense.Amount(), markup: markup, billed: billed}
+			totals[currency] = total
+		} else {
+			if total.amount, err = total.amount.Add(expense.Amount()); err != nil {
+				return err
+			}
+			if total.markup, err = total.markup.Add(markup); err != nil {
+				return err
+			}
+			if total.billed, err = total.billed.Add(billed); err != nil {
+				return err
+			}
+		}
+		total.count++
+		return nil
+	})
+	if err != nil {
+		return nil, errors.NewApplicationError("EXPENSE_FETCH_FAILED", "経費一覧の取得に失敗しました")
+	}
+
+	// 経費は日付順、合計は通貨順に並べる
+	sort.SliceStable(summary.Expenses, func(i, j int) bool {
+		return summary.Expenses[i].Date < summary.Expenses[j].Date
+	})
+	for currency, total := range totals {
+		summary.Totals = append(summary.Totals, &dto.BillableTotalResponse{
+			Currency:     currency,
+			Count:        total.count,
+			Amount:       total.amount.Amount(),
+			Markup:       total.markup.Amount(),
+			BilledAmount: total.billed.Amount(),
+		})
+	}
+	sort.Slice(summary.Totals, func(i, j int) bool {
+		return summary.Totals[i].Currency < summary.Totals[j].Currency
+	})
+
+	if budget := project.Budget(); budget != nil {
+		summary.Budget = &dto.ProjectBudgetResponse{
+			Amount:    budget.Amount(),
+			Currency:  budget.Currency(),
+			Spent:     spent.Amount(),
+			Remaining: math.Round((budget.Amount()-spent.Amount())*100) / 100,
+		}
+	}
+
+	return summary, nil
+}
+
+// ExportBillable プロジェクトの請求対象経費を集計し、請求書作成用のエクスポートを準備
+func (uc *ProjectUseCase) ExportBillable(ctx context.Context, projectID string, req *dto.ExportBillableRequest) (*BillableExport, error) {
+	summary, err := uc.GetBillableSummary(ctx, projectID, &req.BillableSummaryRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &BillableExport{
+		summary:  summary,
+		format:   req.Format,
+		encoding: req.Encoding,
+		now:      uc.clock.Now(),
+	}
+	if export.format == "" {
+		export.format = "csv"
+	}
+	if export.encoding == "" {
+		export.encoding = "utf-8"
+	}
+
+	return export, nil
+}
+
+// findProject IDでプロジェクトを検索
+func (uc *ProjectUseCase) findProject(ctx context.Context, projectID string) (*entity.Project, error) {
+	id, err := valueobject.NewProjectID(projectID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	project, err := uc.projectRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ProjectNotFound, "プロジェクトが見つかりません")
+	}
+
+	return project, nil
+}
+
+// billableUserName 申請者の名前を取得（集計中は取得済みの名前を使う）
+func (uc *ProjectUseCase) billableUserName(ctx context.Context, userID *valueobject.UserID, names map[string]string) string {
+	if name, ok := names[userID.String()]; ok {
+		return name
+	}
+
+	name := ""
+	if user, err := uc.userRepo.FindByID(ctx, userID); err == nil {
+		name = user.Name()
+	}
+	names[userID.String()] = name
+	return name
+}
+
+// billableCategoryName カテゴリ名を取得（集計中は取得済みの名前を使う）
+func (uc *ProjectUseCase) billableCategoryName(ctx context.Context, categoryID *valueobject.CategoryID, names map[string]string) string {
+	if name, ok := names[categoryID.String()]; ok {
+		return name
+	}
+
+	name := ""
+	if category, err := uc.categoryRepo.FindByID(ctx, categoryID); err == nil {
+		name = category.Name()
+	}
+	names[categoryID.String()] = name
+	return name
+}
+
+// parseProjectTerms リクエストのプロジェクトの期間と予算を変換（予算が0の場合は予算なし）
+func parseProjectTerms(startDate, endDate string, budget float64, currency string) (valueobject.Date, valueobject.Date, *valueobject.Money, error) {
+	start, err := valueobject.ParseDate(startDate)
+	if err != nil {
+		return valueobject.Date{}, valueobject.Date{}, nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	var end valueobject.Date
+	if endDate != "" {
+		if end, err = valueobject.ParseDate(endDate); err != nil {
+			return valueobject.Date{}, valueobject.Date{}, nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+	}
+
+	var money *valueobject.Money
+	if budget > 0 {
+		if money, err = valueobject.NewMoney(budget, currency); err != nil {
+			return valueobject.Date{}, valueobject.Date{}, nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+	}
+
+	return start, end, money, nil
+}
+
+// buildProjectResponse プロジェクトレスポンスを構築
+func buildProjectResponse(project *entity.Project) *dto.ProjectResponse {
+	response := &dto.ProjectResponse{
+		ID:        project.ID().String(),
+		Code:      project.Code(),
+		Name:      project.Name(),
+		Client:    project.Client(),
+		StartDate: project.StartDate().String(),
+		CreatedAt: project.CreatedAt(),
+		UpdatedAt: project.UpdatedAt(),
+	}
+
+	if !project.EndDate().IsZero() {
+		response.EndDate = project.EndDate().String()
+	}
+
+	if budget := project.Budget(); budget != nil {
+		response.Budget = budget.Amount()
+		response.Currency = budget.Currency()
+	}
+
+	return response
+}
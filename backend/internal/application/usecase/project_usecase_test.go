@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/infrastructure/persistence"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectUseCase(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	projectRepo := persistence.NewMemoryProjectRepository()
+
+	// ユースケースを初期化
+	useCase := NewProjectUseCase(projectRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+	userUseCase := NewUserUseCase(userRepo, persistence.NewMemoryDepartmentRepository(), persistence.NewMemoryCostCenterRepository(), fakeClock)
+	categoryUseCase := NewCategoryUseCase(categoryRepo, expenseRepo, fakeClock)
+	expenseUseCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock, WithProjectRepository(projectRepo))
+
+	user, err := userUseCase.CreateUser(ctx, &dto.CreateUserRequest{Name: "山田太郎", Email: "yamada@example.com"})
+	require.NoError(t, err)
+	category, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{Name: "交通費"})
+	require.NoError(t, err)
+
+	today := fakeClock.Now()
+	project, err := useCase.CreateProject(ctx, &dto.CreateProjectRequest{
+		Code: "PJ-001", Name: "基幹システム刷新", Client: "株式会社サンプル",
+		StartDate: today.AddDate(0, -1, 0).Format("2006-01-02"), Budget: 20000,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "JPY", project.Currency)
+
+	createExpense := func(amount float64, date time.Time, projectID string, billable bool) (*dto.ExpenseResponse, error) {
+		return expenseUseCase.CreateExpense(ctx, user.ID, &dto.CreateExpenseRequest{
+			CategoryID: category.ID, Amount: amount, Title: "客先訪問", Date: date.Format("2006-01-02"),
+			ProjectID: projectID, Billable: billable,
+		})
+	}
+
+	t.Run("プロジェクトコードの重複はエラー", func(t *testing.T) {
+		_, err := useCase.CreateProject(ctx, &dto.CreateProjectRequest{
+			Code: "PJ-001", Name: "重複", Client: "顧客", StartDate: today.Format("2006-01-02"),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("経費をプロジェクトに関連付ける", func(t *testing.T) {
+		expense, err := createExpense(1000, today, project.ID, true)
+		require.NoError(t, err)
+		assert.Equal(t, project.ID, expense.ProjectID)
+		assert.True(t, expense.Billable)
+
+		_, err = createExpense(1000, today.AddDate(0, -2, 0), project.ID, true)
+		assert.Error(t, err, "プロジェクトの期間外の経費")
+		_, err = createExpense(1000, today, "", true)
+		assert.Error(t, err, "プロジェクトのない請求対象の経費")
+	})
+
+	billable, err := createExpense(10000, today, project.ID, true)
+	require.NoError(t, err)
+	internal, err := createExpense(3000, today, project.ID, false)
+	require.NoError(t, err)
+	for _, id := range []string{billable.ID, internal.ID} {
+		_, err := expenseUseCase.SubmitExpense(ctx, id)
+		require.NoError(t, err)
+		_, err = expenseUseCase.ApproveExpense(ctx, id)
+		require.NoError(t, err)
+	}
+
+	t.Run("承認済みの請求対象の経費に上乗せ率を適用して集計する", func(t *testing.T) {
+		summary, err := useCase.GetBillableSummary(ctx, project.ID, &dto.BillableSummaryRequest{MarkupPercentage: 10})
+		require.NoError(t, err)
+		require.Len(t, summary.Expenses, 1, "下書きと請求対象外の経費は含めない")
+		assert.Equal(t, billable.ID, summary.Expenses[0].ExpenseID)
+		assert.Equal(t, "山田太郎", summary.Expenses[0].UserName)
+		assert.Equal(t, 1000.0, summary.Expenses[0].Markup)
+
+		require.Len(t, summary.Totals, 1)
+		assert.Equal(t, 11000.0, summary.Totals[0].BilledAmount)
+
+		// 予算は請求対象かどうかにかかわらず承認済みの経費で消化する
+		require.NotNil(t, summary.Budget)
+		assert.Equal(t, 13000.0, summary.Budget.Spent)
+		assert.Equal(t, 7000.0, summary.Budget.Remaining)
+
+		summary, err = useCase.GetBillableSummary(ctx, project.ID, &dto.BillableSummaryRequest{
+			DateFrom: today.AddDate(0, 0, 1).Format("2006-01-02"),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, summary.Expenses)
+	})
+
+	t.Run("請求書作成用にCSVで出力する", func(t *testing.T) {
+		export, err := useCase.ExportBillable(ctx, project.ID, &dto.ExportBillableRequest{
+			BillableSummaryRequest: dto.BillableSummaryRequest{MarkupPercentage: 10},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, export.Count())
+		assert.Equal(t, "billable_PJ-001_"+today.Format("20060102")+".csv", export.FileName())
+
+		var buf bytes.Buffer
+		require.NoError(t, export.Write(&buf))
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, []string{"合計", "", "", "", "", "JPY", "10000", "1000", "11000"}, records[2])
+	})
+
+	t.Run("経費が関連付けられたプロジェクトは削除できない", func(t *testing.T) {
+		assert.Error(t, useCase.DeleteProject(ctx, project.ID))
+
+		unused, err := useCase.CreateProject(ctx, &dto.CreateProjectRequest{
+			Code: "PJ-002", Name: "保守", Client: "株式会社サンプル", StartDate: today.Format("2006-01-02"),
+		})
+		require.NoError(t, err)
+		require.NoError(t, useCase.DeleteProject(ctx, unused.ID))
+	})
+}
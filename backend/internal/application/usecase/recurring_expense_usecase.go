@@ -0,0 +1,390 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+)
+
+// RecurringExpenseUseCase 定期経費ユースケース
+type RecurringExpenseUseCase struct {
+	recurringRepo repository.RecurringExpenseRepository
+	expenseRepo   repository.ExpenseRepository
+	userRepo      repository.UserRepository
+	categoryRepo  repository.CategoryRepository
+	periodGuard   *fiscalPeriodGuard
+	clock         clock.Clock
+}
+
+// RecurringExpenseUseCaseOption RecurringExpenseUseCaseの任意の依存関係を設定するオプション
+type RecurringExpenseUseCaseOption func(*RecurringExpenseUseCase)
+
+// WithRecurringFiscalPeriods 会計期間を設定（未設定の場合は締め済みの会計期間を確認しない）
+func WithRecurringFiscalPeriods(calendar *valueobject.FiscalCalendar, periodRepo repository.AccountingPeriodRepository) RecurringExpenseUseCaseOption {
+	return func(uc *RecurringExpenseUseCase) {
+		uc.periodGuard = &fiscalPeriodGuard{calendar: calendar, periodRepo: periodRepo, clock: uc.clock}
+	}
+}
+
+// NewRecurringExpenseUseCase RecurringExpenseUseCaseのコンストラクタ
+func NewRecurringExpenseUseCase(
+	recurringRepo repository.RecurringExpenseRepository,
+	expenseRepo repository.ExpenseRepository,
+	userRepo repository.UserRepository,
+	categoryRepo repository.CategoryRepository,
+	clk clock.Clock,
+	opts ...RecurringExpenseUseCaseOption,
+) *RecurringExpenseUseCase {
+	uc := &RecurringExpenseUseCase{
+		recurringRepo: recurringRepo,
+		expenseRepo:   expenseRepo,
+		userRepo:      userRepo,
+		categoryRepo:  categoryRepo,
+		clock:         clk,
+	}
+
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
+
+// CreateRecurringExpense ユーザーの定期経費を作成
+func (uc *RecurringExpenseUseCase) CreateRecurringExpense(ctx context.Context, userID string, req *dto.CreateRecurringExpenseRequest) (*dto.RecurringExpenseResponse, error) {
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if _, err := uc.userRepo.FindByID(ctx, uid); err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	category, err := uc.findCategory(ctx, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := valueobject.NewMoney(req.Amount, req.Currency)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	schedule, startDate, endDate, err := parseRecurrence(req.Frequency, req.DayOfMonth, req.Weekday, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	recurring, err := entity.NewRecurringExpense(uc.clock, uid, category.ID(), amount, req.Title, req.Description, schedule, startDate, endDate)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if req.Paused {
+		recurring.ChangePaused(true, uc.clock.Now())
+	}
+
+	if err := uc.recurringRepo.Save(ctx, recurring); err != nil {
+		return nil, errors.NewApplicationError(errors.RecurringExpenseCreationFailed, "定期経費の作成に失敗しました")
+	}
+
+	return buildRecurringExpenseResponse(recurring, category), nil
+}
+
+// GetRecurringExpense 定期経費を取得
+func (uc *RecurringExpenseUseCase) GetRecurringExpense(ctx context.Context, recurringID string) (*dto.RecurringExpenseResponse, error) {
+	recurring, err := uc.findRecurringExpense(ctx, recurringID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.buildResponse(ctx, recurring), nil
+}
+
+// GetRecurringExpensesByUser ユーザーの定期経費を取得
+func (uc *RecurringExpenseUseCase) GetRecurringExpensesByUser(ctx context.Context, userID string) ([]*dto.RecurringExpenseResponse, error) {
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if _, err := uc.userRepo.FindByID(ctx, uid); err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	recurrings, err := uc.recurringRepo.FindByUserID(ctx, uid)
+	if err != nil {
+		return nil, errors.NewApplicationError("RECURRING_EXPENSE_FETCH_FAILED", "定期経費一覧の取得に失敗しました")
+	}
+
+	responses := make([]*dto.RecurringExpenseResponse, len(recurrings))
+	for i, recurring := range recurrings {
+		responses[i] = uc.buildResponse(ctx, recurring)
+	}
+
+	return responses, nil
+}
+
+// UpdateRecurringExpense 定期経費を更新（作成済みの経費は変更しない）
+func (uc *RecurringExpenseUseCase) UpdateRecurringExpense(ctx context.Context, recurringID string, req *dto.UpdateRecurringExpenseRequest) (*dto.RecurringExpenseResponse, error) {
+	recurring, err := uc.findRecurringExpense(ctx, recurringID)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := uc.findCategory(ctx, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := valueobject.NewMoney(req.Amount, req.Currency)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	schedule, startDate, endDate, err := parseRecurrence(req.Frequency, req.DayOfMonth, req.Weekday, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recurring.Update(category.ID(), amount, req.Title, req.Description, schedule, startDate, endDate, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+	recurring.ChangePaused(req.Paused, uc.clock.Now())
+
+	if err := uc.recurringRepo.Update(ctx, recurring); err != nil {
+		return nil, errors.NewApplicationError(errors.RecurringExpenseUpdateFailed, "定期経費の更新に失敗しました")
+	}
+
+	return buildRecurringExpenseResponse(recurring, category), nil
+}
+
+// DeleteRecurringExpense 定期経費を削除（作成済みの経費は削除しない）
+func (uc *RecurringExpenseUseCase) DeleteRecurringExpense(ctx context.Context, recurringID string) error {
+	recurring, err := uc.findRecurringExpense(ctx, recurringID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.recurringRepo.Delete(ctx, recurring.ID()); err != nil {
+		return errors.NewApplicationError(errors.RecurringExpenseDeletionFailed, "定期経費の削除に失敗しました")
+	}
+
+	return nil
+}
+
+// GenerateDueExpenses 発生日が到来した定期経費から下書きの経費を作成
+// 発生日は利用者のタイムゾーンの今日までとし、次の場合は経費を作成せずに処理済みとする
+//   - 同じ日付・カテゴリ・金額・件名の経費が既にある（手入力した経費や、前回の実行で作成済みの経費）
+//   - 発生日がカテゴリの経費日付として認める期間外
+//   - 発生日の会計期間が締め済み
+//
+// 定期経費ごとの作成・保存の失敗は結果に記録し、残りの定期経費の処理を継続する
+// 作成に失敗した発生日以降は処理済みにせず、次回の実行で作成し直す
+func (uc *RecurringExpenseUseCase) GenerateDueExpenses(ctx context.Context) (*dto.RecurringExpenseRunResult, error) {
+	recurrings, err := uc.recurringRepo.FindAll(ctx)
+	if err != nil {
+		return nil, errors.NewApplicationError("RECURRING_EXPENSE_FETCH_FAILED", "定期経費一覧の取得に失敗しました")
+	}
+
+	result := &dto.RecurringExpenseRunResult{Failures: make([]*dto.RecurringExpenseFailure, 0)}
+	now := uc.clock.Now()
+
+	for _, stored := range recurrings {
+		if stored.IsPaused() {
+			continue
+		}
+
+		// 処理済みの発生日の保存に失敗した場合に保存済みの定期経費を変更しないよう複製に反映する
+		recurring := stored.Copy()
+
+		// ユーザーまたはカテゴリが削除された定期経費は処理しない
+		user, err := uc.userRepo.FindByID(ctx, recurring.UserID())
+		if err != nil {
+			continue
+		}
+		category, err := uc.categoryRepo.FindByID(ctx, recurring.CategoryID())
+		if err != nil {
+			continue
+		}
+
+		dueDates := recurring.DueDates(valueobject.DateIn(now, user.Location()))
+		if len(dueDates) == 0 {
+			continue
+		}
+
+		for _, date := range dueDates {
+			created, err := uc.generateExpense(ctx, recurring, user, category, date)
+			if err != nil {
+				result.Failures = append(result.Failures, &dto.RecurringExpenseFailure{
+					RecurringExpenseID: recurring.ID().String(),
+					Date:               date.String(),
+					Message:            "経費の作成に失敗しました: " + err.Error(),
+				})
+				break
+			}
+
+			if created {
+				result.CreatedCount++
+			} else {
+				result.SkippedCount++
+			}
+			recurring.MarkProcessed(date, now)
+		}
+
+		if err := uc.recurringRepo.Update(ctx, recurring); err != nil {
+			result.Failures = append(result.Failures, &dto.RecurringExpenseFailure{
+				RecurringExpenseID: recurring.ID().String(),
+				Message:            "定期経費の処理済みの発生日の更新に失敗しました: " + err.Error(),
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// generateExpense 発生日の下書きの経費を作成（作成しなかった場合はfalse）
+func (uc *RecurringExpenseUseCase) generateExpense(ctx context.Context, recurring *entity.RecurringExpense, user *entity.User, category *entity.Category, date valueobject.Date) (bool, error) {
+	// カテゴリの経費日付として認める期間外の発生日は作成しない
+	if _, err := category.CheckExpenseDate(user, date, uc.clock.Now()); err != nil {
+		if domainErr, ok := err.(*errors.DomainError); ok && domainErr.Code == errors.InvalidExpenseDate {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// 締め済みの会計期間の発生日は作成しない（会計期間の取得の失敗はエラーとし、処理済みにしない）
+	if err := uc.periodGuard.ensureOpen(ctx, date); err != nil {
+		if isFiscalPeriodClosed(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	existing, err := uc.expenseRepo.FindByDateRange(ctx, recurring.UserID(), date, date)
+	if err != nil {
+		return false, errors.NewApplicationError("EXPENSE_FETCH_FAILED", "経費一覧の取得に失敗しました")
+	}
+	for _, expense := range existing {
+		if expense.CategoryID().Equals(recurring.CategoryID()) && expense.Amount().Equals(recurring.Amount()) && expense.Title() == recurring.Title() {
+			return false, nil
+		}
+	}
+
+	expense, err := entity.NewExpense(uc.clock, recurring.UserID(), recurring.CategoryID(), recurring.Amount(), recurring.Title(), recurring.Description(), date)
+	if err != nil {
+		return false, errors.NewApplicationError(errors.RecurringExpenseGenerationFailed, err.Error())
+	}
+
+	if err := uc.expenseRepo.Save(ctx, expense); err != nil {
+		return false, errors.NewApplicationError(errors.ExpenseCreationFailed, "定期経費の経費の作成に失敗しました")
+	}
+
+	return true, nil
+}
+
+// findRecurringExpense IDで定期経費を取得
+func (uc *RecurringExpenseUseCase) findRecurringExpense(ctx context.Context, recurringID string) (*entity.RecurringExpense, error) {
+	id, err := valueobject.NewRecurringExpenseID(recurringID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	recurring, err := uc.recurringRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.RecurringExpenseNotFound, "定期経費が見つかりません")
+	}
+
+	return recurring, nil
+}
+
+// findCategory IDでカテゴリを取得
+func (uc *RecurringExpenseUseCase) findCategory(ctx context.Context, categoryID string) (*entity.Category, error) {
+	cid, err := valueobject.NewCategoryID(categoryID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	category, err := uc.categoryRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
+	}
+
+	return category, nil
+}
+
+// buildResponse カテゴリ名を含めて定期経費レスポンスを構築（削除済みのカテゴリは名前なし）
+func (uc *RecurringExpenseUseCase) buildResponse(ctx context.Context, recurring *entity.RecurringExpense) *dto.RecurringExpenseResponse {
+	category, err := uc.categoryRepo.FindByID(ctx, recurring.CategoryID())
+	if err != nil {
+		category = nil
+	}
+
+	return buildRecurringExpenseResponse(recurring, category)
+}
+
+// parseRecurrence リクエストの発生日の規則と期間を変換
+func parseRecurrence(frequency string, dayOfMonth int, weekday, startDate, endDate string) (*valueobject.RecurrenceSchedule, valueobject.Date, valueobject.Date, error) {
+	var schedule *valueobject.RecurrenceSchedule
+	var err error
+	switch valueobject.RecurrenceFrequency(frequency) {
+	case valueobject.RecurrenceMonthly:
+		schedule, err = valueobject.NewMonthlySchedule(dayOfMonth)
+	case valueobject.RecurrenceWeekly:
+		schedule, err = valueobject.NewWeeklySchedule(weekday)
+	default:
+		err = errors.NewDomainError(errors.InvalidRecurringExpense, "無効な繰り返しの単位です: "+frequency)
+	}
+	if err != nil {
+		return nil, valueobject.Date{}, valueobject.Date{}, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	start, err := valueobject.ParseDate(strings.TrimSpace(startDate))
+	if err != nil {
+		return nil, valueobject.Date{}, valueobject.Date{}, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	var end valueobject.Date
+	if endDate != "" {
+		if end, err = valueobject.ParseDate(strings.TrimSpace(endDate)); err != nil {
+			return nil, valueobject.Date{}, valueobject.Date{}, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+	}
+
+	return schedule, start, end, nil
+}
+
+// buildRecurringExpenseResponse 定期経費レスポンスを構築
+func buildRecurringExpenseResponse(recurring *entity.RecurringExpense, category *entity.Category) *dto.RecurringExpenseResponse {
+	response := &dto.RecurringExpenseResponse{
+		ID:               recurring.ID().String(),
+		UserID:           recurring.UserID().String(),
+		CategoryID:       recurring.CategoryID().String(),
+		Amount:           recurring.Amount().Amount(),
+		Currency:         recurring.Amount().Currency(),
+		Title:            recurring.Title(),
+		Description:      recurring.Description(),
+		Frequency:        string(recurring.Schedule().Frequency()),
+		DayOfMonth:       recurring.Schedule().DayOfMonth(),
+		Weekday:          recurring.Schedule().Weekday(),
+		StartDate:        recurring.StartDate().String(),
+		EndDate:          recurring.EndDate().String(),
+		Paused:           recurring.IsPaused(),
+		NextDate:         recurring.NextDate().String(),
+		ProcessedThrough: recurring.ProcessedThrough().String(),
+		CreatedAt:        recurring.CreatedAt(),
+		UpdatedAt:        recurring.UpdatedAt(),
+	}
+
+	if category != nil {
+		response.CategoryName = category.Name()
+	}
+
+	return response
+}
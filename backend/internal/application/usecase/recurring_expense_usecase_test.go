@@ -0,0 +1,298 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/internal/infrastructure/persistence"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurringExpenseUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	recurringRepo := persistence.NewMemoryRecurringExpenseRepository()
+
+	// 現在日時を日本時間の2026年4月10日9時に固定
+	jst := time.FixedZone("JST", 9*60*60)
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, jst))
+
+	// ユースケースを初期化
+	useCase := NewRecurringExpenseUseCase(recurringRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+	expenseUseCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock)
+	userUseCase := NewUserUseCase(userRepo, persistence.NewMemoryDepartmentRepository(), persistence.NewMemoryCostCenterRepository(), fakeClock)
+	categoryUseCase := NewCategoryUseCase(categoryRepo, expenseRepo, fakeClock)
+
+	user, err := userUseCase.CreateUser(ctx, &dto.CreateUserRequest{Name: "山田太郎", Email: "yamada@example.com"})
+	require.NoError(t, err)
+	phone, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{Name: "通信費"})
+	require.NoError(t, err)
+
+	// 30日より前の日付は登録できないカテゴリ
+	commute, err := categoryUseCase.CreateCategory(ctx, &dto.CreateCategoryRequest{
+		Name:       "通勤定期券",
+		DatePolicy: &dto.DatePolicyRequest{MaxAgeDays: 30, Enforcement: "error"},
+	})
+	require.NoError(t, err)
+
+	phoneAllowance, err := useCase.CreateRecurringExpense(ctx, user.ID, &dto.CreateRecurringExpenseRequest{
+		CategoryID: phone.ID, Amount: 3000, Title: "携帯電話手当",
+		Frequency: "monthly", DayOfMonth: 25, StartDate: "2026-01-01",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "JPY", phoneAllowance.Currency)
+	assert.Equal(t, "2026-01-25", phoneAllowance.NextDate)
+
+	commutePass, err := useCase.CreateRecurringExpense(ctx, user.ID, &dto.CreateRecurringExpenseRequest{
+		CategoryID: commute.ID, Amount: 18000, Title: "通勤定期券",
+		Frequency: "monthly", DayOfMonth: 1, StartDate: "2026-02-01",
+	})
+	require.NoError(t, err)
+
+	_, err = useCase.CreateRecurringExpense(ctx, user.ID, &dto.CreateRecurringExpenseRequest{
+		CategoryID: phone.ID, Amount: 1500, Title: "クラウドストレージ",
+		Frequency: "weekly", Weekday: "mon", StartDate: "2026-01-01", Paused: true,
+	})
+	require.NoError(t, err)
+
+	// 2月分の携帯電話手当は手入力済み
+	_, err = expenseUseCase.CreateExpense(ctx, user.ID, &dto.CreateExpenseRequest{
+		CategoryID: phone.ID, Amount: 3000, Title: "携帯電話手当", Date: "2026-02-25",
+	})
+	require.NoError(t, err)
+
+	t.Run("発生日が到来した定期経費から下書きの経費を作成する", func(t *testing.T) {
+		result, err := useCase.GenerateDueExpenses(ctx)
+		require.NoError(t, err)
+
+		// 携帯電話手当: 1月・3月を作成し、手入力済みの2月は重複のため作成しない
+		// 通勤定期券: 30日より前の2月・3月は作成せず、4月を作成する
+		// 一時停止中の定期経費は作成しない
+		assert.Equal(t, 3, result.CreatedCount)
+		assert.Equal(t, 3, result.SkippedCount)
+
+		expenses, err := expenseUseCase.GetExpensesByUser(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, expenses, 4)
+		for _, expense := range expenses {
+			assert.Equal(t, "draft", expense.Status)
+		}
+
+		recurring, err := useCase.GetRecurringExpense(ctx, commutePass.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-04-01", recurring.ProcessedThrough)
+		assert.Equal(t, "2026-05-01", recurring.NextDate)
+	})
+
+	t.Run("処理済みの発生日の経費は再び作成しない", func(t *testing.T) {
+		result, err := useCase.GenerateDueExpenses(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.CreatedCount)
+		assert.Equal(t, 0, result.SkippedCount)
+
+		// 4月25日になると4月分の携帯電話手当を作成する
+		fakeClock.Set(time.Date(2026, 4, 25, 9, 0, 0, 0, jst))
+		result, err = useCase.GenerateDueExpenses(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.CreatedCount)
+	})
+
+	t.Run("定期経費を更新・削除しても作成済みの経費は残る", func(t *testing.T) {
+		updated, err := useCase.UpdateRecurringExpense(ctx, phoneAllowance.ID, &dto.UpdateRecurringExpenseRequest{
+			CategoryID: phone.ID, Amount: 3500, Title: "携帯電話手当",
+			Frequency: "monthly", DayOfMonth: 31, StartDate: "2026-01-01", EndDate: "2026-12-31",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3500.0, updated.Amount)
+		assert.Equal(t, "2026-04-30", updated.NextDate)
+
+		require.NoError(t, useCase.DeleteRecurringExpense(ctx, phoneAllowance.ID))
+		_, err = useCase.GetRecurringExpense(ctx, phoneAllowance.ID)
+		assert.Error(t, err)
+
+		recurrings, err := useCase.GetRecurringExpensesByUser(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Len(t, recurrings, 2)
+
+		expenses, err := expenseUseCase.GetExpensesByUser(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Len(t, expenses, 5)
+	})
+
+	t.Run("不正な発生日の規則はエラー", func(t *testing.T) {
+		_, err := useCase.CreateRecurringExpense(ctx, user.ID, &dto.CreateRecurringExpenseRequest{
+			CategoryID: phone.ID, Amount: 1000, Title: "サブスクリプション",
+			Frequency: "monthly", StartDate: "2026-04-01",
+		})
+		assert.Error(t, err, "毎月の日なし")
+
+		_, err = useCase.CreateRecurringExpense(ctx, user.ID, &dto.CreateRecurringExpenseRequest{
+			CategoryID: phone.ID, Amount: 1000, Title: "サブスクリプション",
+			Frequency: "weekly", Weekday: "mon", StartDate: "2026-04-01", EndDate: "2026-03-31",
+		})
+		assert.Error(t, err, "終了日が開始日より前")
+	})
+}
+
+// failingAccountingPeriodRepository 会計期間の取得に失敗するテスト用リポジトリ
+type failingAccountingPeriodRepository struct {
+	*persistence.MemoryAccountingPeriodRepository
+	fail bool
+}
+
+func (r *failingAccountingPeriodRepository) FindByPeriod(ctx context.Context, period valueobject.FiscalPeriod) (*entity.AccountingPeriod, error) {
+	if r.fail {
+		return nil, fmt.Errorf("storage unavailable")
+	}
+	return r.MemoryAccountingPeriodRepository.FindByPeriod(ctx, period)
+}
+
+func TestRecurringExpenseUseCase_FiscalPeriods(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化（会計期間の取得は失敗する状態から始める）
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	recurringRepo := persistence.NewMemoryRecurringExpenseRepository()
+	periodRepo := &failingAccountingPeriodRepository{MemoryAccountingPeriodRepository: persistence.NewMemoryAccountingPeriodRepository(), fail: true}
+
+	// ユースケースを初期化（4月開始の会計年度）
+	calendar, err := valueobject.NewFiscalCalendar(4)
+	require.NoError(t, err)
+	useCase := NewRecurringExpenseUseCase(recurringRepo, expenseRepo, userRepo, categoryRepo, fakeClock, WithRecurringFiscalPeriods(calendar, periodRepo))
+
+	user, _ := entity.NewUser(fakeClock, "山田太郎", "yamada@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+	finance, _ := entity.NewUser(fakeClock, "経理花子", "keiri@example.com")
+	require.NoError(t, finance.ChangeRole(entity.UserRoleFinance, fakeClock.Now()))
+	require.NoError(t, userRepo.Save(ctx, finance))
+	category, _ := entity.NewCategory(fakeClock, "通信費", "", "")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	// 3月の会計期間は締め済み
+	march, err := valueobject.NewDate(2026, 3, 1)
+	require.NoError(t, err)
+	closed := entity.NewAccountingPeriod(fakeClock, calendar.PeriodOf(march))
+	require.NoError(t, closed.Close(finance, fakeClock.Now()))
+	require.NoError(t, periodRepo.Save(ctx, closed))
+
+	recurring, err := useCase.CreateRecurringExpense(ctx, user.ID().String(), &dto.CreateRecurringExpenseRequest{
+		CategoryID: category.ID().String(), Amount: 3000, Title: "携帯電話手当",
+		Frequency: "monthly", DayOfMonth: 1, StartDate: "2026-03-01",
+	})
+	require.NoError(t, err)
+
+	allowance, err := useCase.CreateRecurringExpense(ctx, user.ID().String(), &dto.CreateRecurringExpenseRequest{
+		CategoryID: category.ID().String(), Amount: 5000, Title: "在宅勤務手当",
+		Frequency: "monthly", DayOfMonth: 5, StartDate: "2026-04-01",
+	})
+	require.NoError(t, err)
+
+	t.Run("会計期間の取得に失敗した場合は結果に記録して残りの定期経費を処理し、発生日を処理済みにしない", func(t *testing.T) {
+		result, err := useCase.GenerateDueExpenses(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.CreatedCount)
+		require.Len(t, result.Failures, 2)
+
+		failures := make(map[string]string)
+		for _, failure := range result.Failures {
+			failures[failure.RecurringExpenseID] = failure.Date
+		}
+		assert.Equal(t, map[string]string{recurring.ID: "2026-03-01", allowance.ID: "2026-04-05"}, failures)
+
+		fetched, err := useCase.GetRecurringExpense(ctx, recurring.ID)
+		require.NoError(t, err)
+		assert.Empty(t, fetched.ProcessedThrough)
+		assert.Equal(t, "2026-03-01", fetched.NextDate)
+	})
+
+	t.Run("締め済みの会計期間の発生日は作成せずに処理済みにする", func(t *testing.T) {
+		periodRepo.fail = false
+
+		result, err := useCase.GenerateDueExpenses(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.CreatedCount)
+		assert.Equal(t, 1, result.SkippedCount)
+		assert.Empty(t, result.Failures)
+
+		fetched, err := useCase.GetRecurringExpense(ctx, recurring.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-04-01", fetched.ProcessedThrough)
+	})
+}
+
+// failingUpdateRecurringExpenseRepository 定期経費の更新に失敗するテスト用リポジトリ
+type failingUpdateRecurringExpenseRepository struct {
+	*persistence.MemoryRecurringExpenseRepository
+	fail bool
+}
+
+func (r *failingUpdateRecurringExpenseRepository) Update(ctx context.Context, recurring *entity.RecurringExpense) error {
+	if r.fail {
+		return fmt.Errorf("storage unavailable")
+	}
+	return r.MemoryRecurringExpenseRepository.Update(ctx, recurring)
+}
+
+func TestRecurringExpenseUseCase_UpdateFailure(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化（定期経費の更新は失敗する状態から始める）
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	recurringRepo := &failingUpdateRecurringExpenseRepository{MemoryRecurringExpenseRepository: persistence.NewMemoryRecurringExpenseRepository(), fail: true}
+	useCase := NewRecurringExpenseUseCase(recurringRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	user, _ := entity.NewUser(fakeClock, "山田太郎", "yamada@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+	category, _ := entity.NewCategory(fakeClock, "通信費", "", "")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	recurring, err := useCase.CreateRecurringExpense(ctx, user.ID().String(), &dto.CreateRecurringExpenseRequest{
+		CategoryID: category.ID().String(), Amount: 3000, Title: "携帯電話手当",
+		Frequency: "monthly", DayOfMonth: 1, StartDate: "2026-04-01",
+	})
+	require.NoError(t, err)
+
+	t.Run("処理済みの発生日を保存できなかった場合は結果に記録し、保存済みの定期経費を変更しない", func(t *testing.T) {
+		result, err := useCase.GenerateDueExpenses(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.CreatedCount)
+		require.Len(t, result.Failures, 1)
+		assert.Equal(t, recurring.ID, result.Failures[0].RecurringExpenseID)
+		assert.Empty(t, result.Failures[0].Date)
+
+		fetched, err := useCase.GetRecurringExpense(ctx, recurring.ID)
+		require.NoError(t, err)
+		assert.Empty(t, fetched.ProcessedThrough)
+	})
+
+	t.Run("次回の実行では作成済みの経費を重複して作成しない", func(t *testing.T) {
+		recurringRepo.fail = false
+
+		result, err := useCase.GenerateDueExpenses(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.CreatedCount)
+		assert.Equal(t, 1, result.SkippedCount)
+		assert.Empty(t, result.Failures)
+
+		fetched, err := useCase.GetRecurringExpense(ctx, recurring.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-04-01", fetched.ProcessedThrough)
+	})
+}
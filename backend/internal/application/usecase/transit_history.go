@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"expense-management-system/pkg/errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// transitHistoryLine ICカードの利用履歴から読み取った1行
+type transitHistoryLine struct {
+	line         int
+	hash         string
+	date         time.Time
+	kind         string
+	entryStation string
+	exitStation  string
+	fare         float64
+	skipReason   string // 取り込まない場合の理由（定期券区間・運賃以外の利用）
+	parseError   string // 読み取れなかった場合の理由
+}
+
+// transitHistoryColumns 利用履歴CSVの列名（カードリーダーのソフトごとの表記の揺れに対応）
+var transitHistoryColumns = map[string][]string{
+	"date":    {"利用日", "日付", "利用日時", "年月日"},
+	"kind":    {"種別", "利用種別", "処理", "種類"},
+	"entry":   {"入場駅", "入場", "乗車駅", "入場駅名"},
+	"exit":    {"出場駅", "出場", "降車駅", "出場駅名"},
+	"fare":    {"支払額", "運賃", "利用額", "差額", "金額"},
+	"balance": {"残額", "残高"},
+	"note":    {"備考", "メモ"},
+}
+
+// transitHistoryDateFormats 利用日の形式
+var transitHistoryDateFormats = []string{"2006/01/02", "2006-01-02", "2006/1/2", "06/01/02"}
+
+// transitNonFareKinds 運賃以外の利用を表す種別
+var transitNonFareKinds = []string{"チャージ", "物販", "入金", "払戻", "積増", "新規", "発行"}
+
+// parseTransitHistory ICカードリーダーが出力した利用履歴CSVを読み取る
+func parseTransitHistory(content string) ([]*transitHistoryLine, error) {
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(content, "\ufeff")))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.InvalidTransitHistory, "利用履歴のヘッダーを読み取れません")
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		for key, aliases := range transitHistoryColumns {
+			if _, found := columns[key]; found {
+				continue
+			}
+			for _, alias := range aliases {
+				if name == alias {
+					columns[key] = i
+				}
+			}
+		}
+	}
+
+	if _, ok := columns["date"]; !ok {
+		return nil, errors.NewApplicationError(errors.InvalidTransitHistory, "利用履歴に利用日の列がありません")
+	}
+	if _, ok := columns["fare"]; !ok {
+		return nil, errors.NewApplicationError(errors.InvalidTransitHistory, "利用履歴に運賃の列がありません")
+	}
+
+	lines := make([]*transitHistoryLine, 0)
+	occurrences := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.InvalidTransitHistory, "利用履歴を読み取れません: "+err.Error())
+		}
+
+		// 空白だけの行は無視
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		lineNumber, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			index, ok := columns[name]
+			if !ok || index >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[index])
+		}
+
+		line := &transitHistoryLine{
+			line:         lineNumber,
+			kind:         field("kind"),
+			entryStation: field("entry"),
+			exitStation:  field("exit"),
+		}
+		lines = append(lines, line)
+
+		// 日時が含まれる場合は日付の部分だけを使う
+		var dateErr error
+		dateValue := strings.Fields(field("date"))
+		if len(dateValue) > 0 {
+			line.date, dateErr = parseStatementDate(dateValue[0], transitHistoryDateFormats)
+		}
+
+		// 取込済みの判定に使う識別子（同じ内容の行は出現順で区別）
+		// 日付・金額は読み取った値で比較し、カードリーダーごとの表記の違い（2024/04/01と2024-04-01 08:30など）で重複して取り込まない
+		key := strings.Join([]string{transitDateKey(line.date, field("date")), line.kind, line.entryStation, line.exitStation, transitAmountKey(field("fare")), transitAmountKey(field("balance"))}, "\x00")
+		occurrences[key]++
+		line.hash = hashStatementLine("ic-", fmt.Sprintf("%s\x00%d", key, occurrences[key]))
+
+		if len(dateValue) == 0 {
+			line.parseError = "利用日がありません"
+			continue
+		}
+		if dateErr != nil {
+			line.parseError = "利用日を読み取れません: " + field("date")
+			continue
+		}
+
+		if strings.Contains(line.kind, "定期") || strings.Contains(field("note"), "定期") {
+			line.skipReason = "定期券区間のため取り込みません"
+			continue
+		}
+
+		if !isTransitFare(line) {
+			line.skipReason = "運賃以外の利用のため取り込みません"
+			continue
+		}
+
+		// 差額の列では運賃が負の値で記載される
+		fare, err := parseStatementAmount(field("fare"))
+		if err != nil {
+			line.parseError = "運賃を読み取れません: " + field("fare")
+			continue
+		}
+		line.fare = math.Abs(fare)
+
+		// 定期券区間内の乗車は運賃が0円になる
+		if line.fare == 0 {
+			line.skipReason = "定期券区間のため取り込みません"
+			continue
+		}
+	}
+
+	return lines, nil
+}
+
+// transitDateKey 取込済みの判定に使う利用日の表記（読み取れない場合は元の表記）
+func transitDateKey(date time.Time, raw string) string {
+	if date.IsZero() {
+		return raw
+	}
+	return date.Format("2006-01-02")
+}
+
+// transitAmountKey 取込済みの判定に使う金額の表記（桁区切り・符号の違いを無視し、読み取れない場合は元の表記）
+func transitAmountKey(raw string) string {
+	amount, err := parseStatementAmount(raw)
+	if err != nil {
+		return raw
+	}
+	return strconv.FormatFloat(math.Abs(amount), 'f', -1, 64)
+}
+
+// isTransitFare 運賃の支払い（乗車）を表す行かどうか
+func isTransitFare(line *transitHistoryLine) bool {
+	for _, kind := range transitNonFareKinds {
+		if strings.Contains(line.kind, kind) {
+			return false
+		}
+	}
+
+	if line.entryStation != "" || line.exitStation != "" {
+		return true
+	}
+
+	return strings.Contains(line.kind, "運賃") || strings.Contains(line.kind, "バス") || strings.Contains(line.kind, "乗車")
+}
+
+// transitRoute 経費のタイトルに使う区間の表記
+func transitRoute(line *transitHistoryLine) string {
+	switch {
+	case line.entryStation != "" && line.exitStation != "":
+		return line.entryStation + "→" + line.exitStation
+	case line.entryStation != "":
+		return line.entryStation
+	case line.exitStation != "":
+		return line.exitStation
+	default:
+		return line.kind
+	}
+}
@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"unicode/utf8"
+)
+
+// defaultTransitCategoryName カテゴリが指定されない場合に使う交通費のカテゴリ名
+const defaultTransitCategoryName = "交通費"
+
+// transitCurrency 交通系ICカードの利用額の通貨
+const transitCurrency = "JPY"
+
+// 利用履歴の行ごとの取込結果
+const (
+	transitLineImported  = "imported"  // 経費を作成した
+	transitLineDuplicate = "duplicate" // 取込済みの行
+	transitLineSkipped   = "skipped"   // 定期券区間・運賃以外の利用
+	transitLineError     = "error"     // 読み取れない、または経費を作成できない
+)
+
+// TransitUseCase 交通系ICカードの利用履歴取込ユースケース
+type TransitUseCase struct {
+	rideRepo     repository.TransitRideRepository
+	expenseRepo  repository.ExpenseRepository
+	userRepo     repository.UserRepository
+	categoryRepo repository.CategoryRepository
+	periodGuard  *fiscalPeriodGuard
+	clock        clock.Clock
+}
+
+// TransitUseCaseOption TransitUseCaseの任意の依存関係を設定するオプション
+type TransitUseCaseOption func(*TransitUseCase)
+
+// WithTransitFiscalPeriods 会計期間を設定（未設定の場合は締め済みの会計期間を確認しない）
+func WithTransitFiscalPeriods(calendar *valueobject.FiscalCalendar, periodRepo repository.AccountingPeriodRepository) TransitUseCaseOption {
+	return func(uc *TransitUseCase) {
+		uc.periodGuard = &fiscalPeriodGuard{calendar: calendar, periodRepo: periodRepo, clock: uc.clock}
+	}
+}
+
+// NewTransitUseCase TransitUseCaseのコンストラクタ
+func NewTransitUseCase(
+	rideRepo repository.TransitRideRepository,
+	expenseRepo repository.ExpenseRepository,
+	userRepo repository.UserRepository,
+	categoryRepo repository.CategoryRepository,
+	clk clock.Clock,
+	opts ...TransitUseCaseOption,
+) *TransitUseCase {
+	uc := &TransitUseCase{
+		rideRepo:     rideRepo,
+		expenseRepo:  expenseRepo,
+		userRepo:     userRepo,
+		categoryRepo: categoryRepo,
+		clock:        clk,
+	}
+
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
+
+// ImportICCardHistory ICカードの利用履歴の運賃ごとに交通費の経費を下書きで作成
+// 定期券区間・運賃以外の利用・取込済みの行は経費を作成せず、行ごとの結果を返す
+func (uc *TransitUseCase) ImportICCardHistory(ctx context.Context, userID string, req *dto.ImportTransitHistoryRequest) (*dto.ImportTransitHistoryResponse, error) {
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, uid)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	category, err := uc.findTransitCategory(ctx, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := parseTransitHistory(req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	total, _ := valueobject.NewMoney(0, transitCurrency)
+	response := &dto.ImportTransitHistoryResponse{
+		CategoryID: category.ID().String(),
+		Currency:   transitCurrency,
+		Lines:      make([]*dto.TransitImportLineResponse, len(lines)),
+	}
+
+	for i, line := range lines {
+		result := &dto.TransitImportLineResponse{
+			Line:         line.line,
+			EntryStation: line.entryStation,
+			ExitStation:  line.exitStation,
+			Amount:       line.fare,
+		}
+		if !line.date.IsZero() {
+			result.Date = line.date.Format("2006-01-02")
+		}
+		response.Lines[i] = result
+
+		switch {
+		case line.parseError != "":
+			result.Status, result.Reason = transitLineError, line.parseError
+			response.ErrorCount++
+			continue
+		case line.skipReason != "":
+			result.Status, result.Reason = transitLineSkipped, line.skipReason
+			response.SkippedCount++
+			continue
+		}
+
+		if existing, err := uc.rideRepo.FindByHash(ctx, uid, line.hash); err == nil && existing != nil {
+			result.Status, result.Reason = transitLineDuplicate, "取込済みの行です"
+			result.ExpenseID = existing.ExpenseID().String()
+			response.DuplicateCount++
+			continue
+		}
+
+		expense, err := uc.createTransitExpense(ctx, user, category, line)
+		if err != nil {
+			result.Status, result.Reason = transitLineError, err.Error()
+			response.ErrorCount++
+			continue
+		}
+
+		result.Status = transitLineImported
+		result.ExpenseID = expense.ID().String()
+		response.ImportedCount++
+
+		total, err = total.Add(expense.Amount())
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+	}
+
+	response.TotalAmount = total.Amount()
+
+	return response, nil
+}
+
+// createTransitExpense 利用履歴の1行から下書きの経費を作成し、取込済みとして記録
+func (uc *TransitUseCase) createTransitExpense(ctx context.Context, user *entity.User, category *entity.Category, line *transitHistoryLine) (*entity.Expense, error) {
+	fare, err := valueobject.NewMoney(line.fare, transitCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	title := "交通費 " + transitRoute(line)
+	for len(title) > 100 {
+		_, size := utf8.DecodeLastRuneInString(title)
+		title = title[:len(title)-size]
+	}
+
+	description := "ICカード利用履歴から作成"
+	if line.kind != "" {
+		description += "（種別: " + line.kind + "）"
+	}
+
+	date := valueobject.DateOf(line.date)
+	expense, _, err := entity.NewExpenseInCategory(uc.clock, user, category, fare, title, description, date)
+	if err != nil {
+		return nil, err
+	}
+
+	// 締め済みの会計期間の利用は取り込まない（取込済みとして記録しないため、再開後に取り込める）
+	if err := uc.periodGuard.ensureOpen(ctx, date); err != nil {
+		return nil, err
+	}
+
+	ride, err := entity.NewTransitRide(uc.clock, user.ID(), line.hash, line.date, line.entryStation, line.exitStation, fare, expense.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.expenseRepo.Save(ctx, expense); err != nil {
+		return nil, errors.NewApplicationError(errors.ExpenseCreationFailed, "経費の作成に失敗しました")
+	}
+
+	// 取込済みとして記録できない場合は経費を削除し、次回の取り込みで再び作成する
+	if err := uc.rideRepo.Save(ctx, ride); err != nil {
+		_ = uc.expenseRepo.Delete(ctx, expense.ID())
+		return nil, errors.NewApplicationError(errors.TransitImportFailed, "利用履歴の取り込みに失敗しました")
+	}
+
+	return expense, nil
+}
+
+// findTransitCategory 経費のカテゴリを取得（指定がない場合は「交通費」カテゴリ）
+func (uc *TransitUseCase) findTransitCategory(ctx context.Context, categoryID string) (*entity.Category, error) {
+	if categoryID == "" {
+		category, err := uc.categoryRepo.FindByName(ctx, defaultTransitCategoryName)
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.CategoryNotFound, "「交通費」カテゴリが見つかりません。category_idを指定してください")
+		}
+		return category, nil
+	}
+
+	cid, err := valueobject.NewCategoryID(categoryID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	category, err := uc.categoryRepo.FindByID(ctx, cid)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.CategoryNotFound, "カテゴリが見つかりません")
+	}
+
+	return category, nil
+}
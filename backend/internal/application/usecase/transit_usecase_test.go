@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/infrastructure/persistence"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitUseCase_ImportICCardHistory(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	rideRepo := persistence.NewMemoryTransitRideRepository()
+
+	// ユースケースを初期化
+	useCase := NewTransitUseCase(rideRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	// テスト用のユーザーとカテゴリを作成
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	category, _ := entity.NewCategory(fakeClock, "交通費", "電車・バス代", "#FF0000")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	day := func(days int) string {
+		return fakeClock.Now().AddDate(0, 0, days).Format("2006/01/02")
+	}
+
+	history := fmt.Sprintf("利用日,種別,入場駅,出場駅,残額,差額\n"+
+		"%[1]s,運賃,新宿,品川,4820,-180\n"+
+		"%[1]s,定期,品川,大崎,4820,0\n"+
+		"%[1]s,チャージ,,,5000,3000\n"+
+		"%[2]s,バス,,,4590,-230\n"+
+		"%[2]s,運賃,品川,新宿,4410,-180\n"+
+		"%[3]s,運賃,新宿,品川,4230,-180\n",
+		day(-3), day(-2), day(-400))
+
+	t.Run("運賃の行ごとに下書きの経費を作成", func(t *testing.T) {
+		result, err := useCase.ImportICCardHistory(ctx, user.ID().String(), &dto.ImportTransitHistoryRequest{
+			Content: history,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, category.ID().String(), result.CategoryID)
+		assert.Equal(t, 3, result.ImportedCount)
+		assert.Equal(t, 2, result.SkippedCount)
+		assert.Equal(t, 1, result.ErrorCount)
+		assert.Equal(t, 590.0, result.TotalAmount)
+		require.Len(t, result.Lines, 6)
+
+		assert.Equal(t, "imported", result.Lines[0].Status)
+		assert.Equal(t, 180.0, result.Lines[0].Amount)
+		assert.Equal(t, "skipped", result.Lines[1].Status)
+		assert.Contains(t, result.Lines[1].Reason, "定期券")
+		assert.Equal(t, "skipped", result.Lines[2].Status)
+		assert.Equal(t, "imported", result.Lines[3].Status)
+		assert.Equal(t, "error", result.Lines[5].Status)
+		assert.Equal(t, 7, result.Lines[5].Line)
+
+		expenses, err := expenseRepo.FindByUserID(ctx, user.ID())
+		require.NoError(t, err)
+		require.Len(t, expenses, 3)
+		for _, expense := range expenses {
+			assert.Equal(t, entity.ExpenseStatusDraft, expense.Status())
+			assert.Equal(t, category.ID(), expense.CategoryID())
+		}
+	})
+
+	t.Run("取込済みの行は再度取り込まない", func(t *testing.T) {
+		// 新しい行を追加した履歴を再度取り込む
+		result, err := useCase.ImportICCardHistory(ctx, user.ID().String(), &dto.ImportTransitHistoryRequest{
+			Content: history + fmt.Sprintf("%s,運賃,新宿,渋谷,4070,-160\n", day(-1)),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, result.ImportedCount)
+		assert.Equal(t, 3, result.DuplicateCount)
+		assert.Equal(t, "duplicate", result.Lines[0].Status)
+		assert.NotEmpty(t, result.Lines[0].ExpenseID)
+		assert.Equal(t, "imported", result.Lines[6].Status)
+
+		expenses, err := expenseRepo.FindByUserID(ctx, user.ID())
+		require.NoError(t, err)
+		assert.Len(t, expenses, 4)
+	})
+
+	t.Run("別のカードリーダーの表記で出力した取込済みの行は再度取り込まない", func(t *testing.T) {
+		result, err := useCase.ImportICCardHistory(ctx, user.ID().String(), &dto.ImportTransitHistoryRequest{
+			Content: fmt.Sprintf("利用日時,種別,入場駅,出場駅,残高,運賃\n%s 08:30,運賃,新宿,品川,\"4,820\",180\n",
+				fakeClock.Now().AddDate(0, 0, -3).Format("2006-01-02")),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, result.ImportedCount)
+		assert.Equal(t, 1, result.DuplicateCount)
+	})
+
+	t.Run("利用日・運賃の列がない場合はエラー", func(t *testing.T) {
+		result, err := useCase.ImportICCardHistory(ctx, user.ID().String(), &dto.ImportTransitHistoryRequest{
+			Content: "入場駅,出場駅\n新宿,品川\n",
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+// failingTransitRideRepository 利用履歴の保存に失敗するテスト用リポジトリ
+type failingTransitRideRepository struct {
+	*persistence.MemoryTransitRideRepository
+}
+
+func (r *failingTransitRideRepository) Save(ctx context.Context, ride *entity.TransitRide) error {
+	return fmt.Errorf("storage unavailable")
+}
+
+func TestTransitUseCase_ImportICCardHistory_RideSaveFailure(t *testing.T) {
+	ctx := context.Background()
+
+	// リポジトリを初期化（利用履歴の保存は常に失敗する）
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	rideRepo := &failingTransitRideRepository{persistence.NewMemoryTransitRideRepository()}
+
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	useCase := NewTransitUseCase(rideRepo, expenseRepo, userRepo, categoryRepo, fakeClock)
+
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	category, _ := entity.NewCategory(fakeClock, "交通費", "電車・バス代", "#FF0000")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	result, err := useCase.ImportICCardHistory(ctx, user.ID().String(), &dto.ImportTransitHistoryRequest{
+		Content: "利用日,種別,入場駅,出場駅,残額,差額\n2026/04/08,運賃,新宿,品川,4820,-180\n",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ErrorCount)
+	assert.Equal(t, "error", result.Lines[0].Status)
+
+	// 取込済みとして記録できなかった行の経費は残らない
+	expenses, err := expenseRepo.FindByUserID(ctx, user.ID())
+	require.NoError(t, err)
+	assert.Empty(t, expenses)
+}
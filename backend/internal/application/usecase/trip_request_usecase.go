@@ -0,0 +1,284 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+)
+
+// TripRequestUseCase 出張申請ユースケース
+type TripRequestUseCase struct {
+	tripRequestRepo repository.TripRequestRepository
+	expenseRepo     repository.ExpenseRepository
+	userRepo        repository.UserRepository
+	clock           clock.Clock
+}
+
+// NewTripRequestUseCase TripRequestUseCaseのコンストラクタ
+func NewTripRequestUseCase(
+	tripRequestRepo repository.TripRequestRepository,
+	expenseRepo repository.ExpenseRepository,
+	userRepo repository.UserRepository,
+	clk clock.Clock,
+) *TripRequestUseCase {
+	return &TripRequestUseCase{
+		tripRequestRepo: tripRequestRepo,
+		expenseRepo:     expenseRepo,
+		userRepo:        userRepo,
+		clock:           clk,
+	}
+}
+
+// CreateTripRequest 出張申請を作成
+func (uc *TripRequestUseCase) CreateTripRequest(ctx context.Context, userID string, req *dto.CreateTripRequestRequest) (*dto.TripRequestResponse, error) {
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// ユーザーの存在確認
+	if _, err := uc.userRepo.FindByID(ctx, uid); err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	estimatedCost, err := valueobject.NewMoney(req.EstimatedCost, req.Currency)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	startDate, endDate, err := parseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	tripRequest, err := entity.NewTripRequest(uc.clock, uid, req.Destination, startDate, endDate, req.Purpose, estimatedCost)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.tripRequestRepo.Save(ctx, tripRequest); err != nil {
+		return nil, errors.NewApplicationError(errors.TripRequestCreationFailed, "出張申請の作成に失敗しました")
+	}
+
+	return buildTripRequestResponse(tripRequest), nil
+}
+
+// GetTripRequest 出張申請を取得
+func (uc *TripRequestUseCase) GetTripRequest(ctx context.Context, tripRequestID string) (*dto.TripRequestResponse, error) {
+	tripRequest, err := uc.findTripRequest(ctx, tripRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildTripRequestResponse(tripRequest), nil
+}
+
+// GetTripRequestsByUser ユーザーの出張申請一覧を取得
+func (uc *TripRequestUseCase) GetTripRequestsByUser(ctx context.Context, userID string) ([]*dto.TripRequestResponse, error) {
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// ユーザーの存在確認
+	if _, err := uc.userRepo.FindByID(ctx, uid); err != nil {
+		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
+	}
+
+	tripRequests, err := uc.tripRequestRepo.FindByUserID(ctx, uid)
+	if err != nil {
+		return nil, errors.NewApplicationError("TRIP_REQUEST_FETCH_FAILED", "出張申請一覧の取得に失敗しました")
+	}
+
+	responses := make([]*dto.TripRequestResponse, len(tripRequests))
+	for i, tripRequest := range tripRequests {
+		responses[i] = buildTripRequestResponse(tripRequest)
+	}
+
+	return responses, nil
+}
+
+// UpdateTripRequest 出張申請を更新
+func (uc *TripRequestUseCase) UpdateTripRequest(ctx context.Context, tripRequestID string, req *dto.UpdateTripRequestRequest) (*dto.TripRequestResponse, error) {
+	tripRequest, err := uc.findTripRequest(ctx, tripRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	estimatedCost, err := valueobject.NewMoney(req.EstimatedCost, req.Currency)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	startDate, endDate, err := parseDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tripRequest.UpdateDetails(req.Destination, startDate, endDate, req.Purpose, estimatedCost, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.tripRequestRepo.Update(ctx, tripRequest); err != nil {
+		return nil, errors.NewApplicationError(errors.TripRequestUpdateFailed, "出張申請の更新に失敗しました")
+	}
+
+	return buildTripRequestResponse(tripRequest), nil
+}
+
+// DeleteTripRequest 出張申請を削除
+func (uc *TripRequestUseCase) DeleteTripRequest(ctx context.Context, tripRequestID string) error {
+	tripRequest, err := uc.findTripRequest(ctx, tripRequestID)
+	if err != nil {
+		return err
+	}
+
+	if !tripRequest.CanDelete() {
+		return errors.NewApplicationError(errors.ValidationFailed, "申請中または承認済みの出張申請は削除できません")
+	}
+
+	// 経費から参照されている出張申請は削除できない
+	expenses, err := uc.expenseRepo.FindByTripRequestID(ctx, tripRequest.ID())
+	if err != nil {
+		return errors.NewApplicationError(errors.TripRequestDeletionFailed, "出張申請の使用状況チェックに失敗しました")
+	}
+
+	if len(expenses) > 0 {
+		return errors.NewApplicationError(errors.TripRequestInUse, "この出張申請は経費で参照されているため削除できません")
+	}
+
+	if err := uc.tripRequestRepo.Delete(ctx, tripRequest.ID()); err != nil {
+		return errors.NewApplicationError(errors.TripRequestDeletionFailed, "出張申請の削除に失敗しました")
+	}
+
+	return nil
+}
+
+// SubmitTripRequest 出張申請を申請
+func (uc *TripRequestUseCase) SubmitTripRequest(ctx context.Context, tripRequestID string) (*dto.TripRequestResponse, error) {
+	return uc.changeTripRequestStatus(ctx, tripRequestID, actionSubmit)
+}
+
+// ApproveTripRequest 出張申請を承認
+func (uc *TripRequestUseCase) ApproveTripRequest(ctx context.Context, tripRequestID string) (*dto.TripRequestResponse, error) {
+	return uc.changeTripRequestStatus(ctx, tripRequestID, actionApprove)
+}
+
+// RejectTripRequest 出張申請を却下
+func (uc *TripRequestUseCase) RejectTripRequest(ctx context.Context, tripRequestID string) (*dto.TripRequestResponse, error) {
+	return uc.changeTripRequestStatus(ctx, tripRequestID, actionReject)
+}
+
+// GetTripCostReport 出張の概算費用と実費を比較
+func (uc *TripRequestUseCase) GetTripCostReport(ctx context.Context, tripRequestID string) (*dto.TripCostReportResponse, error) {
+	tripRequest, err := uc.findTripRequest(ctx, tripRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := uc.expenseRepo.FindByTripRequestID(ctx, tripRequest.ID())
+	if err != nil {
+		return nil, errors.NewApplicationError("EXPENSE_FETCH_FAILED", "経費一覧の取得に失敗しました")
+	}
+
+	currency := tripRequest.EstimatedCost().Currency()
+	actual, _ := valueobject.NewMoney(0, currency)
+	approved, _ := valueobject.NewMoney(0, currency)
+	count := 0
+
+	for _, expense := range expenses {
+		if expense.Status() == entity.ExpenseStatusRejected {
+			continue
+		}
+
+		actual, err = actual.Add(expense.Amount())
+		if err != nil {
+			return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+
+		if expense.Status() == entity.ExpenseStatusApproved {
+			approved, err = approved.Add(expense.Amount())
+			if err != nil {
+				return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+			}
+		}
+
+		count++
+	}
+
+	return &dto.TripCostReportResponse{
+		TripRequestID:   tripRequest.ID().String(),
+		Destination:     tripRequest.Destination(),
+		Currency:        currency,
+		EstimatedAmount: tripRequest.EstimatedCost().Amount(),
+		ActualAmount:    actual.Amount(),
+		ApprovedAmount:  approved.Amount(),
+		Difference:      actual.Amount() - tripRequest.EstimatedCost().Amount(),
+		ExpenseCount:    count,
+	}, nil
+}
+
+// changeTripRequestStatus 出張申請のステータスを変更
+func (uc *TripRequestUseCase) changeTripRequestStatus(ctx context.Context, tripRequestID string, action string) (*dto.TripRequestResponse, error) {
+	tripRequest, err := uc.findTripRequest(ctx, tripRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case actionSubmit:
+		err = tripRequest.Submit(uc.clock.Now())
+	case actionApprove:
+		err = tripRequest.Approve(uc.clock.Now())
+	case actionReject:
+		err = tripRequest.Reject(uc.clock.Now())
+	default:
+		return nil, errors.NewApplicationError(errors.ValidationFailed, "無効なアクションです")
+	}
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	if err := uc.tripRequestRepo.Update(ctx, tripRequest); err != nil {
+		return nil, errors.NewApplicationError(errors.TripRequestUpdateFailed, "出張申請のステータス更新に失敗しました")
+	}
+
+	return buildTripRequestResponse(tripRequest), nil
+}
+
+// findTripRequest IDで出張申請を取得
+func (uc *TripRequestUseCase) findTripRequest(ctx context.Context, tripRequestID string) (*entity.TripRequest, error) {
+	id, err := valueobject.NewTripRequestID(tripRequestID)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	tripRequest, err := uc.tripRequestRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewApplicationError(errors.TripRequestNotFound, "出張申請が見つかりません")
+	}
+
+	return tripRequest, nil
+}
+
+// buildTripRequestResponse 出張申請レスポンスを構築
+func buildTripRequestResponse(tripRequest *entity.TripRequest) *dto.TripRequestResponse {
+	return &dto.TripRequestResponse{
+		ID:            tripRequest.ID().String(),
+		UserID:        tripRequest.UserID().String(),
+		Destination:   tripRequest.Destination(),
+		StartDate:     tripRequest.StartDate().String(),
+		EndDate:       tripRequest.EndDate().String(),
+		Days:          tripRequest.Days(),
+		Purpose:       tripRequest.Purpose(),
+		EstimatedCost: tripRequest.EstimatedCost().Amount(),
+		Currency:      tripRequest.EstimatedCost().Currency(),
+		Status:        string(tripRequest.Status()),
+		CreatedAt:     tripRequest.CreatedAt(),
+		UpdatedAt:     tripRequest.UpdatedAt(),
+	}
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/infrastructure/persistence"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTripRequestUseCase_Workflow(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	// リポジトリを初期化
+	userRepo := persistence.NewMemoryUserRepository()
+	categoryRepo := persistence.NewMemoryCategoryRepository()
+	expenseRepo := persistence.NewMemoryExpenseRepository()
+	tripRequestRepo := persistence.NewMemoryTripRequestRepository()
+
+	// ユースケースを初期化
+	useCase := NewTripRequestUseCase(tripRequestRepo, expenseRepo, userRepo, fakeClock)
+	expenseUseCase := NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, fakeClock, WithTripRequestRepository(tripRequestRepo))
+
+	// テスト用のユーザーとカテゴリを作成
+	user, _ := entity.NewUser(fakeClock, "テストユーザー", "test@example.com")
+	require.NoError(t, userRepo.Save(ctx, user))
+
+	category, _ := entity.NewCategory(fakeClock, "交通費", "交通費カテゴリ", "#FF0000")
+	require.NoError(t, categoryRepo.Save(ctx, category))
+
+	startDate := fakeClock.Now().AddDate(0, 0, -3)
+	endDate := fakeClock.Now().AddDate(0, 0, -1)
+
+	trip, err := useCase.CreateTripRequest(ctx, user.ID().String(), &dto.CreateTripRequestRequest{
+		Destination:   "大阪",
+		StartDate:     startDate.Format("2006-01-02"),
+		EndDate:       endDate.Format("2006-01-02"),
+		Purpose:       "顧客訪問",
+		EstimatedCost: 50000,
+		Currency:      "JPY",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "draft", trip.Status)
+	assert.Equal(t, 3, trip.Days)
+	assert.Equal(t, startDate.Format("2006-01-02"), trip.StartDate)
+	assert.Equal(t, endDate.Format("2006-01-02"), trip.EndDate)
+
+	createExpense := func(amount float64) (*dto.ExpenseResponse, error) {
+		return expenseUseCase.CreateExpense(ctx, user.ID().String(), &dto.CreateExpenseRequest{
+			CategoryID:    category.ID().String(),
+			Amount:        amount,
+			Currency:      "JPY",
+			Title:         "新幹線代",
+			Date:          startDate.Format("2006-01-02"),
+			TripRequestID: trip.ID,
+		})
+	}
+
+	t.Run("未承認の出張申請には経費を紐付けられない", func(t *testing.T) {
+		result, err := createExpense(28000)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("承認フロー", func(t *testing.T) {
+		_, err := useCase.ApproveTripRequest(ctx, trip.ID)
+		assert.Error(t, err, "下書きの出張申請は承認できない")
+
+		submitted, err := useCase.SubmitTripRequest(ctx, trip.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "submitted", submitted.Status)
+
+		approved, err := useCase.ApproveTripRequest(ctx, trip.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "approved", approved.Status)
+	})
+
+	t.Run("概算費用と実費の比較", func(t *testing.T) {
+		expense1, err := createExpense(28000)
+		require.NoError(t, err)
+		assert.Equal(t, trip.ID, expense1.TripRequestID)
+
+		expense2, err := createExpense(30000)
+		require.NoError(t, err)
+
+		_, err = expenseUseCase.SubmitExpense(ctx, expense1.ID)
+		require.NoError(t, err)
+		_, err = expenseUseCase.ApproveExpense(ctx, expense1.ID)
+		require.NoError(t, err)
+
+		report, err := useCase.GetTripCostReport(ctx, trip.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 50000.0, report.EstimatedAmount)
+		assert.Equal(t, 58000.0, report.ActualAmount)
+		assert.Equal(t, 28000.0, report.ApprovedAmount)
+		assert.Equal(t, 8000.0, report.Difference)
+		assert.Equal(t, 2, report.ExpenseCount)
+
+		// 却下された経費は実費に含めない
+		_, err = expenseUseCase.SubmitExpense(ctx, expense2.ID)
+		require.NoError(t, err)
+		_, err = expenseUseCase.RejectExpense(ctx, expense2.ID)
+		require.NoError(t, err)
+
+		report, err = useCase.GetTripCostReport(ctx, trip.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 28000.0, report.ActualAmount)
+		assert.Equal(t, 1, report.ExpenseCount)
+	})
+
+	t.Run("承認済みの出張申請は削除できない", func(t *testing.T) {
+		err := useCase.DeleteTripRequest(ctx, trip.ID)
+		assert.Error(t, err)
+	})
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/domain/clock"
 	"expense-management-system/internal/domain/entity"
 	"expense-management-system/internal/domain/repository"
 	"expense-management-system/internal/domain/valueobject"
@@ -11,13 +12,19 @@ import (
 
 // UserUseCase ユーザーユースケース
 type UserUseCase struct {
-	userRepo repository.UserRepository
+	userRepo       repository.UserRepository
+	departmentRepo repository.DepartmentRepository
+	costCenterRepo repository.CostCenterRepository
+	clock          clock.Clock
 }
 
 // NewUserUseCase UserUseCaseのコンストラクタ
-func NewUserUseCase(userRepo repository.UserRepository) *UserUseCase {
+func NewUserUseCase(userRepo repository.UserRepository, departmentRepo repository.DepartmentRepository, costCenterRepo repository.CostCenterRepository, clk clock.Clock) *UserUseCase {
 	return &UserUseCase{
-		userRepo: userRepo,
+		userRepo:       userRepo,
+		departmentRepo: departmentRepo,
+		costCenterRepo: costCenterRepo,
+		clock:          clk,
 	}
 }
 
@@ -30,23 +37,42 @@ func (uc *UserUseCase) CreateUser(ctx context.Context, req *dto.CreateUserReques
 	}
 
 	// 新しいユーザーを作成
-	user, err := entity.NewUser(req.Name, req.Email)
+	user, err := entity.NewUser(uc.clock, req.Name, req.Email)
 	if err != nil {
 		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
 	}
 
+	// 上長の設定
+	if err := uc.assignManager(ctx, user, req.ManagerID); err != nil {
+		return nil, err
+	}
+
+	// 職能等級の設定
+	if err := user.ChangeGrade(req.Grade, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// 権限の設定
+	if err := user.ChangeRole(entity.UserRole(req.Role), uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// タイムゾーンの設定
+	if err := user.ChangeTimezone(req.Timezone, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// 所属部署と既定のコストセンターの設定
+	if err := uc.assignOrganization(ctx, user, req.DepartmentID, req.CostCenterID); err != nil {
+		return nil, err
+	}
+
 	// ユーザーを保存
 	if err := uc.userRepo.Save(ctx, user); err != nil {
 		return nil, errors.NewApplicationError(errors.UserCreationFailed, "ユーザーの作成に失敗しました")
 	}
 
-	return &dto.UserResponse{
-		ID:        user.ID().String(),
-		Name:      user.Name(),
-		Email:     user.Email(),
-		CreatedAt: user.CreatedAt(),
-		UpdatedAt: user.UpdatedAt(),
-	}, nil
+	return buildUserResponse(user), nil
 }
 
 // GetUser ユーザーを取得
@@ -61,13 +87,7 @@ func (uc *UserUseCase) GetUser(ctx context.Context, userID string) (*dto.UserRes
 		return nil, errors.NewApplicationError(errors.UserNotFound, "ユーザーが見つかりません")
 	}
 
-	return &dto.UserResponse{
-		ID:        user.ID().String(),
-		Name:      user.Name(),
-		Email:     user.Email(),
-		CreatedAt: user.CreatedAt(),
-		UpdatedAt: user.UpdatedAt(),
-	}, nil
+	return buildUserResponse(user), nil
 }
 
 // UpdateUser ユーザーを更新
@@ -91,22 +111,41 @@ func (uc *UserUseCase) UpdateUser(ctx context.Context, userID string, req *dto.U
 	}
 
 	// ユーザー情報を更新
-	if err := user.UpdateProfile(req.Name, req.Email); err != nil {
+	if err := user.UpdateProfile(req.Name, req.Email, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// 上長の設定
+	if err := uc.assignManager(ctx, user, req.ManagerID); err != nil {
+		return nil, err
+	}
+
+	// 職能等級の設定
+	if err := user.ChangeGrade(req.Grade, uc.clock.Now()); err != nil {
 		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
 	}
 
+	// 権限の設定
+	if err := user.ChangeRole(entity.UserRole(req.Role), uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// タイムゾーンの設定
+	if err := user.ChangeTimezone(req.Timezone, uc.clock.Now()); err != nil {
+		return nil, errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// 所属部署と既定のコストセンターの設定
+	if err := uc.assignOrganization(ctx, user, req.DepartmentID, req.CostCenterID); err != nil {
+		return nil, err
+	}
+
 	// ユーザーを保存
 	if err := uc.userRepo.Update(ctx, user); err != nil {
 		return nil, errors.NewApplicationError(errors.UserUpdateFailed, "ユーザーの更新に失敗しました")
 	}
 
-	return &dto.UserResponse{
-		ID:        user.ID().String(),
-		Name:      user.Name(),
-		Email:     user.Email(),
-		CreatedAt: user.CreatedAt(),
-		UpdatedAt: user.UpdatedAt(),
-	}, nil
+	return buildUserResponse(user), nil
 }
 
 // DeleteUser ユーザーを削除
@@ -141,14 +180,100 @@ func (uc *UserUseCase) GetAllUsers(ctx context.Context) ([]*dto.UserResponse, er
 
 	responses := make([]*dto.UserResponse, len(users))
 	for i, user := range users {
-		responses[i] = &dto.UserResponse{
-			ID:        user.ID().String(),
-			Name:      user.Name(),
-			Email:     user.Email(),
-			CreatedAt: user.CreatedAt(),
-			UpdatedAt: user.UpdatedAt(),
-		}
+		responses[i] = buildUserResponse(user)
 	}
 
 	return responses, nil
 }
+
+// assignManager 上長を検証して設定（空文字の場合は解除）
+func (uc *UserUseCase) assignManager(ctx context.Context, user *entity.User, managerID string) error {
+	if managerID == "" {
+		return user.AssignManager(nil, uc.clock.Now())
+	}
+
+	mid, err := valueobject.NewUserID(managerID)
+	if err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	// 上長の存在確認と循環チェック（上長の上長を辿って自分に戻らないこと）
+	current := mid
+	for current != nil {
+		if current.Equals(user.ID()) {
+			return errors.NewApplicationError(errors.ManagerCycleDetected, "上長の階層が循環しています")
+		}
+
+		manager, err := uc.userRepo.FindByID(ctx, current)
+		if err != nil {
+			return errors.NewApplicationError(errors.InvalidManager, "上長が見つかりません")
+		}
+		current = manager.ManagerID()
+	}
+
+	if err := user.AssignManager(mid, uc.clock.Now()); err != nil {
+		return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+	}
+
+	return nil
+}
+
+// assignOrganization 所属部署と既定のコストセンターを検証して設定（空文字の場合は解除）
+func (uc *UserUseCase) assignOrganization(ctx context.Context, user *entity.User, departmentID, costCenterID string) error {
+	var did *valueobject.DepartmentID
+	if departmentID != "" {
+		id, err := valueobject.NewDepartmentID(departmentID)
+		if err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		if _, err := uc.departmentRepo.FindByID(ctx, id); err != nil {
+			return errors.NewApplicationError(errors.DepartmentNotFound, "部署が見つかりません")
+		}
+		did = id
+	}
+
+	var cid *valueobject.CostCenterID
+	if costCenterID != "" {
+		id, err := valueobject.NewCostCenterID(costCenterID)
+		if err != nil {
+			return errors.NewApplicationError(errors.ValidationFailed, err.Error())
+		}
+		if _, err := uc.costCenterRepo.FindByID(ctx, id); err != nil {
+			return errors.NewApplicationError(errors.CostCenterNotFound, "コストセンターが見つかりません")
+		}
+		cid = id
+	}
+
+	user.ChangeDepartment(did, uc.clock.Now())
+	user.AssignCostCenter(cid, uc.clock.Now())
+
+	return nil
+}
+
+// buildUserResponse ユーザーレスポンスを構築
+func buildUserResponse(user *entity.User) *dto.UserResponse {
+	response := &dto.UserResponse{
+		ID:        user.ID().String(),
+		Name:      user.Name(),
+		Email:     user.Email(),
+		Grade:     user.Grade(),
+		Role:      string(user.Role()),
+		Timezone:  user.Timezone(),
+		CreatedAt: user.CreatedAt(),
+		UpdatedAt: user.UpdatedAt(),
+	}
+
+	if user.ManagerID() != nil {
+		response.ManagerID = user.ManagerID().String()
+	}
+
+	if user.DepartmentID() != nil {
+		response.DepartmentID = user.DepartmentID().String()
+	}
+
+	if user.CostCenterID() != nil {
+		response.CostCenterID = user.CostCenterID().String()
+	}
+
+	return response
+}
@@ -0,0 +1,62 @@
+// Package clock 現在日時の取得を抽象化
+// エンティティの作成日時や経費日付の検証など、現在日時に依存するルールはClockから日時を取得する
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock 現在日時を取得するインターフェース
+type Clock interface {
+	// Now 現在日時
+	Now() time.Time
+}
+
+// systemClock システムの時計
+type systemClock struct{}
+
+// System システムの時計を返すClock（本番環境用）
+func System() Clock {
+	return systemClock{}
+}
+
+// Now 現在日時
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Fake 任意の日時に設定・進められるClock（テスト・過去データの投入用）
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFake 現在日時をnowに固定したFakeを作成
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now 設定された現在日時
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// Set 現在日時を設定
+func (f *Fake) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = now
+}
+
+// Advance 現在日時をdだけ進める
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+}
@@ -0,0 +1,159 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+	"time"
+)
+
+// AccountingPeriodStatus 会計期間の状態
+type AccountingPeriodStatus string
+
+const (
+	AccountingPeriodStatusOpen   AccountingPeriodStatus = "open"   // 未締め
+	AccountingPeriodStatusClosed AccountingPeriodStatus = "closed" // 締め済み
+)
+
+// AccountingPeriod 会計期間（月次）の締めの状態を表すエンティティ
+// 締め済みの会計期間に属する経費は変更できない
+type AccountingPeriod struct {
+	period valueobject.FiscalPeriod
+	status AccountingPeriodStatus
+
+	closedBy     *valueobject.UserID // 最後に締めたユーザー
+	closedAt     time.Time
+	reopenedBy   *valueobject.UserID // 最後に再開したユーザー
+	reopenedAt   time.Time
+	reopenReason string
+
+	updatedAt time.Time
+}
+
+// NewAccountingPeriod 未締めのAccountingPeriodを作成
+func NewAccountingPeriod(clk clock.Clock, period valueobject.FiscalPeriod) *AccountingPeriod {
+	return &AccountingPeriod{
+		period:    period,
+		status:    AccountingPeriodStatusOpen,
+		updatedAt: clk.Now(),
+	}
+}
+
+// ReconstructAccountingPeriod 既存データからAccountingPeriodを再構築
+func ReconstructAccountingPeriod(
+	period valueobject.FiscalPeriod,
+	status AccountingPeriodStatus,
+	closedBy *valueobject.UserID,
+	closedAt time.Time,
+	reopenedBy *valueobject.UserID,
+	reopenedAt time.Time,
+	reopenReason string,
+	updatedAt time.Time,
+) (*AccountingPeriod, error) {
+	if status != AccountingPeriodStatusOpen && status != AccountingPeriodStatusClosed {
+		return nil, errors.NewDomainError(errors.InvalidFiscalPeriod, "無効な会計期間の状態です")
+	}
+
+	return &AccountingPeriod{
+		period:       period,
+		status:       status,
+		closedBy:     closedBy,
+		closedAt:     closedAt,
+		reopenedBy:   reopenedBy,
+		reopenedAt:   reopenedAt,
+		reopenReason: reopenReason,
+		updatedAt:    updatedAt,
+	}, nil
+}
+
+// Period 会計期間を取得
+func (p *AccountingPeriod) Period() valueobject.FiscalPeriod {
+	return p.period
+}
+
+// Status 状態を取得
+func (p *AccountingPeriod) Status() AccountingPeriodStatus {
+	return p.status
+}
+
+// IsClosed 締め済みかどうか
+func (p *AccountingPeriod) IsClosed() bool {
+	return p.status == AccountingPeriodStatusClosed
+}
+
+// ClosedBy 最後に締めたユーザーIDを取得（未締めの場合はnil）
+func (p *AccountingPeriod) ClosedBy() *valueobject.UserID {
+	return p.closedBy
+}
+
+// ClosedAt 最後に締めた日時を取得
+func (p *AccountingPeriod) ClosedAt() time.Time {
+	return p.closedAt
+}
+
+// ReopenedBy 最後に再開したユーザーIDを取得（再開していない場合はnil）
+func (p *AccountingPeriod) ReopenedBy() *valueobject.UserID {
+	return p.reopenedBy
+}
+
+// ReopenedAt 最後に再開した日時を取得
+func (p *AccountingPeriod) ReopenedAt() time.Time {
+	return p.reopenedAt
+}
+
+// ReopenReason 再開の理由を取得
+func (p *AccountingPeriod) ReopenReason() string {
+	return p.reopenReason
+}
+
+// UpdatedAt 更新日時を取得
+func (p *AccountingPeriod) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// Close 会計期間を締める（経理担当者・管理者のみ）
+func (p *AccountingPeriod) Close(user *User, now time.Time) error {
+	if user == nil || !user.CanCloseFiscalPeriod() {
+		return errors.NewDomainError(errors.InvalidFiscalPeriod, "会計期間を締められるのは経理担当者または管理者のみです")
+	}
+
+	if p.IsClosed() {
+		return errors.NewDomainError(errors.InvalidFiscalPeriod, "会計期間 "+p.period.Key()+" はすでに締め済みです")
+	}
+
+	if p.period.Start().After(now) {
+		return errors.NewDomainError(errors.InvalidFiscalPeriod, "開始前の会計期間は締められません")
+	}
+
+	p.status = AccountingPeriodStatusClosed
+	p.closedBy = user.ID()
+	p.closedAt = now
+	p.updatedAt = now
+
+	return nil
+}
+
+// Reopen 締め済みの会計期間を再開する（管理者のみ）
+func (p *AccountingPeriod) Reopen(user *User, reason string, now time.Time) error {
+	if user == nil || !user.IsAdmin() {
+		return errors.NewDomainError(errors.InvalidFiscalPeriod, "締め済みの会計期間を再開できるのは管理者のみです")
+	}
+
+	if !p.IsClosed() {
+		return errors.NewDomainError(errors.InvalidFiscalPeriod, "会計期間 "+p.period.Key()+" は締められていません")
+	}
+
+	reason = strings.TrimSpace(reason)
+	if len([]rune(reason)) > 500 {
+		return errors.NewDomainError(errors.InvalidFiscalPeriod, "再開の理由は500文字以内である必要があります")
+	}
+
+	p.status = AccountingPeriodStatusOpen
+	p.reopenedBy = user.ID()
+	p.reopenedAt = now
+	p.reopenReason = reason
+	p.updatedAt = now
+
+	return nil
+}
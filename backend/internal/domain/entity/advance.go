@@ -0,0 +1,309 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+	"time"
+)
+
+// AdvanceStatus 仮払金の状態
+type AdvanceStatus string
+
+const (
+	AdvanceStatusDraft     AdvanceStatus = "draft"     // 下書き
+	AdvanceStatusSubmitted AdvanceStatus = "submitted" // 申請済み
+	AdvanceStatusApproved  AdvanceStatus = "approved"  // 承認済み（未精算）
+	AdvanceStatusRejected  AdvanceStatus = "rejected"  // 却下
+	AdvanceStatusSettled   AdvanceStatus = "settled"   // 精算済み
+)
+
+// Advance 仮払金エンティティ
+type Advance struct {
+	id        *valueobject.AdvanceID
+	userID    *valueobject.UserID
+	amount    *valueobject.Money
+	purpose   string
+	status    AdvanceStatus
+	createdAt time.Time
+	updatedAt time.Time
+
+	// 精算
+	expenseIDs       []*valueobject.ExpenseID       // 精算対象の経費
+	expenseReportIDs []*valueobject.ExpenseReportID // 精算対象の経費レポート
+	settledAmount    *valueobject.Money             // 精算時の実費合計
+	settledAt        time.Time                      // 精算日時
+}
+
+// NewAdvance 新しいAdvanceを作成
+func NewAdvance(clk clock.Clock, userID *valueobject.UserID, amount *valueobject.Money, purpose string) (*Advance, error) {
+	if userID == nil {
+		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
+	}
+
+	if err := validateAdvanceDetails(amount, purpose); err != nil {
+		return nil, err
+	}
+
+	now := clk.Now()
+	return &Advance{
+		id:        valueobject.GenerateAdvanceID(),
+		userID:    userID,
+		amount:    amount,
+		purpose:   strings.TrimSpace(purpose),
+		status:    AdvanceStatusDraft,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ReconstructAdvance 既存データからAdvanceを再構築
+func ReconstructAdvance(
+	id *valueobject.AdvanceID,
+	userID *valueobject.UserID,
+	amount *valueobject.Money,
+	purpose string,
+	status AdvanceStatus,
+	expenseIDs []*valueobject.ExpenseID,
+	expenseReportIDs []*valueobject.ExpenseReportID,
+	settledAmount *valueobject.Money,
+	settledAt time.Time,
+	createdAt, updatedAt time.Time,
+) (*Advance, error) {
+	if id == nil {
+		return nil, errors.NewDomainError(errors.InvalidAdvanceID, "仮払金IDが必要です")
+	}
+
+	if userID == nil {
+		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
+	}
+
+	if err := validateAdvanceDetails(amount, purpose); err != nil {
+		return nil, err
+	}
+
+	switch status {
+	case AdvanceStatusDraft, AdvanceStatusSubmitted, AdvanceStatusApproved, AdvanceStatusRejected:
+	case AdvanceStatusSettled:
+		if settledAmount == nil {
+			return nil, errors.NewDomainError("INVALID_ADVANCE_STATUS", "精算済みの仮払金には精算額が必要です")
+		}
+	default:
+		return nil, errors.NewDomainError("INVALID_ADVANCE_STATUS", "無効な仮払金ステータスです")
+	}
+
+	return &Advance{
+		id:               id,
+		userID:           userID,
+		amount:           amount,
+		purpose:          purpose,
+		status:           status,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+		expenseIDs:       expenseIDs,
+		expenseReportIDs: expenseReportIDs,
+		settledAmount:    settledAmount,
+		settledAt:        settledAt,
+	}, nil
+}
+
+// ID IDを取得
+func (a *Advance) ID() *valueobject.AdvanceID {
+	return a.id
+}
+
+// UserID 申請者のユーザーIDを取得
+func (a *Advance) UserID() *valueobject.UserID {
+	return a.userID
+}
+
+// Amount 仮払額を取得
+func (a *Advance) Amount() *valueobject.Money {
+	return a.amount
+}
+
+// Purpose 目的を取得
+func (a *Advance) Purpose() string {
+	return a.purpose
+}
+
+// Status ステータスを取得
+func (a *Advance) Status() AdvanceStatus {
+	return a.status
+}
+
+// CreatedAt 作成日時を取得
+func (a *Advance) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// UpdatedAt 更新日時を取得
+func (a *Advance) UpdatedAt() time.Time {
+	return a.updatedAt
+}
+
+// ExpenseIDs 精算対象の経費IDを取得
+func (a *Advance) ExpenseIDs() []*valueobject.ExpenseID {
+	return a.expenseIDs
+}
+
+// ExpenseReportIDs 精算対象の経費レポートIDを取得
+func (a *Advance) ExpenseReportIDs() []*valueobject.ExpenseReportID {
+	return a.expenseReportIDs
+}
+
+// SettledAmount 精算時の実費合計を取得（未精算の場合はnil）
+func (a *Advance) SettledAmount() *valueobject.Money {
+	return a.settledAmount
+}
+
+// SettledAt 精算日時を取得
+func (a *Advance) SettledAt() time.Time {
+	return a.settledAt
+}
+
+// IsOpen 支払済みで未精算の仮払金かどうか
+func (a *Advance) IsOpen() bool {
+	return a.status == AdvanceStatusApproved
+}
+
+// RefundAmount 精算時に従業員から返金される額を取得（実費が仮払額を下回る場合）
+func (a *Advance) RefundAmount() *valueobject.Money {
+	if a.settledAmount == nil || !a.amount.IsGreaterThan(a.settledAmount) {
+		zero, _ := valueobject.NewMoney(0, a.amount.Currency())
+		return zero
+	}
+
+	refund, _ := a.amount.Subtract(a.settledAmount)
+	return refund
+}
+
+// AdditionalPayment 精算時に従業員へ追加で支払う額を取得（実費が仮払額を上回る場合）
+func (a *Advance) AdditionalPayment() *valueobject.Money {
+	if a.settledAmount == nil || !a.settledAmount.IsGreaterThan(a.amount) {
+		zero, _ := valueobject.NewMoney(0, a.amount.Currency())
+		return zero
+	}
+
+	additional, _ := a.settledAmount.Subtract(a.amount)
+	return additional
+}
+
+// UpdateDetails 仮払金の内容を更新
+func (a *Advance) UpdateDetails(amount *valueobject.Money, purpose string, now time.Time) error {
+	if a.status != AdvanceStatusDraft {
+		return errors.NewDomainError("ADVANCE_UPDATE_NOT_ALLOWED", "下書き状態の仮払金のみ更新できます")
+	}
+
+	if err := validateAdvanceDetails(amount, purpose); err != nil {
+		return err
+	}
+
+	a.amount = amount
+	a.purpose = strings.TrimSpace(purpose)
+	a.updatedAt = now
+
+	return nil
+}
+
+// Submit 仮払金を申請
+func (a *Advance) Submit(now time.Time) error {
+	if a.status != AdvanceStatusDraft {
+		return errors.NewDomainError("ADVANCE_SUBMIT_NOT_ALLOWED", "下書き状態の仮払金のみ申請できます")
+	}
+
+	a.status = AdvanceStatusSubmitted
+	a.updatedAt = now
+
+	return nil
+}
+
+// Approve 仮払金を承認
+func (a *Advance) Approve(now time.Time) error {
+	if a.status != AdvanceStatusSubmitted {
+		return errors.NewDomainError("ADVANCE_APPROVE_NOT_ALLOWED", "申請済み状態の仮払金のみ承認できます")
+	}
+
+	a.status = AdvanceStatusApproved
+	a.updatedAt = now
+
+	return nil
+}
+
+// Reject 仮払金を却下
+func (a *Advance) Reject(now time.Time) error {
+	if a.status != AdvanceStatusSubmitted {
+		return errors.NewDomainError("ADVANCE_REJECT_NOT_ALLOWED", "申請済み状態の仮払金のみ却下できます")
+	}
+
+	a.status = AdvanceStatusRejected
+	a.updatedAt = now
+
+	return nil
+}
+
+// Settle 経費・経費レポートの実費合計で仮払金を精算
+func (a *Advance) Settle(expenseIDs []*valueobject.ExpenseID, expenseReportIDs []*valueobject.ExpenseReportID, actual *valueobject.Money, now time.Time) error {
+	if a.status != AdvanceStatusApproved {
+		return errors.NewDomainError("ADVANCE_SETTLE_NOT_ALLOWED", "承認済みの仮払金のみ精算できます")
+	}
+
+	if len(expenseIDs) == 0 {
+		return errors.NewDomainError("ADVANCE_SETTLE_NOT_ALLOWED", "精算対象の経費が必要です")
+	}
+
+	if actual == nil {
+		return errors.NewDomainError(errors.InvalidExpenseAmount, "精算額が必要です")
+	}
+
+	if actual.Currency() != a.amount.Currency() {
+		return errors.NewDomainError(errors.InvalidExpenseAmount, "仮払金と異なる通貨の経費では精算できません")
+	}
+
+	a.expenseIDs = expenseIDs
+	a.expenseReportIDs = expenseReportIDs
+	a.settledAmount = actual
+	a.settledAt = now
+	a.status = AdvanceStatusSettled
+	a.updatedAt = now
+
+	return nil
+}
+
+// CanDelete 削除可能かどうか（下書きまたは却下のみ）
+func (a *Advance) CanDelete() bool {
+	return a.status == AdvanceStatusDraft || a.status == AdvanceStatusRejected
+}
+
+// Contains 経費が精算対象に含まれているかどうか
+func (a *Advance) Contains(expenseID *valueobject.ExpenseID) bool {
+	for _, id := range a.expenseIDs {
+		if id.Equals(expenseID) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAdvanceDetails 仮払金の内容のバリデーション
+func validateAdvanceDetails(amount *valueobject.Money, purpose string) error {
+	if amount == nil {
+		return errors.NewDomainError(errors.InvalidExpenseAmount, "仮払額が必要です")
+	}
+
+	if amount.Amount() <= 0 {
+		return errors.NewDomainError(errors.InvalidExpenseAmount, "仮払額は0より大きい必要があります")
+	}
+
+	purpose = strings.TrimSpace(purpose)
+	if purpose == "" {
+		return errors.NewDomainError("INVALID_ADVANCE_PURPOSE", "仮払金の目的は必須です")
+	}
+
+	if len(purpose) > 500 {
+		return errors.NewDomainError("INVALID_ADVANCE_PURPOSE", "仮払金の目的は500文字以内である必要があります")
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+)
+
+// AttendeeType 参加者の区分
+type AttendeeType string
+
+const (
+	AttendeeTypeInternal AttendeeType = "internal" // 社内
+	AttendeeTypeExternal AttendeeType = "external" // 社外（取引先など）
+)
+
+// maxAttendees 1件の経費に記録できる参加者の上限
+const maxAttendees = 200
+
+// meetingExpensePerHeadLimit 会議費として扱える1人当たりの飲食費の上限（円）
+// 1人当たり10,000円以下の飲食費は、社内の者だけの飲食を除き交際費から除かれる
+const meetingExpensePerHeadLimit = 10000
+
+// EntertainmentClass 飲食費の税務上の区分
+type EntertainmentClass string
+
+const (
+	EntertainmentClassNone          EntertainmentClass = ""              // 参加者のいない経費（区分なし）
+	EntertainmentClassMeeting       EntertainmentClass = "meeting"       // 会議費
+	EntertainmentClassEntertainment EntertainmentClass = "entertainment" // 交際費
+)
+
+// Label 区分の表示名
+func (c EntertainmentClass) Label() string {
+	switch c {
+	case EntertainmentClassMeeting:
+		return "会議費"
+	case EntertainmentClassEntertainment:
+		return "交際費"
+	default:
+		return ""
+	}
+}
+
+// ClassifyEntertainment 飲食費の金額と参加者から会議費・交際費を判定
+// 金額には飲食・接待のカテゴリの金額だけを渡す（交通費などは判定の対象外）
+// 社外の参加者を含み、1人当たりの金額が上限以下の場合は会議費、それ以外は交際費とする
+// 日本円以外の経費は上限と比べられないため交際費とする
+func ClassifyEntertainment(amount *valueobject.Money, attendees []*Attendee) EntertainmentClass {
+	if len(attendees) == 0 || amount == nil {
+		return EntertainmentClassNone
+	}
+
+	hasExternal := false
+	for _, attendee := range attendees {
+		if attendee.IsExternal() {
+			hasExternal = true
+			break
+		}
+	}
+
+	// 1人当たりの金額を丸めずに比べるため、上限に人数を掛けた金額と比べる
+	if hasExternal && amount.Currency() == "JPY" && amount.Amount() <= float64(meetingExpensePerHeadLimit*len(attendees)) {
+		return EntertainmentClassMeeting
+	}
+
+	return EntertainmentClassEntertainment
+}
+
+// Attendee 飲食を伴う経費の参加者（氏名・所属会社・社内外の区分）
+type Attendee struct {
+	name         string
+	company      string
+	attendeeType AttendeeType
+}
+
+// NewAttendee 新しいAttendeeを作成（社外の参加者は所属会社が必須）
+func NewAttendee(name, company string, attendeeType AttendeeType) (*Attendee, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.NewDomainError(errors.InvalidAttendee, "参加者の氏名は必須です")
+	}
+
+	if len(name) > 100 {
+		return nil, errors.NewDomainError(errors.InvalidAttendee, "参加者の氏名は100文字以内である必要があります")
+	}
+
+	company = strings.TrimSpace(company)
+	if len(company) > 100 {
+		return nil, errors.NewDomainError(errors.InvalidAttendee, "参加者の会社名は100文字以内である必要があります")
+	}
+
+	switch attendeeType {
+	case AttendeeTypeInternal:
+	case AttendeeTypeExternal:
+		if company == "" {
+			return nil, errors.NewDomainError(errors.InvalidAttendee, "社外の参加者は会社名が必須です: "+name)
+		}
+	default:
+		return nil, errors.NewDomainError(errors.InvalidAttendee, "参加者の区分は internal または external である必要があります")
+	}
+
+	return &Attendee{
+		name:         name,
+		company:      company,
+		attendeeType: attendeeType,
+	}, nil
+}
+
+// Name 氏名を取得
+func (a *Attendee) Name() string {
+	return a.name
+}
+
+// Company 所属会社を取得
+func (a *Attendee) Company() string {
+	return a.company
+}
+
+// Type 社内外の区分を取得
+func (a *Attendee) Type() AttendeeType {
+	return a.attendeeType
+}
+
+// IsExternal 社外の参加者かどうか
+func (a *Attendee) IsExternal() bool {
+	return a.attendeeType == AttendeeTypeExternal
+}
+
+// validateAttendees 参加者のバリデーション
+func validateAttendees(attendees []*Attendee) error {
+	if len(attendees) > maxAttendees {
+		return errors.NewDomainError(errors.InvalidAttendee, "参加者は200人以内である必要があります")
+	}
+
+	for _, attendee := range attendees {
+		if attendee == nil {
+			return errors.NewDomainError(errors.InvalidAttendee, "参加者が必要です")
+		}
+	}
+
+	return nil
+}
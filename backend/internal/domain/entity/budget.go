@@ -0,0 +1,337 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxBudgetPeriodDays 予算の期間の上限（日数）
+const maxBudgetPeriodDays = 366
+
+// BudgetScope 予算の対象（指定した条件を全て満たす経費を集計する）
+type BudgetScope struct {
+	categoryID   *valueobject.CategoryID
+	userID       *valueobject.UserID
+	departmentID *valueobject.DepartmentID
+}
+
+// NewBudgetScope 新しいBudgetScopeを作成（カテゴリ・ユーザー・部署のいずれかが必要）
+func NewBudgetScope(categoryID *valueobject.CategoryID, userID *valueobject.UserID, departmentID *valueobject.DepartmentID) (*BudgetScope, error) {
+	if categoryID == nil && userID == nil && departmentID == nil {
+		return nil, errors.NewDomainError(errors.InvalidBudget, "予算の対象としてカテゴリ・ユーザー・部署のいずれかを指定してください")
+	}
+
+	return &BudgetScope{
+		categoryID:   categoryID,
+		userID:       userID,
+		departmentID: departmentID,
+	}, nil
+}
+
+// CategoryID 対象のカテゴリIDを取得（指定しない場合はnil）
+func (s *BudgetScope) CategoryID() *valueobject.CategoryID {
+	return s.categoryID
+}
+
+// UserID 対象のユーザーIDを取得（指定しない場合はnil）
+func (s *BudgetScope) UserID() *valueobject.UserID {
+	return s.userID
+}
+
+// DepartmentID 対象の部署のIDを取得（指定しない場合はnil）
+func (s *BudgetScope) DepartmentID() *valueobject.DepartmentID {
+	return s.departmentID
+}
+
+// Budget 予算エンティティ（対象と期間ごとの予算額）
+type Budget struct {
+	id         *valueobject.BudgetID
+	name       string
+	scope      *BudgetScope
+	periodFrom valueobject.Date
+	periodTo   valueobject.Date
+	amount     *valueobject.Money // 予算額（基準通貨）
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// NewBudget 新しいBudgetを作成
+func NewBudget(clk clock.Clock, name string, scope *BudgetScope, periodFrom, periodTo valueobject.Date, amount *valueobject.Money) (*Budget, error) {
+	if err := validateBudget(name, scope, periodFrom, periodTo, amount); err != nil {
+		return nil, err
+	}
+
+	now := clk.Now()
+	return &Budget{
+		id:         valueobject.GenerateBudgetID(),
+		name:       strings.TrimSpace(name),
+		scope:      scope,
+		periodFrom: periodFrom,
+		periodTo:   periodTo,
+		amount:     amount,
+		createdAt:  now,
+		updatedAt:  now,
+	}, nil
+}
+
+// ReconstructBudget 既存データからBudgetを再構築
+func ReconstructBudget(id *valueobject.BudgetID, name string, scope *BudgetScope, periodFrom, periodTo valueobject.Date, amount *valueobject.Money, createdAt, updatedAt time.Time) (*Budget, error) {
+	if id == nil {
+		return nil, errors.NewDomainError(errors.InvalidBudgetID, "予算IDが必要です")
+	}
+
+	if err := validateBudget(name, scope, periodFrom, periodTo, amount); err != nil {
+		return nil, err
+	}
+
+	return &Budget{
+		id:         id,
+		name:       name,
+		scope:      scope,
+		periodFrom: periodFrom,
+		periodTo:   periodTo,
+		amount:     amount,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}, nil
+}
+
+// ID IDを取得
+func (b *Budget) ID() *valueobject.BudgetID {
+	return b.id
+}
+
+// Name 予算名を取得
+func (b *Budget) Name() string {
+	return b.name
+}
+
+// Scope 予算の対象を取得
+func (b *Budget) Scope() *BudgetScope {
+	return b.scope
+}
+
+// PeriodFrom 期間の開始日を取得
+func (b *Budget) PeriodFrom() valueobject.Date {
+	return b.periodFrom
+}
+
+// PeriodTo 期間の終了日（この日を含む）を取得
+func (b *Budget) PeriodTo() valueobject.Date {
+	return b.periodTo
+}
+
+// Amount 予算額を取得
+func (b *Budget) Amount() *valueobject.Money {
+	return b.amount
+}
+
+// CreatedAt 作成日時を取得
+func (b *Budget) CreatedAt() time.Time {
+	return b.createdAt
+}
+
+// UpdatedAt 更新日時を取得
+func (b *Budget) UpdatedAt() time.Time {
+	return b.updatedAt
+}
+
+// Update 予算を更新
+func (b *Budget) Update(name string, scope *BudgetScope, periodFrom, periodTo valueobject.Date, amount *valueobject.Money, now time.Time) error {
+	if err := validateBudget(name, scope, periodFrom, periodTo, amount); err != nil {
+		return err
+	}
+
+	b.name = strings.TrimSpace(name)
+	b.scope = scope
+	b.periodFrom = periodFrom
+	b.periodTo = periodTo
+	b.amount = amount
+	b.updatedAt = now
+
+	return nil
+}
+
+// IncludesDate 日付が予算の期間内（両端を含む）かどうか
+func (b *Budget) IncludesDate(date valueobject.Date) bool {
+	return !date.Before(b.periodFrom) && !date.After(b.periodTo)
+}
+
+// Covers 経費が予算の対象かどうか（部署の判定には申請者を使う）
+func (b *Budget) Covers(expense *Expense, user *User) bool {
+	if !b.IncludesDate(expense.Date()) {
+		return false
+	}
+
+	if b.scope.categoryID != nil && !expense.HasCategory(b.scope.categoryID) {
+		return false
+	}
+
+	if b.scope.userID != nil && !b.scope.userID.Equals(expense.UserID()) {
+		return false
+	}
+
+	if b.scope.departmentID != nil && (user == nil || !b.scope.departmentID.Equals(user.DepartmentID())) {
+		return false
+	}
+
+	return true
+}
+
+// CoveredAmount 経費のうち予算の対象となる金額（カテゴリを対象とする予算では、明細に分けた経費はそのカテゴリの明細のみ）
+func (b *Budget) CoveredAmount(expense *Expense) *valueobject.Money {
+	if b.scope.categoryID == nil {
+		return expense.Amount()
+	}
+	return expense.CategoryAmount(b.scope.categoryID)
+}
+
+// Usage 予算の対象の経費から消化状況を計算
+// 申請中の経費を確定前の金額、承認済み（支払済みを含む）の経費を実績として集計する
+// 為替レートを持たないため、予算と異なる通貨の経費は集計せず、通貨ごとの合計を集計外の金額として返す
+func (b *Budget) Usage(expenses []*Expense) (*BudgetUsage, error) {
+	var committed, actual float64
+	excluded := make(map[string]float64)
+	for _, expense := range expenses {
+		if expense.Status() != ExpenseStatusSubmitted && expense.Status() != ExpenseStatusApproved {
+			continue
+		}
+
+		amount := b.CoveredAmount(expense)
+		if amount.Currency() != b.amount.Currency() {
+			excluded[amount.Currency()] += amount.Amount()
+			continue
+		}
+
+		if expense.Status() == ExpenseStatusSubmitted {
+			committed += amount.Amount()
+		} else {
+			actual += amount.Amount()
+		}
+	}
+
+	committedMoney, err := valueobject.NewMoney(committed, b.amount.Currency())
+	if err != nil {
+		return nil, err
+	}
+
+	actualMoney, err := valueobject.NewMoney(actual, b.amount.Currency())
+	if err != nil {
+		return nil, err
+	}
+
+	currencies := make([]string, 0, len(excluded))
+	for currency := range excluded {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	excludedMoney := make([]*valueobject.Money, len(currencies))
+	for i, currency := range currencies {
+		money, err := valueobject.NewMoney(excluded[currency], currency)
+		if err != nil {
+			return nil, err
+		}
+		excludedMoney[i] = money
+	}
+
+	return &BudgetUsage{budget: b, committed: committedMoney, actual: actualMoney, excluded: excludedMoney}, nil
+}
+
+// BudgetUsage 予算の消化状況
+type BudgetUsage struct {
+	budget    *Budget
+	committed *valueobject.Money
+	actual    *valueobject.Money
+	excluded  []*valueobject.Money // 予算と異なる通貨のため集計しなかった経費の通貨ごとの合計
+}
+
+// Committed 申請中の経費の合計を取得
+func (u *BudgetUsage) Committed() *valueobject.Money {
+	return u.committed
+}
+
+// Actual 承認済みの経費の合計を取得
+func (u *BudgetUsage) Actual() *valueobject.Money {
+	return u.actual
+}
+
+// Excluded 予算と異なる通貨のため集計しなかった経費の合計を通貨ごとに取得（通貨コードの順）
+func (u *BudgetUsage) Excluded() []*valueobject.Money {
+	return u.excluded
+}
+
+// Remaining 予算額から申請中と承認済みの経費を引いた残額を取得（超過している場合は負の値）
+func (u *BudgetUsage) Remaining() float64 {
+	return u.budget.amount.Amount() - u.committed.Amount() - u.actual.Amount()
+}
+
+// UtilizationRate 予算の消化率（申請中と承認済みの経費の合計 / 予算額）
+func (u *BudgetUsage) UtilizationRate() float64 {
+	return (u.committed.Amount() + u.actual.Amount()) / u.budget.amount.Amount()
+}
+
+// IsExceeded 予算を超過しているかどうか
+func (u *BudgetUsage) IsExceeded() bool {
+	return u.Remaining() < 0
+}
+
+// WithCommitted 申請中の経費の合計に金額を加えた消化状況を返す（同時に申請する経費の累計に使う）
+func (u *BudgetUsage) WithCommitted(amount *valueobject.Money) (*BudgetUsage, error) {
+	committed, err := u.committed.Add(amount)
+	if err != nil {
+		return nil, err
+	}
+	return &BudgetUsage{budget: u.budget, committed: committed, actual: u.actual, excluded: u.excluded}, nil
+}
+
+// IsComparable 金額を予算の残額と比べられるかどうか（予算と異なる通貨の金額は比べられない）
+func (u *BudgetUsage) IsComparable(amount *valueobject.Money) bool {
+	return amount.Currency() == u.budget.amount.Currency()
+}
+
+// WouldExceed 金額を追加すると残額を超えるかどうか（比べられない金額はfalse）
+func (u *BudgetUsage) WouldExceed(amount *valueobject.Money) bool {
+	if !u.IsComparable(amount) {
+		return false
+	}
+	return amount.Amount() > u.Remaining()
+}
+
+// validateBudget 予算のバリデーション
+func validateBudget(name string, scope *BudgetScope, periodFrom, periodTo valueobject.Date, amount *valueobject.Money) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.NewDomainError(errors.InvalidBudget, "予算名は必須です")
+	}
+
+	if len(name) > 100 {
+		return errors.NewDomainError(errors.InvalidBudget, "予算名は100文字以内である必要があります")
+	}
+
+	if scope == nil {
+		return errors.NewDomainError(errors.InvalidBudget, "予算の対象が必要です")
+	}
+
+	if periodFrom.IsZero() || periodTo.IsZero() {
+		return errors.NewDomainError(errors.InvalidBudget, "予算の期間が必要です")
+	}
+
+	if periodTo.Before(periodFrom) {
+		return errors.NewDomainError(errors.InvalidBudget, "予算の期間の終了日は開始日以降である必要があります")
+	}
+
+	if periodTo.DaysSince(periodFrom) >= maxBudgetPeriodDays {
+		return errors.NewDomainError(errors.InvalidBudget, "予算の期間は366日以内である必要があります")
+	}
+
+	if amount == nil || amount.Amount() <= 0 {
+		return errors.NewDomainError(errors.InvalidBudget, "予算額は0より大きい必要があります")
+	}
+
+	return nil
+}
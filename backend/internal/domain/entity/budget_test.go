@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudget_Usage(t *testing.T) {
+	categoryID := valueobject.GenerateCategoryID()
+	today := valueobject.DateOf(time.Now())
+	periodFrom := today.AddDays(-10)
+	budgetAmount, _ := valueobject.NewMoney(10000, "JPY")
+
+	salesID := valueobject.GenerateDepartmentID()
+	member, err := NewUser(clock.System(), "山田太郎", "yamada@example.com")
+	require.NoError(t, err)
+	member.ChangeDepartment(salesID, time.Now())
+
+	newExpense := func(amount float64, currency string, date valueobject.Date, status ExpenseStatus) *Expense {
+		money, _ := valueobject.NewMoney(amount, currency)
+		expense, err := NewExpense(clock.System(), member.ID(), categoryID, money, "テスト経費", "", date)
+		require.NoError(t, err)
+		if status != ExpenseStatusDraft {
+			require.NoError(t, expense.Submit(time.Now()))
+		}
+		if status == ExpenseStatusApproved {
+			require.NoError(t, expense.Approve(time.Now()))
+		}
+		return expense
+	}
+
+	t.Run("申請中を確定前、承認済みを実績として集計する", func(t *testing.T) {
+		scope, err := NewBudgetScope(categoryID, nil, nil)
+		require.NoError(t, err)
+		budget, err := NewBudget(clock.System(), "交通費予算", scope, periodFrom, today, budgetAmount)
+		require.NoError(t, err)
+
+		usage, err := budget.Usage([]*Expense{
+			newExpense(3000, "JPY", today, ExpenseStatusSubmitted),
+			newExpense(5000, "JPY", today, ExpenseStatusApproved),
+			newExpense(9000, "JPY", today, ExpenseStatusDraft),
+			newExpense(100, "USD", today, ExpenseStatusApproved),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3000.0, usage.Committed().Amount())
+		assert.Equal(t, 5000.0, usage.Actual().Amount())
+		assert.Equal(t, 2000.0, usage.Remaining())
+		assert.InDelta(t, 0.8, usage.UtilizationRate(), 0.0001)
+		assert.False(t, usage.IsExceeded())
+
+		over, _ := valueobject.NewMoney(2001, "JPY")
+		within, _ := valueobject.NewMoney(2000, "JPY")
+		assert.True(t, usage.WouldExceed(over))
+		assert.False(t, usage.WouldExceed(within))
+
+		// 予算と異なる通貨の経費は集計外の金額として通貨ごとに返す
+		require.Len(t, usage.Excluded(), 1)
+		assert.Equal(t, 100.0, usage.Excluded()[0].Amount())
+		assert.Equal(t, "USD", usage.Excluded()[0].Currency())
+		foreign, _ := valueobject.NewMoney(10, "USD")
+		assert.False(t, usage.IsComparable(foreign))
+
+		// 同時に申請する経費を加えた残額と比べる
+		pending, err := usage.WithCommitted(within)
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, pending.Remaining())
+		assert.True(t, pending.WouldExceed(within))
+	})
+
+	t.Run("期間と部署で対象の経費を判定する", func(t *testing.T) {
+		scope, err := NewBudgetScope(nil, nil, salesID)
+		require.NoError(t, err)
+		budget, err := NewBudget(clock.System(), "営業部予算", scope, periodFrom, today, budgetAmount)
+		require.NoError(t, err)
+
+		other, err := NewUser(clock.System(), "鈴木一郎", "suzuki@example.com")
+		require.NoError(t, err)
+
+		assert.True(t, budget.Covers(newExpense(1000, "JPY", today, ExpenseStatusDraft), member))
+		assert.False(t, budget.Covers(newExpense(1000, "JPY", periodFrom.AddDays(-1), ExpenseStatusDraft), member))
+		assert.False(t, budget.Covers(newExpense(1000, "JPY", today, ExpenseStatusDraft), other))
+		assert.False(t, budget.Covers(newExpense(1000, "JPY", today, ExpenseStatusDraft), nil))
+	})
+
+	t.Run("不正な予算はエラー", func(t *testing.T) {
+		_, err := NewBudgetScope(nil, nil, nil)
+		assert.Error(t, err)
+
+		scope, _ := NewBudgetScope(categoryID, nil, nil)
+		_, err = NewBudget(clock.System(), "", scope, periodFrom, today, budgetAmount)
+		assert.Error(t, err)
+		_, err = NewBudget(clock.System(), "逆転", scope, today, periodFrom, budgetAmount)
+		assert.Error(t, err)
+		_, err = NewBudget(clock.System(), "長期", scope, today, today.AddDays(366), budgetAmount)
+		assert.Error(t, err)
+		zero, _ := valueobject.NewMoney(0, "JPY")
+		_, err = NewBudget(clock.System(), "ゼロ", scope, periodFrom, today, zero)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,335 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// CardTransactionStatus カード利用明細の照合状態
+type CardTransactionStatus string
+
+const (
+	CardTransactionStatusUnmatched CardTransactionStatus = "unmatched" // 未照合
+	CardTransactionStatusMatched   CardTransactionStatus = "matched"   // 照合済み
+)
+
+const (
+	// CardMatchDateToleranceDays 照合対象とする利用日と経費日付の最大差（日）
+	CardMatchDateToleranceDays = 3
+
+	// CardMatchThreshold 自動照合に必要な最低スコア
+	CardMatchThreshold = 0.7
+
+	// 照合スコアの配点（金額の一致・日付の近さ・利用先の類似度）
+	cardMatchAmountWeight = 0.5
+	cardMatchDateWeight   = 0.3
+	cardMatchVendorWeight = 0.2
+)
+
+// CardTransaction 法人カードの利用明細エンティティ
+type CardTransaction struct {
+	id              *valueobject.CardTransactionID
+	userID          *valueobject.UserID
+	externalID      string // 明細の一意な識別子（OFXのFITID、またはCSV行のハッシュ）
+	transactionDate time.Time
+	vendor          string
+	amount          *valueobject.Money
+	description     string
+	status          CardTransactionStatus
+	expenseID       *valueobject.ExpenseID // 照合された経費
+	importedAt      time.Time
+	updatedAt       time.Time
+}
+
+// NewCardTransaction 新しいCardTransactionを作成
+func NewCardTransaction(clk clock.Clock, userID *valueobject.UserID, externalID string, transactionDate time.Time, vendor string, amount *valueobject.Money, description string) (*CardTransaction, error) {
+	if userID == nil {
+		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
+	}
+
+	if err := validateCardTransaction(externalID, transactionDate, vendor, amount, description); err != nil {
+		return nil, err
+	}
+
+	now := clk.Now()
+	return &CardTransaction{
+		id:              valueobject.GenerateCardTransactionID(),
+		userID:          userID,
+		externalID:      strings.TrimSpace(externalID),
+		transactionDate: transactionDate,
+		vendor:          strings.TrimSpace(vendor),
+		amount:          amount,
+		description:     strings.TrimSpace(description),
+		status:          CardTransactionStatusUnmatched,
+		importedAt:      now,
+		updatedAt:       now,
+	}, nil
+}
+
+// ReconstructCardTransaction 既存データからCardTransactionを再構築
+func ReconstructCardTransaction(
+	id *valueobject.CardTransactionID,
+	userID *valueobject.UserID,
+	externalID string,
+	transactionDate time.Time,
+	vendor string,
+	amount *valueobject.Money,
+	description string,
+	status CardTransactionStatus,
+	expenseID *valueobject.ExpenseID,
+	importedAt, updatedAt time.Time,
+) (*CardTransaction, error) {
+	if id == nil {
+		return nil, errors.NewDomainError(errors.InvalidCardTransactionID, "カード利用明細IDが必要です")
+	}
+
+	if userID == nil {
+		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
+	}
+
+	if err := validateCardTransaction(externalID, transactionDate, vendor, amount, description); err != nil {
+		return nil, err
+	}
+
+	switch status {
+	case CardTransactionStatusUnmatched:
+		if expenseID != nil {
+			return nil, errors.NewDomainError(errors.InvalidCardTransaction, "未照合のカード利用明細に経費は設定できません")
+		}
+	case CardTransactionStatusMatched:
+		if expenseID == nil {
+			return nil, errors.NewDomainError(errors.InvalidCardTransaction, "照合済みのカード利用明細には経費が必要です")
+		}
+	default:
+		return nil, errors.NewDomainError(errors.InvalidCardTransaction, "無効な照合状態です")
+	}
+
+	return &CardTransaction{
+		id:              id,
+		userID:          userID,
+		externalID:      externalID,
+		transactionDate: transactionDate,
+		vendor:          vendor,
+		amount:          amount,
+		description:     description,
+		status:          status,
+		expenseID:       expenseID,
+		importedAt:      importedAt,
+		updatedAt:       updatedAt,
+	}, nil
+}
+
+// ID IDを取得
+func (t *CardTransaction) ID() *valueobject.CardTransactionID {
+	return t.id
+}
+
+// UserID カード利用者のユーザーIDを取得
+func (t *CardTransaction) UserID() *valueobject.UserID {
+	return t.userID
+}
+
+// ExternalID 明細の一意な識別子を取得
+func (t *CardTransaction) ExternalID() string {
+	return t.externalID
+}
+
+// TransactionDate 利用日を取得
+func (t *CardTransaction) TransactionDate() time.Time {
+	return t.transactionDate
+}
+
+// Vendor 利用先を取得
+func (t *CardTransaction) Vendor() string {
+	return t.vendor
+}
+
+// Amount 利用金額を取得
+func (t *CardTransaction) Amount() *valueobject.Money {
+	return t.amount
+}
+
+// Description 備考を取得
+func (t *CardTransaction) Description() string {
+	return t.description
+}
+
+// Status 照合状態を取得
+func (t *CardTransaction) Status() CardTransactionStatus {
+	return t.status
+}
+
+// ExpenseID 照合された経費IDを取得（未照合の場合はnil）
+func (t *CardTransaction) ExpenseID() *valueobject.ExpenseID {
+	return t.expenseID
+}
+
+// ImportedAt 取込日時を取得
+func (t *CardTransaction) ImportedAt() time.Time {
+	return t.importedAt
+}
+
+// UpdatedAt 更新日時を取得
+func (t *CardTransaction) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// IsReconciled 照合済みかどうか
+func (t *CardTransaction) IsReconciled() bool {
+	return t.status == CardTransactionStatusMatched
+}
+
+// Match 経費と照合
+func (t *CardTransaction) Match(expense *Expense, now time.Time) error {
+	if t.status != CardTransactionStatusUnmatched {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "未照合のカード利用明細のみ照合できます")
+	}
+
+	if expense == nil {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "照合する経費が必要です")
+	}
+
+	if !expense.UserID().Equals(t.userID) {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "他のユーザーの経費とは照合できません")
+	}
+
+	if expense.Amount().Currency() != t.amount.Currency() {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "カード利用明細と異なる通貨の経費とは照合できません")
+	}
+
+	t.expenseID = expense.ID()
+	t.status = CardTransactionStatusMatched
+	t.updatedAt = now
+
+	return nil
+}
+
+// Unmatch 経費との照合を解除
+func (t *CardTransaction) Unmatch(now time.Time) error {
+	if t.status != CardTransactionStatusMatched {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "照合済みのカード利用明細のみ照合を解除できます")
+	}
+
+	t.expenseID = nil
+	t.status = CardTransactionStatusUnmatched
+	t.updatedAt = now
+
+	return nil
+}
+
+// MatchScore 経費との照合スコアを計算（0〜1）
+// 金額と通貨が一致しない場合、または日付の差が許容範囲を超える場合は0
+func (t *CardTransaction) MatchScore(expense *Expense) float64 {
+	if !t.amount.Equals(expense.Amount()) {
+		return 0
+	}
+
+	days := math.Abs(float64(valueobject.DateOf(t.transactionDate).DaysSince(expense.Date())))
+	if days > CardMatchDateToleranceDays {
+		return 0
+	}
+
+	dateScore := 1 - days/(CardMatchDateToleranceDays+1)
+	vendorScore := vendorSimilarity(t.vendor, expense.Title()+" "+expense.Description())
+
+	return cardMatchAmountWeight + cardMatchDateWeight*dateScore + cardMatchVendorWeight*vendorScore
+}
+
+// vendorSimilarity 利用先と経費のタイトル・説明の類似度を計算（0〜1）
+// 一方が他方に含まれる場合は1、それ以外は文字バイグラムのDice係数
+func vendorSimilarity(vendor, text string) float64 {
+	a := normalizeVendor(vendor)
+	b := normalizeVendor(text)
+	if a == "" || b == "" {
+		return 0
+	}
+
+	if strings.Contains(b, a) || strings.Contains(a, b) {
+		return 1
+	}
+
+	aBigrams := bigrams(a)
+	bBigrams := bigrams(b)
+	if len(aBigrams) == 0 || len(bBigrams) == 0 {
+		return 0
+	}
+
+	counts := make(map[string]int)
+	for _, bigram := range bBigrams {
+		counts[bigram]++
+	}
+
+	common := 0
+	for _, bigram := range aBigrams {
+		if counts[bigram] > 0 {
+			counts[bigram]--
+			common++
+		}
+	}
+
+	return float64(2*common) / float64(len(aBigrams)+len(bBigrams))
+}
+
+// normalizeVendor 比較用に大文字小文字・空白・記号の違いを除去
+func normalizeVendor(value string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(value) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// bigrams 文字バイグラムの一覧を取得
+func bigrams(value string) []string {
+	runes := []rune(value)
+	if len(runes) < 2 {
+		return nil
+	}
+
+	result := make([]string, len(runes)-1)
+	for i := 0; i < len(runes)-1; i++ {
+		result[i] = string(runes[i : i+2])
+	}
+	return result
+}
+
+// validateCardTransaction カード利用明細のバリデーション
+func validateCardTransaction(externalID string, transactionDate time.Time, vendor string, amount *valueobject.Money, description string) error {
+	if strings.TrimSpace(externalID) == "" {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "明細の識別子は必須です")
+	}
+
+	if transactionDate.IsZero() {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "利用日は必須です")
+	}
+
+	vendor = strings.TrimSpace(vendor)
+	if vendor == "" {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "利用先は必須です")
+	}
+
+	if len(vendor) > 200 {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "利用先は200文字以内である必要があります")
+	}
+
+	if amount == nil {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "利用金額は必須です")
+	}
+
+	if amount.Amount() <= 0 {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "利用金額は0より大きい必要があります")
+	}
+
+	if len(description) > 1000 {
+		return errors.NewDomainError(errors.InvalidCardTransaction, "備考は1000文字以内である必要があります")
+	}
+
+	return nil
+}
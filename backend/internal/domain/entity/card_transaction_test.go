@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardTransaction_MatchScore(t *testing.T) {
+	userID := valueobject.GenerateUserID()
+	categoryID := valueobject.GenerateCategoryID()
+	date := time.Now().AddDate(0, 0, -10)
+
+	amount, _ := valueobject.NewMoney(4400, "JPY")
+	transaction, err := NewCardTransaction(clock.System(), userID, "FIT-001", date, "TOKYO TAXI CO", amount, "")
+	require.NoError(t, err)
+
+	newExpense := func(amount float64, title string, date time.Time) *Expense {
+		money, _ := valueobject.NewMoney(amount, "JPY")
+		expense, err := NewExpense(clock.System(), userID, categoryID, money, title, "", valueobject.DateOf(date))
+		require.NoError(t, err)
+		return expense
+	}
+
+	t.Run("金額・日付・利用先が一致する場合は満点", func(t *testing.T) {
+		score := transaction.MatchScore(newExpense(4400, "Tokyo Taxi Co 客先訪問", date))
+		assert.InDelta(t, 1.0, score, 0.0001)
+	})
+
+	t.Run("日付が近ければ利用先が異なっても照合対象", func(t *testing.T) {
+		score := transaction.MatchScore(newExpense(4400, "移動費", date.AddDate(0, 0, 1)))
+		assert.GreaterOrEqual(t, score, CardMatchThreshold)
+	})
+
+	t.Run("日付が離れている場合は利用先の類似度が必要", func(t *testing.T) {
+		assert.Less(t, transaction.MatchScore(newExpense(4400, "移動費", date.AddDate(0, 0, -3))), CardMatchThreshold)
+		assert.GreaterOrEqual(t, transaction.MatchScore(newExpense(4400, "TOKYO TAXI", date.AddDate(0, 0, -3))), CardMatchThreshold)
+	})
+
+	t.Run("金額が異なる場合や許容日数を超える場合は0", func(t *testing.T) {
+		assert.Equal(t, 0.0, transaction.MatchScore(newExpense(4401, "TOKYO TAXI CO", date)))
+		assert.Equal(t, 0.0, transaction.MatchScore(newExpense(4400, "TOKYO TAXI CO", date.AddDate(0, 0, -4))))
+	})
+
+	t.Run("照合と照合解除", func(t *testing.T) {
+		expense := newExpense(4400, "TOKYO TAXI CO", date)
+		require.NoError(t, transaction.Match(expense, time.Now()))
+		assert.True(t, transaction.IsReconciled())
+		assert.Equal(t, expense.ID(), transaction.ExpenseID())
+		assert.Error(t, transaction.Match(expense, time.Now()))
+
+		require.NoError(t, transaction.Unmatch(time.Now()))
+		assert.False(t, transaction.IsReconciled())
+		assert.Nil(t, transaction.ExpenseID())
+	})
+
+	t.Run("他のユーザーの経費とは照合できない", func(t *testing.T) {
+		money, _ := valueobject.NewMoney(4400, "JPY")
+		other, err := NewExpense(clock.System(), valueobject.GenerateUserID(), categoryID, money, "TOKYO TAXI CO", "", valueobject.DateOf(date))
+		require.NoError(t, err)
+		assert.Error(t, transaction.Match(other, time.Now()))
+	})
+}
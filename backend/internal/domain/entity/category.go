@@ -1,8 +1,10 @@
 package entity
 
 import (
+	"expense-management-system/internal/domain/clock"
 	"expense-management-system/internal/domain/valueobject"
 	"expense-management-system/pkg/errors"
+	"fmt"
 	"strings"
 	"time"
 )
@@ -15,10 +17,18 @@ type Category struct {
 	color       string
 	createdAt   time.Time
 	updatedAt   time.Time
+
+	accountMapping *valueobject.AccountMapping    // 会計ソフトへの仕訳の対応（未設定の場合はnil）
+	datePolicy     *valueobject.ExpenseDatePolicy // 経費日付として認める期間（未設定の場合はnil）
+	spendingRules  []*valueobject.SpendingRule    // 支出規程のルール
+	entertainment  bool                           // 飲食・接待のカテゴリかどうか（会議費・交際費の区分の対象）
 }
 
+// maxSpendingRules カテゴリに設定できる支出規程のルールの上限
+const maxSpendingRules = 20
+
 // NewCategory 新しいCategoryを作成
-func NewCategory(name, description, color string) (*Category, error) {
+func NewCategory(clk clock.Clock, name, description, color string) (*Category, error) {
 	if err := validateCategoryName(name); err != nil {
 		return nil, err
 	}
@@ -31,7 +41,7 @@ func NewCategory(name, description, color string) (*Category, error) {
 		return nil, err
 	}
 
-	now := time.Now()
+	now := clk.Now()
 	return &Category{
 		id:          valueobject.GenerateCategoryID(),
 		name:        strings.TrimSpace(name),
@@ -43,7 +53,7 @@ func NewCategory(name, description, color string) (*Category, error) {
 }
 
 // ReconstructCategory 既存データからCategoryを再構築
-func ReconstructCategory(id *valueobject.CategoryID, name, description, color string, createdAt, updatedAt time.Time) (*Category, error) {
+func ReconstructCategory(id *valueobject.CategoryID, name, description, color string, accountMapping *valueobject.AccountMapping, datePolicy *valueobject.ExpenseDatePolicy, spendingRules []*valueobject.SpendingRule, entertainment bool, createdAt, updatedAt time.Time) (*Category, error) {
 	if id == nil {
 		return nil, errors.NewDomainError(errors.InvalidCategoryID, "カテゴリIDが必要です")
 	}
@@ -60,6 +70,10 @@ func ReconstructCategory(id *valueobject.CategoryID, name, description, color st
 		return nil, err
 	}
 
+	if err := validateSpendingRules(spendingRules); err != nil {
+		return nil, err
+	}
+
 	return &Category{
 		id:          id,
 		name:        name,
@@ -67,6 +81,11 @@ func ReconstructCategory(id *valueobject.CategoryID, name, description, color st
 		color:       color,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
+
+		accountMapping: accountMapping,
+		datePolicy:     datePolicy,
+		spendingRules:  spendingRules,
+		entertainment:  entertainment,
 	}, nil
 }
 
@@ -90,6 +109,21 @@ func (c *Category) Color() string {
 	return c.color
 }
 
+// AccountMapping 会計ソフトへの仕訳の対応を取得（未設定の場合はnil）
+func (c *Category) AccountMapping() *valueobject.AccountMapping {
+	return c.accountMapping
+}
+
+// DatePolicy 経費日付として認める期間を取得（未設定の場合はnil）
+func (c *Category) DatePolicy() *valueobject.ExpenseDatePolicy {
+	return c.datePolicy
+}
+
+// SpendingRules 支出規程のルールを取得
+func (c *Category) SpendingRules() []*valueobject.SpendingRule {
+	return c.spendingRules
+}
+
 // CreatedAt 作成日時を取得
 func (c *Category) CreatedAt() time.Time {
 	return c.createdAt
@@ -101,7 +135,7 @@ func (c *Category) UpdatedAt() time.Time {
 }
 
 // Update カテゴリ情報を更新
-func (c *Category) Update(name, description, color string) error {
+func (c *Category) Update(name, description, color string, now time.Time) error {
 	if err := validateCategoryName(name); err != nil {
 		return err
 	}
@@ -117,7 +151,105 @@ func (c *Category) Update(name, description, color string) error {
 	c.name = strings.TrimSpace(name)
 	c.description = strings.TrimSpace(description)
 	c.color = strings.TrimSpace(color)
-	c.updatedAt = time.Now()
+	c.updatedAt = now
+
+	return nil
+}
+
+// ChangeAccountMapping 会計ソフトへの仕訳の対応を変更（nilの場合は解除）
+func (c *Category) ChangeAccountMapping(accountMapping *valueobject.AccountMapping, now time.Time) {
+	c.accountMapping = accountMapping
+	c.updatedAt = now
+}
+
+// ChangeDatePolicy 経費日付として認める期間を変更（nilの場合は既定の期間）
+func (c *Category) ChangeDatePolicy(datePolicy *valueobject.ExpenseDatePolicy, now time.Time) {
+	c.datePolicy = datePolicy
+	c.updatedAt = now
+}
+
+// IsEntertainment 飲食・接待のカテゴリかどうか
+func (c *Category) IsEntertainment() bool {
+	return c.entertainment
+}
+
+// ChangeEntertainment 飲食・接待のカテゴリかどうかを変更
+func (c *Category) ChangeEntertainment(entertainment bool, now time.Time) {
+	c.entertainment = entertainment
+	c.updatedAt = now
+}
+
+// ChangeSpendingRules 支出規程のルールを変更（空の場合は解除）
+func (c *Category) ChangeSpendingRules(spendingRules []*valueobject.SpendingRule, now time.Time) error {
+	if err := validateSpendingRules(spendingRules); err != nil {
+		return err
+	}
+
+	c.spendingRules = spendingRules
+	c.updatedAt = now
+
+	return nil
+}
+
+// EvaluateSpendingRules 経費がカテゴリの支出規程に違反していないか判定し、違反を全て返す
+// 明細に分けた経費は、このカテゴリの明細ごとに明細の金額で判定する
+func (c *Category) EvaluateSpendingRules(expense *Expense) []*valueobject.PolicyViolation {
+	violations := make([]*valueobject.PolicyViolation, 0)
+	for _, line := range expense.Lines() {
+		// 明細に分けた経費はこのカテゴリの明細だけを判定する
+		if expense.IsItemized() && !c.id.Equals(line.CategoryID()) {
+			continue
+		}
+
+		facts := expense.LineSpendingFacts(line)
+		for _, rule := range c.spendingRules {
+			violation := rule.Evaluate(facts)
+			if violation == nil {
+				continue
+			}
+
+			// 明細の違反はどの明細かが分かるようにする
+			if expense.IsItemized() {
+				label := line.Description()
+				if label == "" {
+					label = c.name
+				}
+				violation = valueobject.NewPolicyViolation(violation.Rule(), violation.Enforcement(), "明細「"+label+"」: "+violation.Message())
+			}
+			violations = append(violations, violation)
+		}
+	}
+
+	return violations
+}
+
+// CheckExpenseDate 経費日付をカテゴリの期間（未設定の場合は既定の期間）で、利用者のタイムゾーンの今日と比べて検証
+// 古すぎる日付を警告として扱う場合は、エラーの代わりに警告メッセージを返す
+func (c *Category) CheckExpenseDate(user *User, date valueobject.Date, now time.Time) (string, error) {
+	policy := c.datePolicy
+	if policy == nil {
+		policy = valueobject.DefaultExpenseDatePolicy()
+	}
+
+	loc := time.UTC
+	if user != nil {
+		loc = user.Location()
+	}
+
+	return policy.Evaluate(date, now, loc)
+}
+
+// validateSpendingRules 支出規程のルールのバリデーション
+func validateSpendingRules(spendingRules []*valueobject.SpendingRule) error {
+	if len(spendingRules) > maxSpendingRules {
+		return errors.NewDomainError(errors.InvalidSpendingRule, fmt.Sprintf("支出規程のルールは%d件以内である必要があります", maxSpendingRules))
+	}
+
+	for _, rule := range spendingRules {
+		if rule == nil {
+			return errors.NewDomainError(errors.InvalidSpendingRule, "支出規程のルールが必要です")
+		}
+	}
 
 	return nil
 }
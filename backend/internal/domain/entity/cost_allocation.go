@@ -0,0 +1,214 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"math"
+)
+
+// maxCostAllocations 1件の経費を按分できるコストセンターの上限
+const maxCostAllocations = 20
+
+// CostAllocation 経費のコストセンターへの按分
+type CostAllocation struct {
+	costCenterID *valueobject.CostCenterID
+	percentage   float64            // 割合で指定した場合の按分率（%、金額で指定した場合は0）
+	amount       *valueobject.Money // 按分した金額（割合で指定した場合はAllocateCostで計算）
+}
+
+// NewPercentageAllocation 割合（%）で指定した按分を作成
+func NewPercentageAllocation(costCenterID *valueobject.CostCenterID, percentage float64) (*CostAllocation, error) {
+	if costCenterID == nil {
+		return nil, errors.NewDomainError(errors.InvalidCostAllocation, "按分先のコストセンターが必要です")
+	}
+
+	if percentage <= 0 || percentage > 100 {
+		return nil, errors.NewDomainError(errors.InvalidCostAllocation, "按分の割合は0より大きく100以下である必要があります")
+	}
+
+	return &CostAllocation{costCenterID: costCenterID, percentage: percentage}, nil
+}
+
+// NewAmountAllocation 金額で指定した按分を作成
+func NewAmountAllocation(costCenterID *valueobject.CostCenterID, amount *valueobject.Money) (*CostAllocation, error) {
+	if costCenterID == nil {
+		return nil, errors.NewDomainError(errors.InvalidCostAllocation, "按分先のコストセンターが必要です")
+	}
+
+	if amount == nil || amount.Amount() <= 0 {
+		return nil, errors.NewDomainError(errors.InvalidCostAllocation, "按分の金額は0より大きい必要があります")
+	}
+
+	return &CostAllocation{costCenterID: costCenterID, amount: amount}, nil
+}
+
+// CostCenterID 按分先のコストセンターIDを取得
+func (a *CostAllocation) CostCenterID() *valueobject.CostCenterID {
+	return a.costCenterID
+}
+
+// Percentage 按分の割合（%）を取得（金額で指定した場合は0）
+func (a *CostAllocation) Percentage() float64 {
+	return a.percentage
+}
+
+// IsPercentage 割合で指定した按分かどうか
+func (a *CostAllocation) IsPercentage() bool {
+	return a.percentage > 0
+}
+
+// Amount 按分した金額を取得
+func (a *CostAllocation) Amount() *valueobject.Money {
+	return a.amount
+}
+
+// AllocateCost 経費の合計金額を按分に割り当てる
+// 金額で指定した按分を先に差し引き、残りの金額を割合で指定した按分に割り当てる（割合の合計は100%）
+// 割合で計算した金額は日本円では1円未満、それ以外の通貨では0.01未満を切り捨て、端数は最後の割合の按分で調整する
+// 按分した金額の合計が経費の合計金額と一致しない場合はエラー
+func AllocateCost(total *valueobject.Money, allocations []*CostAllocation) ([]*CostAllocation, error) {
+	if len(allocations) == 0 {
+		return nil, nil
+	}
+
+	if len(allocations) > maxCostAllocations {
+		return nil, errors.NewDomainError(errors.InvalidCostAllocation, "按分できるコストセンターは20件までです")
+	}
+
+	seen := make(map[string]bool, len(allocations))
+	fixed, err := valueobject.NewMoney(0, total.Currency())
+	if err != nil {
+		return nil, err
+	}
+	var percentageTotal float64
+	lastPercentage := -1
+	for i, allocation := range allocations {
+		if seen[allocation.costCenterID.String()] {
+			return nil, errors.NewDomainError(errors.InvalidCostAllocation, "同じコストセンターに重複して按分できません")
+		}
+		seen[allocation.costCenterID.String()] = true
+
+		if allocation.IsPercentage() {
+			percentageTotal += allocation.percentage
+			lastPercentage = i
+			continue
+		}
+
+		fixed, err = fixed.Add(allocation.amount)
+		if err != nil {
+			return nil, errors.NewDomainError(errors.InvalidCostAllocation, "按分の金額は経費と同じ通貨で指定してください")
+		}
+	}
+
+	if fixed.IsGreaterThan(total) {
+		return nil, errors.NewDomainError(errors.InvalidCostAllocation, "按分の金額の合計が経費の金額を超えています")
+	}
+
+	if lastPercentage >= 0 && math.Abs(percentageTotal-100) > 1e-9 {
+		return nil, errors.NewDomainError(errors.InvalidCostAllocation, "按分の割合の合計は100%である必要があります")
+	}
+
+	remaining, err := total.Subtract(fixed)
+	if err != nil {
+		return nil, err
+	}
+
+	if lastPercentage >= 0 && remaining.Amount() == 0 {
+		return nil, errors.NewDomainError(errors.InvalidCostAllocation, "割合で按分する残りの金額がありません")
+	}
+
+	unit := 0.01
+	if total.Currency() == "JPY" {
+		unit = 1
+	}
+
+	resolved := make([]*CostAllocation, len(allocations))
+	allocated, err := valueobject.NewMoney(0, total.Currency())
+	if err != nil {
+		return nil, err
+	}
+	for i, allocation := range allocations {
+		if i == lastPercentage {
+			continue
+		}
+
+		amount := allocation.amount
+		if allocation.IsPercentage() {
+			amount, err = valueobject.NewMoney(math.Floor(remaining.Amount()*allocation.percentage/100/unit+1e-9)*unit, total.Currency())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		allocated, err = allocated.Add(amount)
+		if err != nil {
+			return nil, err
+		}
+		resolved[i] = &CostAllocation{costCenterID: allocation.costCenterID, percentage: allocation.percentage, amount: amount}
+	}
+
+	// 端数は最後の割合の按分で調整する
+	if lastPercentage >= 0 {
+		allocation := allocations[lastPercentage]
+		amount, err := total.Subtract(allocated)
+		if err != nil {
+			return nil, err
+		}
+
+		allocated, err = allocated.Add(amount)
+		if err != nil {
+			return nil, err
+		}
+		resolved[lastPercentage] = &CostAllocation{costCenterID: allocation.costCenterID, percentage: allocation.percentage, amount: amount}
+	}
+
+	if !allocated.Equals(total) {
+		return nil, errors.NewDomainError(errors.InvalidCostAllocation, "按分の金額の合計が経費の金額と一致しません")
+	}
+
+	return resolved, nil
+}
+
+// ProrateAllocations 経費の一部の金額（明細の金額など）を按分した金額の比率で割り当てる
+// 日本円では1円未満、それ以外の通貨では0.01未満を切り捨て、端数は最後の按分で調整する
+func ProrateAllocations(amount, total *valueobject.Money, allocations []*CostAllocation) ([]*valueobject.Money, error) {
+	if len(allocations) == 0 {
+		return nil, nil
+	}
+
+	if total.Amount() <= 0 {
+		return nil, errors.NewDomainError(errors.InvalidCostAllocation, "按分する経費の金額は0より大きい必要があります")
+	}
+
+	unit := 0.01
+	if amount.Currency() == "JPY" {
+		unit = 1
+	}
+
+	amounts := make([]*valueobject.Money, len(allocations))
+	allocated, err := valueobject.NewMoney(0, amount.Currency())
+	if err != nil {
+		return nil, err
+	}
+	for i, allocation := range allocations[:len(allocations)-1] {
+		share, err := valueobject.NewMoney(math.Floor(amount.Amount()*allocation.amount.Amount()/total.Amount()/unit+1e-9)*unit, amount.Currency())
+		if err != nil {
+			return nil, err
+		}
+
+		allocated, err = allocated.Add(share)
+		if err != nil {
+			return nil, err
+		}
+		amounts[i] = share
+	}
+
+	// 端数は最後の按分で調整する
+	last, err := amount.Subtract(allocated)
+	if err != nil {
+		return nil, err
+	}
+	amounts[len(allocations)-1] = last
+
+	return amounts, nil
+}
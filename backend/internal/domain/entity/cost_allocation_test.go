@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocateCost(t *testing.T) {
+	sales := valueobject.GenerateCostCenterID()
+	dev := valueobject.GenerateCostCenterID()
+	admin := valueobject.GenerateCostCenterID()
+	money := func(amount float64, currency string) *valueobject.Money {
+		m, _ := valueobject.NewMoney(amount, currency)
+		return m
+	}
+	percentage := func(id *valueobject.CostCenterID, p float64) *CostAllocation {
+		allocation, err := NewPercentageAllocation(id, p)
+		require.NoError(t, err)
+		return allocation
+	}
+	amount := func(id *valueobject.CostCenterID, m *valueobject.Money) *CostAllocation {
+		allocation, err := NewAmountAllocation(id, m)
+		require.NoError(t, err)
+		return allocation
+	}
+
+	t.Run("割合の端数は最後の按分で調整する", func(t *testing.T) {
+		resolved, err := AllocateCost(money(1000, "JPY"), []*CostAllocation{
+			percentage(sales, 33.3), percentage(dev, 33.3), percentage(admin, 33.4),
+		})
+		require.NoError(t, err)
+		require.Len(t, resolved, 3)
+		assert.Equal(t, 333.0, resolved[0].Amount().Amount())
+		assert.Equal(t, 333.0, resolved[1].Amount().Amount())
+		assert.Equal(t, 334.0, resolved[2].Amount().Amount())
+	})
+
+	t.Run("金額の按分を差し引いた残りを割合で按分する", func(t *testing.T) {
+		resolved, err := AllocateCost(money(10000, "JPY"), []*CostAllocation{
+			percentage(sales, 50), amount(dev, money(3001, "JPY")), percentage(admin, 50),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3499.0, resolved[0].Amount().Amount())
+		assert.Equal(t, 3001.0, resolved[1].Amount().Amount())
+		assert.Equal(t, 3500.0, resolved[2].Amount().Amount())
+	})
+
+	t.Run("日本円以外は0.01単位で按分する", func(t *testing.T) {
+		resolved, err := AllocateCost(money(10, "USD"), []*CostAllocation{
+			percentage(sales, 33.3), percentage(dev, 66.7),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3.33, resolved[0].Amount().Amount())
+		assert.Equal(t, 6.67, resolved[1].Amount().Amount())
+	})
+
+	t.Run("合計が経費の金額と一致しない按分はエラー", func(t *testing.T) {
+		_, err := AllocateCost(money(1000, "JPY"), []*CostAllocation{percentage(sales, 60), percentage(dev, 30)})
+		assert.Error(t, err)
+		_, err = AllocateCost(money(1000, "JPY"), []*CostAllocation{amount(sales, money(600, "JPY")), amount(dev, money(300, "JPY"))})
+		assert.Error(t, err)
+		_, err = AllocateCost(money(1000, "JPY"), []*CostAllocation{amount(sales, money(1001, "JPY"))})
+		assert.Error(t, err)
+		_, err = AllocateCost(money(1000, "JPY"), []*CostAllocation{amount(sales, money(1000, "JPY")), percentage(dev, 100)})
+		assert.Error(t, err)
+		_, err = AllocateCost(money(1000, "JPY"), []*CostAllocation{amount(sales, money(1000, "USD"))})
+		assert.Error(t, err)
+		_, err = AllocateCost(money(1000, "JPY"), []*CostAllocation{percentage(sales, 50), percentage(sales, 50)})
+		assert.Error(t, err)
+
+		_, err = NewPercentageAllocation(sales, 0)
+		assert.Error(t, err)
+		_, err = NewPercentageAllocation(sales, 100.1)
+		assert.Error(t, err)
+	})
+
+	t.Run("按分のない経費は既定のコストセンターに全額を負担させる", func(t *testing.T) {
+		expense, err := NewExpense(clock.System(), valueobject.GenerateUserID(), valueobject.GenerateCategoryID(), money(5000, "JPY"), "テスト経費", "", valueobject.DateOf(time.Now()))
+		require.NoError(t, err)
+		assert.Empty(t, expense.ChargedAllocations(nil))
+
+		charged := expense.ChargedAllocations(sales)
+		require.Len(t, charged, 1)
+		assert.True(t, charged[0].CostCenterID().Equals(sales))
+		assert.Equal(t, 5000.0, charged[0].Amount().Amount())
+
+		require.NoError(t, expense.ChangeAllocations([]*CostAllocation{percentage(sales, 40), percentage(dev, 60)}, time.Now()))
+		charged = expense.ChargedAllocations(admin)
+		require.Len(t, charged, 2)
+		assert.Equal(t, 3000.0, charged[1].Amount().Amount())
+
+		// 金額を変更すると割合の按分を計算し直す
+		require.NoError(t, expense.UpdateDetails(expense.CategoryID(), money(6000, "JPY"), expense.Title(), "", expense.Date(), time.Now()))
+		assert.Equal(t, 3600.0, expense.Allocations()[1].Amount().Amount())
+	})
+}
@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+	"time"
+)
+
+// CostCenter コストセンターエンティティ（経費を負担する部署内の単位）
+type CostCenter struct {
+	id           *valueobject.CostCenterID
+	code         string // 会計ソフトの部門として出力するコード
+	name         string
+	departmentID *valueobject.DepartmentID
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// NewCostCenter 新しいCostCenterを作成
+func NewCostCenter(clk clock.Clock, code, name string, departmentID *valueobject.DepartmentID) (*CostCenter, error) {
+	if err := validateCostCenter(code, name, departmentID); err != nil {
+		return nil, err
+	}
+
+	now := clk.Now()
+	return &CostCenter{
+		id:           valueobject.GenerateCostCenterID(),
+		code:         strings.TrimSpace(code),
+		name:         strings.TrimSpace(name),
+		departmentID: departmentID,
+		createdAt:    now,
+		updatedAt:    now,
+	}, nil
+}
+
+// ReconstructCostCenter 既存データからCostCenterを再構築
+func ReconstructCostCenter(id *valueobject.CostCenterID, code, name string, departmentID *valueobject.DepartmentID, createdAt, updatedAt time.Time) (*CostCenter, error) {
+	if id == nil {
+		return nil, errors.NewDomainError(errors.InvalidCostCenterID, "コストセンターIDが必要です")
+	}
+
+	if err := validateCostCenter(code, name, departmentID); err != nil {
+		return nil, err
+	}
+
+	return &CostCenter{
+		id:           id,
+		code:         code,
+		name:         name,
+		departmentID: departmentID,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}, nil
+}
+
+// ID IDを取得
+func (c *CostCenter) ID() *valueobject.CostCenterID {
+	return c.id
+}
+
+// Code コストセンターコードを取得
+func (c *CostCenter) Code() string {
+	return c.code
+}
+
+// Name コストセンター名を取得
+func (c *CostCenter) Name() string {
+	return c.name
+}
+
+// DepartmentID 所属する部署のIDを取得
+func (c *CostCenter) DepartmentID() *valueobject.DepartmentID {
+	return c.departmentID
+}
+
+// CreatedAt 作成日時を取得
+func (c *CostCenter) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt 更新日時を取得
+func (c *CostCenter) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// Update コストセンターを更新
+func (c *CostCenter) Update(code, name string, departmentID *valueobject.DepartmentID, now time.Time) error {
+	if err := validateCostCenter(code, name, departmentID); err != nil {
+		return err
+	}
+
+	c.code = strings.TrimSpace(code)
+	c.name = strings.TrimSpace(name)
+	c.departmentID = departmentID
+	c.updatedAt = now
+
+	return nil
+}
+
+// validateCostCenter コストセンターのバリデーション
+func validateCostCenter(code, name string, departmentID *valueobject.DepartmentID) error {
+	if err := validateOrganizationCode(code, errors.InvalidCostCenter, "コストセンター"); err != nil {
+		return err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.NewDomainError(errors.InvalidCostCenter, "コストセンター名は必須です")
+	}
+
+	if len(name) > 100 {
+		return errors.NewDomainError(errors.InvalidCostCenter, "コストセンター名は100文字以内である必要があります")
+	}
+
+	if departmentID == nil {
+		return errors.NewDomainError(errors.InvalidCostCenter, "コストセンターの所属部署が必要です")
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+	"time"
+)
+
+// maxOrganizationCodeLength 部署・コストセンターのコードの上限（文字数）
+const maxOrganizationCodeLength = 20
+
+// Department 部署エンティティ
+type Department struct {
+	id        *valueobject.DepartmentID
+	code      string // 会計ソフトなどで使う部署コード
+	name      string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewDepartment 新しいDepartmentを作成
+func NewDepartment(clk clock.Clock, code, name string) (*Department, error) {
+	if err := validateDepartment(code, name); err != nil {
+		return nil, err
+	}
+
+	now := clk.Now()
+	return &Department{
+		id:        valueobject.GenerateDepartmentID(),
+		code:      strings.TrimSpace(code),
+		name:      strings.TrimSpace(name),
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ReconstructDepartment 既存データからDepartmentを再構築
+func ReconstructDepartment(id *valueobject.DepartmentID, code, name string, createdAt, updatedAt time.Time) (*Department, error) {
+	if id == nil {
+		return nil, errors.NewDomainError(errors.InvalidDepartmentID, "部署IDが必要です")
+	}
+
+	if err := validateDepartment(code, name); err != nil {
+		return nil, err
+	}
+
+	return &Department{
+		id:        id,
+		code:      code,
+		name:      name,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}, nil
+}
+
+// ID IDを取得
+func (d *Department) ID() *valueobject.DepartmentID {
+	return d.id
+}
+
+// Code 部署コードを取得
+func (d *Department) Code() string {
+	return d.code
+}
+
+// Name 部署名を取得
+func (d *Department) Name() string {
+	return d.name
+}
+
+// CreatedAt 作成日時を取得
+func (d *Department) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// UpdatedAt 更新日時を取得
+func (d *Department) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// Update 部署コードと部署名を更新
+func (d *Department) Update(code, name string, now time.Time) error {
+	if err := validateDepartment(code, name); err != nil {
+		return err
+	}
+
+	d.code = strings.TrimSpace(code)
+	d.name = strings.TrimSpace(name)
+	d.updatedAt = now
+
+	return nil
+}
+
+// validateDepartment 部署のバリデーション
+func validateDepartment(code, name string) error {
+	if err := validateOrganizationCode(code, errors.InvalidDepartment, "部署"); err != nil {
+		return err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.NewDomainError(errors.InvalidDepartment, "部署名は必須です")
+	}
+
+	if len(name) > 100 {
+		return errors.NewDomainError(errors.InvalidDepartment, "部署名は100文字以内である必要があります")
+	}
+
+	return nil
+}
+
+// validateOrganizationCode 部署・コストセンターのコードのバリデーション（英数字・ハイフン・アンダースコアのみ）
+func validateOrganizationCode(code, errorCode, label string) error {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return errors.NewDomainError(errorCode, label+"コードは必須です")
+	}
+
+	if len(code) > maxOrganizationCodeLength {
+		return errors.NewDomainError(errorCode, label+"コードは20文字以内である必要があります")
+	}
+
+	for _, c := range code {
+		if !((c >= '0' && c <= '9') || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || c == '-' || c == '_') {
+			return errors.NewDomainError(errorCode, label+"コードは英数字・ハイフン・アンダースコアで入力してください")
+		}
+	}
+
+	return nil
+}
@@ -1,10 +1,12 @@
 package entity
 
 import (
+	"expense-management-system/internal/domain/clock"
 	"expense-management-system/internal/domain/valueobject"
 	"expense-management-system/pkg/errors"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // ExpenseStatus 経費の状態
@@ -17,6 +19,30 @@ const (
 	ExpenseStatusRejected  ExpenseStatus = "rejected"  // 却下
 )
 
+// Label ステータスの表示名を取得
+func (s ExpenseStatus) Label() string {
+	switch s {
+	case ExpenseStatusDraft:
+		return "下書き"
+	case ExpenseStatusSubmitted:
+		return "申請済み"
+	case ExpenseStatusApproved:
+		return "承認済み"
+	case ExpenseStatusRejected:
+		return "却下"
+	default:
+		return string(s)
+	}
+}
+
+// ExpenseKind 経費の種類
+type ExpenseKind string
+
+const (
+	ExpenseKindStandard ExpenseKind = "standard" // 通常の経費
+	ExpenseKindMileage  ExpenseKind = "mileage"  // 走行距離精算
+)
+
 // Expense 経費エンティティ
 type Expense struct {
 	id          *valueobject.ExpenseID
@@ -25,14 +51,47 @@ type Expense struct {
 	amount      *valueobject.Money
 	title       string
 	description string
-	date        time.Time
+	date        valueobject.Date
 	status      ExpenseStatus
 	createdAt   time.Time
 	updatedAt   time.Time
+
+	// 承認ルーティング
+	approverID      *valueobject.UserID // 現在の承認者
+	submittedAt     time.Time           // 申請日時
+	routedAt        time.Time           // 現在の承認者に回付された日時
+	escalationLevel int                 // エスカレーション回数
+	comment         string              // 申請・承認・却下時のコメント
+
+	tripRequestID *valueobject.TripRequestID // 関連する出張申請
+
+	kind    ExpenseKind // 経費の種類
+	mileage *Mileage    // 走行距離精算の明細（走行距離精算の場合のみ）
+
+	paidAt time.Time // 支払日時（未払いの場合はゼロ値）
+
+	// 支出規程
+	timeOfDay           *valueobject.TimeOfDay         // 利用時刻（未入力の場合はnil）
+	distanceKm          float64                        // 移動距離（km、未入力の場合は0）
+	policyJustification string                         // 規程外の経費として申請する理由
+	policyViolations    []*valueobject.PolicyViolation // 申請時に規程外として認めた違反
+
+	attendees []*Attendee // 飲食を伴う経費の参加者
+
+	allocations []*CostAllocation // コストセンターへの按分（未指定の場合は申請者の既定のコストセンター）
+
+	projectID *valueobject.ProjectID // 関連する顧客向けプロジェクト
+	billable  bool                   // 顧客に請求する経費かどうか
+
+	lineItems []*ExpenseLineItem // 明細（未指定の場合は経費のカテゴリと金額の1行として扱う）
+
+	// 重複の検出
+	vendor      string // 支払先（店舗・会社名など、任意）
+	receiptHash string // 領収書ファイルのハッシュ（SHA-256の16進数、任意）
 }
 
 // NewExpense 新しいExpenseを作成
-func NewExpense(userID *valueobject.UserID, categoryID *valueobject.CategoryID, amount *valueobject.Money, title, description string, date time.Time) (*Expense, error) {
+func NewExpense(clk clock.Clock, userID *valueobject.UserID, categoryID *valueobject.CategoryID, amount *valueobject.Money, title, description string, date valueobject.Date) (*Expense, error) {
 	if userID == nil {
 		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
 	}
@@ -57,7 +116,7 @@ func NewExpense(userID *valueobject.UserID, categoryID *valueobject.CategoryID,
 		return nil, err
 	}
 
-	now := time.Now()
+	now := clk.Now()
 	return &Expense{
 		id:          valueobject.GenerateExpenseID(),
 		userID:      userID,
@@ -69,9 +128,84 @@ func NewExpense(userID *valueobject.UserID, categoryID *valueobject.CategoryID,
 		status:      ExpenseStatusDraft,
 		createdAt:   now,
 		updatedAt:   now,
+		kind:        ExpenseKindStandard,
 	}, nil
 }
 
+// NewMileageExpense 走行距離精算の経費を作成（金額は走行距離と単価表から計算）
+func NewMileageExpense(clk clock.Clock, userID *valueobject.UserID, categoryID *valueobject.CategoryID, mileage *Mileage, title, description string, date valueobject.Date) (*Expense, error) {
+	if mileage == nil {
+		return nil, errors.NewDomainError(errors.InvalidMileage, "走行距離精算の明細が必要です")
+	}
+
+	amount, err := mileage.CalculateAmount(date)
+	if err != nil {
+		return nil, err
+	}
+
+	expense, err := NewExpense(clk, userID, categoryID, amount, title, description, date)
+	if err != nil {
+		return nil, err
+	}
+
+	expense.kind = ExpenseKindMileage
+	expense.mileage = mileage
+
+	return expense, nil
+}
+
+// NewExpenseInCategory 申請者とカテゴリを指定して経費を作成
+// カテゴリの経費日付として認める期間を申請者のタイムゾーンで検証し、期間外はエラー、遅延申請の期間は警告を返す
+func NewExpenseInCategory(clk clock.Clock, user *User, category *Category, amount *valueobject.Money, title, description string, date valueobject.Date) (*Expense, string, error) {
+	if err := validateExpenseOwner(user, category); err != nil {
+		return nil, "", err
+	}
+
+	expense, err := NewExpense(clk, user.ID(), category.ID(), amount, title, description, date)
+	if err != nil {
+		return nil, "", err
+	}
+
+	warning, err := category.CheckExpenseDate(user, date, clk.Now())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return expense, warning, nil
+}
+
+// NewMileageExpenseInCategory 申請者とカテゴリを指定して走行距離精算の経費を作成（経費日付の検証はNewExpenseInCategoryと同じ）
+func NewMileageExpenseInCategory(clk clock.Clock, user *User, category *Category, mileage *Mileage, title, description string, date valueobject.Date) (*Expense, string, error) {
+	if err := validateExpenseOwner(user, category); err != nil {
+		return nil, "", err
+	}
+
+	expense, err := NewMileageExpense(clk, user.ID(), category.ID(), mileage, title, description, date)
+	if err != nil {
+		return nil, "", err
+	}
+
+	warning, err := category.CheckExpenseDate(user, date, clk.Now())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return expense, warning, nil
+}
+
+// validateExpenseOwner 経費を作成する申請者とカテゴリのバリデーション
+func validateExpenseOwner(user *User, category *Category) error {
+	if user == nil {
+		return errors.NewDomainError(errors.InvalidUserID, "申請者が必要です")
+	}
+
+	if category == nil {
+		return errors.NewDomainError(errors.InvalidCategoryID, "カテゴリが必要です")
+	}
+
+	return nil
+}
+
 // ReconstructExpense 既存データからExpenseを再構築
 func ReconstructExpense(
 	id *valueobject.ExpenseID,
@@ -79,8 +213,26 @@ func ReconstructExpense(
 	categoryID *valueobject.CategoryID,
 	amount *valueobject.Money,
 	title, description string,
-	date time.Time,
+	date valueobject.Date,
 	status ExpenseStatus,
+	approverID *valueobject.UserID,
+	submittedAt, routedAt time.Time,
+	escalationLevel int,
+	comment string,
+	tripRequestID *valueobject.TripRequestID,
+	kind ExpenseKind,
+	mileage *Mileage,
+	paidAt time.Time,
+	timeOfDay *valueobject.TimeOfDay,
+	distanceKm float64,
+	policyJustification string,
+	policyViolations []*valueobject.PolicyViolation,
+	attendees []*Attendee,
+	allocations []*CostAllocation,
+	projectID *valueobject.ProjectID,
+	billable bool,
+	lineItems []*ExpenseLineItem,
+	vendor, receiptHash string,
 	createdAt, updatedAt time.Time,
 ) (*Expense, error) {
 	if id == nil {
@@ -115,20 +267,98 @@ func ReconstructExpense(
 		return nil, errors.NewDomainError("INVALID_EXPENSE_STATUS", "無効な経費ステータスです")
 	}
 
+	if escalationLevel < 0 {
+		return nil, errors.NewDomainError(errors.InvalidEscalation, "エスカレーション回数は負の値にできません")
+	}
+
+	if err := validateExpenseComment(comment); err != nil {
+		return nil, err
+	}
+
+	if err := validateExpenseKind(kind, mileage); err != nil {
+		return nil, err
+	}
+
+	if !paidAt.IsZero() && status != ExpenseStatusApproved {
+		return nil, errors.NewDomainError("INVALID_EXPENSE_STATUS", "支払済みの経費は承認済みである必要があります")
+	}
+
+	if err := validatePolicyJustification(policyJustification); err != nil {
+		return nil, err
+	}
+
+	if err := validateAttendees(attendees); err != nil {
+		return nil, err
+	}
+
+	resolved, err := AllocateCost(amount, allocations)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateBillable(projectID, billable); err != nil {
+		return nil, err
+	}
+
+	if len(lineItems) > 0 && kind == ExpenseKindMileage {
+		return nil, errors.NewDomainError(errors.InvalidLineItem, "走行距離精算の経費は明細に分けられません")
+	}
+
+	if err := validateLineItems(amount, lineItems); err != nil {
+		return nil, err
+	}
+
+	if err := validateReceipt(vendor, receiptHash); err != nil {
+		return nil, err
+	}
+
 	return &Expense{
-		id:          id,
-		userID:      userID,
-		categoryID:  categoryID,
-		amount:      amount,
-		title:       title,
-		description: description,
-		date:        date,
-		status:      status,
-		createdAt:   createdAt,
-		updatedAt:   updatedAt,
+		id:              id,
+		userID:          userID,
+		categoryID:      categoryID,
+		amount:          amount,
+		title:           title,
+		description:     description,
+		date:            date,
+		status:          status,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+		approverID:      approverID,
+		submittedAt:     submittedAt,
+		routedAt:        routedAt,
+		escalationLevel: escalationLevel,
+		comment:         comment,
+		tripRequestID:   tripRequestID,
+		kind:            kind,
+		mileage:         mileage,
+		paidAt:          paidAt,
+
+		timeOfDay:           timeOfDay,
+		distanceKm:          distanceKm,
+		policyJustification: policyJustification,
+		policyViolations:    policyViolations,
+
+		attendees: attendees,
+
+		allocations: resolved,
+
+		projectID: projectID,
+		billable:  billable,
+
+		lineItems: lineItems,
+
+		vendor:      vendor,
+		receiptHash: receiptHash,
 	}, nil
 }
 
+// Copy 経費の複製を作成（変更を全て検証してから反映するために使う）
+// 値オブジェクト・参加者・按分・明細は不変で、変更時はスライスごと置き換えるため共有する
+func (e *Expense) Copy() *Expense {
+	copied := *e
+	return &copied
+}
+
 // ID IDを取得
 func (e *Expense) ID() *valueobject.ExpenseID {
 	return e.id
@@ -159,8 +389,8 @@ func (e *Expense) Description() string {
 	return e.description
 }
 
-// Date 日付を取得
-func (e *Expense) Date() time.Time {
+// Date 経費日付（暦日）を取得
+func (e *Expense) Date() valueobject.Date {
 	return e.date
 }
 
@@ -179,8 +409,479 @@ func (e *Expense) UpdatedAt() time.Time {
 	return e.updatedAt
 }
 
+// ApproverID 現在の承認者IDを取得（未割り当ての場合はnil）
+func (e *Expense) ApproverID() *valueobject.UserID {
+	return e.approverID
+}
+
+// SubmittedAt 申請日時を取得
+func (e *Expense) SubmittedAt() time.Time {
+	return e.submittedAt
+}
+
+// RoutedAt 現在の承認者に回付された日時を取得
+func (e *Expense) RoutedAt() time.Time {
+	return e.routedAt
+}
+
+// EscalationLevel エスカレーション回数を取得
+func (e *Expense) EscalationLevel() int {
+	return e.escalationLevel
+}
+
+// Comment 申請・承認・却下時のコメントを取得
+func (e *Expense) Comment() string {
+	return e.comment
+}
+
+// SetComment 申請・承認・却下時のコメントを設定
+func (e *Expense) SetComment(comment string, now time.Time) error {
+	if err := validateExpenseComment(comment); err != nil {
+		return err
+	}
+
+	e.comment = strings.TrimSpace(comment)
+	e.updatedAt = now
+
+	return nil
+}
+
+// TripRequestID 関連する出張申請IDを取得（未設定の場合はnil）
+func (e *Expense) TripRequestID() *valueobject.TripRequestID {
+	return e.tripRequestID
+}
+
+// LinkTripRequest 出張申請を関連付け（nilの場合は解除）
+func (e *Expense) LinkTripRequest(tripRequestID *valueobject.TripRequestID, now time.Time) error {
+	if e.status != ExpenseStatusDraft {
+		return errors.NewDomainError("EXPENSE_UPDATE_NOT_ALLOWED", "下書き状態の経費のみ更新できます")
+	}
+
+	e.tripRequestID = tripRequestID
+	e.updatedAt = now
+
+	return nil
+}
+
+// Kind 経費の種類を取得
+func (e *Expense) Kind() ExpenseKind {
+	return e.kind
+}
+
+// Mileage 走行距離精算の明細を取得（走行距離精算以外はnil）
+func (e *Expense) Mileage() *Mileage {
+	return e.mileage
+}
+
+// IsMileage 走行距離精算の経費かどうか
+func (e *Expense) IsMileage() bool {
+	return e.kind == ExpenseKindMileage
+}
+
+// TimeOfDay 利用時刻を取得（未入力の場合はnil）
+func (e *Expense) TimeOfDay() *valueobject.TimeOfDay {
+	return e.timeOfDay
+}
+
+// ChangeTimeOfDay 利用時刻を変更（nilの場合は解除）
+func (e *Expense) ChangeTimeOfDay(timeOfDay *valueobject.TimeOfDay, now time.Time) error {
+	if e.status != ExpenseStatusDraft {
+		return errors.NewDomainError("EXPENSE_UPDATE_NOT_ALLOWED", "下書き状態の経費のみ更新できます")
+	}
+
+	e.timeOfDay = timeOfDay
+	e.updatedAt = now
+
+	return nil
+}
+
+// DistanceKm 移動距離（km）を取得（未入力の場合は0）
+// 走行距離精算の経費は走行距離を返す
+func (e *Expense) DistanceKm() float64 {
+	if e.mileage != nil {
+		return e.mileage.DistanceKm()
+	}
+	return e.distanceKm
+}
+
+// ChangeDistanceKm 移動距離（km）を変更（0の場合は解除）
+func (e *Expense) ChangeDistanceKm(distanceKm float64, now time.Time) error {
+	if e.status != ExpenseStatusDraft {
+		return errors.NewDomainError("EXPENSE_UPDATE_NOT_ALLOWED", "下書き状態の経費のみ更新できます")
+	}
+
+	if distanceKm < 0 {
+		return errors.NewDomainError(errors.InvalidExpenseDistance, "移動距離は0以上である必要があります")
+	}
+
+	if distanceKm > 10000 {
+		return errors.NewDomainError(errors.InvalidExpenseDistance, "移動距離は10000km以内である必要があります")
+	}
+
+	e.distanceKm = distanceKm
+	e.updatedAt = now
+
+	return nil
+}
+
+// PolicyJustification 規程外の経費として申請する理由を取得
+func (e *Expense) PolicyJustification() string {
+	return e.policyJustification
+}
+
+// ChangePolicyJustification 規程外の経費として申請する理由を変更
+func (e *Expense) ChangePolicyJustification(justification string, now time.Time) error {
+	if e.status != ExpenseStatusDraft {
+		return errors.NewDomainError("EXPENSE_UPDATE_NOT_ALLOWED", "下書き状態の経費のみ更新できます")
+	}
+
+	if err := validatePolicyJustification(justification); err != nil {
+		return err
+	}
+
+	e.policyJustification = strings.TrimSpace(justification)
+	e.updatedAt = now
+
+	return nil
+}
+
+// PolicyViolations 申請時に規程外として認めた支出規程の違反を取得
+func (e *Expense) PolicyViolations() []*valueobject.PolicyViolation {
+	return e.policyViolations
+}
+
+// IsPolicyException 規程外の経費として申請されたかどうか
+func (e *Expense) IsPolicyException() bool {
+	return len(e.policyViolations) > 0
+}
+
+// Attendees 参加者を取得
+func (e *Expense) Attendees() []*Attendee {
+	return e.attendees
+}
+
+// ChangeAttendees 参加者を変更（空の場合は解除）
+func (e *Expense) ChangeAttendees(attendees []*Attendee, now time.Time) error {
+	if e.status != ExpenseStatusDraft {
+		return errors.NewDomainError("EXPENSE_UPDATE_NOT_ALLOWED", "下書き状態の経費のみ更新できます")
+	}
+
+	if err := validateAttendees(attendees); err != nil {
+		return err
+	}
+
+	e.attendees = attendees
+	e.updatedAt = now
+
+	return nil
+}
+
+// Allocations コストセンターへの按分を取得（未指定の場合は空）
+func (e *Expense) Allocations() []*CostAllocation {
+	return e.allocations
+}
+
+// ChangeAllocations コストセンターへの按分を変更（空の場合は解除）
+func (e *Expense) ChangeAllocations(allocations []*CostAllocation, now time.Time) error {
+	if e.status != ExpenseStatusDraft {
+		return errors.NewDomainError("EXPENSE_UPDATE_NOT_ALLOWED", "下書き状態の経費のみ更新できます")
+	}
+
+	resolved, err := AllocateCost(e.amount, allocations)
+	if err != nil {
+		return err
+	}
+
+	e.allocations = resolved
+	e.updatedAt = now
+
+	return nil
+}
+
+// ChargedAllocations 経費を負担するコストセンターへの按分を取得
+// 按分を指定していない経費は、申請者の既定のコストセンター（未設定の場合はnil）が全額を負担する
+func (e *Expense) ChargedAllocations(defaultCostCenterID *valueobject.CostCenterID) []*CostAllocation {
+	if len(e.allocations) > 0 || defaultCostCenterID == nil {
+		return e.allocations
+	}
+
+	return []*CostAllocation{{costCenterID: defaultCostCenterID, percentage: 100, amount: e.amount}}
+}
+
+// ProjectID 関連するプロジェクトのIDを取得（未指定の場合はnil）
+func (e *Expense) ProjectID() *valueobject.ProjectID {
+	return e.projectID
+}
+
+// IsBillable 顧客に請求する経費かどうか
+func (e *Expense) IsBillable() bool {
+	return e.billable
+}
+
+// ChangeProject プロジェクトと請求対象かどうかを変更（プロジェクトがnilの場合は解除）
+func (e *Expense) ChangeProject(projectID *valueobject.ProjectID, billable bool, now time.Time) error {
+	if e.status != ExpenseStatusDraft {
+		return errors.NewDomainError("EXPENSE_UPDATE_NOT_ALLOWED", "下書き状態の経費のみ更新できます")
+	}
+
+	if err := validateBillable(projectID, billable); err != nil {
+		return err
+	}
+
+	e.projectID = projectID
+	e.billable = billable
+	e.updatedAt = now
+
+	return nil
+}
+
+// Vendor 支払先を取得（未入力の場合は空）
+func (e *Expense) Vendor() string {
+	return e.vendor
+}
+
+// ReceiptHash 領収書ファイルのハッシュを取得（未入力の場合は空）
+func (e *Expense) ReceiptHash() string {
+	return e.receiptHash
+}
+
+// ChangeReceipt 支払先と領収書ファイルのハッシュを変更（空の場合は解除）
+// ハッシュは大文字小文字を区別せずに比較するため、小文字にして保持する
+func (e *Expense) ChangeReceipt(vendor, receiptHash string, now time.Time) error {
+	if e.status != ExpenseStatusDraft {
+		return errors.NewDomainError("EXPENSE_UPDATE_NOT_ALLOWED", "下書き状態の経費のみ更新できます")
+	}
+
+	vendor = strings.TrimSpace(vendor)
+	receiptHash = strings.ToLower(strings.TrimSpace(receiptHash))
+	if err := validateReceipt(vendor, receiptHash); err != nil {
+		return err
+	}
+
+	e.vendor = vendor
+	e.receiptHash = receiptHash
+	e.updatedAt = now
+
+	return nil
+}
+
+// LineItems 明細を取得（明細に分けていない場合は空）
+func (e *Expense) LineItems() []*ExpenseLineItem {
+	return e.lineItems
+}
+
+// IsItemized 明細に分けた経費かどうか
+func (e *Expense) IsItemized() bool {
+	return len(e.lineItems) > 0
+}
+
+// ChangeLineItems 明細を変更（空の場合は解除）
+// 明細の金額の合計は経費の金額と一致する必要がある
+func (e *Expense) ChangeLineItems(lineItems []*ExpenseLineItem, now time.Time) error {
+	if e.status != ExpenseStatusDraft {
+		return errors.NewDomainError("EXPENSE_UPDATE_NOT_ALLOWED", "下書き状態の経費のみ更新できます")
+	}
+
+	if len(lineItems) > 0 && e.IsMileage() {
+		return errors.NewDomainError(errors.InvalidLineItem, "走行距離精算の経費は明細に分けられません")
+	}
+
+	if err := validateLineItems(e.amount, lineItems); err != nil {
+		return err
+	}
+
+	e.lineItems = lineItems
+	e.updatedAt = now
+
+	return nil
+}
+
+// Lines カテゴリ別の集計や支出規程の判定に使う明細を取得
+// 明細に分けていない経費は、経費のカテゴリと金額の1行とする
+func (e *Expense) Lines() []*ExpenseLineItem {
+	if len(e.lineItems) > 0 {
+		return e.lineItems
+	}
+
+	return []*ExpenseLineItem{{categoryID: e.categoryID, amount: e.amount}}
+}
+
+// HasCategory 経費または明細のいずれかがカテゴリに該当するかどうか
+func (e *Expense) HasCategory(categoryID *valueobject.CategoryID) bool {
+	if e.categoryID.Equals(categoryID) {
+		return true
+	}
+
+	for _, lineItem := range e.lineItems {
+		if lineItem.categoryID.Equals(categoryID) {
+			return true
+		}
+	}
+	return false
+}
+
+// CategoryAmount 経費のうちカテゴリに該当する明細の金額の合計を取得
+func (e *Expense) CategoryAmount(categoryID *valueobject.CategoryID) *valueobject.Money {
+	sum, _ := valueobject.NewMoney(0, e.amount.Currency())
+	for _, line := range e.Lines() {
+		if line.categoryID.Equals(categoryID) {
+			sum, _ = sum.Add(line.amount)
+		}
+	}
+	return sum
+}
+
+// PerHeadAmount 参加者1人当たりの金額を取得（参加者がいない場合はnil）
+func (e *Expense) PerHeadAmount() *valueobject.Money {
+	if len(e.attendees) == 0 {
+		return nil
+	}
+
+	perHead, err := valueobject.NewMoney(e.amount.Amount()/float64(len(e.attendees)), e.amount.Currency())
+	if err != nil {
+		return nil
+	}
+	return perHead
+}
+
+// EntertainmentClass 参加者と1人当たりの金額から判定した会議費・交際費の区分を取得
+// isEntertainment で飲食・接待のカテゴリかどうかを判定し、該当する明細の金額の合計だけで判定する（該当する明細がない場合は区分なし）
+func (e *Expense) EntertainmentClass(isEntertainment func(categoryID *valueobject.CategoryID) bool) EntertainmentClass {
+	sum, _ := valueobject.NewMoney(0, e.amount.Currency())
+	found := false
+	for _, line := range e.Lines() {
+		if !isEntertainment(line.categoryID) {
+			continue
+		}
+		sum, _ = sum.Add(line.amount)
+		found = true
+	}
+
+	if !found {
+		return EntertainmentClassNone
+	}
+	return ClassifyEntertainment(sum, e.attendees)
+}
+
+// LineSpendingFacts 支出規程の判定に使う明細の内容を取得（金額と1人当たりの金額は明細の金額で計算する）
+func (e *Expense) LineSpendingFacts(line *ExpenseLineItem) valueobject.SpendingFacts {
+	facts := e.SpendingFacts()
+	facts.Amount = line.amount
+
+	facts.PerHeadAmount = nil
+	if len(e.attendees) > 0 {
+		if perHead, err := valueobject.NewMoney(line.amount.Amount()/float64(len(e.attendees)), line.amount.Currency()); err == nil {
+			facts.PerHeadAmount = perHead
+		}
+	}
+
+	return facts
+}
+
+// SpendingFacts 支出規程の判定に使う経費の内容を取得
+func (e *Expense) SpendingFacts() valueobject.SpendingFacts {
+	provided := make([]string, 0, 5)
+	if e.description != "" {
+		provided = append(provided, valueobject.SpendingFieldDescription)
+	}
+	if e.tripRequestID != nil {
+		provided = append(provided, valueobject.SpendingFieldTripRequest)
+	}
+	if e.timeOfDay != nil {
+		provided = append(provided, valueobject.SpendingFieldTime)
+	}
+	if len(e.attendees) > 0 {
+		provided = append(provided, valueobject.SpendingFieldAttendees)
+	}
+	if e.DistanceKm() > 0 {
+		provided = append(provided, valueobject.SpendingFieldDistance)
+	}
+
+	return valueobject.SpendingFacts{
+		Amount:         e.amount,
+		Date:           e.date,
+		Time:           e.timeOfDay,
+		PerHeadAmount:  e.PerHeadAmount(),
+		DistanceKm:     e.DistanceKm(),
+		ProvidedFields: provided,
+	}
+}
+
+// AcceptPolicyViolations 申請前に支出規程の違反を確認し、規程外の経費として記録
+// 申請できない違反がある場合や、規程外の経費として申請する理由が未入力の場合はエラー
+func (e *Expense) AcceptPolicyViolations(violations []*valueobject.PolicyViolation) error {
+	if e.status != ExpenseStatusDraft {
+		return errors.NewDomainError("EXPENSE_SUBMIT_NOT_ALLOWED", "下書き状態の経費のみ申請できます")
+	}
+
+	blocking := make([]string, 0)
+	for _, violation := range violations {
+		if violation.IsBlocking() {
+			blocking = append(blocking, violation.Message())
+		}
+	}
+	if len(blocking) > 0 {
+		return errors.NewDomainError(errors.PolicyViolation, "支出規程に違反しているため申請できません: "+strings.Join(blocking, " / "))
+	}
+
+	if len(violations) > 0 && e.policyJustification == "" {
+		return errors.NewDomainError(errors.JustificationRequired, "支出規程の対象外となる経費を申請するには理由の入力が必要です")
+	}
+
+	e.policyViolations = violations
+
+	return nil
+}
+
 // UpdateDetails 経費の詳細を更新
-func (e *Expense) UpdateDetails(categoryID *valueobject.CategoryID, amount *valueobject.Money, title, description string, date time.Time) error {
+// 走行距離精算の金額は走行距離から計算されるため、現在の金額から変更することはできない
+func (e *Expense) UpdateDetails(categoryID *valueobject.CategoryID, amount *valueobject.Money, title, description string, date valueobject.Date, now time.Time) error {
+	if amount == nil {
+		return errors.NewDomainError(errors.InvalidExpenseAmount, "金額が必要です")
+	}
+
+	if e.IsMileage() {
+		if !amount.Equals(e.amount) {
+			return errors.NewDomainError(errors.MileageAmountLocked, "走行距離精算の金額は直接変更できません。走行距離を変更してください")
+		}
+
+		// 日付の変更で適用される単価が変わる場合も金額は走行距離から再計算する
+		recalculated, err := e.mileage.CalculateAmount(date)
+		if err != nil {
+			return err
+		}
+		amount = recalculated
+	}
+
+	return e.applyDetails(categoryID, amount, title, description, date, now)
+}
+
+// UpdateMileage 走行距離精算の明細と詳細を更新（金額は走行距離と単価表から再計算）
+func (e *Expense) UpdateMileage(categoryID *valueobject.CategoryID, mileage *Mileage, title, description string, date valueobject.Date, now time.Time) error {
+	if !e.IsMileage() {
+		return errors.NewDomainError(errors.InvalidMileage, "走行距離精算の経費ではありません")
+	}
+
+	if mileage == nil {
+		return errors.NewDomainError(errors.InvalidMileage, "走行距離精算の明細が必要です")
+	}
+
+	amount, err := mileage.CalculateAmount(date)
+	if err != nil {
+		return err
+	}
+
+	if err := e.applyDetails(categoryID, amount, title, description, date, now); err != nil {
+		return err
+	}
+
+	e.mileage = mileage
+
+	return nil
+}
+
+// applyDetails 経費の詳細を検証して反映
+func (e *Expense) applyDetails(categoryID *valueobject.CategoryID, amount *valueobject.Money, title, description string, date valueobject.Date, now time.Time) error {
 	// 下書き状態でのみ更新可能
 	if e.status != ExpenseStatusDraft {
 		return errors.NewDomainError("EXPENSE_UPDATE_NOT_ALLOWED", "下書き状態の経費のみ更新できます")
@@ -206,57 +907,161 @@ func (e *Expense) UpdateDetails(categoryID *valueobject.CategoryID, amount *valu
 		return err
 	}
 
+	// 按分は変更後の金額で割り当て直す（金額で指定した按分の合計が一致しない場合はエラー）
+	allocations, err := AllocateCost(amount, e.allocations)
+	if err != nil {
+		return err
+	}
+
+	// 明細は金額を変更しないため、合計が変更後の金額と一致しない場合はエラー
+	if err := validateLineItems(amount, e.lineItems); err != nil {
+		return err
+	}
+
 	e.categoryID = categoryID
 	e.amount = amount
+	e.allocations = allocations
 	e.title = strings.TrimSpace(title)
 	e.description = strings.TrimSpace(description)
 	e.date = date
-	e.updatedAt = time.Now()
+	e.updatedAt = now
 
 	return nil
 }
 
 // Submit 経費を申請
-func (e *Expense) Submit() error {
+func (e *Expense) Submit(now time.Time) error {
 	if e.status != ExpenseStatusDraft {
 		return errors.NewDomainError("EXPENSE_SUBMIT_NOT_ALLOWED", "下書き状態の経費のみ申請できます")
 	}
 
 	e.status = ExpenseStatusSubmitted
-	e.updatedAt = time.Now()
+	e.submittedAt = now
+	e.routedAt = now
+	e.updatedAt = now
 
 	return nil
 }
 
 // Approve 経費を承認
-func (e *Expense) Approve() error {
+func (e *Expense) Approve(now time.Time) error {
 	if e.status != ExpenseStatusSubmitted {
 		return errors.NewDomainError("EXPENSE_APPROVE_NOT_ALLOWED", "申請済み状態の経費のみ承認できます")
 	}
 
 	e.status = ExpenseStatusApproved
-	e.updatedAt = time.Now()
+	e.updatedAt = now
+
+	return nil
+}
+
+// PaidAt 支払日時を取得（未払いの場合はゼロ値）
+func (e *Expense) PaidAt() time.Time {
+	return e.paidAt
+}
+
+// IsPaid 支払済みかどうか
+func (e *Expense) IsPaid() bool {
+	return !e.paidAt.IsZero()
+}
+
+// MarkPaid 承認済みの経費を支払済みにする（paidAtは支払日時、nowは更新日時）
+func (e *Expense) MarkPaid(paidAt, now time.Time) error {
+	if e.status != ExpenseStatusApproved {
+		return errors.NewDomainError("EXPENSE_PAY_NOT_ALLOWED", "承認済み状態の経費のみ支払済みにできます")
+	}
+
+	if e.IsPaid() {
+		return errors.NewDomainError("EXPENSE_PAY_NOT_ALLOWED", "経費はすでに支払済みです")
+	}
+
+	if paidAt.IsZero() {
+		return errors.NewDomainError("EXPENSE_PAY_NOT_ALLOWED", "支払日時が必要です")
+	}
+
+	e.paidAt = paidAt
+	e.updatedAt = now
 
 	return nil
 }
 
 // Reject 経費を却下
-func (e *Expense) Reject() error {
+func (e *Expense) Reject(now time.Time) error {
 	if e.status != ExpenseStatusSubmitted {
 		return errors.NewDomainError("EXPENSE_REJECT_NOT_ALLOWED", "申請済み状態の経費のみ却下できます")
 	}
 
 	e.status = ExpenseStatusRejected
-	e.updatedAt = time.Now()
+	e.updatedAt = now
 
 	return nil
 }
 
+// AssignApprover 承認者を割り当て
+func (e *Expense) AssignApprover(approverID *valueobject.UserID, now time.Time) error {
+	if e.status != ExpenseStatusSubmitted {
+		return errors.NewDomainError(errors.InvalidEscalation, "申請済み状態の経費のみ承認者を割り当てできます")
+	}
+
+	if approverID == nil {
+		return errors.NewDomainError(errors.InvalidUserID, "承認者IDが必要です")
+	}
+
+	if approverID.Equals(e.userID) {
+		return errors.NewDomainError(errors.InvalidEscalation, "申請者自身を承認者にすることはできません")
+	}
+
+	e.approverID = approverID
+	e.routedAt = now
+	e.updatedAt = now
+
+	return nil
+}
+
+// Escalate 承認者を上位の階層に回付
+func (e *Expense) Escalate(nextApproverID *valueobject.UserID, now time.Time) error {
+	if nextApproverID != nil && nextApproverID.Equals(e.approverID) {
+		return errors.NewDomainError(errors.InvalidEscalation, "現在の承認者と同じユーザーには回付できません")
+	}
+
+	if err := e.AssignApprover(nextApproverID, now); err != nil {
+		return err
+	}
+
+	e.escalationLevel++
+
+	return nil
+}
+
+// IsStale 承認待ちのまま期限（SLA）を超過しているかどうか
+func (e *Expense) IsStale(now time.Time, sla time.Duration) bool {
+	if e.status != ExpenseStatusSubmitted {
+		return false
+	}
+
+	return now.Sub(e.routedAt) > sla
+}
+
+// CanApprove 承認可能かどうか
+func (e *Expense) CanApprove() bool {
+	return e.status == ExpenseStatusSubmitted
+}
+
+// CanReject 却下可能かどうか
+func (e *Expense) CanReject() bool {
+	return e.status == ExpenseStatusSubmitted
+}
+
 // CanEdit 編集可能かどうか
 func (e *Expense) CanEdit() bool {
 	return e.status == ExpenseStatusDraft
 }
 
+// CanDelete 削除可能かどうか（承認済み・申請中の経費は削除できない）
+func (e *Expense) CanDelete() bool {
+	return e.status == ExpenseStatusDraft || e.status == ExpenseStatusRejected
+}
+
 // CanSubmit 申請可能かどうか
 func (e *Expense) CanSubmit() bool {
 	return e.status == ExpenseStatusDraft
@@ -285,21 +1090,77 @@ func validateExpenseDescription(description string) error {
 	return nil
 }
 
+// validateExpenseComment コメントのバリデーション
+func validateExpenseComment(comment string) error {
+	if utf8.RuneCountInString(comment) > 500 {
+		return errors.NewDomainError("INVALID_EXPENSE_COMMENT", "コメントは500文字以内である必要があります")
+	}
+
+	return nil
+}
+
+// validatePolicyJustification 規程外の経費として申請する理由のバリデーション
+func validatePolicyJustification(justification string) error {
+	if len(justification) > 500 {
+		return errors.NewDomainError("INVALID_POLICY_JUSTIFICATION", "規程外の経費として申請する理由は500文字以内である必要があります")
+	}
+
+	return nil
+}
+
 // validateExpenseDate 経費日付のバリデーション
-func validateExpenseDate(date time.Time) error {
+// 経費日付として認める期間はカテゴリごとに異なるため、Category.CheckExpenseDate で検証する
+func validateExpenseDate(date valueobject.Date) error {
 	if date.IsZero() {
-		return errors.NewDomainError("INVALID_EXPENSE_DATE", "経費日付が必要です")
+		return errors.NewDomainError(errors.InvalidExpenseDate, "経費日付が必要です")
+	}
+
+	return nil
+}
+
+// validateBillable 請求対象の経費にはプロジェクトが必要
+func validateBillable(projectID *valueobject.ProjectID, billable bool) error {
+	if billable && projectID == nil {
+		return errors.NewDomainError(errors.InvalidBillable, "顧客に請求する経費にはプロジェクトの指定が必要です")
+	}
+	return nil
+}
+
+// validateReceipt 支払先と領収書ファイルのハッシュのバリデーション
+func validateReceipt(vendor, receiptHash string) error {
+	if len(vendor) > 100 {
+		return errors.NewDomainError(errors.InvalidReceipt, "支払先は100文字以内である必要があります")
+	}
+
+	if receiptHash == "" {
+		return nil
 	}
 
-	// 未来の日付は許可しない（今日より後）
-	now := time.Now()
-	if date.After(time.Date(now.Year(), now.Month(), now.Day(), 23, 59, 59, 0, now.Location())) {
-		return errors.NewDomainError("INVALID_EXPENSE_DATE", "経費日付は未来の日付にできません")
+	if len(receiptHash) != 64 {
+		return errors.NewDomainError(errors.InvalidReceipt, "領収書ファイルのハッシュはSHA-256の16進数（64文字）で指定してください")
+	}
+	for _, r := range receiptHash {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return errors.NewDomainError(errors.InvalidReceipt, "領収書ファイルのハッシュはSHA-256の16進数（64文字）で指定してください")
+		}
 	}
 
-	// 1年以上前の日付は許可しない
-	if date.Before(time.Now().AddDate(-1, 0, 0)) {
-		return errors.NewDomainError("INVALID_EXPENSE_DATE", "経費日付は1年以内である必要があります")
+	return nil
+}
+
+// validateExpenseKind 経費の種類と走行距離精算の明細の整合性をチェック
+func validateExpenseKind(kind ExpenseKind, mileage *Mileage) error {
+	switch kind {
+	case ExpenseKindStandard:
+		if mileage != nil {
+			return errors.NewDomainError(errors.InvalidMileage, "通常の経費に走行距離精算の明細は設定できません")
+		}
+	case ExpenseKindMileage:
+		if mileage == nil {
+			return errors.NewDomainError(errors.InvalidMileage, "走行距離精算の明細が必要です")
+		}
+	default:
+		return errors.NewDomainError("INVALID_EXPENSE_KIND", "無効な経費の種類です")
 	}
 
 	return nil
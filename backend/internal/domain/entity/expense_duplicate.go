@@ -0,0 +1,54 @@
+package entity
+
+// DuplicateReason 重複の疑いがある理由
+type DuplicateReason string
+
+const (
+	DuplicateReasonSameReceipt  DuplicateReason = "same_receipt"  // 領収書ファイルのハッシュが一致
+	DuplicateReasonSameVendor   DuplicateReason = "same_vendor"   // 金額・日付・支払先が一致
+	DuplicateReasonSimilarTitle DuplicateReason = "similar_title" // 金額・日付が一致し、件名が類似
+)
+
+// DuplicateTitleThreshold 件名が類似しているとみなす類似度（0〜1）
+const DuplicateTitleThreshold = 0.6
+
+// Label 重複の疑いがある理由の表示名を取得
+func (r DuplicateReason) Label() string {
+	switch r {
+	case DuplicateReasonSameReceipt:
+		return "領収書ファイルが同じ"
+	case DuplicateReasonSameVendor:
+		return "金額・日付・支払先が同じ"
+	case DuplicateReasonSimilarTitle:
+		return "金額・日付が同じで件名が類似"
+	default:
+		return string(r)
+	}
+}
+
+// DuplicateReasons 他の経費と重複している疑いがある理由を取得（重複の疑いがない場合は空）
+// 申請者が異なる経費（同僚が同じタクシー代を申請した場合など）も比較する。却下された経費は支払われないため比較しない
+func (e *Expense) DuplicateReasons(other *Expense) []DuplicateReason {
+	if other == nil || other.id.Equals(e.id) || other.status == ExpenseStatusRejected || e.status == ExpenseStatusRejected {
+		return nil
+	}
+
+	var reasons []DuplicateReason
+	if e.receiptHash != "" && e.receiptHash == other.receiptHash {
+		reasons = append(reasons, DuplicateReasonSameReceipt)
+	}
+
+	if !e.amount.Equals(other.amount) || !e.date.Equals(other.date) {
+		return reasons
+	}
+
+	if vendor := normalizeVendor(e.vendor); vendor != "" && vendor == normalizeVendor(other.vendor) {
+		reasons = append(reasons, DuplicateReasonSameVendor)
+	}
+
+	if vendorSimilarity(e.title, other.title) >= DuplicateTitleThreshold {
+		reasons = append(reasons, DuplicateReasonSimilarTitle)
+	}
+
+	return reasons
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+)
+
+// maxExpenseLineItems 1件の経費に設定できる明細の上限
+const maxExpenseLineItems = 50
+
+// ExpenseLineItem 経費の明細（1枚の領収書に含まれる、カテゴリの異なる内訳）
+type ExpenseLineItem struct {
+	categoryID  *valueobject.CategoryID
+	amount      *valueobject.Money
+	taxCode     valueobject.TaxCode // 税区分（空の場合はカテゴリの税区分）
+	description string
+}
+
+// NewExpenseLineItem 経費の明細を作成（税区分は空でもよい）
+func NewExpenseLineItem(categoryID *valueobject.CategoryID, amount *valueobject.Money, taxCode valueobject.TaxCode, description string) (*ExpenseLineItem, error) {
+	if categoryID == nil {
+		return nil, errors.NewDomainError(errors.InvalidLineItem, "明細のカテゴリが必要です")
+	}
+
+	if amount == nil || amount.Amount() <= 0 {
+		return nil, errors.NewDomainError(errors.InvalidLineItem, "明細の金額は0より大きい必要があります")
+	}
+
+	if taxCode != "" {
+		if _, err := valueobject.NewTaxCode(string(taxCode)); err != nil {
+			return nil, errors.NewDomainError(errors.InvalidLineItem, "明細の税区分が無効です: "+string(taxCode))
+		}
+	}
+
+	description = strings.TrimSpace(description)
+	if len(description) > 100 {
+		return nil, errors.NewDomainError(errors.InvalidLineItem, "明細の説明は100文字以内である必要があります")
+	}
+
+	return &ExpenseLineItem{
+		categoryID:  categoryID,
+		amount:      amount,
+		taxCode:     taxCode,
+		description: description,
+	}, nil
+}
+
+// CategoryID 明細のカテゴリIDを取得
+func (l *ExpenseLineItem) CategoryID() *valueobject.CategoryID {
+	return l.categoryID
+}
+
+// Amount 明細の金額（税込）を取得
+func (l *ExpenseLineItem) Amount() *valueobject.Money {
+	return l.amount
+}
+
+// TaxCode 明細の税区分を取得（空の場合はカテゴリの税区分を適用する）
+func (l *ExpenseLineItem) TaxCode() valueobject.TaxCode {
+	return l.taxCode
+}
+
+// Description 明細の説明を取得
+func (l *ExpenseLineItem) Description() string {
+	return l.description
+}
+
+// validateLineItems 明細のバリデーション（明細は2件以上で、金額の合計は経費の金額と一致すること）
+func validateLineItems(total *valueobject.Money, lineItems []*ExpenseLineItem) error {
+	if len(lineItems) == 0 {
+		return nil
+	}
+
+	if len(lineItems) < 2 {
+		return errors.NewDomainError(errors.InvalidLineItem, "明細に分ける場合は2件以上指定してください")
+	}
+
+	if len(lineItems) > maxExpenseLineItems {
+		return errors.NewDomainError(errors.InvalidLineItem, "明細は50件までです")
+	}
+
+	sum, err := valueobject.NewMoney(0, total.Currency())
+	if err != nil {
+		return err
+	}
+	for _, lineItem := range lineItems {
+		if lineItem == nil {
+			return errors.NewDomainError(errors.InvalidLineItem, "明細が必要です")
+		}
+
+		sum, err = sum.Add(lineItem.amount)
+		if err != nil {
+			return errors.NewDomainError(errors.InvalidLineItem, "明細の金額は経費と同じ通貨で指定してください")
+		}
+	}
+
+	if !sum.Equals(total) {
+		return errors.NewDomainError(errors.InvalidLineItem, "明細の金額の合計が経費の金額と一致しません")
+	}
+
+	return nil
+}
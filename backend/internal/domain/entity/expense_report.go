@@ -0,0 +1,300 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+	"time"
+)
+
+// ExpenseReportStatus 経費レポートの状態
+type ExpenseReportStatus string
+
+const (
+	ExpenseReportStatusDraft     ExpenseReportStatus = "draft"     // 下書き
+	ExpenseReportStatusSubmitted ExpenseReportStatus = "submitted" // 申請済み
+	ExpenseReportStatusApproved  ExpenseReportStatus = "approved"  // 承認済み
+	ExpenseReportStatusRejected  ExpenseReportStatus = "rejected"  // 却下
+)
+
+// ExpenseReport 複数の経費をまとめて申請する経費レポート（集約ルート）
+type ExpenseReport struct {
+	id          *valueobject.ExpenseReportID
+	ownerID     *valueobject.UserID
+	title       string
+	periodStart valueobject.Date // 対象期間の開始日
+	periodEnd   valueobject.Date // 対象期間の終了日（この日を含む）
+	expenseIDs  []*valueobject.ExpenseID
+	status      ExpenseReportStatus
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewExpenseReport 新しいExpenseReportを作成
+func NewExpenseReport(clk clock.Clock, ownerID *valueobject.UserID, title string, periodStart, periodEnd valueobject.Date, expenseIDs []*valueobject.ExpenseID) (*ExpenseReport, error) {
+	if ownerID == nil {
+		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
+	}
+
+	if err := validateExpenseReportTitle(title); err != nil {
+		return nil, err
+	}
+
+	if err := validateExpenseReportPeriod(periodStart, periodEnd); err != nil {
+		return nil, err
+	}
+
+	if err := validateExpenseReportExpenseIDs(expenseIDs); err != nil {
+		return nil, err
+	}
+
+	now := clk.Now()
+	return &ExpenseReport{
+		id:          valueobject.GenerateExpenseReportID(),
+		ownerID:     ownerID,
+		title:       strings.TrimSpace(title),
+		periodStart: periodStart,
+		periodEnd:   periodEnd,
+		expenseIDs:  append([]*valueobject.ExpenseID(nil), expenseIDs...),
+		status:      ExpenseReportStatusDraft,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// ReconstructExpenseReport 既存データからExpenseReportを再構築
+func ReconstructExpenseReport(
+	id *valueobject.ExpenseReportID,
+	ownerID *valueobject.UserID,
+	title string,
+	periodStart, periodEnd valueobject.Date,
+	expenseIDs []*valueobject.ExpenseID,
+	status ExpenseReportStatus,
+	createdAt, updatedAt time.Time,
+) (*ExpenseReport, error) {
+	if id == nil {
+		return nil, errors.NewDomainError(errors.InvalidExpenseReportID, "経費レポートIDが必要です")
+	}
+
+	if ownerID == nil {
+		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
+	}
+
+	if err := validateExpenseReportTitle(title); err != nil {
+		return nil, err
+	}
+
+	if err := validateExpenseReportPeriod(periodStart, periodEnd); err != nil {
+		return nil, err
+	}
+
+	if err := validateExpenseReportExpenseIDs(expenseIDs); err != nil {
+		return nil, err
+	}
+
+	switch status {
+	case ExpenseReportStatusDraft, ExpenseReportStatusSubmitted, ExpenseReportStatusApproved, ExpenseReportStatusRejected:
+	default:
+		return nil, errors.NewDomainError("INVALID_EXPENSE_REPORT_STATUS", "無効な経費レポートステータスです")
+	}
+
+	return &ExpenseReport{
+		id:          id,
+		ownerID:     ownerID,
+		title:       title,
+		periodStart: periodStart,
+		periodEnd:   periodEnd,
+		expenseIDs:  append([]*valueobject.ExpenseID(nil), expenseIDs...),
+		status:      status,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}, nil
+}
+
+// ID IDを取得
+func (r *ExpenseReport) ID() *valueobject.ExpenseReportID {
+	return r.id
+}
+
+// OwnerID 所有者のユーザーIDを取得
+func (r *ExpenseReport) OwnerID() *valueobject.UserID {
+	return r.ownerID
+}
+
+// Title タイトルを取得
+func (r *ExpenseReport) Title() string {
+	return r.title
+}
+
+// PeriodStart 対象期間の開始日を取得
+func (r *ExpenseReport) PeriodStart() valueobject.Date {
+	return r.periodStart
+}
+
+// PeriodEnd 対象期間の終了日を取得
+func (r *ExpenseReport) PeriodEnd() valueobject.Date {
+	return r.periodEnd
+}
+
+// ExpenseIDs 含まれる経費IDの一覧を取得
+func (r *ExpenseReport) ExpenseIDs() []*valueobject.ExpenseID {
+	return append([]*valueobject.ExpenseID(nil), r.expenseIDs...)
+}
+
+// Status ステータスを取得
+func (r *ExpenseReport) Status() ExpenseReportStatus {
+	return r.status
+}
+
+// CreatedAt 作成日時を取得
+func (r *ExpenseReport) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt 更新日時を取得
+func (r *ExpenseReport) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// Contains 経費が含まれているかどうか
+func (r *ExpenseReport) Contains(expenseID *valueobject.ExpenseID) bool {
+	for _, id := range r.expenseIDs {
+		if id.Equals(expenseID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Copy 経費レポートの複製を作成（変更を全て検証してから反映するために使う）
+// 経費IDのリストは変更時にスライスごと置き換えるため共有する
+func (r *ExpenseReport) Copy() *ExpenseReport {
+	copied := *r
+	return &copied
+}
+
+// CoversDate 経費日付（暦日）が対象期間内かどうか
+func (r *ExpenseReport) CoversDate(date valueobject.Date) bool {
+	return !date.Before(r.periodStart) && !date.After(r.periodEnd)
+}
+
+// UpdateDetails 経費レポートの内容を更新
+func (r *ExpenseReport) UpdateDetails(title string, periodStart, periodEnd valueobject.Date, expenseIDs []*valueobject.ExpenseID, now time.Time) error {
+	if r.status != ExpenseReportStatusDraft {
+		return errors.NewDomainError("EXPENSE_REPORT_UPDATE_NOT_ALLOWED", "下書き状態の経費レポートのみ更新できます")
+	}
+
+	if err := validateExpenseReportTitle(title); err != nil {
+		return err
+	}
+
+	if err := validateExpenseReportPeriod(periodStart, periodEnd); err != nil {
+		return err
+	}
+
+	if err := validateExpenseReportExpenseIDs(expenseIDs); err != nil {
+		return err
+	}
+
+	r.title = strings.TrimSpace(title)
+	r.periodStart = periodStart
+	r.periodEnd = periodEnd
+	r.expenseIDs = append([]*valueobject.ExpenseID(nil), expenseIDs...)
+	r.updatedAt = now
+
+	return nil
+}
+
+// Submit 経費レポートを申請
+func (r *ExpenseReport) Submit(now time.Time) error {
+	if r.status != ExpenseReportStatusDraft {
+		return errors.NewDomainError("EXPENSE_REPORT_SUBMIT_NOT_ALLOWED", "下書き状態の経費レポートのみ申請できます")
+	}
+
+	if len(r.expenseIDs) == 0 {
+		return errors.NewDomainError("EXPENSE_REPORT_SUBMIT_NOT_ALLOWED", "経費が含まれていない経費レポートは申請できません")
+	}
+
+	r.status = ExpenseReportStatusSubmitted
+	r.updatedAt = now
+
+	return nil
+}
+
+// Approve 経費レポートを承認
+func (r *ExpenseReport) Approve(now time.Time) error {
+	if r.status != ExpenseReportStatusSubmitted {
+		return errors.NewDomainError("EXPENSE_REPORT_APPROVE_NOT_ALLOWED", "申請済み状態の経費レポートのみ承認できます")
+	}
+
+	r.status = ExpenseReportStatusApproved
+	r.updatedAt = now
+
+	return nil
+}
+
+// Reject 経費レポートを却下
+func (r *ExpenseReport) Reject(now time.Time) error {
+	if r.status != ExpenseReportStatusSubmitted {
+		return errors.NewDomainError("EXPENSE_REPORT_REJECT_NOT_ALLOWED", "申請済み状態の経費レポートのみ却下できます")
+	}
+
+	r.status = ExpenseReportStatusRejected
+	r.updatedAt = now
+
+	return nil
+}
+
+// CanEdit 編集可能かどうか
+func (r *ExpenseReport) CanEdit() bool {
+	return r.status == ExpenseReportStatusDraft
+}
+
+// CanDelete 削除可能かどうか（申請中・承認済みのレポートは削除できない）
+func (r *ExpenseReport) CanDelete() bool {
+	return r.status == ExpenseReportStatusDraft || r.status == ExpenseReportStatusRejected
+}
+
+// validateExpenseReportTitle 経費レポートタイトルのバリデーション
+func validateExpenseReportTitle(title string) error {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return errors.NewDomainError("INVALID_EXPENSE_REPORT_TITLE", "経費レポートのタイトルは必須です")
+	}
+
+	if len(title) > 100 {
+		return errors.NewDomainError("INVALID_EXPENSE_REPORT_TITLE", "経費レポートのタイトルは100文字以内である必要があります")
+	}
+
+	return nil
+}
+
+// validateExpenseReportPeriod 対象期間のバリデーション
+func validateExpenseReportPeriod(periodStart, periodEnd valueobject.Date) error {
+	if periodStart.IsZero() || periodEnd.IsZero() {
+		return errors.NewDomainError("INVALID_EXPENSE_REPORT_PERIOD", "対象期間の開始日と終了日が必要です")
+	}
+
+	if periodEnd.Before(periodStart) {
+		return errors.NewDomainError("INVALID_EXPENSE_REPORT_PERIOD", "対象期間の終了日は開始日以降である必要があります")
+	}
+
+	return nil
+}
+
+// validateExpenseReportExpenseIDs 経費IDリストのバリデーション
+func validateExpenseReportExpenseIDs(expenseIDs []*valueobject.ExpenseID) error {
+	seen := make(map[string]bool, len(expenseIDs))
+	for _, id := range expenseIDs {
+		if id == nil {
+			return errors.NewDomainError("INVALID_EXPENSE_ID", "経費IDが必要です")
+		}
+		if seen[id.String()] {
+			return errors.NewDomainError("INVALID_EXPENSE_ID", "同じ経費を重複して含めることはできません")
+		}
+		seen[id.String()] = true
+	}
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package entity
 
 import (
+	"expense-management-system/internal/domain/clock"
 	"expense-management-system/internal/domain/valueobject"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,7 +15,7 @@ func TestNewExpense(t *testing.T) {
 	userID := valueobject.GenerateUserID()
 	categoryID := valueobject.GenerateCategoryID()
 	amount, _ := valueobject.NewMoney(1000, "JPY")
-	validDate := time.Now().AddDate(0, 0, -1)
+	validDate := valueobject.DateOf(time.Now().AddDate(0, 0, -1))
 
 	tests := []struct {
 		name        string
@@ -22,7 +24,7 @@ func TestNewExpense(t *testing.T) {
 		amount      *valueobject.Money
 		title       string
 		description string
-		date        time.Time
+		date        valueobject.Date
 		wantErr     bool
 	}{
 		{
@@ -76,20 +78,20 @@ func TestNewExpense(t *testing.T) {
 			wantErr:     true,
 		},
 		{
-			name:        "未来の日付",
+			name:        "日付がゼロ値",
 			userID:      userID,
 			categoryID:  categoryID,
 			amount:      amount,
 			title:       "テスト経費",
 			description: "テスト用の経費です",
-			date:        time.Now().AddDate(0, 0, 2),
+			date:        valueobject.Date{},
 			wantErr:     true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			expense, err := NewExpense(tt.userID, tt.categoryID, tt.amount, tt.title, tt.description, tt.date)
+			expense, err := NewExpense(clock.System(), tt.userID, tt.categoryID, tt.amount, tt.title, tt.description, tt.date)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -102,6 +104,7 @@ func TestNewExpense(t *testing.T) {
 				assert.Equal(t, tt.description, expense.Description())
 				assert.True(t, expense.CanEdit())
 				assert.True(t, expense.CanSubmit())
+				assert.True(t, expense.CanDelete())
 			}
 		})
 	}
@@ -111,29 +114,30 @@ func TestExpense_Submit(t *testing.T) {
 	userID := valueobject.GenerateUserID()
 	categoryID := valueobject.GenerateCategoryID()
 	amount, _ := valueobject.NewMoney(1000, "JPY")
-	validDate := time.Now().AddDate(0, 0, -1)
+	validDate := valueobject.DateOf(time.Now().AddDate(0, 0, -1))
 
 	t.Run("下書き状態から申請状態への変更", func(t *testing.T) {
-		expense, err := NewExpense(userID, categoryID, amount, "テスト経費", "説明", validDate)
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "説明", validDate)
 		require.NoError(t, err)
 
-		err = expense.Submit()
+		err = expense.Submit(time.Now())
 		require.NoError(t, err)
 		assert.Equal(t, ExpenseStatusSubmitted, expense.Status())
 		assert.False(t, expense.CanEdit())
 		assert.False(t, expense.CanSubmit())
+		assert.False(t, expense.CanDelete())
 	})
 
 	t.Run("申請済み状態からの申請はエラー", func(t *testing.T) {
-		expense, err := NewExpense(userID, categoryID, amount, "テスト経費", "説明", validDate)
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "説明", validDate)
 		require.NoError(t, err)
 
 		// 一度申請
-		err = expense.Submit()
+		err = expense.Submit(time.Now())
 		require.NoError(t, err)
 
 		// 再度申請を試行
-		err = expense.Submit()
+		err = expense.Submit(time.Now())
 		assert.Error(t, err)
 	})
 }
@@ -142,27 +146,27 @@ func TestExpense_Approve(t *testing.T) {
 	userID := valueobject.GenerateUserID()
 	categoryID := valueobject.GenerateCategoryID()
 	amount, _ := valueobject.NewMoney(1000, "JPY")
-	validDate := time.Now().AddDate(0, 0, -1)
+	validDate := valueobject.DateOf(time.Now().AddDate(0, 0, -1))
 
 	t.Run("申請状態から承認状態への変更", func(t *testing.T) {
-		expense, err := NewExpense(userID, categoryID, amount, "テスト経費", "説明", validDate)
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "説明", validDate)
 		require.NoError(t, err)
 
 		// 申請
-		err = expense.Submit()
+		err = expense.Submit(time.Now())
 		require.NoError(t, err)
 
 		// 承認
-		err = expense.Approve()
+		err = expense.Approve(time.Now())
 		require.NoError(t, err)
 		assert.Equal(t, ExpenseStatusApproved, expense.Status())
 	})
 
 	t.Run("下書き状態からの承認はエラー", func(t *testing.T) {
-		expense, err := NewExpense(userID, categoryID, amount, "テスト経費", "説明", validDate)
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "説明", validDate)
 		require.NoError(t, err)
 
-		err = expense.Approve()
+		err = expense.Approve(time.Now())
 		assert.Error(t, err)
 	})
 }
@@ -171,27 +175,28 @@ func TestExpense_Reject(t *testing.T) {
 	userID := valueobject.GenerateUserID()
 	categoryID := valueobject.GenerateCategoryID()
 	amount, _ := valueobject.NewMoney(1000, "JPY")
-	validDate := time.Now().AddDate(0, 0, -1)
+	validDate := valueobject.DateOf(time.Now().AddDate(0, 0, -1))
 
 	t.Run("申請状態から却下状態への変更", func(t *testing.T) {
-		expense, err := NewExpense(userID, categoryID, amount, "テスト経費", "説明", validDate)
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "説明", validDate)
 		require.NoError(t, err)
 
 		// 申請
-		err = expense.Submit()
+		err = expense.Submit(time.Now())
 		require.NoError(t, err)
 
 		// 却下
-		err = expense.Reject()
+		err = expense.Reject(time.Now())
 		require.NoError(t, err)
 		assert.Equal(t, ExpenseStatusRejected, expense.Status())
+		assert.True(t, expense.CanDelete())
 	})
 
 	t.Run("下書き状態からの却下はエラー", func(t *testing.T) {
-		expense, err := NewExpense(userID, categoryID, amount, "テスト経費", "説明", validDate)
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "説明", validDate)
 		require.NoError(t, err)
 
-		err = expense.Reject()
+		err = expense.Reject(time.Now())
 		assert.Error(t, err)
 	})
 }
@@ -202,14 +207,14 @@ func TestExpense_UpdateDetails(t *testing.T) {
 	categoryID2 := valueobject.GenerateCategoryID()
 	amount1, _ := valueobject.NewMoney(1000, "JPY")
 	amount2, _ := valueobject.NewMoney(2000, "JPY")
-	validDate := time.Now().AddDate(0, 0, -1)
+	validDate := valueobject.DateOf(time.Now().AddDate(0, 0, -1))
 
 	t.Run("下書き状態の経費詳細更新", func(t *testing.T) {
-		expense, err := NewExpense(userID, categoryID1, amount1, "元のタイトル", "元の説明", validDate)
+		expense, err := NewExpense(clock.System(), userID, categoryID1, amount1, "元のタイトル", "元の説明", validDate)
 		require.NoError(t, err)
 
-		newDate := time.Now().AddDate(0, 0, -2)
-		err = expense.UpdateDetails(categoryID2, amount2, "新しいタイトル", "新しい説明", newDate)
+		newDate := valueobject.DateOf(time.Now().AddDate(0, 0, -2))
+		err = expense.UpdateDetails(categoryID2, amount2, "新しいタイトル", "新しい説明", newDate, time.Now())
 		require.NoError(t, err)
 
 		assert.Equal(t, categoryID2, expense.CategoryID())
@@ -220,60 +225,654 @@ func TestExpense_UpdateDetails(t *testing.T) {
 	})
 
 	t.Run("申請済み状態の経費更新はエラー", func(t *testing.T) {
-		expense, err := NewExpense(userID, categoryID1, amount1, "元のタイトル", "元の説明", validDate)
+		expense, err := NewExpense(clock.System(), userID, categoryID1, amount1, "元のタイトル", "元の説明", validDate)
 		require.NoError(t, err)
 
 		// 申請
-		err = expense.Submit()
+		err = expense.Submit(time.Now())
 		require.NoError(t, err)
 
 		// 更新試行
-		err = expense.UpdateDetails(categoryID2, amount2, "新しいタイトル", "新しい説明", validDate)
+		err = expense.UpdateDetails(categoryID2, amount2, "新しいタイトル", "新しい説明", validDate, time.Now())
 		assert.Error(t, err)
 	})
 }
 
-func TestValidateExpenseDate(t *testing.T) {
+func TestCategory_CheckExpenseDate(t *testing.T) {
+	// 日本時間の2026年4月1日 0:30（協定世界時では3月31日）
+	jst, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	now := time.Date(2026, 4, 1, 0, 30, 0, 0, jst)
+	date := func(year int, month time.Month, day int) valueobject.Date {
+		d, err := valueobject.NewDate(year, month, day)
+		require.NoError(t, err)
+		return d
+	}
+
+	tokyo, _ := NewUser(clock.System(), "山田太郎", "yamada@example.com")
+	utc, _ := NewUser(clock.System(), "John Smith", "john@example.com")
+	require.NoError(t, utc.ChangeTimezone("UTC", time.Now()))
+
+	standard, _ := NewCategory(clock.System(), "交通費", "", "")
+	travel, _ := NewCategory(clock.System(), "出張費", "", "")
+	policy, err := valueobject.NewExpenseDatePolicy(90, 30, "warning")
+	require.NoError(t, err)
+	travel.ChangeDatePolicy(policy, time.Now())
+
 	tests := []struct {
-		name    string
-		date    time.Time
-		wantErr bool
+		name        string
+		category    *Category
+		user        *User
+		date        valueobject.Date
+		wantErr     bool
+		wantWarning bool
+	}{
+		{name: "利用者のタイムゾーンの今日", category: standard, user: tokyo, date: date(2026, 4, 1)},
+		{name: "協定世界時の利用者には明日の日付（エラー）", category: standard, user: utc, date: date(2026, 4, 1), wantErr: true},
+		{name: "既定の期間は1年以内", category: standard, user: tokyo, date: date(2025, 4, 1)},
+		{name: "既定の期間で1年より前の日付（エラー）", category: standard, user: tokyo, date: date(2025, 3, 31), wantErr: true},
+		{name: "ゼロ値の日付（エラー）", category: standard, user: tokyo, date: valueobject.Date{}, wantErr: true},
+		{name: "許容日数内の未来の日付", category: travel, user: tokyo, date: date(2026, 5, 1)},
+		{name: "許容日数を超える未来の日付（エラー）", category: travel, user: tokyo, date: date(2026, 5, 2), wantErr: true},
+		{name: "古さの上限を超える日付は警告", category: travel, user: tokyo, date: date(2025, 12, 31), wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warning, err := tt.category.CheckExpenseDate(tt.user, tt.date, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantWarning, warning != "")
+		})
+	}
+
+	t.Run("カテゴリを指定した経費の作成時に期間を検証", func(t *testing.T) {
+		clk := clock.NewFake(now)
+		amount, _ := valueobject.NewMoney(1000, "JPY")
+
+		expense, warning, err := NewExpenseInCategory(clk, tokyo, travel, amount, "新幹線代", "", date(2025, 12, 31))
+		require.NoError(t, err)
+		assert.Contains(t, warning, "遅延申請")
+		assert.Equal(t, tokyo.ID(), expense.UserID())
+		assert.Equal(t, travel.ID(), expense.CategoryID())
+
+		_, _, err = NewExpenseInCategory(clk, tokyo, standard, amount, "電車代", "", date(2025, 3, 31))
+		assert.Error(t, err)
+		_, _, err = NewExpenseInCategory(clk, utc, standard, amount, "電車代", "", date(2026, 4, 1))
+		assert.Error(t, err)
+		_, _, err = NewExpenseInCategory(clk, nil, standard, amount, "電車代", "", date(2026, 3, 31))
+		assert.Error(t, err)
+
+		mileage, err := NewMileage("本社", "横浜営業所", 40, VehicleTypeCar)
+		require.NoError(t, err)
+		_, _, err = NewMileageExpenseInCategory(clk, tokyo, travel, mileage, "社用車", "", date(2026, 5, 2))
+		assert.Error(t, err)
+	})
+
+	t.Run("無効な設定はエラー", func(t *testing.T) {
+		_, err := valueobject.NewExpenseDatePolicy(0, 0, "")
+		assert.Error(t, err)
+		_, err = valueobject.NewExpenseDatePolicy(365, -1, "")
+		assert.Error(t, err)
+		_, err = valueobject.NewExpenseDatePolicy(365, 0, "ignore")
+		assert.Error(t, err)
+		assert.Error(t, utc.ChangeTimezone("Mars/Olympus", time.Now()))
+	})
+}
+
+func TestExpense_Escalate(t *testing.T) {
+	userID := valueobject.GenerateUserID()
+	managerID := valueobject.GenerateUserID()
+	directorID := valueobject.GenerateUserID()
+	categoryID := valueobject.GenerateCategoryID()
+	amount, _ := valueobject.NewMoney(1000, "JPY")
+	validDate := valueobject.DateOf(time.Now().AddDate(0, 0, -1))
+
+	t.Run("申請済みの経費を上位の承認者に回付", func(t *testing.T) {
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "説明", validDate)
+		require.NoError(t, err)
+		require.NoError(t, expense.Submit(time.Now()))
+		require.NoError(t, expense.AssignApprover(managerID, time.Now()))
+
+		err = expense.Escalate(directorID, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, directorID, expense.ApproverID())
+		assert.Equal(t, 1, expense.EscalationLevel())
+	})
+
+	t.Run("下書き状態の経費は回付できない", func(t *testing.T) {
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "説明", validDate)
+		require.NoError(t, err)
+
+		err = expense.Escalate(directorID, time.Now())
+		assert.Error(t, err)
+	})
+
+	t.Run("申請者自身には回付できない", func(t *testing.T) {
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "説明", validDate)
+		require.NoError(t, err)
+		require.NoError(t, expense.Submit(time.Now()))
+
+		err = expense.Escalate(userID, time.Now())
+		assert.Error(t, err)
+	})
+
+	t.Run("SLA超過の判定", func(t *testing.T) {
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "説明", validDate)
+		require.NoError(t, err)
+		assert.False(t, expense.IsStale(time.Now().Add(100*time.Hour), 72*time.Hour))
+
+		require.NoError(t, expense.Submit(time.Now()))
+		assert.False(t, expense.IsStale(time.Now(), 72*time.Hour))
+		assert.True(t, expense.IsStale(time.Now().Add(73*time.Hour), 72*time.Hour))
+	})
+}
+
+func TestFindMileageRate(t *testing.T) {
+	tests := []struct {
+		name        string
+		vehicleType VehicleType
+		date        time.Time
+		wantRate    float64
+		wantErr     bool
 	}{
 		{
-			name:    "昨日の日付",
-			date:    time.Now().AddDate(0, 0, -1),
-			wantErr: false,
-		},
-		{
-			name:    "今日の日付",
-			date:    time.Now(),
-			wantErr: false,
+			name:        "改定前の自動車の単価",
+			vehicleType: VehicleTypeCar,
+			date:        time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+			wantRate:    15,
 		},
 		{
-			name:    "明日の日付（エラー）",
-			date:    time.Now().AddDate(0, 0, 1),
-			wantErr: true,
+			name:        "改定後の自動車の単価",
+			vehicleType: VehicleTypeCar,
+			date:        time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			wantRate:    20,
 		},
 		{
-			name:    "1年以上前の日付（エラー）",
-			date:    time.Now().AddDate(-1, -1, 0),
-			wantErr: true,
+			name:        "二輪車の単価",
+			vehicleType: VehicleTypeMotorcycle,
+			date:        time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			wantRate:    10,
 		},
 		{
-			name:    "ゼロ値の日付（エラー）",
-			date:    time.Time{},
-			wantErr: true,
+			name:        "適用開始前の日付",
+			vehicleType: VehicleTypeCar,
+			date:        time.Date(2019, 3, 31, 0, 0, 0, 0, time.UTC),
+			wantErr:     true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateExpenseDate(tt.date)
+			rate, err := FindMileageRate(tt.vehicleType, tt.date)
 			if tt.wantErr {
 				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
+				return
 			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRate, rate.RatePerKm())
 		})
 	}
 }
+
+func TestExpense_Mileage(t *testing.T) {
+	userID := valueobject.GenerateUserID()
+	categoryID := valueobject.GenerateCategoryID()
+	date := valueobject.DateOf(time.Now().AddDate(0, 0, -1))
+
+	mileage, err := NewMileage("本社", "横浜営業所", 32.4, VehicleTypeCar)
+	require.NoError(t, err)
+
+	expense, err := NewMileageExpense(clock.System(), userID, categoryID, mileage, "横浜営業所への移動", "", date)
+	require.NoError(t, err)
+
+	rate, err := FindMileageRate(VehicleTypeCar, date.Time())
+	require.NoError(t, err)
+
+	assert.Equal(t, ExpenseKindMileage, expense.Kind())
+	assert.Equal(t, 32.4*rate.RatePerKm(), expense.Amount().Amount())
+
+	t.Run("金額は直接変更できない", func(t *testing.T) {
+		changed, _ := valueobject.NewMoney(9999, "JPY")
+		err := expense.UpdateDetails(categoryID, changed, "横浜営業所への移動", "", date, time.Now())
+		assert.Error(t, err)
+	})
+
+	t.Run("金額を変えなければ詳細は更新できる", func(t *testing.T) {
+		err := expense.UpdateDetails(categoryID, expense.Amount(), "横浜営業所への往復", "", date, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, "横浜営業所への往復", expense.Title())
+	})
+
+	t.Run("走行距離の変更で金額を再計算", func(t *testing.T) {
+		roundTrip, err := NewMileage("本社", "横浜営業所", 64.8, VehicleTypeCar)
+		require.NoError(t, err)
+
+		err = expense.UpdateMileage(categoryID, roundTrip, "横浜営業所への往復", "", date, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 64.8, expense.Mileage().DistanceKm())
+		assert.Equal(t, 64.8*rate.RatePerKm(), expense.Amount().Amount())
+	})
+
+	t.Run("通常の経費は走行距離を更新できない", func(t *testing.T) {
+		amount, _ := valueobject.NewMoney(1000, "JPY")
+		standard, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "", date)
+		require.NoError(t, err)
+
+		err = standard.UpdateMileage(categoryID, mileage, "テスト経費", "", date, time.Now())
+		assert.Error(t, err)
+	})
+}
+
+func TestExpense_MarkPaid(t *testing.T) {
+	userID := valueobject.GenerateUserID()
+	categoryID := valueobject.GenerateCategoryID()
+	amount, _ := valueobject.NewMoney(1000, "JPY")
+	validDate := valueobject.DateOf(time.Now().AddDate(0, 0, -1))
+
+	t.Run("承認済みの経費を支払済みにする", func(t *testing.T) {
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "", validDate)
+		require.NoError(t, err)
+		require.NoError(t, expense.Submit(time.Now()))
+		require.NoError(t, expense.Approve(time.Now()))
+
+		// 支払日時は過去の日付でも、更新日時は登録した日時にする
+		paidAt := time.Now().AddDate(0, 0, -3)
+		now := time.Now()
+		require.NoError(t, expense.MarkPaid(paidAt, now))
+		assert.True(t, expense.IsPaid())
+		assert.Equal(t, paidAt, expense.PaidAt())
+		assert.Equal(t, now, expense.UpdatedAt())
+		assert.False(t, expense.CanDelete())
+
+		// 二重の支払いはエラー
+		assert.Error(t, expense.MarkPaid(paidAt, now))
+	})
+
+	t.Run("承認前の経費は支払済みにできない", func(t *testing.T) {
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "テスト経費", "", validDate)
+		require.NoError(t, err)
+		require.NoError(t, expense.Submit(time.Now()))
+
+		assert.Error(t, expense.MarkPaid(time.Now(), time.Now()))
+		assert.False(t, expense.IsPaid())
+	})
+}
+
+func TestExpense_AcceptPolicyViolations(t *testing.T) {
+	userID := valueobject.GenerateUserID()
+	categoryID := valueobject.GenerateCategoryID()
+	amount, _ := valueobject.NewMoney(12000, "JPY")
+	validDate := valueobject.DateOf(time.Now().AddDate(0, 0, -1))
+
+	limit, _ := valueobject.NewMoney(10000, "JPY")
+	exceptionRule, _ := valueobject.NewMaxAmountRule(limit, "exception")
+	blockRule, _ := valueobject.NewCurrencyRule([]string{"USD"}, "block")
+
+	category, err := NewCategory(clock.System(), "会議費", "", "")
+	require.NoError(t, err)
+
+	t.Run("申請できない違反がある場合はエラー", func(t *testing.T) {
+		require.NoError(t, category.ChangeSpendingRules([]*valueobject.SpendingRule{exceptionRule, blockRule}, time.Now()))
+
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "会食", "", validDate)
+		require.NoError(t, err)
+		require.NoError(t, expense.ChangePolicyJustification("取引先との会食のため", time.Now()))
+
+		violations := category.EvaluateSpendingRules(expense)
+		assert.Len(t, violations, 2)
+		assert.Error(t, expense.AcceptPolicyViolations(violations))
+		assert.False(t, expense.IsPolicyException())
+	})
+
+	t.Run("理由を入力すれば規程外の経費として記録される", func(t *testing.T) {
+		require.NoError(t, category.ChangeSpendingRules([]*valueobject.SpendingRule{exceptionRule}, time.Now()))
+
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "会食", "", validDate)
+		require.NoError(t, err)
+
+		violations := category.EvaluateSpendingRules(expense)
+		require.Len(t, violations, 1)
+		assert.Error(t, expense.AcceptPolicyViolations(violations))
+
+		require.NoError(t, expense.ChangePolicyJustification("取引先との会食のため", time.Now()))
+		require.NoError(t, expense.AcceptPolicyViolations(violations))
+		assert.True(t, expense.IsPolicyException())
+		assert.Len(t, expense.PolicyViolations(), 1)
+
+		// 申請後は利用時刻と理由を変更できない
+		require.NoError(t, expense.Submit(time.Now()))
+		assert.Error(t, expense.ChangePolicyJustification("", time.Now()))
+	})
+
+	t.Run("違反がなければ理由は不要", func(t *testing.T) {
+		require.NoError(t, category.ChangeSpendingRules(nil, time.Now()))
+
+		expense, err := NewExpense(clock.System(), userID, categoryID, amount, "会食", "", validDate)
+		require.NoError(t, err)
+		require.NoError(t, expense.AcceptPolicyViolations(category.EvaluateSpendingRules(expense)))
+		assert.False(t, expense.IsPolicyException())
+	})
+}
+
+func TestExpense_Attendees(t *testing.T) {
+	userID := valueobject.GenerateUserID()
+	categoryID := valueobject.GenerateCategoryID()
+	validDate := valueobject.DateOf(time.Now().AddDate(0, 0, -1))
+
+	internal, err := NewAttendee("山田太郎", "", AttendeeTypeInternal)
+	require.NoError(t, err)
+	external, err := NewAttendee("佐藤花子", "株式会社サンプル", AttendeeTypeExternal)
+	require.NoError(t, err)
+
+	newExpense := func(amount float64, currency string) *Expense {
+		money, _ := valueobject.NewMoney(amount, currency)
+		expense, err := NewExpense(clock.System(), userID, categoryID, money, "会食", "", validDate)
+		require.NoError(t, err)
+		return expense
+	}
+	isMeal := func(id *valueobject.CategoryID) bool {
+		return id.Equals(categoryID)
+	}
+
+	t.Run("社外の参加者は会社名が必須", func(t *testing.T) {
+		_, err := NewAttendee("佐藤花子", "", AttendeeTypeExternal)
+		assert.Error(t, err)
+
+		_, err = NewAttendee("", "株式会社サンプル", AttendeeTypeExternal)
+		assert.Error(t, err)
+
+		_, err = NewAttendee("佐藤花子", "株式会社サンプル", "guest")
+		assert.Error(t, err)
+	})
+
+	t.Run("参加者がいない経費は区分なし", func(t *testing.T) {
+		expense := newExpense(30000, "JPY")
+		assert.Nil(t, expense.PerHeadAmount())
+		assert.Equal(t, EntertainmentClassNone, expense.EntertainmentClass(isMeal))
+	})
+
+	t.Run("1人当たり10,000円以下は会議費、超える場合は交際費", func(t *testing.T) {
+		expense := newExpense(20000, "JPY")
+		require.NoError(t, expense.ChangeAttendees([]*Attendee{internal, external}, time.Now()))
+		assert.Equal(t, 10000.0, expense.PerHeadAmount().Amount())
+		assert.Equal(t, EntertainmentClassMeeting, expense.EntertainmentClass(isMeal))
+		assert.Equal(t, "会議費", expense.EntertainmentClass(isMeal).Label())
+
+		expense = newExpense(20001, "JPY")
+		require.NoError(t, expense.ChangeAttendees([]*Attendee{internal, external}, time.Now()))
+		assert.Equal(t, EntertainmentClassEntertainment, expense.EntertainmentClass(isMeal))
+	})
+
+	t.Run("社内の参加者だけの飲食と日本円以外は交際費", func(t *testing.T) {
+		expense := newExpense(3000, "JPY")
+		require.NoError(t, expense.ChangeAttendees([]*Attendee{internal}, time.Now()))
+		assert.Equal(t, EntertainmentClassEntertainment, expense.EntertainmentClass(isMeal))
+
+		expense = newExpense(30, "USD")
+		require.NoError(t, expense.ChangeAttendees([]*Attendee{internal, external}, time.Now()))
+		assert.Equal(t, EntertainmentClassEntertainment, expense.EntertainmentClass(isMeal))
+	})
+
+	t.Run("飲食・接待以外のカテゴリの経費は区分なし", func(t *testing.T) {
+		expense := newExpense(3000, "JPY")
+		require.NoError(t, expense.ChangeAttendees([]*Attendee{internal, external}, time.Now()))
+		assert.Equal(t, EntertainmentClassNone, expense.EntertainmentClass(func(*valueobject.CategoryID) bool { return false }))
+	})
+
+	t.Run("1人当たりの金額の上限を支出規程で判定できる", func(t *testing.T) {
+		limit, _ := valueobject.NewMoney(5000, "JPY")
+		rule, err := valueobject.NewMaxPerHeadRule(limit, "exception")
+		require.NoError(t, err)
+
+		expense := newExpense(12000, "JPY")
+		assert.NotNil(t, rule.Evaluate(expense.SpendingFacts()))
+
+		require.NoError(t, expense.ChangeAttendees([]*Attendee{internal, external}, time.Now()))
+		assert.NotNil(t, rule.Evaluate(expense.SpendingFacts()))
+
+		third, _ := NewAttendee("鈴木一郎", "株式会社サンプル", AttendeeTypeExternal)
+		require.NoError(t, expense.ChangeAttendees([]*Attendee{internal, external, third}, time.Now()))
+		assert.Nil(t, rule.Evaluate(expense.SpendingFacts()))
+	})
+
+	t.Run("申請後は参加者を変更できない", func(t *testing.T) {
+		expense := newExpense(3000, "JPY")
+		require.NoError(t, expense.Submit(time.Now()))
+		assert.Error(t, expense.ChangeAttendees([]*Attendee{internal}, time.Now()))
+	})
+}
+
+func TestExpense_LineItems(t *testing.T) {
+	userID := valueobject.GenerateUserID()
+	roomID := valueobject.GenerateCategoryID()
+	mealID := valueobject.GenerateCategoryID()
+	parkingID := valueobject.GenerateCategoryID()
+	date := valueobject.DateOf(time.Now().AddDate(0, 0, -1))
+	money := func(amount float64, currency string) *valueobject.Money {
+		m, _ := valueobject.NewMoney(amount, currency)
+		return m
+	}
+	lineItem := func(categoryID *valueobject.CategoryID, amount float64, description string) *ExpenseLineItem {
+		item, err := NewExpenseLineItem(categoryID, money(amount, "JPY"), "", description)
+		require.NoError(t, err)
+		return item
+	}
+
+	newHotelExpense := func() *Expense {
+		expense, err := NewExpense(clock.System(), userID, roomID, money(15000, "JPY"), "ホテル代", "", date)
+		require.NoError(t, err)
+		return expense
+	}
+
+	t.Run("明細の合計が経費の金額と一致すれば明細に分けられる", func(t *testing.T) {
+		expense := newHotelExpense()
+		require.NoError(t, expense.ChangeLineItems([]*ExpenseLineItem{
+			lineItem(roomID, 12000, "宿泊"),
+			lineItem(mealID, 2000, "朝食"),
+			lineItem(parkingID, 1000, "駐車場"),
+		}, time.Now()))
+
+		assert.True(t, expense.IsItemized())
+		assert.Len(t, expense.Lines(), 3)
+		assert.True(t, expense.HasCategory(mealID))
+		assert.Equal(t, 2000.0, expense.CategoryAmount(mealID).Amount())
+		assert.Equal(t, 0.0, expense.CategoryAmount(valueobject.GenerateCategoryID()).Amount())
+
+		// 明細を解除すると経費のカテゴリと金額の1行として扱う
+		require.NoError(t, expense.ChangeLineItems(nil, time.Now()))
+		assert.False(t, expense.IsItemized())
+		require.Len(t, expense.Lines(), 1)
+		assert.Equal(t, 15000.0, expense.Lines()[0].Amount().Amount())
+		assert.False(t, expense.HasCategory(mealID))
+	})
+
+	t.Run("不正な明細はエラー", func(t *testing.T) {
+		expense := newHotelExpense()
+		assert.Error(t, expense.ChangeLineItems([]*ExpenseLineItem{
+			lineItem(roomID, 12000, "宿泊"),
+			lineItem(mealID, 2000, "朝食"),
+		}, time.Now()), "合計が一致しない")
+		assert.Error(t, expense.ChangeLineItems([]*ExpenseLineItem{lineItem(roomID, 15000, "宿泊")}, time.Now()), "明細が1件")
+
+		usd, err := NewExpenseLineItem(mealID, money(20, "USD"), "", "朝食")
+		require.NoError(t, err)
+		assert.Error(t, expense.ChangeLineItems([]*ExpenseLineItem{lineItem(roomID, 14980, "宿泊"), usd}, time.Now()), "通貨が異なる")
+
+		_, err = NewExpenseLineItem(mealID, money(0, "JPY"), "", "朝食")
+		assert.Error(t, err, "金額が0")
+		_, err = NewExpenseLineItem(mealID, money(1000, "JPY"), "exempt", "朝食")
+		assert.Error(t, err, "無効な税区分")
+		assert.False(t, expense.IsItemized())
+	})
+
+	t.Run("明細に分けた経費は金額を変えると明細と一致しなくなるためエラー", func(t *testing.T) {
+		expense := newHotelExpense()
+		require.NoError(t, expense.ChangeLineItems([]*ExpenseLineItem{
+			lineItem(roomID, 13000, "宿泊"),
+			lineItem(parkingID, 2000, "駐車場"),
+		}, time.Now()))
+
+		assert.Error(t, expense.UpdateDetails(roomID, money(16000, "JPY"), "ホテル代", "", date, time.Now()))
+		assert.NoError(t, expense.UpdateDetails(roomID, money(15000, "JPY"), "ホテル代（出張）", "", date, time.Now()))
+	})
+
+	t.Run("距離精算の経費は明細に分けられない", func(t *testing.T) {
+		mileage, err := NewMileage("本社", "横浜営業所", 10, VehicleTypeCar)
+		require.NoError(t, err)
+		expense, err := NewMileageExpense(clock.System(), userID, roomID, mileage, "横浜営業所への移動", "", date)
+		require.NoError(t, err)
+
+		half := expense.Amount().Amount() / 2
+		assert.Error(t, expense.ChangeLineItems([]*ExpenseLineItem{
+			lineItem(roomID, half, ""),
+			lineItem(parkingID, expense.Amount().Amount()-half, ""),
+		}, time.Now()))
+	})
+
+	t.Run("支出規程はカテゴリの明細ごとに明細の金額で判定する", func(t *testing.T) {
+		meal, err := NewCategory(clock.System(), "食事代", "", "")
+		require.NoError(t, err)
+		limit, err := valueobject.NewMaxAmountRule(money(1500, "JPY"), "exception")
+		require.NoError(t, err)
+		require.NoError(t, meal.ChangeSpendingRules([]*valueobject.SpendingRule{limit}, time.Now()))
+
+		expense := newHotelExpense()
+		require.NoError(t, expense.ChangeLineItems([]*ExpenseLineItem{
+			lineItem(roomID, 12000, "宿泊"),
+			lineItem(meal.ID(), 2000, "朝食"),
+			lineItem(parkingID, 1000, "駐車場"),
+		}, time.Now()))
+
+		violations := meal.EvaluateSpendingRules(expense)
+		require.Len(t, violations, 1)
+		assert.Contains(t, violations[0].Message(), "明細「朝食」")
+
+		// 経費の合計はカテゴリの上限を超えていても、明細の金額が上限内なら違反にしない
+		require.NoError(t, expense.ChangeLineItems([]*ExpenseLineItem{
+			lineItem(roomID, 13500, "宿泊"),
+			lineItem(meal.ID(), 1500, "朝食"),
+		}, time.Now()))
+		assert.Empty(t, meal.EvaluateSpendingRules(expense))
+	})
+
+	t.Run("カテゴリの予算は該当する明細の金額だけを消化する", func(t *testing.T) {
+		scope, err := NewBudgetScope(mealID, nil, nil)
+		require.NoError(t, err)
+		budget, err := NewBudget(clock.System(), "食事代予算", scope, date, date, money(10000, "JPY"))
+		require.NoError(t, err)
+
+		expense := newHotelExpense()
+		require.NoError(t, expense.ChangeLineItems([]*ExpenseLineItem{
+			lineItem(roomID, 12000, "宿泊"),
+			lineItem(mealID, 3000, "夕食"),
+		}, time.Now()))
+		require.NoError(t, expense.Submit(time.Now()))
+
+		assert.True(t, budget.Covers(expense, nil))
+		usage, err := budget.Usage([]*Expense{expense})
+		require.NoError(t, err)
+		assert.Equal(t, 3000.0, usage.Committed().Amount())
+	})
+
+	t.Run("明細の金額を按分の比率で割り当てる", func(t *testing.T) {
+		sales, err := NewAmountAllocation(valueobject.GenerateCostCenterID(), money(10000, "JPY"))
+		require.NoError(t, err)
+		support, err := NewAmountAllocation(valueobject.GenerateCostCenterID(), money(5000, "JPY"))
+		require.NoError(t, err)
+
+		amounts, err := ProrateAllocations(money(1000, "JPY"), money(15000, "JPY"), []*CostAllocation{sales, support})
+		require.NoError(t, err)
+		require.Len(t, amounts, 2)
+		assert.Equal(t, 666.0, amounts[0].Amount())
+		assert.Equal(t, 334.0, amounts[1].Amount(), "端数は最後の按分で調整する")
+	})
+
+	t.Run("会議費・交際費の区分は飲食・接待のカテゴリの明細の金額だけで判定する", func(t *testing.T) {
+		guest, err := NewAttendee("佐藤花子", "株式会社サンプル", AttendeeTypeExternal)
+		require.NoError(t, err)
+		isMeal := func(id *valueobject.CategoryID) bool {
+			return id.Equals(mealID)
+		}
+
+		// 経費の金額の1人当たりは10,000円を超えるが、飲食の明細の1人当たりは2,000円
+		expense := newHotelExpense()
+		require.NoError(t, expense.ChangeAttendees([]*Attendee{guest}, time.Now()))
+		assert.Equal(t, EntertainmentClassNone, expense.EntertainmentClass(isMeal), "経費のカテゴリが飲食・接待以外")
+
+		require.NoError(t, expense.ChangeLineItems([]*ExpenseLineItem{
+			lineItem(roomID, 13000, "宿泊"),
+			lineItem(mealID, 2000, "会食"),
+		}, time.Now()))
+		assert.Equal(t, EntertainmentClassMeeting, expense.EntertainmentClass(isMeal))
+
+		// 飲食の明細の合計で判定する
+		require.NoError(t, expense.ChangeLineItems([]*ExpenseLineItem{
+			lineItem(roomID, 4000, "宿泊"),
+			lineItem(mealID, 10000, "会食"),
+			lineItem(mealID, 1000, "二次会"),
+		}, time.Now()))
+		assert.Equal(t, EntertainmentClassEntertainment, expense.EntertainmentClass(isMeal))
+	})
+}
+
+func TestExpense_DuplicateReasons(t *testing.T) {
+	date := valueobject.DateOf(time.Now())
+	categoryID := valueobject.GenerateCategoryID()
+	newExpense := func(userID *valueobject.UserID, amount float64, title string, date valueobject.Date) *Expense {
+		money, _ := valueobject.NewMoney(amount, "JPY")
+		expense, err := NewExpense(clock.System(), userID, categoryID, money, title, "", date)
+		require.NoError(t, err)
+		return expense
+	}
+	hash := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	t.Run("金額・日付が同じで件名が類似していれば他のユーザーの経費も重複の疑い", func(t *testing.T) {
+		expense := newExpense(valueobject.GenerateUserID(), 2400, "タクシー代", date)
+		shared := newExpense(valueobject.GenerateUserID(), 2400, "タクシー代（客先訪問）", date)
+		assert.Equal(t, []DuplicateReason{DuplicateReasonSimilarTitle}, expense.DuplicateReasons(shared))
+
+		assert.Empty(t, expense.DuplicateReasons(newExpense(expense.UserID(), 2500, "タクシー代", date)), "金額が異なる")
+		assert.Empty(t, expense.DuplicateReasons(newExpense(expense.UserID(), 2400, "タクシー代", date.AddDays(-1))), "日付が異なる")
+		assert.Empty(t, expense.DuplicateReasons(newExpense(expense.UserID(), 2400, "新幹線代", date)), "件名が異なる")
+		assert.Empty(t, expense.DuplicateReasons(expense), "同じ経費")
+	})
+
+	t.Run("支払先は大文字小文字と記号の違いを無視して比較する", func(t *testing.T) {
+		expense := newExpense(valueobject.GenerateUserID(), 1100, "打ち合わせのランチ", date)
+		require.NoError(t, expense.ChangeReceipt("Cafe Sample", "", time.Now()))
+		other := newExpense(expense.UserID(), 1100, "昼食", date)
+		require.NoError(t, other.ChangeReceipt("CAFE-SAMPLE", "", time.Now()))
+
+		assert.Equal(t, []DuplicateReason{DuplicateReasonSameVendor}, expense.DuplicateReasons(other))
+	})
+
+	t.Run("領収書ファイルのハッシュが同じなら金額や日付が異なっても重複の疑い", func(t *testing.T) {
+		expense := newExpense(valueobject.GenerateUserID(), 3000, "書籍代", date)
+		require.NoError(t, expense.ChangeReceipt("", strings.ToUpper(hash), time.Now()))
+		assert.Equal(t, hash, expense.ReceiptHash(), "小文字で保持する")
+
+		other := newExpense(expense.UserID(), 300, "文房具", date.AddDays(-30))
+		require.NoError(t, other.ChangeReceipt("", hash, time.Now()))
+		assert.Equal(t, []DuplicateReason{DuplicateReasonSameReceipt}, expense.DuplicateReasons(other))
+
+		// 却下された経費は比較しない
+		require.NoError(t, other.Submit(time.Now()))
+		require.NoError(t, other.Reject(time.Now()))
+		assert.Empty(t, expense.DuplicateReasons(other))
+	})
+
+	t.Run("不正なハッシュ・申請後の変更はエラー", func(t *testing.T) {
+		expense := newExpense(valueobject.GenerateUserID(), 3000, "書籍代", date)
+		assert.Error(t, expense.ChangeReceipt("", "abc", time.Now()))
+		assert.Error(t, expense.ChangeReceipt("", strings.Repeat("g", 64), time.Now()))
+
+		require.NoError(t, expense.Submit(time.Now()))
+		assert.Error(t, expense.ChangeReceipt("書店", "", time.Now()))
+	})
+}
@@ -0,0 +1,226 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"math"
+	"strings"
+	"time"
+)
+
+// JournalSide 仕訳の貸借
+type JournalSide string
+
+const (
+	JournalSideDebit  JournalSide = "debit"  // 借方
+	JournalSideCredit JournalSide = "credit" // 貸方
+)
+
+// JournalEntrySource 仕訳の発生元
+type JournalEntrySource string
+
+const (
+	JournalEntrySourceExpenseApproval JournalEntrySource = "expense_approval" // 経費の承認（費用の計上）
+	JournalEntrySourceExpensePayment  JournalEntrySource = "expense_payment"  // 経費の支払い
+)
+
+// JournalLine 仕訳の明細行
+type JournalLine struct {
+	account    string
+	department string
+	side       JournalSide
+	amount     *valueobject.Money
+}
+
+// NewJournalLine 新しいJournalLineを作成
+func NewJournalLine(account, department string, side JournalSide, amount *valueobject.Money) (*JournalLine, error) {
+	account = strings.TrimSpace(account)
+	if account == "" {
+		return nil, errors.NewDomainError(errors.InvalidJournalEntry, "勘定科目が必要です")
+	}
+
+	if side != JournalSideDebit && side != JournalSideCredit {
+		return nil, errors.NewDomainError(errors.InvalidJournalEntry, "無効な貸借区分です")
+	}
+
+	if amount == nil || amount.Amount() <= 0 {
+		return nil, errors.NewDomainError(errors.InvalidJournalEntry, "仕訳の金額は0より大きい必要があります")
+	}
+
+	return &JournalLine{
+		account:    account,
+		department: strings.TrimSpace(department),
+		side:       side,
+		amount:     amount,
+	}, nil
+}
+
+// Account 勘定科目を取得
+func (l *JournalLine) Account() string {
+	return l.account
+}
+
+// Department 部門を取得
+func (l *JournalLine) Department() string {
+	return l.department
+}
+
+// Side 貸借を取得
+func (l *JournalLine) Side() JournalSide {
+	return l.side
+}
+
+// Amount 金額を取得
+func (l *JournalLine) Amount() *valueobject.Money {
+	return l.amount
+}
+
+// JournalEntry 複式簿記の仕訳エンティティ
+// 作成後は変更できない（訂正は反対仕訳で行う）
+type JournalEntry struct {
+	id          *valueobject.JournalEntryID
+	date        valueobject.Date
+	description string
+	source      JournalEntrySource
+	sourceID    string // 発生元のID（経費ID）
+	lines       []*JournalLine
+	createdAt   time.Time
+}
+
+// NewJournalEntry 新しいJournalEntryを作成（借方と貸方の合計が一致しない場合はエラー）
+func NewJournalEntry(clk clock.Clock, date valueobject.Date, description string, source JournalEntrySource, sourceID string, lines []*JournalLine) (*JournalEntry, error) {
+	if err := validateJournalEntry(date, source, sourceID, lines); err != nil {
+		return nil, err
+	}
+
+	return &JournalEntry{
+		id:          valueobject.GenerateJournalEntryID(),
+		date:        date,
+		description: strings.TrimSpace(description),
+		source:      source,
+		sourceID:    sourceID,
+		lines:       lines,
+		createdAt:   clk.Now(),
+	}, nil
+}
+
+// ReconstructJournalEntry 既存データからJournalEntryを再構築
+func ReconstructJournalEntry(
+	id *valueobject.JournalEntryID,
+	date valueobject.Date,
+	description string,
+	source JournalEntrySource,
+	sourceID string,
+	lines []*JournalLine,
+	createdAt time.Time,
+) (*JournalEntry, error) {
+	if id == nil {
+		return nil, errors.NewDomainError(errors.InvalidJournalEntryID, "仕訳IDが必要です")
+	}
+
+	if err := validateJournalEntry(date, source, sourceID, lines); err != nil {
+		return nil, err
+	}
+
+	return &JournalEntry{
+		id:          id,
+		date:        date,
+		description: description,
+		source:      source,
+		sourceID:    sourceID,
+		lines:       lines,
+		createdAt:   createdAt,
+	}, nil
+}
+
+// ID IDを取得
+func (j *JournalEntry) ID() *valueobject.JournalEntryID {
+	return j.id
+}
+
+// Date 計上日を取得
+func (j *JournalEntry) Date() valueobject.Date {
+	return j.date
+}
+
+// Description 摘要を取得
+func (j *JournalEntry) Description() string {
+	return j.description
+}
+
+// Source 発生元を取得
+func (j *JournalEntry) Source() JournalEntrySource {
+	return j.source
+}
+
+// SourceID 発生元のIDを取得
+func (j *JournalEntry) SourceID() string {
+	return j.sourceID
+}
+
+// Lines 明細行を取得
+func (j *JournalEntry) Lines() []*JournalLine {
+	return j.lines
+}
+
+// CreatedAt 作成日時を取得
+func (j *JournalEntry) CreatedAt() time.Time {
+	return j.createdAt
+}
+
+// Currency 仕訳の通貨を取得
+func (j *JournalEntry) Currency() string {
+	return j.lines[0].amount.Currency()
+}
+
+// validateJournalEntry 仕訳のバリデーション
+func validateJournalEntry(date valueobject.Date, source JournalEntrySource, sourceID string, lines []*JournalLine) error {
+	if date.IsZero() {
+		return errors.NewDomainError(errors.InvalidJournalEntry, "計上日が必要です")
+	}
+
+	if source != JournalEntrySourceExpenseApproval && source != JournalEntrySourceExpensePayment {
+		return errors.NewDomainError(errors.InvalidJournalEntry, "無効な仕訳の発生元です")
+	}
+
+	if strings.TrimSpace(sourceID) == "" {
+		return errors.NewDomainError(errors.InvalidJournalEntry, "仕訳の発生元のIDが必要です")
+	}
+
+	if len(lines) < 2 {
+		return errors.NewDomainError(errors.InvalidJournalEntry, "仕訳には借方と貸方の明細行が必要です")
+	}
+
+	// 金額は小数点以下2桁に丸められているため、1/100単位の整数で合計する
+	var debit, credit int64
+	currency := ""
+	for _, line := range lines {
+		if line == nil {
+			return errors.NewDomainError(errors.InvalidJournalEntry, "仕訳の明細行が不正です")
+		}
+
+		if currency == "" {
+			currency = line.amount.Currency()
+		} else if line.amount.Currency() != currency {
+			return errors.NewDomainError(errors.InvalidJournalEntry, "1つの仕訳に異なる通貨は混在できません")
+		}
+
+		cents := int64(math.Round(line.amount.Amount() * 100))
+		if line.side == JournalSideDebit {
+			debit += cents
+		} else {
+			credit += cents
+		}
+	}
+
+	if debit == 0 || credit == 0 {
+		return errors.NewDomainError(errors.InvalidJournalEntry, "仕訳には借方と貸方の明細行が必要です")
+	}
+
+	if debit != credit {
+		return errors.NewDomainError(errors.UnbalancedJournalEntry, "借方と貸方の合計が一致しません")
+	}
+
+	return nil
+}
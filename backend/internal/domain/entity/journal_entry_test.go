@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJournalEntry(t *testing.T) {
+	date, _ := valueobject.NewDate(2024, time.April, 1)
+	newLine := func(account string, side JournalSide, amount float64, currency string) *JournalLine {
+		money, err := valueobject.NewMoney(amount, currency)
+		require.NoError(t, err)
+		line, err := NewJournalLine(account, "", side, money)
+		require.NoError(t, err)
+		return line
+	}
+
+	t.Run("借方と貸方の合計が一致する仕訳", func(t *testing.T) {
+		entry, err := NewJournalEntry(clock.System(), date, "電車代", JournalEntrySourceExpenseApproval, "expense-1", []*JournalLine{
+			newLine("旅費交通費", JournalSideDebit, 1000, "JPY"),
+			newLine("仮払消費税", JournalSideDebit, 100, "JPY"),
+			newLine("未払費用", JournalSideCredit, 1100, "JPY"),
+		})
+		require.NoError(t, err)
+		assert.Len(t, entry.Lines(), 3)
+		assert.Equal(t, "JPY", entry.Currency())
+	})
+
+	t.Run("借方と貸方の合計が一致しない場合はエラー", func(t *testing.T) {
+		_, err := NewJournalEntry(clock.System(), date, "電車代", JournalEntrySourceExpenseApproval, "expense-1", []*JournalLine{
+			newLine("旅費交通費", JournalSideDebit, 1000, "JPY"),
+			newLine("未払費用", JournalSideCredit, 1100, "JPY"),
+		})
+		assert.ErrorContains(t, err, "UNBALANCED_JOURNAL_ENTRY")
+	})
+
+	t.Run("片側だけの仕訳や異なる通貨の混在はエラー", func(t *testing.T) {
+		_, err := NewJournalEntry(clock.System(), date, "", JournalEntrySourceExpensePayment, "expense-1", []*JournalLine{
+			newLine("未払費用", JournalSideDebit, 500, "JPY"),
+			newLine("普通預金", JournalSideDebit, 500, "JPY"),
+		})
+		assert.Error(t, err)
+
+		_, err = NewJournalEntry(clock.System(), date, "", JournalEntrySourceExpensePayment, "expense-1", []*JournalLine{
+			newLine("未払費用", JournalSideDebit, 500, "JPY"),
+			newLine("普通預金", JournalSideCredit, 500, "USD"),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("金額が0の明細行は作成できない", func(t *testing.T) {
+		zero, _ := valueobject.NewMoney(0, "JPY")
+		_, err := NewJournalLine("未払費用", "", JournalSideDebit, zero)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,159 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"math"
+	"strings"
+	"time"
+)
+
+// VehicleType 車両の種類
+type VehicleType string
+
+const (
+	VehicleTypeCar        VehicleType = "car"        // 自動車
+	VehicleTypeMotorcycle VehicleType = "motorcycle" // 二輪車
+)
+
+// mileageCurrency 走行距離精算の通貨
+const mileageCurrency = "JPY"
+
+// maxMileageDistanceKm 1件あたりの最大走行距離（km）
+const maxMileageDistanceKm = 10000
+
+// MileageRate 走行距離精算の単価（適用開始日ごと）
+type MileageRate struct {
+	vehicleType   VehicleType
+	effectiveFrom time.Time
+	ratePerKm     float64
+}
+
+// mileageRateTable 走行距離精算の単価表（車両の種類ごとに適用開始日の昇順）
+var mileageRateTable = []*MileageRate{
+	{vehicleType: VehicleTypeCar, effectiveFrom: time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), ratePerKm: 15},
+	{vehicleType: VehicleTypeCar, effectiveFrom: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), ratePerKm: 20},
+	{vehicleType: VehicleTypeMotorcycle, effectiveFrom: time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), ratePerKm: 8},
+	{vehicleType: VehicleTypeMotorcycle, effectiveFrom: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), ratePerKm: 10},
+}
+
+// VehicleType 車両の種類を取得
+func (r *MileageRate) VehicleType() VehicleType {
+	return r.vehicleType
+}
+
+// EffectiveFrom 適用開始日を取得
+func (r *MileageRate) EffectiveFrom() time.Time {
+	return r.effectiveFrom
+}
+
+// RatePerKm 1kmあたりの単価を取得
+func (r *MileageRate) RatePerKm() float64 {
+	return r.ratePerKm
+}
+
+// FindMileageRate 指定日に適用される単価を取得
+func FindMileageRate(vehicleType VehicleType, date time.Time) (*MileageRate, error) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	var found *MileageRate
+	for _, rate := range mileageRateTable {
+		if rate.vehicleType == vehicleType && !rate.effectiveFrom.After(day) {
+			found = rate
+		}
+	}
+
+	if found == nil {
+		return nil, errors.NewDomainError(errors.InvalidMileage, "指定日に適用される走行距離の単価がありません")
+	}
+
+	return found, nil
+}
+
+// Mileage 走行距離精算の明細（出発地・目的地・走行距離・車両の種類）
+type Mileage struct {
+	origin      string
+	destination string
+	distanceKm  float64
+	vehicleType VehicleType
+}
+
+// NewMileage 新しいMileageを作成
+func NewMileage(origin, destination string, distanceKm float64, vehicleType VehicleType) (*Mileage, error) {
+	origin = strings.TrimSpace(origin)
+	if origin == "" {
+		return nil, errors.NewDomainError(errors.InvalidMileage, "出発地は必須です")
+	}
+
+	if len(origin) > 100 {
+		return nil, errors.NewDomainError(errors.InvalidMileage, "出発地は100文字以内である必要があります")
+	}
+
+	destination = strings.TrimSpace(destination)
+	if destination == "" {
+		return nil, errors.NewDomainError(errors.InvalidMileage, "目的地は必須です")
+	}
+
+	if len(destination) > 100 {
+		return nil, errors.NewDomainError(errors.InvalidMileage, "目的地は100文字以内である必要があります")
+	}
+
+	if distanceKm <= 0 {
+		return nil, errors.NewDomainError(errors.InvalidMileage, "走行距離は0より大きい必要があります")
+	}
+
+	if distanceKm > maxMileageDistanceKm {
+		return nil, errors.NewDomainError(errors.InvalidMileage, "走行距離は10000km以内である必要があります")
+	}
+
+	if !isValidVehicleType(vehicleType) {
+		return nil, errors.NewDomainError(errors.InvalidMileage, "無効な車両の種類です")
+	}
+
+	return &Mileage{
+		origin:      origin,
+		destination: destination,
+		distanceKm:  distanceKm,
+		vehicleType: vehicleType,
+	}, nil
+}
+
+// Origin 出発地を取得
+func (m *Mileage) Origin() string {
+	return m.origin
+}
+
+// Destination 目的地を取得
+func (m *Mileage) Destination() string {
+	return m.destination
+}
+
+// DistanceKm 走行距離（km）を取得
+func (m *Mileage) DistanceKm() float64 {
+	return m.distanceKm
+}
+
+// VehicleType 車両の種類を取得
+func (m *Mileage) VehicleType() VehicleType {
+	return m.vehicleType
+}
+
+// CalculateAmount 指定日の単価で金額を計算（1円未満は四捨五入）
+func (m *Mileage) CalculateAmount(date valueobject.Date) (*valueobject.Money, error) {
+	rate, err := FindMileageRate(m.vehicleType, date.Time())
+	if err != nil {
+		return nil, err
+	}
+
+	return valueobject.NewMoney(math.Round(m.distanceKm*rate.ratePerKm), mileageCurrency)
+}
+
+// isValidVehicleType 有効な車両の種類かチェック
+func isValidVehicleType(vehicleType VehicleType) bool {
+	switch vehicleType {
+	case VehicleTypeCar, VehicleTypeMotorcycle:
+		return true
+	default:
+		return false
+	}
+}
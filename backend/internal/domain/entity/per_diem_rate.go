@@ -0,0 +1,162 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+	"time"
+)
+
+const (
+	// PerDiemHalfDayCutoffHour 半日扱いの基準時刻（出発日はこの時刻以降の出発、帰着日はこの時刻より前の帰着で半日）
+	PerDiemHalfDayCutoffHour = 12
+
+	// PerDiemHalfDayRatio 半日扱いの日当の支給割合
+	PerDiemHalfDayRatio = 0.5
+
+	// PerDiemMaxDays 一度に計算できる最大日数
+	PerDiemMaxDays = 366
+)
+
+// PerDiemRate 出張旅費規程の日当単価エンティティ（地域区分×職能等級ごと）
+type PerDiemRate struct {
+	destinationClass string
+	grade            string
+	dailyAmount      *valueobject.Money
+	updatedAt        time.Time
+}
+
+// PerDiemDay 日当の支給対象日
+type PerDiemDay struct {
+	Date    time.Time
+	HalfDay bool
+	Amount  *valueobject.Money
+}
+
+// NewPerDiemRate 新しいPerDiemRateを作成
+func NewPerDiemRate(clk clock.Clock, destinationClass, grade string, dailyAmount *valueobject.Money) (*PerDiemRate, error) {
+	if err := validatePerDiemRate(destinationClass, grade, dailyAmount); err != nil {
+		return nil, err
+	}
+
+	return &PerDiemRate{
+		destinationClass: strings.TrimSpace(destinationClass),
+		grade:            strings.TrimSpace(grade),
+		dailyAmount:      dailyAmount,
+		updatedAt:        clk.Now(),
+	}, nil
+}
+
+// ReconstructPerDiemRate 既存データからPerDiemRateを再構築
+func ReconstructPerDiemRate(destinationClass, grade string, dailyAmount *valueobject.Money, updatedAt time.Time) (*PerDiemRate, error) {
+	if err := validatePerDiemRate(destinationClass, grade, dailyAmount); err != nil {
+		return nil, err
+	}
+
+	return &PerDiemRate{
+		destinationClass: destinationClass,
+		grade:            grade,
+		dailyAmount:      dailyAmount,
+		updatedAt:        updatedAt,
+	}, nil
+}
+
+// DestinationClass 地域区分を取得
+func (r *PerDiemRate) DestinationClass() string {
+	return r.destinationClass
+}
+
+// Grade 職能等級を取得
+func (r *PerDiemRate) Grade() string {
+	return r.grade
+}
+
+// DailyAmount 日当（1日分）を取得
+func (r *PerDiemRate) DailyAmount() *valueobject.Money {
+	return r.dailyAmount
+}
+
+// UpdatedAt 更新日時を取得
+func (r *PerDiemRate) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// Calculate 出発日時と帰着日時から日ごとの日当を計算
+// 出発日は基準時刻以降の出発、帰着日は基準時刻より前の帰着の場合に半日扱いとする
+func (r *PerDiemRate) Calculate(departureAt, returnAt time.Time) ([]*PerDiemDay, error) {
+	if returnAt.Before(departureAt) {
+		return nil, errors.NewDomainError(errors.InvalidPerDiemRate, "帰着日時は出発日時以降である必要があります")
+	}
+
+	firstDay := truncateToDate(departureAt)
+	lastDay := truncateToDate(returnAt)
+	if lastDay.After(firstDay.AddDate(0, 0, PerDiemMaxDays-1)) {
+		return nil, errors.NewDomainError(errors.InvalidPerDiemRate, "日当を計算できる期間は366日以内です")
+	}
+
+	halfAmount, err := r.dailyAmount.Multiply(PerDiemHalfDayRatio)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]*PerDiemDay, 0)
+	for day := firstDay; !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+		halfDay := false
+		if day.Equal(firstDay) && departureAt.Hour() >= PerDiemHalfDayCutoffHour {
+			halfDay = true
+		}
+		if day.Equal(lastDay) && returnAt.Hour() < PerDiemHalfDayCutoffHour {
+			halfDay = true
+		}
+
+		amount := r.dailyAmount
+		if halfDay {
+			amount = halfAmount
+		}
+
+		days = append(days, &PerDiemDay{
+			Date:    day,
+			HalfDay: halfDay,
+			Amount:  amount,
+		})
+	}
+
+	return days, nil
+}
+
+// validatePerDiemRate 日当単価のバリデーション
+func validatePerDiemRate(destinationClass, grade string, dailyAmount *valueobject.Money) error {
+	destinationClass = strings.TrimSpace(destinationClass)
+	if destinationClass == "" {
+		return errors.NewDomainError(errors.InvalidPerDiemRate, "地域区分は必須です")
+	}
+
+	if len(destinationClass) > 50 {
+		return errors.NewDomainError(errors.InvalidPerDiemRate, "地域区分は50文字以内である必要があります")
+	}
+
+	grade = strings.TrimSpace(grade)
+	if grade == "" {
+		return errors.NewDomainError(errors.InvalidPerDiemRate, "職能等級は必須です")
+	}
+
+	if err := validateUserGrade(grade); err != nil {
+		return err
+	}
+
+	if dailyAmount == nil {
+		return errors.NewDomainError(errors.InvalidPerDiemRate, "日当の金額は必須です")
+	}
+
+	if dailyAmount.Amount() <= 0 {
+		return errors.NewDomainError(errors.InvalidPerDiemRate, "日当の金額は0より大きい必要があります")
+	}
+
+	return nil
+}
+
+// truncateToDate 時刻を切り捨てて日付のみにする
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,203 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"math"
+	"strings"
+	"time"
+)
+
+// maxMarkupPercentage 請求時の上乗せ率の上限（%）
+const maxMarkupPercentage = 100
+
+// Project 顧客向けのプロジェクトエンティティ（経費を顧客に請求する単位）
+type Project struct {
+	id        *valueobject.ProjectID
+	code      string
+	name      string
+	client    string           // 請求先の顧客名
+	startDate valueobject.Date // 経費を計上できる期間の開始日
+	endDate   valueobject.Date // 経費を計上できる期間の終了日（この日を含む、ゼロ値の場合は終了日なし）
+	budget    *valueobject.Money
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewProject 新しいProjectを作成（予算はnilでもよい）
+func NewProject(clk clock.Clock, code, name, client string, startDate, endDate valueobject.Date, budget *valueobject.Money) (*Project, error) {
+	if err := validateProject(code, name, client, startDate, endDate, budget); err != nil {
+		return nil, err
+	}
+
+	now := clk.Now()
+	return &Project{
+		id:        valueobject.GenerateProjectID(),
+		code:      strings.TrimSpace(code),
+		name:      strings.TrimSpace(name),
+		client:    strings.TrimSpace(client),
+		startDate: startDate,
+		endDate:   endDate,
+		budget:    budget,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ReconstructProject 既存データからProjectを再構築
+func ReconstructProject(id *valueobject.ProjectID, code, name, client string, startDate, endDate valueobject.Date, budget *valueobject.Money, createdAt, updatedAt time.Time) (*Project, error) {
+	if id == nil {
+		return nil, errors.NewDomainError(errors.InvalidProjectID, "プロジェクトIDが必要です")
+	}
+
+	if err := validateProject(code, name, client, startDate, endDate, budget); err != nil {
+		return nil, err
+	}
+
+	return &Project{
+		id:        id,
+		code:      code,
+		name:      name,
+		client:    client,
+		startDate: startDate,
+		endDate:   endDate,
+		budget:    budget,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}, nil
+}
+
+// ID IDを取得
+func (p *Project) ID() *valueobject.ProjectID {
+	return p.id
+}
+
+// Code プロジェクトコードを取得
+func (p *Project) Code() string {
+	return p.code
+}
+
+// Name プロジェクト名を取得
+func (p *Project) Name() string {
+	return p.name
+}
+
+// Client 請求先の顧客名を取得
+func (p *Project) Client() string {
+	return p.client
+}
+
+// StartDate 期間の開始日を取得
+func (p *Project) StartDate() valueobject.Date {
+	return p.startDate
+}
+
+// EndDate 期間の終了日を取得（終了日なしの場合はゼロ値）
+func (p *Project) EndDate() valueobject.Date {
+	return p.endDate
+}
+
+// Budget 予算を取得（未設定の場合はnil）
+func (p *Project) Budget() *valueobject.Money {
+	return p.budget
+}
+
+// CreatedAt 作成日時を取得
+func (p *Project) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+// UpdatedAt 更新日時を取得
+func (p *Project) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// Update プロジェクトを更新
+func (p *Project) Update(code, name, client string, startDate, endDate valueobject.Date, budget *valueobject.Money, now time.Time) error {
+	if err := validateProject(code, name, client, startDate, endDate, budget); err != nil {
+		return err
+	}
+
+	p.code = strings.TrimSpace(code)
+	p.name = strings.TrimSpace(name)
+	p.client = strings.TrimSpace(client)
+	p.startDate = startDate
+	p.endDate = endDate
+	p.budget = budget
+	p.updatedAt = now
+
+	return nil
+}
+
+// IsActiveOn 日付がプロジェクトの期間内（両端を含む）かどうか
+func (p *Project) IsActiveOn(date valueobject.Date) bool {
+	if date.Before(p.startDate) {
+		return false
+	}
+	return p.endDate.IsZero() || !date.After(p.endDate)
+}
+
+// ApplyMarkup 請求する経費の金額に上乗せ率（%）を適用し、上乗せ額と請求額を計算する
+// 上乗せ額は日本円では1円未満、それ以外の通貨では0.01未満を四捨五入する
+func ApplyMarkup(amount *valueobject.Money, markupPercentage float64) (*valueobject.Money, *valueobject.Money, error) {
+	if markupPercentage < 0 || markupPercentage > maxMarkupPercentage {
+		return nil, nil, errors.NewDomainError(errors.InvalidProject, "上乗せ率は0以上100以下である必要があります")
+	}
+
+	unit := 0.01
+	if amount.Currency() == "JPY" {
+		unit = 1
+	}
+
+	markup, err := valueobject.NewMoney(math.Round(amount.Amount()*markupPercentage/100/unit)*unit, amount.Currency())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	billed, err := amount.Add(markup)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return markup, billed, nil
+}
+
+// validateProject プロジェクトのバリデーション
+func validateProject(code, name, client string, startDate, endDate valueobject.Date, budget *valueobject.Money) error {
+	if err := validateOrganizationCode(code, errors.InvalidProject, "プロジェクト"); err != nil {
+		return err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.NewDomainError(errors.InvalidProject, "プロジェクト名は必須です")
+	}
+
+	if len(name) > 100 {
+		return errors.NewDomainError(errors.InvalidProject, "プロジェクト名は100文字以内である必要があります")
+	}
+
+	client = strings.TrimSpace(client)
+	if client == "" {
+		return errors.NewDomainError(errors.InvalidProject, "顧客名は必須です")
+	}
+
+	if len(client) > 100 {
+		return errors.NewDomainError(errors.InvalidProject, "顧客名は100文字以内である必要があります")
+	}
+
+	if startDate.IsZero() {
+		return errors.NewDomainError(errors.InvalidProject, "プロジェクトの開始日が必要です")
+	}
+
+	if !endDate.IsZero() && endDate.Before(startDate) {
+		return errors.NewDomainError(errors.InvalidProject, "プロジェクトの終了日は開始日以降である必要があります")
+	}
+
+	if budget != nil && budget.Amount() <= 0 {
+		return errors.NewDomainError(errors.InvalidProject, "プロジェクトの予算は0より大きい必要があります")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProject(t *testing.T) {
+	date := func(value string) valueobject.Date {
+		d, err := valueobject.ParseDate(value)
+		require.NoError(t, err)
+		return d
+	}
+	money := func(amount float64, currency string) *valueobject.Money {
+		m, _ := valueobject.NewMoney(amount, currency)
+		return m
+	}
+
+	t.Run("期間の両端を含めて経費を計上できる", func(t *testing.T) {
+		project, err := NewProject(clock.System(), "PJ-001", "基幹システム刷新", "株式会社サンプル", date("2024-04-01"), date("2024-09-30"), nil)
+		require.NoError(t, err)
+		assert.False(t, project.IsActiveOn(date("2024-03-31")))
+		assert.True(t, project.IsActiveOn(date("2024-04-01")))
+		assert.True(t, project.IsActiveOn(date("2024-09-30")))
+		assert.False(t, project.IsActiveOn(date("2024-10-01")))
+
+		// 終了日なしのプロジェクト
+		open, err := NewProject(clock.System(), "PJ-002", "保守", "株式会社サンプル", date("2024-04-01"), valueobject.Date{}, money(500000, "JPY"))
+		require.NoError(t, err)
+		assert.True(t, open.IsActiveOn(date("2030-01-01")))
+	})
+
+	t.Run("上乗せ額は日本円では1円単位、それ以外は0.01単位で丸める", func(t *testing.T) {
+		markup, billed, err := ApplyMarkup(money(1234, "JPY"), 15)
+		require.NoError(t, err)
+		assert.Equal(t, 185.0, markup.Amount())
+		assert.Equal(t, 1419.0, billed.Amount())
+
+		markup, billed, err = ApplyMarkup(money(10.05, "USD"), 12.5)
+		require.NoError(t, err)
+		assert.Equal(t, 1.26, markup.Amount())
+		assert.Equal(t, 11.31, billed.Amount())
+
+		_, _, err = ApplyMarkup(money(1000, "JPY"), 100.1)
+		assert.Error(t, err)
+	})
+
+	t.Run("不正なプロジェクトはエラー", func(t *testing.T) {
+		_, err := NewProject(clock.System(), "PJ 001", "刷新", "顧客", date("2024-04-01"), valueobject.Date{}, nil)
+		assert.Error(t, err, "コードに空白")
+		_, err = NewProject(clock.System(), "PJ-001", "刷新", "", date("2024-04-01"), valueobject.Date{}, nil)
+		assert.Error(t, err, "顧客名なし")
+		_, err = NewProject(clock.System(), "PJ-001", "刷新", "顧客", valueobject.Date{}, valueobject.Date{}, nil)
+		assert.Error(t, err, "開始日なし")
+		_, err = NewProject(clock.System(), "PJ-001", "刷新", "顧客", date("2024-04-01"), date("2024-03-31"), nil)
+		assert.Error(t, err, "終了日が開始日より前")
+		_, err = NewProject(clock.System(), "PJ-001", "刷新", "顧客", date("2024-04-01"), valueobject.Date{}, money(0, "JPY"))
+		assert.Error(t, err, "予算が0")
+	})
+
+	t.Run("請求対象の経費にはプロジェクトが必要", func(t *testing.T) {
+		expense, err := NewExpense(clock.System(), valueobject.GenerateUserID(), valueobject.GenerateCategoryID(), money(5000, "JPY"), "テスト経費", "", valueobject.DateOf(time.Now()))
+		require.NoError(t, err)
+		assert.Error(t, expense.ChangeProject(nil, true, time.Now()))
+
+		projectID := valueobject.GenerateProjectID()
+		require.NoError(t, expense.ChangeProject(projectID, true, time.Now()))
+		assert.True(t, expense.ProjectID().Equals(projectID))
+		assert.True(t, expense.IsBillable())
+	})
+}
@@ -0,0 +1,257 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+	"time"
+)
+
+// RecurringExpense 定期経費エンティティ（定期券・携帯電話手当・サブスクリプションなど、毎月・毎週発生する経費のテンプレート）
+type RecurringExpense struct {
+	id               *valueobject.RecurringExpenseID
+	userID           *valueobject.UserID
+	categoryID       *valueobject.CategoryID
+	amount           *valueobject.Money
+	title            string
+	description      string
+	schedule         *valueobject.RecurrenceSchedule
+	startDate        valueobject.Date // 最初の発生日の基準日（この日を含む）
+	endDate          valueobject.Date // 終了日（この日を含む、ゼロ値の場合は終了日なし）
+	paused           bool             // 一時停止中は経費を作成しない
+	processedThrough valueobject.Date // 経費の作成を処理済みの最後の発生日（ゼロ値の場合は未処理）
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+// NewRecurringExpense 新しいRecurringExpenseを作成
+func NewRecurringExpense(clk clock.Clock, userID *valueobject.UserID, categoryID *valueobject.CategoryID, amount *valueobject.Money, title, description string, schedule *valueobject.RecurrenceSchedule, startDate, endDate valueobject.Date) (*RecurringExpense, error) {
+	if userID == nil {
+		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
+	}
+
+	if err := validateRecurringExpense(categoryID, amount, title, description, schedule, startDate, endDate); err != nil {
+		return nil, err
+	}
+
+	now := clk.Now()
+	return &RecurringExpense{
+		id:          valueobject.GenerateRecurringExpenseID(),
+		userID:      userID,
+		categoryID:  categoryID,
+		amount:      amount,
+		title:       strings.TrimSpace(title),
+		description: strings.TrimSpace(description),
+		schedule:    schedule,
+		startDate:   startDate,
+		endDate:     endDate,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// ReconstructRecurringExpense 既存データからRecurringExpenseを再構築
+func ReconstructRecurringExpense(id *valueobject.RecurringExpenseID, userID *valueobject.UserID, categoryID *valueobject.CategoryID, amount *valueobject.Money, title, description string, schedule *valueobject.RecurrenceSchedule, startDate, endDate valueobject.Date, paused bool, processedThrough valueobject.Date, createdAt, updatedAt time.Time) (*RecurringExpense, error) {
+	if id == nil {
+		return nil, errors.NewDomainError(errors.InvalidRecurringExpenseID, "定期経費IDが必要です")
+	}
+
+	if userID == nil {
+		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
+	}
+
+	if err := validateRecurringExpense(categoryID, amount, title, description, schedule, startDate, endDate); err != nil {
+		return nil, err
+	}
+
+	return &RecurringExpense{
+		id:               id,
+		userID:           userID,
+		categoryID:       categoryID,
+		amount:           amount,
+		title:            title,
+		description:      description,
+		schedule:         schedule,
+		startDate:        startDate,
+		endDate:          endDate,
+		paused:           paused,
+		processedThrough: processedThrough,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}, nil
+}
+
+// ID IDを取得
+func (r *RecurringExpense) ID() *valueobject.RecurringExpenseID {
+	return r.id
+}
+
+// UserID ユーザーIDを取得
+func (r *RecurringExpense) UserID() *valueobject.UserID {
+	return r.userID
+}
+
+// CategoryID カテゴリIDを取得
+func (r *RecurringExpense) CategoryID() *valueobject.CategoryID {
+	return r.categoryID
+}
+
+// Amount 金額を取得
+func (r *RecurringExpense) Amount() *valueobject.Money {
+	return r.amount
+}
+
+// Title 件名を取得
+func (r *RecurringExpense) Title() string {
+	return r.title
+}
+
+// Description 説明を取得
+func (r *RecurringExpense) Description() string {
+	return r.description
+}
+
+// Schedule 発生日の規則を取得
+func (r *RecurringExpense) Schedule() *valueobject.RecurrenceSchedule {
+	return r.schedule
+}
+
+// StartDate 開始日を取得
+func (r *RecurringExpense) StartDate() valueobject.Date {
+	return r.startDate
+}
+
+// EndDate 終了日を取得（終了日なしの場合はゼロ値）
+func (r *RecurringExpense) EndDate() valueobject.Date {
+	return r.endDate
+}
+
+// IsPaused 一時停止中かどうか
+func (r *RecurringExpense) IsPaused() bool {
+	return r.paused
+}
+
+// ProcessedThrough 経費の作成を処理済みの最後の発生日を取得（未処理の場合はゼロ値）
+func (r *RecurringExpense) ProcessedThrough() valueobject.Date {
+	return r.processedThrough
+}
+
+// CreatedAt 作成日時を取得
+func (r *RecurringExpense) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt 更新日時を取得
+func (r *RecurringExpense) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// Update 定期経費を更新（処理済みの発生日は変わらないため、過去の発生日の経費は作成し直さない）
+func (r *RecurringExpense) Update(categoryID *valueobject.CategoryID, amount *valueobject.Money, title, description string, schedule *valueobject.RecurrenceSchedule, startDate, endDate valueobject.Date, now time.Time) error {
+	if err := validateRecurringExpense(categoryID, amount, title, description, schedule, startDate, endDate); err != nil {
+		return err
+	}
+
+	r.categoryID = categoryID
+	r.amount = amount
+	r.title = strings.TrimSpace(title)
+	r.description = strings.TrimSpace(description)
+	r.schedule = schedule
+	r.startDate = startDate
+	r.endDate = endDate
+	r.updatedAt = now
+
+	return nil
+}
+
+// ChangePaused 一時停止を変更（再開すると、停止中に到来した発生日の経費も作成する）
+func (r *RecurringExpense) ChangePaused(paused bool, now time.Time) {
+	r.paused = paused
+	r.updatedAt = now
+}
+
+// Copy 定期経費の複製を作成（処理済みの発生日を保存できた場合だけ反映するために使う）
+func (r *RecurringExpense) Copy() *RecurringExpense {
+	copied := *r
+	return &copied
+}
+
+// NextDate 未処理の最初の発生日を取得（終了日を過ぎた場合はゼロ値）
+func (r *RecurringExpense) NextDate() valueobject.Date {
+	after := r.startDate.AddDays(-1)
+	if r.processedThrough.After(after) {
+		after = r.processedThrough
+	}
+
+	next := r.schedule.Next(after)
+	if !r.endDate.IsZero() && next.After(r.endDate) {
+		return valueobject.Date{}
+	}
+	return next
+}
+
+// DueDates 今日（利用者のタイムゾーンの暦日）までに到来した未処理の発生日を古い順に取得（一時停止中は空）
+func (r *RecurringExpense) DueDates(today valueobject.Date) []valueobject.Date {
+	dates := make([]valueobject.Date, 0)
+	if r.paused {
+		return dates
+	}
+
+	for date := r.NextDate(); !date.IsZero() && !date.After(today); date = r.schedule.Next(date) {
+		if !r.endDate.IsZero() && date.After(r.endDate) {
+			break
+		}
+		dates = append(dates, date)
+	}
+
+	return dates
+}
+
+// MarkProcessed 発生日を処理済みにする（経費を作成しなかった場合も同じ発生日を再び処理しない）
+func (r *RecurringExpense) MarkProcessed(date valueobject.Date, now time.Time) {
+	if !date.After(r.processedThrough) {
+		return
+	}
+
+	r.processedThrough = date
+	r.updatedAt = now
+}
+
+// validateRecurringExpense 定期経費のバリデーション
+func validateRecurringExpense(categoryID *valueobject.CategoryID, amount *valueobject.Money, title, description string, schedule *valueobject.RecurrenceSchedule, startDate, endDate valueobject.Date) error {
+	if categoryID == nil {
+		return errors.NewDomainError(errors.InvalidCategoryID, "カテゴリIDが必要です")
+	}
+
+	if amount == nil || amount.Amount() <= 0 {
+		return errors.NewDomainError(errors.InvalidRecurringExpense, "定期経費の金額は0より大きい必要があります")
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return errors.NewDomainError(errors.InvalidRecurringExpense, "定期経費の件名は必須です")
+	}
+
+	if len(title) > 100 {
+		return errors.NewDomainError(errors.InvalidRecurringExpense, "定期経費の件名は100文字以内である必要があります")
+	}
+
+	if len(strings.TrimSpace(description)) > 500 {
+		return errors.NewDomainError(errors.InvalidRecurringExpense, "定期経費の説明は500文字以内である必要があります")
+	}
+
+	if schedule == nil {
+		return errors.NewDomainError(errors.InvalidRecurringExpense, "定期経費の発生日の規則が必要です")
+	}
+
+	if startDate.IsZero() {
+		return errors.NewDomainError(errors.InvalidRecurringExpense, "定期経費の開始日が必要です")
+	}
+
+	if !endDate.IsZero() && endDate.Before(startDate) {
+		return errors.NewDomainError(errors.InvalidRecurringExpense, "定期経費の終了日は開始日以降である必要があります")
+	}
+
+	return nil
+}
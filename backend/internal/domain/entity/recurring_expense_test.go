@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurringExpense(t *testing.T) {
+	date := func(value string) valueobject.Date {
+		d, err := valueobject.ParseDate(value)
+		require.NoError(t, err)
+		return d
+	}
+	amount, _ := valueobject.NewMoney(6800, "JPY")
+	newRecurring := func(schedule *valueobject.RecurrenceSchedule, start, end valueobject.Date) *RecurringExpense {
+		recurring, err := NewRecurringExpense(clock.System(), valueobject.GenerateUserID(), valueobject.GenerateCategoryID(), amount, "携帯電話手当", "", schedule, start, end)
+		require.NoError(t, err)
+		return recurring
+	}
+
+	t.Run("月末より後の日は毎月の末日に発生する", func(t *testing.T) {
+		schedule, err := valueobject.NewMonthlySchedule(31)
+		require.NoError(t, err)
+		recurring := newRecurring(schedule, date("2024-01-15"), date("2024-04-30"))
+
+		dates := recurring.DueDates(date("2024-12-31"))
+		require.Len(t, dates, 4)
+		assert.Equal(t, "2024-01-31", dates[0].String())
+		assert.Equal(t, "2024-02-29", dates[1].String())
+		assert.Equal(t, "2024-04-30", dates[3].String(), "終了日を含む")
+	})
+
+	t.Run("毎週の指定曜日に発生する", func(t *testing.T) {
+		schedule, err := valueobject.NewWeeklySchedule("mon")
+		require.NoError(t, err)
+		assert.Equal(t, "mon", schedule.Weekday())
+
+		// 2024-04-01は月曜日
+		recurring := newRecurring(schedule, date("2024-04-01"), valueobject.Date{})
+		dates := recurring.DueDates(date("2024-04-15"))
+		require.Len(t, dates, 3)
+		assert.Equal(t, "2024-04-08", dates[1].String())
+	})
+
+	t.Run("処理済みの発生日と一時停止中の定期経費は対象外", func(t *testing.T) {
+		schedule, _ := valueobject.NewMonthlySchedule(1)
+		recurring := newRecurring(schedule, date("2024-04-01"), valueobject.Date{})
+
+		recurring.MarkProcessed(date("2024-05-01"), time.Now())
+		recurring.MarkProcessed(date("2024-04-01"), time.Now())
+		assert.Equal(t, "2024-05-01", recurring.ProcessedThrough().String(), "処理済みの発生日は戻らない")
+		assert.Equal(t, "2024-06-01", recurring.NextDate().String())
+
+		recurring.ChangePaused(true, time.Now())
+		assert.Empty(t, recurring.DueDates(date("2024-08-01")))
+
+		recurring.ChangePaused(false, time.Now())
+		assert.Len(t, recurring.DueDates(date("2024-08-01")), 3)
+	})
+
+	t.Run("不正な定期経費はエラー", func(t *testing.T) {
+		_, err := valueobject.NewMonthlySchedule(32)
+		assert.Error(t, err)
+		_, err = valueobject.NewWeeklySchedule("monday")
+		assert.Error(t, err)
+
+		schedule, _ := valueobject.NewMonthlySchedule(1)
+		_, err = NewRecurringExpense(clock.System(), valueobject.GenerateUserID(), valueobject.GenerateCategoryID(), amount, " ", "", schedule, date("2024-04-01"), valueobject.Date{})
+		assert.Error(t, err, "件名なし")
+		_, err = NewRecurringExpense(clock.System(), valueobject.GenerateUserID(), valueobject.GenerateCategoryID(), amount, "定期券", "", schedule, date("2024-04-01"), date("2024-03-31"))
+		assert.Error(t, err, "終了日が開始日より前")
+	})
+}
@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+	"time"
+)
+
+// TransitRide 交通系ICカードの利用履歴から取り込んだ乗車記録エンティティ
+// 同じ履歴行を二重に取り込まないよう、履歴行のハッシュと作成した経費を記録する
+type TransitRide struct {
+	userID       *valueobject.UserID
+	hash         string // 履歴行の内容から求めた識別子
+	rideDate     time.Time
+	entryStation string
+	exitStation  string
+	fare         *valueobject.Money
+	expenseID    *valueobject.ExpenseID // 作成した経費
+	importedAt   time.Time
+}
+
+// NewTransitRide 新しいTransitRideを作成
+func NewTransitRide(clk clock.Clock, userID *valueobject.UserID, hash string, rideDate time.Time, entryStation, exitStation string, fare *valueobject.Money, expenseID *valueobject.ExpenseID) (*TransitRide, error) {
+	if err := validateTransitRide(userID, hash, rideDate, fare, expenseID); err != nil {
+		return nil, err
+	}
+
+	return &TransitRide{
+		userID:       userID,
+		hash:         strings.TrimSpace(hash),
+		rideDate:     rideDate,
+		entryStation: strings.TrimSpace(entryStation),
+		exitStation:  strings.TrimSpace(exitStation),
+		fare:         fare,
+		expenseID:    expenseID,
+		importedAt:   clk.Now(),
+	}, nil
+}
+
+// ReconstructTransitRide 既存データからTransitRideを再構築
+func ReconstructTransitRide(userID *valueobject.UserID, hash string, rideDate time.Time, entryStation, exitStation string, fare *valueobject.Money, expenseID *valueobject.ExpenseID, importedAt time.Time) (*TransitRide, error) {
+	if err := validateTransitRide(userID, hash, rideDate, fare, expenseID); err != nil {
+		return nil, err
+	}
+
+	return &TransitRide{
+		userID:       userID,
+		hash:         hash,
+		rideDate:     rideDate,
+		entryStation: entryStation,
+		exitStation:  exitStation,
+		fare:         fare,
+		expenseID:    expenseID,
+		importedAt:   importedAt,
+	}, nil
+}
+
+// UserID 利用者のユーザーIDを取得
+func (r *TransitRide) UserID() *valueobject.UserID {
+	return r.userID
+}
+
+// Hash 履歴行の識別子を取得
+func (r *TransitRide) Hash() string {
+	return r.hash
+}
+
+// RideDate 利用日を取得
+func (r *TransitRide) RideDate() time.Time {
+	return r.rideDate
+}
+
+// EntryStation 入場駅を取得
+func (r *TransitRide) EntryStation() string {
+	return r.entryStation
+}
+
+// ExitStation 出場駅を取得
+func (r *TransitRide) ExitStation() string {
+	return r.exitStation
+}
+
+// Fare 運賃を取得
+func (r *TransitRide) Fare() *valueobject.Money {
+	return r.fare
+}
+
+// ExpenseID 作成した経費のIDを取得
+func (r *TransitRide) ExpenseID() *valueobject.ExpenseID {
+	return r.expenseID
+}
+
+// ImportedAt 取込日時を取得
+func (r *TransitRide) ImportedAt() time.Time {
+	return r.importedAt
+}
+
+// validateTransitRide 乗車記録のバリデーション
+func validateTransitRide(userID *valueobject.UserID, hash string, rideDate time.Time, fare *valueobject.Money, expenseID *valueobject.ExpenseID) error {
+	if userID == nil {
+		return errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
+	}
+
+	if strings.TrimSpace(hash) == "" {
+		return errors.NewDomainError(errors.InvalidTransitRide, "履歴行の識別子は必須です")
+	}
+
+	if rideDate.IsZero() {
+		return errors.NewDomainError(errors.InvalidTransitRide, "利用日は必須です")
+	}
+
+	if fare == nil || fare.Amount() <= 0 {
+		return errors.NewDomainError(errors.InvalidTransitRide, "運賃は0より大きい必要があります")
+	}
+
+	if expenseID == nil {
+		return errors.NewDomainError(errors.InvalidTransitRide, "作成した経費のIDが必要です")
+	}
+
+	return nil
+}
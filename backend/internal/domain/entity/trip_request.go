@@ -0,0 +1,257 @@
+package entity
+
+import (
+	"expense-management-system/internal/domain/clock"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"strings"
+	"time"
+)
+
+// TripRequestStatus 出張申請の状態
+type TripRequestStatus string
+
+const (
+	TripRequestStatusDraft     TripRequestStatus = "draft"     // 下書き
+	TripRequestStatusSubmitted TripRequestStatus = "submitted" // 申請済み
+	TripRequestStatusApproved  TripRequestStatus = "approved"  // 承認済み
+	TripRequestStatusRejected  TripRequestStatus = "rejected"  // 却下
+)
+
+// TripRequest 出張の事前申請エンティティ
+type TripRequest struct {
+	id            *valueobject.TripRequestID
+	userID        *valueobject.UserID
+	destination   string
+	startDate     valueobject.Date // 出発日
+	endDate       valueobject.Date // 帰着日（この日を含む）
+	purpose       string
+	estimatedCost *valueobject.Money
+	status        TripRequestStatus
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// NewTripRequest 新しいTripRequestを作成
+func NewTripRequest(clk clock.Clock, userID *valueobject.UserID, destination string, startDate, endDate valueobject.Date, purpose string, estimatedCost *valueobject.Money) (*TripRequest, error) {
+	if userID == nil {
+		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
+	}
+
+	if err := validateTripRequestDetails(destination, startDate, endDate, purpose, estimatedCost); err != nil {
+		return nil, err
+	}
+
+	now := clk.Now()
+	return &TripRequest{
+		id:            valueobject.GenerateTripRequestID(),
+		userID:        userID,
+		destination:   strings.TrimSpace(destination),
+		startDate:     startDate,
+		endDate:       endDate,
+		purpose:       strings.TrimSpace(purpose),
+		estimatedCost: estimatedCost,
+		status:        TripRequestStatusDraft,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+// ReconstructTripRequest 既存データからTripRequestを再構築
+func ReconstructTripRequest(
+	id *valueobject.TripRequestID,
+	userID *valueobject.UserID,
+	destination string,
+	startDate, endDate valueobject.Date,
+	purpose string,
+	estimatedCost *valueobject.Money,
+	status TripRequestStatus,
+	createdAt, updatedAt time.Time,
+) (*TripRequest, error) {
+	if id == nil {
+		return nil, errors.NewDomainError(errors.InvalidTripRequestID, "出張申請IDが必要です")
+	}
+
+	if userID == nil {
+		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
+	}
+
+	if err := validateTripRequestDetails(destination, startDate, endDate, purpose, estimatedCost); err != nil {
+		return nil, err
+	}
+
+	switch status {
+	case TripRequestStatusDraft, TripRequestStatusSubmitted, TripRequestStatusApproved, TripRequestStatusRejected:
+	default:
+		return nil, errors.NewDomainError("INVALID_TRIP_REQUEST_STATUS", "無効な出張申請ステータスです")
+	}
+
+	return &TripRequest{
+		id:            id,
+		userID:        userID,
+		destination:   destination,
+		startDate:     startDate,
+		endDate:       endDate,
+		purpose:       purpose,
+		estimatedCost: estimatedCost,
+		status:        status,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}, nil
+}
+
+// ID IDを取得
+func (t *TripRequest) ID() *valueobject.TripRequestID {
+	return t.id
+}
+
+// UserID 申請者のユーザーIDを取得
+func (t *TripRequest) UserID() *valueobject.UserID {
+	return t.userID
+}
+
+// Destination 出張先を取得
+func (t *TripRequest) Destination() string {
+	return t.destination
+}
+
+// StartDate 出発日を取得
+func (t *TripRequest) StartDate() valueobject.Date {
+	return t.startDate
+}
+
+// EndDate 帰着日を取得
+func (t *TripRequest) EndDate() valueobject.Date {
+	return t.endDate
+}
+
+// Purpose 目的を取得
+func (t *TripRequest) Purpose() string {
+	return t.purpose
+}
+
+// EstimatedCost 概算費用を取得
+func (t *TripRequest) EstimatedCost() *valueobject.Money {
+	return t.estimatedCost
+}
+
+// Status ステータスを取得
+func (t *TripRequest) Status() TripRequestStatus {
+	return t.status
+}
+
+// CreatedAt 作成日時を取得
+func (t *TripRequest) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt 更新日時を取得
+func (t *TripRequest) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// UpdateDetails 出張申請の内容を更新
+func (t *TripRequest) UpdateDetails(destination string, startDate, endDate valueobject.Date, purpose string, estimatedCost *valueobject.Money, now time.Time) error {
+	if t.status != TripRequestStatusDraft {
+		return errors.NewDomainError("TRIP_REQUEST_UPDATE_NOT_ALLOWED", "下書き状態の出張申請のみ更新できます")
+	}
+
+	if err := validateTripRequestDetails(destination, startDate, endDate, purpose, estimatedCost); err != nil {
+		return err
+	}
+
+	t.destination = strings.TrimSpace(destination)
+	t.startDate = startDate
+	t.endDate = endDate
+	t.purpose = strings.TrimSpace(purpose)
+	t.estimatedCost = estimatedCost
+	t.updatedAt = now
+
+	return nil
+}
+
+// Submit 出張申請を申請
+func (t *TripRequest) Submit(now time.Time) error {
+	if t.status != TripRequestStatusDraft {
+		return errors.NewDomainError("TRIP_REQUEST_SUBMIT_NOT_ALLOWED", "下書き状態の出張申請のみ申請できます")
+	}
+
+	t.status = TripRequestStatusSubmitted
+	t.updatedAt = now
+
+	return nil
+}
+
+// Approve 出張申請を承認
+func (t *TripRequest) Approve(now time.Time) error {
+	if t.status != TripRequestStatusSubmitted {
+		return errors.NewDomainError("TRIP_REQUEST_APPROVE_NOT_ALLOWED", "申請済み状態の出張申請のみ承認できます")
+	}
+
+	t.status = TripRequestStatusApproved
+	t.updatedAt = now
+
+	return nil
+}
+
+// Reject 出張申請を却下
+func (t *TripRequest) Reject(now time.Time) error {
+	if t.status != TripRequestStatusSubmitted {
+		return errors.NewDomainError("TRIP_REQUEST_REJECT_NOT_ALLOWED", "申請済み状態の出張申請のみ却下できます")
+	}
+
+	t.status = TripRequestStatusRejected
+	t.updatedAt = now
+
+	return nil
+}
+
+// IsApproved 承認済みかどうか（経費から参照できるのは承認済みの出張申請のみ）
+func (t *TripRequest) IsApproved() bool {
+	return t.status == TripRequestStatusApproved
+}
+
+// CanDelete 削除可能かどうか
+func (t *TripRequest) CanDelete() bool {
+	return t.status == TripRequestStatusDraft || t.status == TripRequestStatusRejected
+}
+
+// Days 出張日数を取得（出発日と帰着日を含む）
+func (t *TripRequest) Days() int {
+	return t.endDate.DaysSince(t.startDate) + 1
+}
+
+// validateTripRequestDetails 出張申請の内容のバリデーション
+func validateTripRequestDetails(destination string, startDate, endDate valueobject.Date, purpose string, estimatedCost *valueobject.Money) error {
+	destination = strings.TrimSpace(destination)
+	if destination == "" {
+		return errors.NewDomainError("INVALID_TRIP_DESTINATION", "出張先は必須です")
+	}
+
+	if len(destination) > 100 {
+		return errors.NewDomainError("INVALID_TRIP_DESTINATION", "出張先は100文字以内である必要があります")
+	}
+
+	if startDate.IsZero() || endDate.IsZero() {
+		return errors.NewDomainError("INVALID_TRIP_DATES", "出発日と帰着日が必要です")
+	}
+
+	if endDate.Before(startDate) {
+		return errors.NewDomainError("INVALID_TRIP_DATES", "帰着日は出発日以降である必要があります")
+	}
+
+	purpose = strings.TrimSpace(purpose)
+	if purpose == "" {
+		return errors.NewDomainError("INVALID_TRIP_PURPOSE", "出張目的は必須です")
+	}
+
+	if len(purpose) > 500 {
+		return errors.NewDomainError("INVALID_TRIP_PURPOSE", "出張目的は500文字以内である必要があります")
+	}
+
+	if estimatedCost == nil {
+		return errors.NewDomainError(errors.InvalidExpenseAmount, "概算費用が必要です")
+	}
+
+	return nil
+}
@@ -1,23 +1,45 @@
 package entity
 
 import (
+	"expense-management-system/internal/domain/clock"
 	"expense-management-system/internal/domain/valueobject"
 	"expense-management-system/pkg/errors"
 	"strings"
 	"time"
+
+	// タイムゾーンのデータベースがない環境でもタイムゾーンを読み込めるように埋め込む
+	_ "time/tzdata"
+)
+
+// UserRole ユーザーの権限
+type UserRole string
+
+const (
+	UserRoleMember  UserRole = "member"  // 一般の従業員
+	UserRoleFinance UserRole = "finance" // 経理担当者（会計期間の締め）
+	UserRoleAdmin   UserRole = "admin"   // 管理者（締めた会計期間の再開）
 )
 
+// DefaultUserTimezone タイムゾーンを指定しない場合のユーザーのタイムゾーン
+const DefaultUserTimezone = "Asia/Tokyo"
+
 // User ユーザーエンティティ
 type User struct {
-	id        *valueobject.UserID
-	name      string
-	email     string
-	createdAt time.Time
-	updatedAt time.Time
+	id           *valueobject.UserID
+	name         string
+	email        string
+	managerID    *valueobject.UserID
+	grade        string
+	departmentID *valueobject.DepartmentID // 所属部署（予算の集計などに使用）
+	costCenterID *valueobject.CostCenterID // 按分を指定しない経費の既定のコストセンター
+	role         UserRole
+	location     *time.Location // 経費日付の検証などに使うタイムゾーン
+	createdAt    time.Time
+	updatedAt    time.Time
 }
 
 // NewUser 新しいUserを作成
-func NewUser(name, email string) (*User, error) {
+func NewUser(clk clock.Clock, name, email string) (*User, error) {
 	if err := validateUserName(name); err != nil {
 		return nil, err
 	}
@@ -26,18 +48,25 @@ func NewUser(name, email string) (*User, error) {
 		return nil, err
 	}
 
-	now := time.Now()
+	location, err := loadUserTimezone(DefaultUserTimezone)
+	if err != nil {
+		return nil, err
+	}
+
+	now := clk.Now()
 	return &User{
 		id:        valueobject.GenerateUserID(),
 		name:      strings.TrimSpace(name),
 		email:     strings.TrimSpace(email),
+		role:      UserRoleMember,
+		location:  location,
 		createdAt: now,
 		updatedAt: now,
 	}, nil
 }
 
 // ReconstructUser 既存データからUserを再構築
-func ReconstructUser(id *valueobject.UserID, name, email string, createdAt, updatedAt time.Time) (*User, error) {
+func ReconstructUser(id *valueobject.UserID, name, email string, managerID *valueobject.UserID, grade string, departmentID *valueobject.DepartmentID, costCenterID *valueobject.CostCenterID, role UserRole, timezone string, createdAt, updatedAt time.Time) (*User, error) {
 	if id == nil {
 		return nil, errors.NewDomainError(errors.InvalidUserID, "ユーザーIDが必要です")
 	}
@@ -50,12 +79,31 @@ func ReconstructUser(id *valueobject.UserID, name, email string, createdAt, upda
 		return nil, err
 	}
 
+	if err := validateUserGrade(grade); err != nil {
+		return nil, err
+	}
+
+	if err := validateUserRole(role); err != nil {
+		return nil, err
+	}
+
+	location, err := loadUserTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+
 	return &User{
-		id:        id,
-		name:      name,
-		email:     email,
-		createdAt: createdAt,
-		updatedAt: updatedAt,
+		id:           id,
+		name:         name,
+		email:        email,
+		managerID:    managerID,
+		grade:        grade,
+		departmentID: departmentID,
+		costCenterID: costCenterID,
+		role:         role,
+		location:     location,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
 	}, nil
 }
 
@@ -74,6 +122,51 @@ func (u *User) Email() string {
 	return u.email
 }
 
+// ManagerID 上長のユーザーIDを取得（未設定の場合はnil）
+func (u *User) ManagerID() *valueobject.UserID {
+	return u.managerID
+}
+
+// Grade 職能等級を取得（未設定の場合は空文字）
+func (u *User) Grade() string {
+	return u.grade
+}
+
+// DepartmentID 所属部署のIDを取得（未設定の場合はnil）
+func (u *User) DepartmentID() *valueobject.DepartmentID {
+	return u.departmentID
+}
+
+// CostCenterID 既定のコストセンターのIDを取得（未設定の場合はnil）
+func (u *User) CostCenterID() *valueobject.CostCenterID {
+	return u.costCenterID
+}
+
+// Role 権限を取得
+func (u *User) Role() UserRole {
+	return u.role
+}
+
+// IsAdmin 管理者かどうか
+func (u *User) IsAdmin() bool {
+	return u.role == UserRoleAdmin
+}
+
+// CanCloseFiscalPeriod 会計期間を締められるかどうか（経理担当者・管理者）
+func (u *User) CanCloseFiscalPeriod() bool {
+	return u.role == UserRoleFinance || u.role == UserRoleAdmin
+}
+
+// Timezone タイムゾーン名を取得（例: Asia/Tokyo）
+func (u *User) Timezone() string {
+	return u.location.String()
+}
+
+// Location タイムゾーンを取得
+func (u *User) Location() *time.Location {
+	return u.location
+}
+
 // CreatedAt 作成日時を取得
 func (u *User) CreatedAt() time.Time {
 	return u.createdAt
@@ -85,7 +178,7 @@ func (u *User) UpdatedAt() time.Time {
 }
 
 // UpdateProfile プロフィールを更新
-func (u *User) UpdateProfile(name, email string) error {
+func (u *User) UpdateProfile(name, email string, now time.Time) error {
 	if err := validateUserName(name); err != nil {
 		return err
 	}
@@ -96,7 +189,72 @@ func (u *User) UpdateProfile(name, email string) error {
 
 	u.name = strings.TrimSpace(name)
 	u.email = strings.TrimSpace(email)
-	u.updatedAt = time.Now()
+	u.updatedAt = now
+
+	return nil
+}
+
+// AssignManager 上長を設定（nilの場合は解除）
+func (u *User) AssignManager(managerID *valueobject.UserID, now time.Time) error {
+	if managerID != nil && managerID.Equals(u.id) {
+		return errors.NewDomainError(errors.InvalidManager, "自分自身を上長に設定することはできません")
+	}
+
+	u.managerID = managerID
+	u.updatedAt = now
+
+	return nil
+}
+
+// ChangeGrade 職能等級を変更（空文字の場合は解除）
+func (u *User) ChangeGrade(grade string, now time.Time) error {
+	if err := validateUserGrade(grade); err != nil {
+		return err
+	}
+
+	u.grade = strings.TrimSpace(grade)
+	u.updatedAt = now
+
+	return nil
+}
+
+// ChangeDepartment 所属部署を変更（nilの場合は解除）
+func (u *User) ChangeDepartment(departmentID *valueobject.DepartmentID, now time.Time) {
+	u.departmentID = departmentID
+	u.updatedAt = now
+}
+
+// AssignCostCenter 既定のコストセンターを設定（nilの場合は解除）
+func (u *User) AssignCostCenter(costCenterID *valueobject.CostCenterID, now time.Time) {
+	u.costCenterID = costCenterID
+	u.updatedAt = now
+}
+
+// ChangeRole 権限を変更（空文字の場合は一般の従業員）
+func (u *User) ChangeRole(role UserRole, now time.Time) error {
+	if role == "" {
+		role = UserRoleMember
+	}
+
+	if err := validateUserRole(role); err != nil {
+		return err
+	}
+
+	u.role = role
+	u.updatedAt = now
+
+	return nil
+}
+
+// ChangeTimezone タイムゾーンを変更（空文字の場合は既定のタイムゾーン）
+func (u *User) ChangeTimezone(timezone string, now time.Time) error {
+	location, err := loadUserTimezone(timezone)
+	if err != nil {
+		return err
+	}
+
+	u.location = location
+	u.updatedAt = now
 
 	return nil
 }
@@ -133,3 +291,37 @@ func validateUserEmail(email string) error {
 
 	return nil
 }
+
+// validateUserGrade 職能等級のバリデーション
+func validateUserGrade(grade string) error {
+	if len(strings.TrimSpace(grade)) > 50 {
+		return errors.NewDomainError(errors.InvalidUserGrade, "職能等級は50文字以内である必要があります")
+	}
+
+	return nil
+}
+
+// validateUserRole 権限のバリデーション
+func validateUserRole(role UserRole) error {
+	switch role {
+	case UserRoleMember, UserRoleFinance, UserRoleAdmin:
+		return nil
+	default:
+		return errors.NewDomainError(errors.InvalidUserRole, "無効な権限です: "+string(role))
+	}
+}
+
+// loadUserTimezone IANAのタイムゾーン名からタイムゾーンを取得（空文字の場合は既定のタイムゾーン）
+func loadUserTimezone(timezone string) (*time.Location, error) {
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		timezone = DefaultUserTimezone
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.NewDomainError(errors.InvalidUserTimezone, "無効なタイムゾーンです: "+timezone)
+	}
+
+	return location, nil
+}
@@ -0,0 +1,22 @@
+// Package event ドメインイベントを定義
+package event
+
+import (
+	"context"
+	"time"
+)
+
+// Event ドメインイベント
+type Event interface {
+	// Name イベント名
+	Name() string
+
+	// OccurredAt 発生日時
+	OccurredAt() time.Time
+}
+
+// Publisher ドメインイベントの発行インターフェース
+type Publisher interface {
+	// Publish イベントを発行
+	Publish(ctx context.Context, e Event) error
+}
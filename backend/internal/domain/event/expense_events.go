@@ -0,0 +1,64 @@
+package event
+
+import "time"
+
+// イベント名
+const (
+	ExpenseEscalatedEvent = "expense.escalated"
+	ExpenseApprovedEvent  = "expense.approved"
+	ExpensePaidEvent      = "expense.paid"
+)
+
+// ExpenseEscalated 承認待ちの経費が上位の承認者にエスカレーションされた
+type ExpenseEscalated struct {
+	ExpenseID      string
+	UserID         string
+	FromApproverID string // 未割り当てだった場合は空文字
+	ToApproverID   string
+	Level          int
+	At             time.Time
+}
+
+// Name イベント名
+func (e ExpenseEscalated) Name() string {
+	return ExpenseEscalatedEvent
+}
+
+// OccurredAt 発生日時
+func (e ExpenseEscalated) OccurredAt() time.Time {
+	return e.At
+}
+
+// ExpenseApproved 経費が承認された
+type ExpenseApproved struct {
+	ExpenseID string
+	UserID    string
+	At        time.Time
+}
+
+// Name イベント名
+func (e ExpenseApproved) Name() string {
+	return ExpenseApprovedEvent
+}
+
+// OccurredAt 発生日時
+func (e ExpenseApproved) OccurredAt() time.Time {
+	return e.At
+}
+
+// ExpensePaid 承認済みの経費が申請者に支払われた
+type ExpensePaid struct {
+	ExpenseID string
+	UserID    string
+	At        time.Time // 支払日時
+}
+
+// Name イベント名
+func (e ExpensePaid) Name() string {
+	return ExpensePaidEvent
+}
+
+// OccurredAt 発生日時
+func (e ExpensePaid) OccurredAt() time.Time {
+	return e.At
+}
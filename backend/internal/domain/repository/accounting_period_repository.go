@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// AccountingPeriodRepository 会計期間の締めの状態のリポジトリインターフェース
+// 一度も締めていない会計期間は保存されていない
+type AccountingPeriodRepository interface {
+	// Save 会計期間の締めの状態を保存（同じ会計期間は上書き）
+	Save(ctx context.Context, period *entity.AccountingPeriod) error
+
+	// FindByPeriod 会計期間で締めの状態を検索
+	FindByPeriod(ctx context.Context, period valueobject.FiscalPeriod) (*entity.AccountingPeriod, error)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// AdvanceRepository 仮払金リポジトリインターフェース
+type AdvanceRepository interface {
+	// Save 仮払金を保存
+	Save(ctx context.Context, advance *entity.Advance) error
+
+	// FindByID IDで仮払金を検索
+	FindByID(ctx context.Context, id *valueobject.AdvanceID) (*entity.Advance, error)
+
+	// FindByUserID ユーザーIDで仮払金を検索
+	FindByUserID(ctx context.Context, userID *valueobject.UserID) ([]*entity.Advance, error)
+
+	// FindByExpenseID 経費を精算対象に含む仮払金を検索
+	FindByExpenseID(ctx context.Context, expenseID *valueobject.ExpenseID) (*entity.Advance, error)
+
+	// Update 仮払金を更新
+	Update(ctx context.Context, advance *entity.Advance) error
+
+	// Delete 仮払金を削除
+	Delete(ctx context.Context, id *valueobject.AdvanceID) error
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// BudgetRepository 予算リポジトリインターフェース
+type BudgetRepository interface {
+	// Save 予算を保存
+	Save(ctx context.Context, budget *entity.Budget) error
+
+	// FindByID IDで予算を検索
+	FindByID(ctx context.Context, id *valueobject.BudgetID) (*entity.Budget, error)
+
+	// FindByDate 期間に日付を含む予算を検索
+	FindByDate(ctx context.Context, date valueobject.Date) ([]*entity.Budget, error)
+
+	// FindAll 全ての予算を取得
+	FindAll(ctx context.Context) ([]*entity.Budget, error)
+
+	// Update 予算を更新
+	Update(ctx context.Context, budget *entity.Budget) error
+
+	// Delete 予算を削除
+	Delete(ctx context.Context, id *valueobject.BudgetID) error
+}
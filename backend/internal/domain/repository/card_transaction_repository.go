@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// CardTransactionRepository カード利用明細リポジトリインターフェース
+type CardTransactionRepository interface {
+	// Save カード利用明細を保存
+	Save(ctx context.Context, transaction *entity.CardTransaction) error
+
+	// FindByID IDでカード利用明細を検索
+	FindByID(ctx context.Context, id *valueobject.CardTransactionID) (*entity.CardTransaction, error)
+
+	// FindByUserID ユーザーIDでカード利用明細を検索
+	FindByUserID(ctx context.Context, userID *valueobject.UserID) ([]*entity.CardTransaction, error)
+
+	// FindByUserIDAndStatus ユーザーIDと照合状態でカード利用明細を検索
+	FindByUserIDAndStatus(ctx context.Context, userID *valueobject.UserID, status entity.CardTransactionStatus) ([]*entity.CardTransaction, error)
+
+	// FindByExternalID ユーザーIDと明細の識別子でカード利用明細を検索
+	FindByExternalID(ctx context.Context, userID *valueobject.UserID, externalID string) (*entity.CardTransaction, error)
+
+	// FindByExpenseID 経費と照合されたカード利用明細を検索
+	FindByExpenseID(ctx context.Context, expenseID *valueobject.ExpenseID) (*entity.CardTransaction, error)
+
+	// Update カード利用明細を更新
+	Update(ctx context.Context, transaction *entity.CardTransaction) error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// CostCenterRepository コストセンターリポジトリインターフェース
+type CostCenterRepository interface {
+	// Save コストセンターを保存
+	Save(ctx context.Context, costCenter *entity.CostCenter) error
+
+	// FindByID IDでコストセンターを検索
+	FindByID(ctx context.Context, id *valueobject.CostCenterID) (*entity.CostCenter, error)
+
+	// FindByCode コストセンターコードでコストセンターを検索
+	FindByCode(ctx context.Context, code string) (*entity.CostCenter, error)
+
+	// FindByDepartmentID 部署に所属するコストセンターを検索
+	FindByDepartmentID(ctx context.Context, departmentID *valueobject.DepartmentID) ([]*entity.CostCenter, error)
+
+	// FindAll 全てのコストセンターを取得
+	FindAll(ctx context.Context) ([]*entity.CostCenter, error)
+
+	// Update コストセンターを更新
+	Update(ctx context.Context, costCenter *entity.CostCenter) error
+
+	// Delete コストセンターを削除
+	Delete(ctx context.Context, id *valueobject.CostCenterID) error
+}
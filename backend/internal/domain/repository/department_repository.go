@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// DepartmentRepository 部署リポジトリインターフェース
+type DepartmentRepository interface {
+	// Save 部署を保存
+	Save(ctx context.Context, department *entity.Department) error
+
+	// FindByID IDで部署を検索
+	FindByID(ctx context.Context, id *valueobject.DepartmentID) (*entity.Department, error)
+
+	// FindByCode 部署コードで部署を検索
+	FindByCode(ctx context.Context, code string) (*entity.Department, error)
+
+	// FindAll 全ての部署を取得
+	FindAll(ctx context.Context) ([]*entity.Department, error)
+
+	// Update 部署を更新
+	Update(ctx context.Context, department *entity.Department) error
+
+	// Delete 部署を削除
+	Delete(ctx context.Context, id *valueobject.DepartmentID) error
+}
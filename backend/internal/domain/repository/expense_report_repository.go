@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// ExpenseReportRepository 経費レポートリポジトリインターフェース
+type ExpenseReportRepository interface {
+	// Save 経費レポートを保存
+	Save(ctx context.Context, report *entity.ExpenseReport) error
+
+	// FindByID IDで経費レポートを検索
+	FindByID(ctx context.Context, id *valueobject.ExpenseReportID) (*entity.ExpenseReport, error)
+
+	// FindByOwnerID 所有者のユーザーIDで経費レポートを検索
+	FindByOwnerID(ctx context.Context, ownerID *valueobject.UserID) ([]*entity.ExpenseReport, error)
+
+	// FindByExpenseID 経費を含む経費レポートを検索
+	FindByExpenseID(ctx context.Context, expenseID *valueobject.ExpenseID) (*entity.ExpenseReport, error)
+
+	// Update 経費レポートを更新
+	Update(ctx context.Context, report *entity.ExpenseReport) error
+
+	// Delete 経費レポートを削除
+	Delete(ctx context.Context, id *valueobject.ExpenseReportID) error
+}
@@ -4,14 +4,27 @@ import (
 	"context"
 	"expense-management-system/internal/domain/entity"
 	"expense-management-system/internal/domain/valueobject"
-	"time"
 )
 
+// ExpenseFilter 経費の検索条件（指定しない項目は条件にしない）
+type ExpenseFilter struct {
+	UserID     *valueobject.UserID
+	CategoryID *valueobject.CategoryID // 明細のカテゴリを含む
+	Status     entity.ExpenseStatus
+	DateFrom   valueobject.Date // この日以降
+	DateTo     valueobject.Date // この日まで（この日を含む）
+
+	ReceiptHash string // 領収書ファイルのハッシュ
+}
+
 // ExpenseRepository 経費リポジトリインターフェース
 type ExpenseRepository interface {
 	// Save 経費を保存
 	Save(ctx context.Context, expense *entity.Expense) error
 
+	// SaveAll 複数の経費をまとめて保存（1件でも保存できない場合はいずれも保存しない）
+	SaveAll(ctx context.Context, expenses []*entity.Expense) error
+
 	// FindByID IDで経費を検索
 	FindByID(ctx context.Context, id *valueobject.ExpenseID) (*entity.Expense, error)
 
@@ -21,11 +34,20 @@ type ExpenseRepository interface {
 	// FindByUserIDAndStatus ユーザーIDとステータスで経費を検索
 	FindByUserIDAndStatus(ctx context.Context, userID *valueobject.UserID, status entity.ExpenseStatus) ([]*entity.Expense, error)
 
-	// FindByCategoryID カテゴリIDで経費を検索
+	// FindByStatus ステータスで経費を検索
+	FindByStatus(ctx context.Context, status entity.ExpenseStatus) ([]*entity.Expense, error)
+
+	// FindByCategoryID カテゴリIDで経費を検索（明細のカテゴリを含む）
 	FindByCategoryID(ctx context.Context, categoryID *valueobject.CategoryID) ([]*entity.Expense, error)
 
-	// FindByDateRange 日付範囲で経費を検索
-	FindByDateRange(ctx context.Context, userID *valueobject.UserID, from, to time.Time) ([]*entity.Expense, error)
+	// FindByTripRequestID 出張申請IDで経費を検索
+	FindByTripRequestID(ctx context.Context, tripRequestID *valueobject.TripRequestID) ([]*entity.Expense, error)
+
+	// FindByDateRange 経費日付が期間内（両端を含む）の経費を検索
+	FindByDateRange(ctx context.Context, userID *valueobject.UserID, from, to valueobject.Date) ([]*entity.Expense, error)
+
+	// Iterate 条件に一致する経費を日付の順に1件ずつ処理する（fnがエラーを返した場合は中断）
+	Iterate(ctx context.Context, filter ExpenseFilter, fn func(expense *entity.Expense) error) error
 
 	// FindAll 全ての経費を取得
 	FindAll(ctx context.Context) ([]*entity.Expense, error)
@@ -33,6 +55,9 @@ type ExpenseRepository interface {
 	// Update 経費を更新
 	Update(ctx context.Context, expense *entity.Expense) error
 
+	// UpdateAll 複数の経費をまとめて更新（1件でも存在しない場合はいずれも更新しない）
+	UpdateAll(ctx context.Context, expenses []*entity.Expense) error
+
 	// Delete 経費を削除
 	Delete(ctx context.Context, id *valueobject.ExpenseID) error
 
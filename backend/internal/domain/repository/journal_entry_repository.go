@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// JournalEntryRepository 仕訳リポジトリインターフェース
+type JournalEntryRepository interface {
+	// Save 仕訳を保存
+	Save(ctx context.Context, entry *entity.JournalEntry) error
+
+	// FindByID IDで仕訳を検索
+	FindByID(ctx context.Context, id *valueobject.JournalEntryID) (*entity.JournalEntry, error)
+
+	// FindBySource 発生元で仕訳を検索
+	FindBySource(ctx context.Context, source entity.JournalEntrySource, sourceID string) (*entity.JournalEntry, error)
+
+	// FindByDateRange 計上日が期間内（両端を含む）の仕訳を計上日の順に検索
+	FindByDateRange(ctx context.Context, from, to valueobject.Date) ([]*entity.JournalEntry, error)
+}
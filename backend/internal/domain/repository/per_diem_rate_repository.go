@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+)
+
+// PerDiemRateRepository 日当単価リポジトリインターフェース
+type PerDiemRateRepository interface {
+	// Save 日当単価を保存（同じ地域区分と職能等級の単価は上書き）
+	Save(ctx context.Context, rate *entity.PerDiemRate) error
+
+	// FindByClassAndGrade 地域区分と職能等級で日当単価を検索
+	FindByClassAndGrade(ctx context.Context, destinationClass, grade string) (*entity.PerDiemRate, error)
+
+	// FindAll 全ての日当単価を取得
+	FindAll(ctx context.Context) ([]*entity.PerDiemRate, error)
+
+	// Delete 日当単価を削除
+	Delete(ctx context.Context, destinationClass, grade string) error
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// ProjectRepository プロジェクトリポジトリインターフェース
+type ProjectRepository interface {
+	// Save プロジェクトを保存
+	Save(ctx context.Context, project *entity.Project) error
+
+	// FindByID IDでプロジェクトを検索
+	FindByID(ctx context.Context, id *valueobject.ProjectID) (*entity.Project, error)
+
+	// FindByCode プロジェクトコードでプロジェクトを検索
+	FindByCode(ctx context.Context, code string) (*entity.Project, error)
+
+	// FindAll 全てのプロジェクトを取得
+	FindAll(ctx context.Context) ([]*entity.Project, error)
+
+	// Update プロジェクトを更新
+	Update(ctx context.Context, project *entity.Project) error
+
+	// Delete プロジェクトを削除
+	Delete(ctx context.Context, id *valueobject.ProjectID) error
+}
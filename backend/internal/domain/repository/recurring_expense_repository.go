@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// RecurringExpenseRepository 定期経費リポジトリインターフェース
+type RecurringExpenseRepository interface {
+	// Save 定期経費を保存
+	Save(ctx context.Context, recurring *entity.RecurringExpense) error
+
+	// FindByID IDで定期経費を検索
+	FindByID(ctx context.Context, id *valueobject.RecurringExpenseID) (*entity.RecurringExpense, error)
+
+	// FindByUserID ユーザーIDで定期経費を検索
+	FindByUserID(ctx context.Context, userID *valueobject.UserID) ([]*entity.RecurringExpense, error)
+
+	// FindAll 全ての定期経費を取得
+	FindAll(ctx context.Context) ([]*entity.RecurringExpense, error)
+
+	// Update 定期経費を更新
+	Update(ctx context.Context, recurring *entity.RecurringExpense) error
+
+	// Delete 定期経費を削除
+	Delete(ctx context.Context, id *valueobject.RecurringExpenseID) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// TransitRideRepository 交通系ICカードの乗車記録リポジトリインターフェース
+type TransitRideRepository interface {
+	// Save 乗車記録を保存
+	Save(ctx context.Context, ride *entity.TransitRide) error
+
+	// FindByHash ユーザーIDと履歴行の識別子で乗車記録を検索
+	FindByHash(ctx context.Context, userID *valueobject.UserID, hash string) (*entity.TransitRide, error)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+)
+
+// TripRequestRepository 出張申請リポジトリインターフェース
+type TripRequestRepository interface {
+	// Save 出張申請を保存
+	Save(ctx context.Context, tripRequest *entity.TripRequest) error
+
+	// FindByID IDで出張申請を検索
+	FindByID(ctx context.Context, id *valueobject.TripRequestID) (*entity.TripRequest, error)
+
+	// FindByUserID ユーザーIDで出張申請を検索
+	FindByUserID(ctx context.Context, userID *valueobject.UserID) ([]*entity.TripRequest, error)
+
+	// Update 出張申請を更新
+	Update(ctx context.Context, tripRequest *entity.TripRequest) error
+
+	// Delete 出張申請を削除
+	Delete(ctx context.Context, id *valueobject.TripRequestID) error
+}
@@ -0,0 +1,61 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// AccountMapping 会計ソフトへ仕訳を出力するときの対応（借方勘定科目・税区分・部門）を表すValue Object
+type AccountMapping struct {
+	debitAccount string
+	taxCode      TaxCode
+	department   string
+}
+
+// NewAccountMapping 新しいAccountMappingを作成（税区分を省略した場合は課税仕入10%）
+func NewAccountMapping(debitAccount, taxCode, department string) (*AccountMapping, error) {
+	debitAccount = strings.TrimSpace(debitAccount)
+	department = strings.TrimSpace(department)
+
+	if debitAccount == "" {
+		return nil, errors.NewDomainError(errors.InvalidAccountMapping, "借方勘定科目は必須です")
+	}
+
+	if utf8.RuneCountInString(debitAccount) > 50 {
+		return nil, errors.NewDomainError(errors.InvalidAccountMapping, "借方勘定科目は50文字以内である必要があります")
+	}
+
+	if utf8.RuneCountInString(department) > 50 {
+		return nil, errors.NewDomainError(errors.InvalidAccountMapping, "部門は50文字以内である必要があります")
+	}
+
+	code := TaxCodeStandard
+	if taxCode != "" {
+		var err error
+		if code, err = NewTaxCode(taxCode); err != nil {
+			return nil, err
+		}
+	}
+
+	return &AccountMapping{
+		debitAccount: debitAccount,
+		taxCode:      code,
+		department:   department,
+	}, nil
+}
+
+// DebitAccount 借方勘定科目を取得
+func (m *AccountMapping) DebitAccount() string {
+	return m.debitAccount
+}
+
+// TaxCode 税区分を取得
+func (m *AccountMapping) TaxCode() TaxCode {
+	return m.taxCode
+}
+
+// Department 部門を取得（未設定の場合は空文字）
+func (m *AccountMapping) Department() string {
+	return m.department
+}
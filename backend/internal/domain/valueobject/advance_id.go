@@ -0,0 +1,50 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// AdvanceID 仮払金IDを表すValue Object
+type AdvanceID struct {
+	value string
+}
+
+// NewAdvanceID 新しいAdvanceIDを作成
+func NewAdvanceID(value string) (*AdvanceID, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.NewDomainError(errors.InvalidAdvanceID, "仮払金IDは空文字列にできません")
+	}
+
+	// UUIDの形式チェック
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, errors.NewDomainError(errors.InvalidAdvanceID, "仮払金IDは有効なUUID形式である必要があります")
+	}
+
+	return &AdvanceID{value: value}, nil
+}
+
+// GenerateAdvanceID 新しいAdvanceIDを生成
+func GenerateAdvanceID() *AdvanceID {
+	return &AdvanceID{value: uuid.New().String()}
+}
+
+// Value 値を取得
+func (t *AdvanceID) Value() string {
+	return t.value
+}
+
+// Equals 等価性をチェック
+func (t *AdvanceID) Equals(other *AdvanceID) bool {
+	if other == nil {
+		return false
+	}
+	return t.value == other.value
+}
+
+// String 文字列表現
+func (t *AdvanceID) String() string {
+	return t.value
+}
@@ -0,0 +1,50 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// BudgetID 予算IDを表すValue Object
+type BudgetID struct {
+	value string
+}
+
+// NewBudgetID 新しいBudgetIDを作成
+func NewBudgetID(value string) (*BudgetID, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.NewDomainError(errors.InvalidBudgetID, "予算IDは空文字列にできません")
+	}
+
+	// UUIDの形式チェック
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, errors.NewDomainError(errors.InvalidBudgetID, "予算IDは有効なUUID形式である必要があります")
+	}
+
+	return &BudgetID{value: value}, nil
+}
+
+// GenerateBudgetID 新しいBudgetIDを生成
+func GenerateBudgetID() *BudgetID {
+	return &BudgetID{value: uuid.New().String()}
+}
+
+// Value 値を取得
+func (t *BudgetID) Value() string {
+	return t.value
+}
+
+// Equals 等価性をチェック
+func (t *BudgetID) Equals(other *BudgetID) bool {
+	if other == nil {
+		return false
+	}
+	return t.value == other.value
+}
+
+// String 文字列表現
+func (t *BudgetID) String() string {
+	return t.value
+}
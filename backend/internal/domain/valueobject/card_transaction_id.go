@@ -0,0 +1,50 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// CardTransactionID カード利用明細IDを表すValue Object
+type CardTransactionID struct {
+	value string
+}
+
+// NewCardTransactionID 新しいCardTransactionIDを作成
+func NewCardTransactionID(value string) (*CardTransactionID, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.NewDomainError(errors.InvalidCardTransactionID, "カード利用明細IDは空文字列にできません")
+	}
+
+	// UUIDの形式チェック
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, errors.NewDomainError(errors.InvalidCardTransactionID, "カード利用明細IDは有効なUUID形式である必要があります")
+	}
+
+	return &CardTransactionID{value: value}, nil
+}
+
+// GenerateCardTransactionID 新しいCardTransactionIDを生成
+func GenerateCardTransactionID() *CardTransactionID {
+	return &CardTransactionID{value: uuid.New().String()}
+}
+
+// Value 値を取得
+func (t *CardTransactionID) Value() string {
+	return t.value
+}
+
+// Equals 等価性をチェック
+func (t *CardTransactionID) Equals(other *CardTransactionID) bool {
+	if other == nil {
+		return false
+	}
+	return t.value == other.value
+}
+
+// String 文字列表現
+func (t *CardTransactionID) String() string {
+	return t.value
+}
@@ -0,0 +1,50 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// CostCenterID コストセンターIDを表すValue Object
+type CostCenterID struct {
+	value string
+}
+
+// NewCostCenterID 新しいCostCenterIDを作成
+func NewCostCenterID(value string) (*CostCenterID, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.NewDomainError(errors.InvalidCostCenterID, "コストセンターIDは空文字列にできません")
+	}
+
+	// UUIDの形式チェック
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, errors.NewDomainError(errors.InvalidCostCenterID, "コストセンターIDは有効なUUID形式である必要があります")
+	}
+
+	return &CostCenterID{value: value}, nil
+}
+
+// GenerateCostCenterID 新しいCostCenterIDを生成
+func GenerateCostCenterID() *CostCenterID {
+	return &CostCenterID{value: uuid.New().String()}
+}
+
+// Value 値を取得
+func (t *CostCenterID) Value() string {
+	return t.value
+}
+
+// Equals 等価性をチェック
+func (t *CostCenterID) Equals(other *CostCenterID) bool {
+	if other == nil {
+		return false
+	}
+	return t.value == other.value
+}
+
+// String 文字列表現
+func (t *CostCenterID) String() string {
+	return t.value
+}
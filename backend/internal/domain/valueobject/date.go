@@ -0,0 +1,122 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"time"
+)
+
+// dateLayout 暦日の文字列表現（YYYY-MM-DD）
+const dateLayout = "2006-01-02"
+
+// Date 時刻とタイムゾーンを持たない暦日（経費日付など）を表すValue Object
+// 日本時間の2026年4月1日に入力した経費が、協定世界時に変換されて3月31日になることはない
+type Date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// NewDate 新しいDateを作成（存在しない日付はエラー）
+func NewDate(year int, month time.Month, day int) (Date, error) {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	if t.Year() != year || t.Month() != month || t.Day() != day {
+		return Date{}, errors.NewDomainError(errors.InvalidExpenseDate, "存在しない日付です")
+	}
+
+	return Date{year: year, month: month, day: day}, nil
+}
+
+// DateOf 日時の暦日を日時自身のタイムゾーンで取得（ゼロ値の場合はゼロ値）
+func DateOf(t time.Time) Date {
+	if t.IsZero() {
+		return Date{}
+	}
+
+	return Date{year: t.Year(), month: t.Month(), day: t.Day()}
+}
+
+// DateIn 日時の暦日をタイムゾーンlocで取得（利用者にとっての「今日」など）
+func DateIn(t time.Time, loc *time.Location) Date {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return DateOf(t.In(loc))
+}
+
+// ParseDate 「YYYY-MM-DD」形式の文字列からDateを作成
+// 日時（RFC3339）の場合は、指定されたオフセットのまま暦日を取り出す
+func ParseDate(value string) (Date, error) {
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		return DateOf(t), nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return DateOf(t), nil
+	}
+
+	return Date{}, errors.NewDomainError(errors.InvalidExpenseDate, "日付は YYYY-MM-DD 形式で指定してください: "+value)
+}
+
+// Year 年を取得
+func (d Date) Year() int {
+	return d.year
+}
+
+// Month 月を取得
+func (d Date) Month() time.Month {
+	return d.month
+}
+
+// Day 日を取得
+func (d Date) Day() int {
+	return d.day
+}
+
+// IsZero ゼロ値（未設定）かどうか
+func (d Date) IsZero() bool {
+	return d.year == 0 && d.month == 0 && d.day == 0
+}
+
+// Time 暦日の0時（協定世界時）の日時を取得
+func (d Date) Time() time.Time {
+	if d.IsZero() {
+		return time.Time{}
+	}
+
+	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, time.UTC)
+}
+
+// AddDays 日数を加算したDateを取得
+func (d Date) AddDays(days int) Date {
+	return DateOf(d.Time().AddDate(0, 0, days))
+}
+
+// DaysSince otherから何日後かを取得（前の場合は負の値）
+func (d Date) DaysSince(other Date) int {
+	return int(d.Time().Sub(other.Time()).Hours() / 24)
+}
+
+// Before otherより前かどうか
+func (d Date) Before(other Date) bool {
+	return d.Time().Before(other.Time())
+}
+
+// After otherより後かどうか
+func (d Date) After(other Date) bool {
+	return d.Time().After(other.Time())
+}
+
+// Equals 等価性をチェック
+func (d Date) Equals(other Date) bool {
+	return d == other
+}
+
+// String 「YYYY-MM-DD」形式の文字列表現（ゼロ値の場合は空文字）
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return d.Time().Format(dateLayout)
+}
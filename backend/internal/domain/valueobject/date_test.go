@@ -0,0 +1,55 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDate(t *testing.T) {
+	t.Run("YYYY-MM-DD形式の文字列から作成", func(t *testing.T) {
+		date, err := ParseDate("2026-04-01")
+		require.NoError(t, err)
+		assert.Equal(t, 2026, date.Year())
+		assert.Equal(t, time.April, date.Month())
+		assert.Equal(t, 1, date.Day())
+		assert.Equal(t, "2026-04-01", date.String())
+	})
+
+	t.Run("日時の場合は指定されたオフセットの日付になる", func(t *testing.T) {
+		date, err := ParseDate("2026-04-01T00:00:00+09:00")
+		require.NoError(t, err)
+		assert.Equal(t, "2026-04-01", date.String())
+	})
+
+	t.Run("不正な形式や存在しない日付はエラー", func(t *testing.T) {
+		for _, value := range []string{"", "2026/04/01", "2026-02-30"} {
+			_, err := ParseDate(value)
+			assert.Error(t, err, value)
+		}
+
+		_, err := NewDate(2026, time.February, 29)
+		assert.Error(t, err)
+	})
+
+	t.Run("タイムゾーンごとの今日", func(t *testing.T) {
+		jst := time.FixedZone("JST", 9*60*60)
+		now := time.Date(2026, 3, 31, 16, 0, 0, 0, time.UTC)
+		assert.Equal(t, "2026-04-01", DateIn(now, jst).String())
+		assert.Equal(t, "2026-03-31", DateIn(now, time.UTC).String())
+	})
+
+	t.Run("日数の加算と比較", func(t *testing.T) {
+		date, _ := NewDate(2026, time.March, 31)
+		next := date.AddDays(1)
+		assert.Equal(t, "2026-04-01", next.String())
+		assert.True(t, date.Before(next))
+		assert.True(t, next.After(date))
+		assert.Equal(t, 1, next.DaysSince(date))
+		assert.Equal(t, -365, date.AddDays(-365).DaysSince(date))
+		assert.True(t, Date{}.IsZero())
+		assert.Equal(t, "", Date{}.String())
+	})
+}
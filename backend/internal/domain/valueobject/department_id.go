@@ -0,0 +1,50 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// DepartmentID 部署IDを表すValue Object
+type DepartmentID struct {
+	value string
+}
+
+// NewDepartmentID 新しいDepartmentIDを作成
+func NewDepartmentID(value string) (*DepartmentID, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.NewDomainError(errors.InvalidDepartmentID, "部署IDは空文字列にできません")
+	}
+
+	// UUIDの形式チェック
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, errors.NewDomainError(errors.InvalidDepartmentID, "部署IDは有効なUUID形式である必要があります")
+	}
+
+	return &DepartmentID{value: value}, nil
+}
+
+// GenerateDepartmentID 新しいDepartmentIDを生成
+func GenerateDepartmentID() *DepartmentID {
+	return &DepartmentID{value: uuid.New().String()}
+}
+
+// Value 値を取得
+func (t *DepartmentID) Value() string {
+	return t.value
+}
+
+// Equals 等価性をチェック
+func (t *DepartmentID) Equals(other *DepartmentID) bool {
+	if other == nil {
+		return false
+	}
+	return t.value == other.value
+}
+
+// String 文字列表現
+func (t *DepartmentID) String() string {
+	return t.value
+}
@@ -0,0 +1,105 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"fmt"
+	"time"
+)
+
+// DateWindowEnforcement 経費日付が古すぎる場合の扱い
+type DateWindowEnforcement string
+
+const (
+	DateWindowEnforcementError   DateWindowEnforcement = "error"   // エラーとして登録を拒否する
+	DateWindowEnforcementWarning DateWindowEnforcement = "warning" // 遅延申請の警告を付けて登録を許可する
+)
+
+const (
+	defaultExpenseMaxAgeDays = 365  // 既定の経費日付の古さの上限（日数）
+	maxExpenseMaxAgeDays     = 3650 // 設定できる古さの上限（日数）
+	maxExpenseFutureDays     = 365  // 設定できる未来の日付の許容日数
+)
+
+// ExpenseDatePolicy 経費日付として認める期間（古さの上限・未来の日付の許容日数）を表すValue Object
+// 経費日付（暦日）は利用者のタイムゾーンの「今日」と比べる
+type ExpenseDatePolicy struct {
+	maxAgeDays  int
+	futureDays  int
+	enforcement DateWindowEnforcement
+}
+
+// DefaultExpenseDatePolicy 既定のExpenseDatePolicy（1年以内・未来の日付は不可・古すぎる場合はエラー）
+func DefaultExpenseDatePolicy() *ExpenseDatePolicy {
+	return &ExpenseDatePolicy{
+		maxAgeDays:  defaultExpenseMaxAgeDays,
+		futureDays:  0,
+		enforcement: DateWindowEnforcementError,
+	}
+}
+
+// NewExpenseDatePolicy 新しいExpenseDatePolicyを作成（古さの扱いを省略した場合はエラー）
+func NewExpenseDatePolicy(maxAgeDays, futureDays int, enforcement string) (*ExpenseDatePolicy, error) {
+	if maxAgeDays < 1 || maxAgeDays > maxExpenseMaxAgeDays {
+		return nil, errors.NewDomainError(errors.InvalidDatePolicy, fmt.Sprintf("経費日付の古さの上限は1〜%d日である必要があります", maxExpenseMaxAgeDays))
+	}
+
+	if futureDays < 0 || futureDays > maxExpenseFutureDays {
+		return nil, errors.NewDomainError(errors.InvalidDatePolicy, fmt.Sprintf("未来の日付の許容日数は0〜%d日である必要があります", maxExpenseFutureDays))
+	}
+
+	mode := DateWindowEnforcementError
+	switch DateWindowEnforcement(enforcement) {
+	case "", DateWindowEnforcementError:
+	case DateWindowEnforcementWarning:
+		mode = DateWindowEnforcementWarning
+	default:
+		return nil, errors.NewDomainError(errors.InvalidDatePolicy, "古すぎる経費日付の扱いは error または warning である必要があります")
+	}
+
+	return &ExpenseDatePolicy{
+		maxAgeDays:  maxAgeDays,
+		futureDays:  futureDays,
+		enforcement: mode,
+	}, nil
+}
+
+// MaxAgeDays 経費日付の古さの上限（日数）を取得
+func (p *ExpenseDatePolicy) MaxAgeDays() int {
+	return p.maxAgeDays
+}
+
+// FutureDays 未来の日付の許容日数を取得（事前に予約する出張など）
+func (p *ExpenseDatePolicy) FutureDays() int {
+	return p.futureDays
+}
+
+// Enforcement 経費日付が古すぎる場合の扱いを取得
+func (p *ExpenseDatePolicy) Enforcement() DateWindowEnforcement {
+	return p.enforcement
+}
+
+// Evaluate 経費日付をタイムゾーンlocにおける現在日時nowの暦日と比べて検証
+// 古すぎる日付を警告として扱う場合は、エラーの代わりに警告メッセージを返す
+func (p *ExpenseDatePolicy) Evaluate(date Date, now time.Time, loc *time.Location) (string, error) {
+	if date.IsZero() {
+		return "", errors.NewDomainError(errors.InvalidExpenseDate, "経費日付が必要です")
+	}
+
+	today := DateIn(now, loc)
+
+	if date.After(today.AddDays(p.futureDays)) {
+		if p.futureDays == 0 {
+			return "", errors.NewDomainError(errors.InvalidExpenseDate, "経費日付は未来の日付にできません")
+		}
+		return "", errors.NewDomainError(errors.InvalidExpenseDate, fmt.Sprintf("経費日付は%d日後までである必要があります", p.futureDays))
+	}
+
+	if date.Before(today.AddDays(-p.maxAgeDays)) {
+		if p.enforcement == DateWindowEnforcementWarning {
+			return fmt.Sprintf("経費日付が%d日より前のため遅延申請として扱われます", p.maxAgeDays), nil
+		}
+		return "", errors.NewDomainError(errors.InvalidExpenseDate, fmt.Sprintf("経費日付は%d日以内である必要があります", p.maxAgeDays))
+	}
+
+	return "", nil
+}
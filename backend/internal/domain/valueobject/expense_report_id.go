@@ -0,0 +1,50 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ExpenseReportID 経費レポートIDを表すValue Object
+type ExpenseReportID struct {
+	value string
+}
+
+// NewExpenseReportID 新しいExpenseReportIDを作成
+func NewExpenseReportID(value string) (*ExpenseReportID, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.NewDomainError(errors.InvalidExpenseReportID, "経費レポートIDは空文字列にできません")
+	}
+
+	// UUIDの形式チェック
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, errors.NewDomainError(errors.InvalidExpenseReportID, "経費レポートIDは有効なUUID形式である必要があります")
+	}
+
+	return &ExpenseReportID{value: value}, nil
+}
+
+// GenerateExpenseReportID 新しいExpenseReportIDを生成
+func GenerateExpenseReportID() *ExpenseReportID {
+	return &ExpenseReportID{value: uuid.New().String()}
+}
+
+// Value 値を取得
+func (r *ExpenseReportID) Value() string {
+	return r.value
+}
+
+// Equals 等価性をチェック
+func (r *ExpenseReportID) Equals(other *ExpenseReportID) bool {
+	if other == nil {
+		return false
+	}
+	return r.value == other.value
+}
+
+// String 文字列表現
+func (r *ExpenseReportID) String() string {
+	return r.value
+}
@@ -0,0 +1,111 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"fmt"
+	"time"
+)
+
+// FiscalCalendar 会計年度の開始月に基づく会計期間（月次）の暦
+type FiscalCalendar struct {
+	startMonth time.Month
+}
+
+// NewFiscalCalendar 新しいFiscalCalendarを作成（開始月は1〜12）
+func NewFiscalCalendar(startMonth int) (*FiscalCalendar, error) {
+	if startMonth < 1 || startMonth > 12 {
+		return nil, errors.NewDomainError(errors.InvalidFiscalPeriod, "会計年度の開始月は1〜12である必要があります")
+	}
+
+	return &FiscalCalendar{startMonth: time.Month(startMonth)}, nil
+}
+
+// StartMonth 会計年度の開始月を取得
+func (c *FiscalCalendar) StartMonth() time.Month {
+	return c.startMonth
+}
+
+// PeriodOf 暦日が属する会計期間を取得
+func (c *FiscalCalendar) PeriodOf(date Date) FiscalPeriod {
+	return c.newPeriod(date.Year(), date.Month())
+}
+
+// ParsePeriod 「YYYY-MM」形式の文字列から会計期間を取得
+func (c *FiscalCalendar) ParsePeriod(value string) (FiscalPeriod, error) {
+	month, err := time.Parse("2006-01", value)
+	if err != nil {
+		return FiscalPeriod{}, errors.NewDomainError(errors.InvalidFiscalPeriod, "会計期間は YYYY-MM 形式で指定してください: "+value)
+	}
+
+	return c.newPeriod(month.Year(), month.Month()), nil
+}
+
+// PeriodsOf 会計年度の会計期間を第1期から順に取得
+func (c *FiscalCalendar) PeriodsOf(fiscalYear int) []FiscalPeriod {
+	periods := make([]FiscalPeriod, 12)
+	start := time.Date(fiscalYear, c.startMonth, 1, 0, 0, 0, 0, time.UTC)
+	for i := range periods {
+		month := start.AddDate(0, i, 0)
+		periods[i] = c.newPeriod(month.Year(), month.Month())
+	}
+	return periods
+}
+
+// newPeriod 暦年の月から会計期間を作成
+func (c *FiscalCalendar) newPeriod(year int, month time.Month) FiscalPeriod {
+	// 会計年度は開始月の属する暦年で表す（4月開始の場合、2025年3月は2024年度）
+	fiscalYear := year
+	if month < c.startMonth {
+		fiscalYear--
+	}
+
+	return FiscalPeriod{
+		year:       year,
+		month:      month,
+		fiscalYear: fiscalYear,
+		number:     (int(month)-int(c.startMonth)+12)%12 + 1,
+	}
+}
+
+// FiscalPeriod 会計期間（月次）を表すValue Object
+type FiscalPeriod struct {
+	year       int
+	month      time.Month
+	fiscalYear int
+	number     int
+}
+
+// Key 「YYYY-MM」形式の会計期間のキー
+func (p FiscalPeriod) Key() string {
+	return fmt.Sprintf("%04d-%02d", p.year, int(p.month))
+}
+
+// FiscalYear 会計年度を取得
+func (p FiscalPeriod) FiscalYear() int {
+	return p.fiscalYear
+}
+
+// Number 会計年度の第何期（1〜12）かを取得
+func (p FiscalPeriod) Number() int {
+	return p.number
+}
+
+// Start 会計期間の初日を取得
+func (p FiscalPeriod) Start() time.Time {
+	return time.Date(p.year, p.month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// End 会計期間の末日を取得
+func (p FiscalPeriod) End() time.Time {
+	return p.Start().AddDate(0, 1, -1)
+}
+
+// Equals 等価性をチェック
+func (p FiscalPeriod) Equals(other FiscalPeriod) bool {
+	return p.year == other.year && p.month == other.month
+}
+
+// String 文字列表現
+func (p FiscalPeriod) String() string {
+	return p.Key()
+}
@@ -0,0 +1,65 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFiscalCalendar(t *testing.T) {
+	calendar, err := NewFiscalCalendar(4)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		date           Date
+		wantKey        string
+		wantFiscalYear int
+		wantNumber     int
+	}{
+		{"期首の月", DateOf(time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC)), "2024-04", 2024, 1},
+		{"年末", DateOf(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)), "2024-12", 2024, 9},
+		{"期末の月は前年度", DateOf(time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)), "2025-03", 2024, 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period := calendar.PeriodOf(tt.date)
+			assert.Equal(t, tt.wantKey, period.Key())
+			assert.Equal(t, tt.wantFiscalYear, period.FiscalYear())
+			assert.Equal(t, tt.wantNumber, period.Number())
+		})
+	}
+
+	t.Run("会計年度の会計期間と期間の末日", func(t *testing.T) {
+		periods := calendar.PeriodsOf(2024)
+		require.Len(t, periods, 12)
+		assert.Equal(t, "2024-04", periods[0].Key())
+		assert.Equal(t, "2025-03", periods[11].Key())
+		assert.Equal(t, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), periods[10].End())
+	})
+
+	t.Run("YYYY-MM形式の文字列から会計期間を取得", func(t *testing.T) {
+		period, err := calendar.ParsePeriod("2025-01")
+		require.NoError(t, err)
+		assert.Equal(t, 10, period.Number())
+
+		_, err = calendar.ParsePeriod("2025/01")
+		assert.Error(t, err)
+	})
+
+	t.Run("1月開始の会計年度は暦年と一致", func(t *testing.T) {
+		calendar, err := NewFiscalCalendar(1)
+		require.NoError(t, err)
+		period := calendar.PeriodOf(DateOf(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, 2024, period.FiscalYear())
+		assert.Equal(t, 1, period.Number())
+	})
+
+	t.Run("開始月が範囲外の場合はエラー", func(t *testing.T) {
+		_, err := NewFiscalCalendar(13)
+		assert.Error(t, err)
+	})
+}
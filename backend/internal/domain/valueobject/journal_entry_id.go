@@ -0,0 +1,50 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// JournalEntryID 仕訳IDを表すValue Object
+type JournalEntryID struct {
+	value string
+}
+
+// NewJournalEntryID 新しいJournalEntryIDを作成
+func NewJournalEntryID(value string) (*JournalEntryID, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.NewDomainError(errors.InvalidJournalEntryID, "仕訳IDは空文字列にできません")
+	}
+
+	// UUIDの形式チェック
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, errors.NewDomainError(errors.InvalidJournalEntryID, "仕訳IDは有効なUUID形式である必要があります")
+	}
+
+	return &JournalEntryID{value: value}, nil
+}
+
+// GenerateJournalEntryID 新しいJournalEntryIDを生成
+func GenerateJournalEntryID() *JournalEntryID {
+	return &JournalEntryID{value: uuid.New().String()}
+}
+
+// Value 値を取得
+func (t *JournalEntryID) Value() string {
+	return t.value
+}
+
+// Equals 等価性をチェック
+func (t *JournalEntryID) Equals(other *JournalEntryID) bool {
+	if other == nil {
+		return false
+	}
+	return t.value == other.value
+}
+
+// String 文字列表現
+func (t *JournalEntryID) String() string {
+	return t.value
+}
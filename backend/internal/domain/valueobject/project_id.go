@@ -0,0 +1,50 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ProjectID プロジェクトIDを表すValue Object
+type ProjectID struct {
+	value string
+}
+
+// NewProjectID 新しいProjectIDを作成
+func NewProjectID(value string) (*ProjectID, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.NewDomainError(errors.InvalidProjectID, "プロジェクトIDは空文字列にできません")
+	}
+
+	// UUIDの形式チェック
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, errors.NewDomainError(errors.InvalidProjectID, "プロジェクトIDは有効なUUID形式である必要があります")
+	}
+
+	return &ProjectID{value: value}, nil
+}
+
+// GenerateProjectID 新しいProjectIDを生成
+func GenerateProjectID() *ProjectID {
+	return &ProjectID{value: uuid.New().String()}
+}
+
+// Value 値を取得
+func (t *ProjectID) Value() string {
+	return t.value
+}
+
+// Equals 等価性をチェック
+func (t *ProjectID) Equals(other *ProjectID) bool {
+	if other == nil {
+		return false
+	}
+	return t.value == other.value
+}
+
+// String 文字列表現
+func (t *ProjectID) String() string {
+	return t.value
+}
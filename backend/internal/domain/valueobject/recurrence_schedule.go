@@ -0,0 +1,94 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+	"time"
+)
+
+// RecurrenceFrequency 定期経費の繰り返しの単位
+type RecurrenceFrequency string
+
+const (
+	RecurrenceMonthly RecurrenceFrequency = "monthly" // 毎月の指定日
+	RecurrenceWeekly  RecurrenceFrequency = "weekly"  // 毎週の指定曜日
+)
+
+// RecurrenceSchedule 定期経費の発生日の規則を表すValue Object
+type RecurrenceSchedule struct {
+	frequency  RecurrenceFrequency
+	dayOfMonth int          // monthly: 日（1〜31、月末より後の日はその月の末日）
+	weekday    time.Weekday // weekly: 曜日
+}
+
+// NewMonthlySchedule 毎月の指定日の規則を作成（31日を指定した場合は毎月の末日）
+func NewMonthlySchedule(dayOfMonth int) (*RecurrenceSchedule, error) {
+	if dayOfMonth < 1 || dayOfMonth > 31 {
+		return nil, errors.NewDomainError(errors.InvalidRecurringExpense, "毎月の日は1〜31で指定してください")
+	}
+
+	return &RecurrenceSchedule{frequency: RecurrenceMonthly, dayOfMonth: dayOfMonth}, nil
+}
+
+// NewWeeklySchedule 毎週の指定曜日の規則を作成（曜日は sun, mon, tue, wed, thu, fri, sat）
+func NewWeeklySchedule(weekday string) (*RecurrenceSchedule, error) {
+	day, ok := weekdayNames[strings.ToLower(strings.TrimSpace(weekday))]
+	if !ok {
+		return nil, errors.NewDomainError(errors.InvalidRecurringExpense, "無効な曜日です: "+weekday)
+	}
+
+	return &RecurrenceSchedule{frequency: RecurrenceWeekly, weekday: day}, nil
+}
+
+// Frequency 繰り返しの単位を取得
+func (s *RecurrenceSchedule) Frequency() RecurrenceFrequency {
+	return s.frequency
+}
+
+// DayOfMonth 毎月の日を取得（weeklyの場合は0）
+func (s *RecurrenceSchedule) DayOfMonth() int {
+	return s.dayOfMonth
+}
+
+// Weekday 毎週の曜日の名前を取得（monthlyの場合は空）
+func (s *RecurrenceSchedule) Weekday() string {
+	if s.frequency != RecurrenceWeekly {
+		return ""
+	}
+
+	for name, day := range weekdayNames {
+		if day == s.weekday {
+			return name
+		}
+	}
+	return ""
+}
+
+// Next 指定日より後の最初の発生日を取得
+func (s *RecurrenceSchedule) Next(after Date) Date {
+	if s.frequency == RecurrenceWeekly {
+		date := after.AddDays(1)
+		for date.Time().Weekday() != s.weekday {
+			date = date.AddDays(1)
+		}
+		return date
+	}
+
+	date := s.dayIn(after.Year(), after.Month())
+	if !date.After(after) {
+		next := time.Date(after.Year(), after.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		date = s.dayIn(next.Year(), next.Month())
+	}
+	return date
+}
+
+// dayIn 月の発生日を取得（指定日が月末より後の場合は末日）
+func (s *RecurrenceSchedule) dayIn(year int, month time.Month) Date {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	day := s.dayOfMonth
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return Date{year: year, month: month, day: day}
+}
@@ -0,0 +1,50 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// RecurringExpenseID 定期経費IDを表すValue Object
+type RecurringExpenseID struct {
+	value string
+}
+
+// NewRecurringExpenseID 新しいRecurringExpenseIDを作成
+func NewRecurringExpenseID(value string) (*RecurringExpenseID, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.NewDomainError(errors.InvalidRecurringExpenseID, "定期経費IDは空文字列にできません")
+	}
+
+	// UUIDの形式チェック
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, errors.NewDomainError(errors.InvalidRecurringExpenseID, "定期経費IDは有効なUUID形式である必要があります")
+	}
+
+	return &RecurringExpenseID{value: value}, nil
+}
+
+// GenerateRecurringExpenseID 新しいRecurringExpenseIDを生成
+func GenerateRecurringExpenseID() *RecurringExpenseID {
+	return &RecurringExpenseID{value: uuid.New().String()}
+}
+
+// Value 値を取得
+func (t *RecurringExpenseID) Value() string {
+	return t.value
+}
+
+// Equals 等価性をチェック
+func (t *RecurringExpenseID) Equals(other *RecurringExpenseID) bool {
+	if other == nil {
+		return false
+	}
+	return t.value == other.value
+}
+
+// String 文字列表現
+func (t *RecurringExpenseID) String() string {
+	return t.value
+}
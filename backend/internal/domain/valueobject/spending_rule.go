@@ -0,0 +1,426 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SpendingRuleType 支出規程のルールの種類
+type SpendingRuleType string
+
+const (
+	SpendingRuleMaxAmount      SpendingRuleType = "max_amount"      // 金額の上限
+	SpendingRuleMaxPerHead     SpendingRuleType = "max_per_head"    // 参加者1人当たりの金額の上限
+	SpendingRuleRequiredFields SpendingRuleType = "required_fields" // 必須の入力項目
+	SpendingRuleWeekdays       SpendingRuleType = "weekdays"        // 利用できる曜日
+	SpendingRuleTimeWindow     SpendingRuleType = "time_window"     // 利用できる時間帯
+	SpendingRuleCurrency       SpendingRuleType = "currency"        // 利用できる通貨
+	SpendingRuleMinDistance    SpendingRuleType = "min_distance"    // 移動距離の下限
+	SpendingRuleAnyOf          SpendingRuleType = "any_of"          // いずれかのルールを満たす
+)
+
+// PolicyEnforcement 支出規程に違反した場合の扱い
+type PolicyEnforcement string
+
+const (
+	PolicyEnforcementBlock     PolicyEnforcement = "block"     // 申請できない
+	PolicyEnforcementException PolicyEnforcement = "exception" // 理由を入力すれば規程外の経費として申請できる
+)
+
+// 必須にできる経費の任意項目
+const (
+	SpendingFieldDescription = "description"  // 説明
+	SpendingFieldTripRequest = "trip_request" // 出張申請
+	SpendingFieldTime        = "time"         // 利用時刻
+	SpendingFieldAttendees   = "attendees"    // 参加者
+	SpendingFieldDistance    = "distance"     // 移動距離
+)
+
+// spendingFieldLabels 必須にできる項目の表示名
+var spendingFieldLabels = map[string]string{
+	SpendingFieldDescription: "説明",
+	SpendingFieldTripRequest: "出張申請",
+	SpendingFieldTime:        "利用時刻",
+	SpendingFieldAttendees:   "参加者",
+	SpendingFieldDistance:    "移動距離",
+}
+
+// weekdayNames 曜日の指定に使う名前
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// weekdayLabels 曜日の表示名
+var weekdayLabels = [...]string{"日", "月", "火", "水", "木", "金", "土"}
+
+// SpendingFacts 支出規程の判定に使う経費の内容
+type SpendingFacts struct {
+	Amount         *Money
+	Date           Date
+	Time           *TimeOfDay // 利用時刻（未入力の場合はnil）
+	PerHeadAmount  *Money     // 参加者1人当たりの金額（参加者が未入力の場合はnil）
+	DistanceKm     float64    // 移動距離（km、未入力の場合は0）
+	ProvidedFields []string   // 入力済みの任意項目
+}
+
+// SpendingRule カテゴリの支出規程の1つのルールを表すValue Object
+type SpendingRule struct {
+	ruleType    SpendingRuleType
+	enforcement PolicyEnforcement
+
+	maxAmount     *Money          // 金額の上限（1人当たりの金額の上限を含む）
+	fields        []string        // 必須の入力項目
+	weekdays      []time.Weekday  // 利用できる曜日
+	from, to      TimeOfDay       // 利用できる時間帯（fromがtoより後の場合は日をまたぐ）
+	currencies    []string        // 利用できる通貨
+	minDistanceKm float64         // 移動距離の下限（km）
+	rules         []*SpendingRule // いずれかを満たせばよいルール
+}
+
+// NewMaxAmountRule 金額の上限のルールを作成
+func NewMaxAmountRule(maxAmount *Money, enforcement string) (*SpendingRule, error) {
+	if maxAmount == nil || maxAmount.Amount() <= 0 {
+		return nil, errors.NewDomainError(errors.InvalidSpendingRule, "金額の上限は0より大きい必要があります")
+	}
+
+	return newSpendingRule(SpendingRuleMaxAmount, enforcement, func(r *SpendingRule) {
+		r.maxAmount = maxAmount
+	})
+}
+
+// NewMaxPerHeadRule 参加者1人当たりの金額の上限のルールを作成
+func NewMaxPerHeadRule(maxAmount *Money, enforcement string) (*SpendingRule, error) {
+	if maxAmount == nil || maxAmount.Amount() <= 0 {
+		return nil, errors.NewDomainError(errors.InvalidSpendingRule, "1人当たりの金額の上限は0より大きい必要があります")
+	}
+
+	return newSpendingRule(SpendingRuleMaxPerHead, enforcement, func(r *SpendingRule) {
+		r.maxAmount = maxAmount
+	})
+}
+
+// NewRequiredFieldsRule 必須の入力項目のルールを作成
+func NewRequiredFieldsRule(fields []string, enforcement string) (*SpendingRule, error) {
+	if len(fields) == 0 {
+		return nil, errors.NewDomainError(errors.InvalidSpendingRule, "必須の入力項目を1つ以上指定してください")
+	}
+
+	normalized := make([]string, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if _, ok := spendingFieldLabels[field]; !ok {
+			return nil, errors.NewDomainError(errors.InvalidSpendingRule, "必須にできない入力項目です: "+field)
+		}
+		normalized = append(normalized, field)
+	}
+
+	return newSpendingRule(SpendingRuleRequiredFields, enforcement, func(r *SpendingRule) {
+		r.fields = normalized
+	})
+}
+
+// NewWeekdaysRule 利用できる曜日のルールを作成（曜日は sun, mon, ... , sat で指定）
+func NewWeekdaysRule(weekdays []string, enforcement string) (*SpendingRule, error) {
+	if len(weekdays) == 0 {
+		return nil, errors.NewDomainError(errors.InvalidSpendingRule, "利用できる曜日を1つ以上指定してください")
+	}
+
+	days := make([]time.Weekday, 0, len(weekdays))
+	for _, name := range weekdays {
+		day, ok := weekdayNames[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, errors.NewDomainError(errors.InvalidSpendingRule, "曜日は sun, mon, tue, wed, thu, fri, sat のいずれかで指定してください: "+name)
+		}
+		days = append(days, day)
+	}
+
+	return newSpendingRule(SpendingRuleWeekdays, enforcement, func(r *SpendingRule) {
+		r.weekdays = days
+	})
+}
+
+// NewTimeWindowRule 利用できる時間帯のルールを作成（22:00〜05:00のように日をまたぐ時間帯も指定できる）
+func NewTimeWindowRule(from, to TimeOfDay, enforcement string) (*SpendingRule, error) {
+	if from.Equals(to) {
+		return nil, errors.NewDomainError(errors.InvalidSpendingRule, "時間帯の開始と終了は異なる時刻である必要があります")
+	}
+
+	return newSpendingRule(SpendingRuleTimeWindow, enforcement, func(r *SpendingRule) {
+		r.from = from
+		r.to = to
+	})
+}
+
+// NewCurrencyRule 利用できる通貨のルールを作成
+func NewCurrencyRule(currencies []string, enforcement string) (*SpendingRule, error) {
+	if len(currencies) == 0 {
+		return nil, errors.NewDomainError(errors.InvalidSpendingRule, "利用できる通貨を1つ以上指定してください")
+	}
+
+	normalized := make([]string, 0, len(currencies))
+	for _, currency := range currencies {
+		currency = strings.ToUpper(strings.TrimSpace(currency))
+		if len(currency) != 3 {
+			return nil, errors.NewDomainError(errors.InvalidSpendingRule, "通貨は3文字の通貨コードで指定してください: "+currency)
+		}
+		normalized = append(normalized, currency)
+	}
+
+	return newSpendingRule(SpendingRuleCurrency, enforcement, func(r *SpendingRule) {
+		r.currencies = normalized
+	})
+}
+
+// NewMinDistanceRule 移動距離の下限のルールを作成（下限以上の移動距離のみ認める）
+func NewMinDistanceRule(minDistanceKm float64, enforcement string) (*SpendingRule, error) {
+	if minDistanceKm <= 0 {
+		return nil, errors.NewDomainError(errors.InvalidSpendingRule, "移動距離の下限は0より大きい必要があります")
+	}
+
+	return newSpendingRule(SpendingRuleMinDistance, enforcement, func(r *SpendingRule) {
+		r.minDistanceKm = minDistanceKm
+	})
+}
+
+// NewAnyOfRule いずれかのルールを満たせばよいルールを作成（「22:00以降または2km以上のタクシー」など）
+// 違反時の扱いはこのルールのものを使い、含まれるルールの違反時の扱いは使わない
+func NewAnyOfRule(rules []*SpendingRule, enforcement string) (*SpendingRule, error) {
+	if len(rules) < 2 {
+		return nil, errors.NewDomainError(errors.InvalidSpendingRule, "いずれかを満たせばよいルールは2つ以上指定してください")
+	}
+
+	for _, rule := range rules {
+		if rule == nil {
+			return nil, errors.NewDomainError(errors.InvalidSpendingRule, "支出規程のルールが必要です")
+		}
+		if rule.ruleType == SpendingRuleAnyOf {
+			return nil, errors.NewDomainError(errors.InvalidSpendingRule, "いずれかを満たせばよいルールは入れ子にできません")
+		}
+	}
+
+	return newSpendingRule(SpendingRuleAnyOf, enforcement, func(r *SpendingRule) {
+		r.rules = rules
+	})
+}
+
+// newSpendingRule 違反時の扱いを検証してルールを作成（省略した場合は申請できない）
+func newSpendingRule(ruleType SpendingRuleType, enforcement string, configure func(*SpendingRule)) (*SpendingRule, error) {
+	mode := PolicyEnforcementBlock
+	switch PolicyEnforcement(enforcement) {
+	case "", PolicyEnforcementBlock:
+	case PolicyEnforcementException:
+		mode = PolicyEnforcementException
+	default:
+		return nil, errors.NewDomainError(errors.InvalidSpendingRule, "違反時の扱いは block または exception である必要があります")
+	}
+
+	rule := &SpendingRule{ruleType: ruleType, enforcement: mode}
+	configure(rule)
+
+	return rule, nil
+}
+
+// Type ルールの種類を取得
+func (r *SpendingRule) Type() SpendingRuleType {
+	return r.ruleType
+}
+
+// Enforcement 違反した場合の扱いを取得
+func (r *SpendingRule) Enforcement() PolicyEnforcement {
+	return r.enforcement
+}
+
+// MaxAmount 金額の上限を取得（金額・1人当たりの金額の上限のルール以外はnil）
+func (r *SpendingRule) MaxAmount() *Money {
+	return r.maxAmount
+}
+
+// Fields 必須の入力項目を取得
+func (r *SpendingRule) Fields() []string {
+	return r.fields
+}
+
+// Weekdays 利用できる曜日を sun, mon, ... の名前で取得
+func (r *SpendingRule) Weekdays() []string {
+	names := make([]string, 0, len(r.weekdays))
+	for _, day := range r.weekdays {
+		names = append(names, strings.ToLower(day.String()[:3]))
+	}
+	return names
+}
+
+// TimeWindow 利用できる時間帯の開始と終了を取得
+func (r *SpendingRule) TimeWindow() (TimeOfDay, TimeOfDay) {
+	return r.from, r.to
+}
+
+// Currencies 利用できる通貨を取得
+func (r *SpendingRule) Currencies() []string {
+	return r.currencies
+}
+
+// MinDistanceKm 移動距離の下限（km）を取得
+func (r *SpendingRule) MinDistanceKm() float64 {
+	return r.minDistanceKm
+}
+
+// Rules いずれかを満たせばよいルールを取得
+func (r *SpendingRule) Rules() []*SpendingRule {
+	return r.rules
+}
+
+// Evaluate 経費の内容がルールに違反していないか判定（違反がない場合はnil）
+func (r *SpendingRule) Evaluate(facts SpendingFacts) *PolicyViolation {
+	switch r.ruleType {
+	case SpendingRuleMaxAmount:
+		if facts.Amount == nil {
+			return nil
+		}
+		limit := formatAmount(r.maxAmount)
+		if facts.Amount.Currency() != r.maxAmount.Currency() {
+			return r.violation(fmt.Sprintf("上限額（%s）と異なる通貨の経費です", limit))
+		}
+		if facts.Amount.IsGreaterThan(r.maxAmount) {
+			return r.violation(fmt.Sprintf("金額 %s が上限（%s）を超えています", formatAmount(facts.Amount), limit))
+		}
+	case SpendingRuleMaxPerHead:
+		limit := formatAmount(r.maxAmount)
+		if facts.PerHeadAmount == nil {
+			return r.violation("参加者の入力が必要です（1人当たりの上限: " + limit + "）")
+		}
+		if facts.PerHeadAmount.Currency() != r.maxAmount.Currency() {
+			return r.violation(fmt.Sprintf("1人当たりの上限額（%s）と異なる通貨の経費です", limit))
+		}
+		if facts.PerHeadAmount.IsGreaterThan(r.maxAmount) {
+			return r.violation(fmt.Sprintf("1人当たりの金額 %s が上限（%s）を超えています", formatAmount(facts.PerHeadAmount), limit))
+		}
+	case SpendingRuleRequiredFields:
+		missing := make([]string, 0)
+		for _, field := range r.fields {
+			if !containsString(facts.ProvidedFields, field) {
+				missing = append(missing, spendingFieldLabels[field])
+			}
+		}
+		if len(missing) > 0 {
+			return r.violation(strings.Join(missing, "・") + "の入力が必要です")
+		}
+	case SpendingRuleWeekdays:
+		if facts.Date.IsZero() {
+			return nil
+		}
+		weekday := facts.Date.Time().Weekday()
+		for _, day := range r.weekdays {
+			if day == weekday {
+				return nil
+			}
+		}
+		return r.violation(weekdayLabels[weekday] + "曜日の経費は認められていません")
+	case SpendingRuleTimeWindow:
+		window := fmt.Sprintf("%s〜%s", r.from, r.to)
+		if facts.Time == nil {
+			return r.violation("利用時刻の入力が必要です（認められた時間帯: " + window + "）")
+		}
+		if !r.coversTime(*facts.Time) {
+			return r.violation(fmt.Sprintf("利用時刻 %s は認められた時間帯（%s）外です", facts.Time, window))
+		}
+	case SpendingRuleCurrency:
+		if facts.Amount == nil || containsString(r.currencies, facts.Amount.Currency()) {
+			return nil
+		}
+		return r.violation(fmt.Sprintf("通貨 %s は利用できません（利用できる通貨: %s）", facts.Amount.Currency(), strings.Join(r.currencies, ", ")))
+	case SpendingRuleMinDistance:
+		limit := formatDistance(r.minDistanceKm)
+		if facts.DistanceKm <= 0 {
+			return r.violation("移動距離の入力が必要です（下限: " + limit + "）")
+		}
+		if facts.DistanceKm < r.minDistanceKm {
+			return r.violation(fmt.Sprintf("移動距離 %s が下限（%s）未満です", formatDistance(facts.DistanceKm), limit))
+		}
+	case SpendingRuleAnyOf:
+		messages := make([]string, 0, len(r.rules))
+		for _, rule := range r.rules {
+			violation := rule.Evaluate(facts)
+			if violation == nil {
+				return nil
+			}
+			messages = append(messages, violation.Message())
+		}
+		return r.violation("いずれかの条件を満たす必要があります（" + strings.Join(messages, " / ") + "）")
+	}
+
+	return nil
+}
+
+// coversTime 時刻が利用できる時間帯（開始・終了を含む）に含まれるかどうか
+func (r *SpendingRule) coversTime(t TimeOfDay) bool {
+	if r.from.Before(r.to) {
+		return !t.Before(r.from) && !r.to.Before(t)
+	}
+	return !t.Before(r.from) || !r.to.Before(t)
+}
+
+// violation ルールの違反を作成
+func (r *SpendingRule) violation(message string) *PolicyViolation {
+	return &PolicyViolation{rule: r.ruleType, enforcement: r.enforcement, message: message}
+}
+
+// PolicyViolation 支出規程の違反を表すValue Object
+type PolicyViolation struct {
+	rule        SpendingRuleType
+	enforcement PolicyEnforcement
+	message     string
+}
+
+// NewPolicyViolation 既存データからPolicyViolationを作成
+func NewPolicyViolation(rule SpendingRuleType, enforcement PolicyEnforcement, message string) *PolicyViolation {
+	return &PolicyViolation{rule: rule, enforcement: enforcement, message: message}
+}
+
+// Rule 違反したルールの種類を取得
+func (v *PolicyViolation) Rule() SpendingRuleType {
+	return v.rule
+}
+
+// Enforcement 違反した場合の扱いを取得
+func (v *PolicyViolation) Enforcement() PolicyEnforcement {
+	return v.enforcement
+}
+
+// Message 違反の内容を取得
+func (v *PolicyViolation) Message() string {
+	return v.message
+}
+
+// IsBlocking 申請できない違反かどうか
+func (v *PolicyViolation) IsBlocking() bool {
+	return v.enforcement == PolicyEnforcementBlock
+}
+
+// formatAmount 金額を「5000 JPY」の形式で表示
+func formatAmount(m *Money) string {
+	return strconv.FormatFloat(m.Amount(), 'f', -1, 64) + " " + m.Currency()
+}
+
+// formatDistance 移動距離を「2.5 km」の形式で表示
+func formatDistance(km float64) string {
+	return strconv.FormatFloat(km, 'f', -1, 64) + " km"
+}
+
+// containsString 文字列のスライスに値が含まれるかどうか
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
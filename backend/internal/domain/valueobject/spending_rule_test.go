@@ -0,0 +1,125 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpendingRule_Evaluate(t *testing.T) {
+	amount, _ := NewMoney(12000, "JPY")
+	saturday, _ := ParseDate("2026-04-04")
+	lateNight, _ := ParseTimeOfDay("23:30")
+	morning, _ := ParseTimeOfDay("09:00")
+
+	facts := SpendingFacts{Amount: amount, Date: saturday, Time: &lateNight}
+
+	t.Run("金額の上限を超えると違反", func(t *testing.T) {
+		limit, _ := NewMoney(10000, "JPY")
+		rule, err := NewMaxAmountRule(limit, "exception")
+		require.NoError(t, err)
+
+		violation := rule.Evaluate(facts)
+		require.NotNil(t, violation)
+		assert.Equal(t, SpendingRuleMaxAmount, violation.Rule())
+		assert.False(t, violation.IsBlocking())
+
+		within, _ := NewMoney(10000, "JPY")
+		assert.Nil(t, rule.Evaluate(SpendingFacts{Amount: within}))
+	})
+
+	t.Run("認められていない曜日は違反", func(t *testing.T) {
+		rule, err := NewWeekdaysRule([]string{"mon", "tue", "wed", "thu", "fri"}, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"mon", "tue", "wed", "thu", "fri"}, rule.Weekdays())
+
+		violation := rule.Evaluate(facts)
+		require.NotNil(t, violation)
+		assert.True(t, violation.IsBlocking())
+		assert.Contains(t, violation.Message(), "土曜日")
+
+		_, err = NewWeekdaysRule([]string{"holiday"}, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("日をまたぐ時間帯を判定できる", func(t *testing.T) {
+		from, _ := ParseTimeOfDay("22:00")
+		to, _ := ParseTimeOfDay("05:00")
+		rule, err := NewTimeWindowRule(from, to, "")
+		require.NoError(t, err)
+
+		assert.Nil(t, rule.Evaluate(facts))
+		assert.NotNil(t, rule.Evaluate(SpendingFacts{Time: &morning}))
+		assert.NotNil(t, rule.Evaluate(SpendingFacts{}))
+
+		_, err = NewTimeWindowRule(from, from, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("必須の入力項目が未入力の場合は違反", func(t *testing.T) {
+		rule, err := NewRequiredFieldsRule([]string{SpendingFieldDescription, SpendingFieldTime}, "")
+		require.NoError(t, err)
+
+		violation := rule.Evaluate(SpendingFacts{ProvidedFields: []string{SpendingFieldTime}})
+		require.NotNil(t, violation)
+		assert.Contains(t, violation.Message(), "説明")
+		assert.Nil(t, rule.Evaluate(SpendingFacts{ProvidedFields: []string{SpendingFieldDescription, SpendingFieldTime}}))
+
+		_, err = NewRequiredFieldsRule([]string{"receipt"}, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("認められていない通貨は違反", func(t *testing.T) {
+		rule, err := NewCurrencyRule([]string{"jpy"}, "")
+		require.NoError(t, err)
+		assert.Nil(t, rule.Evaluate(facts))
+
+		usd, _ := NewMoney(100, "USD")
+		assert.NotNil(t, rule.Evaluate(SpendingFacts{Amount: usd}))
+	})
+
+	t.Run("移動距離が下限未満または未入力の場合は違反", func(t *testing.T) {
+		rule, err := NewMinDistanceRule(2, "")
+		require.NoError(t, err)
+
+		assert.Nil(t, rule.Evaluate(SpendingFacts{DistanceKm: 2}))
+		violation := rule.Evaluate(SpendingFacts{DistanceKm: 1.5})
+		require.NotNil(t, violation)
+		assert.Contains(t, violation.Message(), "1.5 km")
+		assert.NotNil(t, rule.Evaluate(SpendingFacts{}))
+
+		_, err = NewMinDistanceRule(0, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("いずれかのルールを満たせば違反なし", func(t *testing.T) {
+		from, _ := ParseTimeOfDay("22:00")
+		to, _ := ParseTimeOfDay("05:00")
+		nightRule, _ := NewTimeWindowRule(from, to, "")
+		distanceRule, _ := NewMinDistanceRule(2, "")
+		rule, err := NewAnyOfRule([]*SpendingRule{nightRule, distanceRule}, "exception")
+		require.NoError(t, err)
+
+		afternoon, _ := ParseTimeOfDay("14:00")
+		assert.Nil(t, rule.Evaluate(facts))
+		assert.Nil(t, rule.Evaluate(SpendingFacts{Time: &afternoon, DistanceKm: 3}))
+
+		violation := rule.Evaluate(SpendingFacts{Time: &afternoon, DistanceKm: 1})
+		require.NotNil(t, violation)
+		assert.Equal(t, SpendingRuleAnyOf, violation.Rule())
+		assert.Equal(t, PolicyEnforcementException, violation.Enforcement())
+		assert.Contains(t, violation.Message(), "14:00")
+		assert.Contains(t, violation.Message(), "1 km")
+
+		_, err = NewAnyOfRule([]*SpendingRule{nightRule}, "")
+		assert.Error(t, err)
+		_, err = NewAnyOfRule([]*SpendingRule{rule, distanceRule}, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("違反時の扱いが不正な場合はエラー", func(t *testing.T) {
+		_, err := NewCurrencyRule([]string{"JPY"}, "warning")
+		assert.Error(t, err)
+	})
+}
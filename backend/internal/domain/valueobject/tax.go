@@ -0,0 +1,110 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"math"
+)
+
+// StandardConsumptionTaxRate 消費税の標準税率
+const StandardConsumptionTaxRate = 0.10
+
+// ReducedConsumptionTaxRate 消費税の軽減税率
+const ReducedConsumptionTaxRate = 0.08
+
+// TaxCode 仕入れの税区分
+type TaxCode string
+
+const (
+	TaxCodeStandard   TaxCode = "standard"     // 課税仕入（標準税率10%）
+	TaxCodeReduced    TaxCode = "reduced"      // 課税仕入（軽減税率8%）
+	TaxCodeNonTaxable TaxCode = "non_taxable"  // 非課税仕入
+	TaxCodeOutOfScope TaxCode = "out_of_scope" // 対象外（不課税）
+)
+
+// NewTaxCode 税区分を作成
+func NewTaxCode(value string) (TaxCode, error) {
+	code := TaxCode(value)
+	switch code {
+	case TaxCodeStandard, TaxCodeReduced, TaxCodeNonTaxable, TaxCodeOutOfScope:
+		return code, nil
+	default:
+		return "", errors.NewDomainError(errors.InvalidAccountMapping, "無効な税区分です: "+value)
+	}
+}
+
+// Rate 税区分の消費税率を取得
+func (c TaxCode) Rate() float64 {
+	switch c {
+	case TaxCodeStandard:
+		return StandardConsumptionTaxRate
+	case TaxCodeReduced:
+		return ReducedConsumptionTaxRate
+	default:
+		return 0
+	}
+}
+
+// TaxBreakdown 税込金額の内訳を表すValue Object
+type TaxBreakdown struct {
+	rate  float64
+	gross *Money
+	net   *Money
+	tax   *Money
+}
+
+// NewTaxBreakdown 税込金額を税率で本体価格と消費税額に分ける
+// 消費税額は日本円では1円未満、それ以外の通貨では0.01未満を切り捨てる
+func NewTaxBreakdown(gross *Money, rate float64) (*TaxBreakdown, error) {
+	if gross == nil {
+		return nil, errors.NewDomainError(errors.InvalidExpenseAmount, "金額が必要です")
+	}
+
+	if rate < 0 || rate >= 1 {
+		return nil, errors.NewDomainError(errors.InvalidExpenseAmount, "税率は0以上1未満である必要があります")
+	}
+
+	unit := 0.01
+	if gross.Currency() == "JPY" {
+		unit = 1
+	}
+
+	// 浮動小数点の誤差で1単位少なく切り捨てないように補正する
+	taxAmount := math.Floor(gross.Amount()*rate/(1+rate)/unit+1e-9) * unit
+
+	tax, err := NewMoney(taxAmount, gross.Currency())
+	if err != nil {
+		return nil, err
+	}
+
+	net, err := gross.Subtract(tax)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TaxBreakdown{
+		rate:  rate,
+		gross: gross,
+		net:   net,
+		tax:   tax,
+	}, nil
+}
+
+// Rate 税率を取得
+func (t *TaxBreakdown) Rate() float64 {
+	return t.rate
+}
+
+// Gross 税込金額を取得
+func (t *TaxBreakdown) Gross() *Money {
+	return t.gross
+}
+
+// Net 本体価格（税抜金額）を取得
+func (t *TaxBreakdown) Net() *Money {
+	return t.net
+}
+
+// Tax 消費税額を取得
+func (t *TaxBreakdown) Tax() *Money {
+	return t.tax
+}
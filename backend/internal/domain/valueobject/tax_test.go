@@ -0,0 +1,45 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTaxBreakdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		rate     float64
+		wantNet  float64
+		wantTax  float64
+	}{
+		{name: "標準税率", amount: 1100, currency: "JPY", rate: 0.10, wantNet: 1000, wantTax: 100},
+		{name: "1円未満は切り捨て", amount: 1000, currency: "JPY", rate: 0.10, wantNet: 910, wantTax: 90},
+		{name: "軽減税率", amount: 1080, currency: "JPY", rate: 0.08, wantNet: 1000, wantTax: 80},
+		{name: "日本円以外は0.01未満を切り捨て", amount: 10, currency: "USD", rate: 0.10, wantNet: 9.1, wantTax: 0.9},
+		{name: "非課税", amount: 500, currency: "JPY", rate: 0, wantNet: 500, wantTax: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gross, err := NewMoney(tt.amount, tt.currency)
+			require.NoError(t, err)
+
+			breakdown, err := NewTaxBreakdown(gross, tt.rate)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantNet, breakdown.Net().Amount())
+			assert.Equal(t, tt.wantTax, breakdown.Tax().Amount())
+			assert.Equal(t, tt.currency, breakdown.Tax().Currency())
+		})
+	}
+
+	t.Run("不正な税率", func(t *testing.T) {
+		gross, _ := NewMoney(100, "JPY")
+		_, err := NewTaxBreakdown(gross, -0.1)
+		assert.Error(t, err)
+	})
+}
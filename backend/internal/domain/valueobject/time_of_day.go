@@ -0,0 +1,56 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"fmt"
+	"time"
+)
+
+// TimeOfDay 日付とタイムゾーンを持たない時刻（タクシーの乗車時刻など）を表すValue Object
+type TimeOfDay struct {
+	minutes int // 0時からの経過分数
+}
+
+// NewTimeOfDay 新しいTimeOfDayを作成
+func NewTimeOfDay(hour, minute int) (TimeOfDay, error) {
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return TimeOfDay{}, errors.NewDomainError(errors.InvalidTimeOfDay, "時刻は00:00〜23:59である必要があります")
+	}
+
+	return TimeOfDay{minutes: hour*60 + minute}, nil
+}
+
+// ParseTimeOfDay 「HH:MM」形式の文字列からTimeOfDayを作成
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return TimeOfDay{}, errors.NewDomainError(errors.InvalidTimeOfDay, "時刻は HH:MM 形式で指定してください: "+value)
+	}
+
+	return NewTimeOfDay(t.Hour(), t.Minute())
+}
+
+// Hour 時を取得
+func (t TimeOfDay) Hour() int {
+	return t.minutes / 60
+}
+
+// Minute 分を取得
+func (t TimeOfDay) Minute() int {
+	return t.minutes % 60
+}
+
+// Before otherより前かどうか
+func (t TimeOfDay) Before(other TimeOfDay) bool {
+	return t.minutes < other.minutes
+}
+
+// Equals 等価性をチェック
+func (t TimeOfDay) Equals(other TimeOfDay) bool {
+	return t.minutes == other.minutes
+}
+
+// String 「HH:MM」形式の文字列表現
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t.Hour(), t.Minute())
+}
@@ -0,0 +1,21 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	tod, err := ParseTimeOfDay("07:05")
+	require.NoError(t, err)
+	assert.Equal(t, 7, tod.Hour())
+	assert.Equal(t, 5, tod.Minute())
+	assert.Equal(t, "07:05", tod.String())
+
+	_, err = ParseTimeOfDay("24:00")
+	assert.Error(t, err)
+	_, err = ParseTimeOfDay("7時")
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package valueobject
+
+import (
+	"expense-management-system/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// TripRequestID 出張申請IDを表すValue Object
+type TripRequestID struct {
+	value string
+}
+
+// NewTripRequestID 新しいTripRequestIDを作成
+func NewTripRequestID(value string) (*TripRequestID, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.NewDomainError(errors.InvalidTripRequestID, "出張申請IDは空文字列にできません")
+	}
+
+	// UUIDの形式チェック
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, errors.NewDomainError(errors.InvalidTripRequestID, "出張申請IDは有効なUUID形式である必要があります")
+	}
+
+	return &TripRequestID{value: value}, nil
+}
+
+// GenerateTripRequestID 新しいTripRequestIDを生成
+func GenerateTripRequestID() *TripRequestID {
+	return &TripRequestID{value: uuid.New().String()}
+}
+
+// Value 値を取得
+func (t *TripRequestID) Value() string {
+	return t.value
+}
+
+// Equals 等価性をチェック
+func (t *TripRequestID) Equals(other *TripRequestID) bool {
+	if other == nil {
+		return false
+	}
+	return t.value == other.value
+}
+
+// String 文字列表現
+func (t *TripRequestID) String() string {
+	return t.value
+}
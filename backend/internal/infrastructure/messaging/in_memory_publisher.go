@@ -0,0 +1,48 @@
+// Package messaging プロセス内のイベント配信を提供
+package messaging
+
+import (
+	"context"
+	"expense-management-system/internal/domain/event"
+	"fmt"
+	"sync"
+)
+
+// Handler イベントハンドラー
+type Handler func(ctx context.Context, e event.Event) error
+
+// InMemoryPublisher 購読者に同期的にイベントを配信するPublisher実装
+type InMemoryPublisher struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewInMemoryPublisher InMemoryPublisherのコンストラクタ
+func NewInMemoryPublisher() *InMemoryPublisher {
+	return &InMemoryPublisher{
+		handlers: make(map[string][]Handler),
+	}
+}
+
+// Subscribe イベント名に対するハンドラーを登録
+func (p *InMemoryPublisher) Subscribe(eventName string, handler Handler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.handlers[eventName] = append(p.handlers[eventName], handler)
+}
+
+// Publish イベントを購読者に配信
+func (p *InMemoryPublisher) Publish(ctx context.Context, e event.Event) error {
+	p.mu.RLock()
+	handlers := append([]Handler(nil), p.handlers[e.Name()]...)
+	p.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, e); err != nil {
+			return fmt.Errorf("failed to handle event %s: %w", e.Name(), err)
+		}
+	}
+
+	return nil
+}
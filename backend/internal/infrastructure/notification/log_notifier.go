@@ -0,0 +1,37 @@
+// Package notification 利用者への通知を提供
+package notification
+
+import (
+	"context"
+	"expense-management-system/internal/domain/event"
+	"log"
+)
+
+// LogNotifier 通知内容をログに出力する通知実装
+type LogNotifier struct {
+	logger *log.Logger
+}
+
+// NewLogNotifier LogNotifierのコンストラクタ
+func NewLogNotifier(logger *log.Logger) *LogNotifier {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return &LogNotifier{
+		logger: logger,
+	}
+}
+
+// Handle イベントを通知
+func (n *LogNotifier) Handle(ctx context.Context, e event.Event) error {
+	switch ev := e.(type) {
+	case event.ExpenseEscalated:
+		n.logger.Printf("📣 [%s] 経費 %s の承認が滞留したため承認者 %s に回付しました（レベル %d）",
+			ev.Name(), ev.ExpenseID, ev.ToApproverID, ev.Level)
+	default:
+		n.logger.Printf("📣 [%s] %+v", e.Name(), e)
+	}
+
+	return nil
+}
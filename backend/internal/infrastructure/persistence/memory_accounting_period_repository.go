@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sync"
+)
+
+// MemoryAccountingPeriodRepository メモリベースの会計期間リポジトリ実装
+type MemoryAccountingPeriodRepository struct {
+	mu      sync.RWMutex
+	periods map[string]*entity.AccountingPeriod
+}
+
+// NewMemoryAccountingPeriodRepository MemoryAccountingPeriodRepositoryのコンストラクタ
+func NewMemoryAccountingPeriodRepository() *MemoryAccountingPeriodRepository {
+	return &MemoryAccountingPeriodRepository{
+		periods: make(map[string]*entity.AccountingPeriod),
+	}
+}
+
+// Save 会計期間の締めの状態を保存（同じ会計期間は上書き）
+func (r *MemoryAccountingPeriodRepository) Save(ctx context.Context, period *entity.AccountingPeriod) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.periods[period.Period().Key()] = period
+	return nil
+}
+
+// FindByPeriod 会計期間で締めの状態を検索
+func (r *MemoryAccountingPeriodRepository) FindByPeriod(ctx context.Context, period valueobject.FiscalPeriod) (*entity.AccountingPeriod, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	accountingPeriod, exists := r.periods[period.Key()]
+	if !exists {
+		return nil, errors.NewDomainError(errors.AccountingPeriodNotFound, "会計期間の締めの状態が見つかりません")
+	}
+
+	return accountingPeriod, nil
+}
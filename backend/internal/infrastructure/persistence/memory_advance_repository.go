@@ -0,0 +1,99 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sync"
+)
+
+// MemoryAdvanceRepository メモリベースの仮払金リポジトリ実装
+type MemoryAdvanceRepository struct {
+	mu       sync.RWMutex
+	advances map[string]*entity.Advance
+}
+
+// NewMemoryAdvanceRepository MemoryAdvanceRepositoryのコンストラクタ
+func NewMemoryAdvanceRepository() *MemoryAdvanceRepository {
+	return &MemoryAdvanceRepository{
+		advances: make(map[string]*entity.Advance),
+	}
+}
+
+// Save 仮払金を保存
+func (r *MemoryAdvanceRepository) Save(ctx context.Context, advance *entity.Advance) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.advances[advance.ID().String()] = advance
+	return nil
+}
+
+// FindByID IDで仮払金を検索
+func (r *MemoryAdvanceRepository) FindByID(ctx context.Context, id *valueobject.AdvanceID) (*entity.Advance, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	advance, exists := r.advances[id.String()]
+	if !exists {
+		return nil, errors.NewDomainError(errors.AdvanceNotFound, "仮払金が見つかりません")
+	}
+
+	return advance, nil
+}
+
+// FindByUserID ユーザーIDで仮払金を検索
+func (r *MemoryAdvanceRepository) FindByUserID(ctx context.Context, userID *valueobject.UserID) ([]*entity.Advance, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	advances := make([]*entity.Advance, 0)
+	for _, advance := range r.advances {
+		if advance.UserID().Equals(userID) {
+			advances = append(advances, advance)
+		}
+	}
+
+	return advances, nil
+}
+
+// FindByExpenseID 経費を精算対象に含む仮払金を検索
+func (r *MemoryAdvanceRepository) FindByExpenseID(ctx context.Context, expenseID *valueobject.ExpenseID) (*entity.Advance, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, advance := range r.advances {
+		if advance.Contains(expenseID) {
+			return advance, nil
+		}
+	}
+
+	return nil, errors.NewDomainError(errors.AdvanceNotFound, "仮払金が見つかりません")
+}
+
+// Update 仮払金を更新
+func (r *MemoryAdvanceRepository) Update(ctx context.Context, advance *entity.Advance) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.advances[advance.ID().String()]; !exists {
+		return errors.NewDomainError(errors.AdvanceNotFound, "仮払金が見つかりません")
+	}
+
+	r.advances[advance.ID().String()] = advance
+	return nil
+}
+
+// Delete 仮払金を削除
+func (r *MemoryAdvanceRepository) Delete(ctx context.Context, id *valueobject.AdvanceID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.advances[id.String()]; !exists {
+		return errors.NewDomainError(errors.AdvanceNotFound, "仮払金が見つかりません")
+	}
+
+	delete(r.advances, id.String())
+	return nil
+}
@@ -0,0 +1,111 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sort"
+	"sync"
+)
+
+// MemoryBudgetRepository メモリベースの予算リポジトリ実装
+type MemoryBudgetRepository struct {
+	mu      sync.RWMutex
+	budgets map[string]*entity.Budget
+}
+
+// NewMemoryBudgetRepository MemoryBudgetRepositoryのコンストラクタ
+func NewMemoryBudgetRepository() *MemoryBudgetRepository {
+	return &MemoryBudgetRepository{
+		budgets: make(map[string]*entity.Budget),
+	}
+}
+
+// Save 予算を保存
+func (r *MemoryBudgetRepository) Save(ctx context.Context, budget *entity.Budget) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.budgets[budget.ID().String()] = budget
+	return nil
+}
+
+// FindByID IDで予算を検索
+func (r *MemoryBudgetRepository) FindByID(ctx context.Context, id *valueobject.BudgetID) (*entity.Budget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	budget, exists := r.budgets[id.String()]
+	if !exists {
+		return nil, errors.NewDomainError(errors.BudgetNotFound, "予算が見つかりません")
+	}
+
+	return budget, nil
+}
+
+// FindByDate 期間に日付を含む予算を検索（期間の開始日の順）
+func (r *MemoryBudgetRepository) FindByDate(ctx context.Context, date valueobject.Date) ([]*entity.Budget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	budgets := make([]*entity.Budget, 0)
+	for _, budget := range r.budgets {
+		if budget.IncludesDate(date) {
+			budgets = append(budgets, budget)
+		}
+	}
+	sortBudgets(budgets)
+
+	return budgets, nil
+}
+
+// FindAll 全ての予算を取得（期間の開始日の順）
+func (r *MemoryBudgetRepository) FindAll(ctx context.Context) ([]*entity.Budget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	budgets := make([]*entity.Budget, 0, len(r.budgets))
+	for _, budget := range r.budgets {
+		budgets = append(budgets, budget)
+	}
+	sortBudgets(budgets)
+
+	return budgets, nil
+}
+
+// Update 予算を更新
+func (r *MemoryBudgetRepository) Update(ctx context.Context, budget *entity.Budget) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.budgets[budget.ID().String()]; !exists {
+		return errors.NewDomainError(errors.BudgetNotFound, "予算が見つかりません")
+	}
+
+	r.budgets[budget.ID().String()] = budget
+	return nil
+}
+
+// Delete 予算を削除
+func (r *MemoryBudgetRepository) Delete(ctx context.Context, id *valueobject.BudgetID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.budgets[id.String()]; !exists {
+		return errors.NewDomainError(errors.BudgetNotFound, "予算が見つかりません")
+	}
+
+	delete(r.budgets, id.String())
+	return nil
+}
+
+// sortBudgets 予算を期間の開始日、予算名の順に並べる
+func sortBudgets(budgets []*entity.Budget) {
+	sort.Slice(budgets, func(i, j int) bool {
+		if !budgets[i].PeriodFrom().Equals(budgets[j].PeriodFrom()) {
+			return budgets[i].PeriodFrom().Before(budgets[j].PeriodFrom())
+		}
+		return budgets[i].Name() < budgets[j].Name()
+	})
+}
@@ -0,0 +1,115 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sync"
+)
+
+// MemoryCardTransactionRepository メモリベースのカード利用明細リポジトリ実装
+type MemoryCardTransactionRepository struct {
+	mu           sync.RWMutex
+	transactions map[string]*entity.CardTransaction
+}
+
+// NewMemoryCardTransactionRepository MemoryCardTransactionRepositoryのコンストラクタ
+func NewMemoryCardTransactionRepository() *MemoryCardTransactionRepository {
+	return &MemoryCardTransactionRepository{
+		transactions: make(map[string]*entity.CardTransaction),
+	}
+}
+
+// Save カード利用明細を保存
+func (r *MemoryCardTransactionRepository) Save(ctx context.Context, transaction *entity.CardTransaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.transactions[transaction.ID().String()] = transaction
+	return nil
+}
+
+// FindByID IDでカード利用明細を検索
+func (r *MemoryCardTransactionRepository) FindByID(ctx context.Context, id *valueobject.CardTransactionID) (*entity.CardTransaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	transaction, exists := r.transactions[id.String()]
+	if !exists {
+		return nil, errors.NewDomainError(errors.CardTransactionNotFound, "カード利用明細が見つかりません")
+	}
+
+	return transaction, nil
+}
+
+// FindByUserID ユーザーIDでカード利用明細を検索
+func (r *MemoryCardTransactionRepository) FindByUserID(ctx context.Context, userID *valueobject.UserID) ([]*entity.CardTransaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	transactions := make([]*entity.CardTransaction, 0)
+	for _, transaction := range r.transactions {
+		if transaction.UserID().Equals(userID) {
+			transactions = append(transactions, transaction)
+		}
+	}
+
+	return transactions, nil
+}
+
+// FindByUserIDAndStatus ユーザーIDと照合状態でカード利用明細を検索
+func (r *MemoryCardTransactionRepository) FindByUserIDAndStatus(ctx context.Context, userID *valueobject.UserID, status entity.CardTransactionStatus) ([]*entity.CardTransaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	transactions := make([]*entity.CardTransaction, 0)
+	for _, transaction := range r.transactions {
+		if transaction.UserID().Equals(userID) && transaction.Status() == status {
+			transactions = append(transactions, transaction)
+		}
+	}
+
+	return transactions, nil
+}
+
+// FindByExternalID ユーザーIDと明細の識別子でカード利用明細を検索
+func (r *MemoryCardTransactionRepository) FindByExternalID(ctx context.Context, userID *valueobject.UserID, externalID string) (*entity.CardTransaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, transaction := range r.transactions {
+		if transaction.UserID().Equals(userID) && transaction.ExternalID() == externalID {
+			return transaction, nil
+		}
+	}
+
+	return nil, errors.NewDomainError(errors.CardTransactionNotFound, "カード利用明細が見つかりません")
+}
+
+// FindByExpenseID 経費と照合されたカード利用明細を検索
+func (r *MemoryCardTransactionRepository) FindByExpenseID(ctx context.Context, expenseID *valueobject.ExpenseID) (*entity.CardTransaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, transaction := range r.transactions {
+		if transaction.ExpenseID() != nil && transaction.ExpenseID().Equals(expenseID) {
+			return transaction, nil
+		}
+	}
+
+	return nil, errors.NewDomainError(errors.CardTransactionNotFound, "カード利用明細が見つかりません")
+}
+
+// Update カード利用明細を更新
+func (r *MemoryCardTransactionRepository) Update(ctx context.Context, transaction *entity.CardTransaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.transactions[transaction.ID().String()]; !exists {
+		return errors.NewDomainError(errors.CardTransactionNotFound, "カード利用明細が見つかりません")
+	}
+
+	r.transactions[transaction.ID().String()] = transaction
+	return nil
+}
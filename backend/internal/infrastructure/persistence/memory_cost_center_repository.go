@@ -0,0 +1,122 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sort"
+	"sync"
+)
+
+// MemoryCostCenterRepository メモリベースのコストセンターリポジトリ実装
+type MemoryCostCenterRepository struct {
+	mu          sync.RWMutex
+	costCenters map[string]*entity.CostCenter
+}
+
+// NewMemoryCostCenterRepository MemoryCostCenterRepositoryのコンストラクタ
+func NewMemoryCostCenterRepository() *MemoryCostCenterRepository {
+	return &MemoryCostCenterRepository{
+		costCenters: make(map[string]*entity.CostCenter),
+	}
+}
+
+// Save コストセンターを保存
+func (r *MemoryCostCenterRepository) Save(ctx context.Context, costCenter *entity.CostCenter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.costCenters[costCenter.ID().String()] = costCenter
+	return nil
+}
+
+// FindByID IDでコストセンターを検索
+func (r *MemoryCostCenterRepository) FindByID(ctx context.Context, id *valueobject.CostCenterID) (*entity.CostCenter, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	costCenter, exists := r.costCenters[id.String()]
+	if !exists {
+		return nil, errors.NewDomainError(errors.CostCenterNotFound, "コストセンターが見つかりません")
+	}
+
+	return costCenter, nil
+}
+
+// FindByCode コストセンターコードでコストセンターを検索
+func (r *MemoryCostCenterRepository) FindByCode(ctx context.Context, code string) (*entity.CostCenter, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, costCenter := range r.costCenters {
+		if costCenter.Code() == code {
+			return costCenter, nil
+		}
+	}
+
+	return nil, errors.NewDomainError(errors.CostCenterNotFound, "コストセンターが見つかりません")
+}
+
+// FindByDepartmentID 部署に所属するコストセンターを検索（コストセンターコードの順）
+func (r *MemoryCostCenterRepository) FindByDepartmentID(ctx context.Context, departmentID *valueobject.DepartmentID) ([]*entity.CostCenter, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	costCenters := make([]*entity.CostCenter, 0)
+	for _, costCenter := range r.costCenters {
+		if costCenter.DepartmentID().Equals(departmentID) {
+			costCenters = append(costCenters, costCenter)
+		}
+	}
+	sortCostCenters(costCenters)
+
+	return costCenters, nil
+}
+
+// FindAll 全てのコストセンターを取得（コストセンターコードの順）
+func (r *MemoryCostCenterRepository) FindAll(ctx context.Context) ([]*entity.CostCenter, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	costCenters := make([]*entity.CostCenter, 0, len(r.costCenters))
+	for _, costCenter := range r.costCenters {
+		costCenters = append(costCenters, costCenter)
+	}
+	sortCostCenters(costCenters)
+
+	return costCenters, nil
+}
+
+// Update コストセンターを更新
+func (r *MemoryCostCenterRepository) Update(ctx context.Context, costCenter *entity.CostCenter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.costCenters[costCenter.ID().String()]; !exists {
+		return errors.NewDomainError(errors.CostCenterNotFound, "コストセンターが見つかりません")
+	}
+
+	r.costCenters[costCenter.ID().String()] = costCenter
+	return nil
+}
+
+// Delete コストセンターを削除
+func (r *MemoryCostCenterRepository) Delete(ctx context.Context, id *valueobject.CostCenterID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.costCenters[id.String()]; !exists {
+		return errors.NewDomainError(errors.CostCenterNotFound, "コストセンターが見つかりません")
+	}
+
+	delete(r.costCenters, id.String())
+	return nil
+}
+
+// sortCostCenters コストセンターをコストセンターコードの順に並べる
+func sortCostCenters(costCenters []*entity.CostCenter) {
+	sort.Slice(costCenters, func(i, j int) bool {
+		return costCenters[i].Code() < costCenters[j].Code()
+	})
+}
@@ -0,0 +1,101 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sort"
+	"sync"
+)
+
+// MemoryDepartmentRepository メモリベースの部署リポジトリ実装
+type MemoryDepartmentRepository struct {
+	mu          sync.RWMutex
+	departments map[string]*entity.Department
+}
+
+// NewMemoryDepartmentRepository MemoryDepartmentRepositoryのコンストラクタ
+func NewMemoryDepartmentRepository() *MemoryDepartmentRepository {
+	return &MemoryDepartmentRepository{
+		departments: make(map[string]*entity.Department),
+	}
+}
+
+// Save 部署を保存
+func (r *MemoryDepartmentRepository) Save(ctx context.Context, department *entity.Department) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.departments[department.ID().String()] = department
+	return nil
+}
+
+// FindByID IDで部署を検索
+func (r *MemoryDepartmentRepository) FindByID(ctx context.Context, id *valueobject.DepartmentID) (*entity.Department, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	department, exists := r.departments[id.String()]
+	if !exists {
+		return nil, errors.NewDomainError(errors.DepartmentNotFound, "部署が見つかりません")
+	}
+
+	return department, nil
+}
+
+// FindByCode 部署コードで部署を検索
+func (r *MemoryDepartmentRepository) FindByCode(ctx context.Context, code string) (*entity.Department, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, department := range r.departments {
+		if department.Code() == code {
+			return department, nil
+		}
+	}
+
+	return nil, errors.NewDomainError(errors.DepartmentNotFound, "部署が見つかりません")
+}
+
+// FindAll 全ての部署を取得（部署コードの順）
+func (r *MemoryDepartmentRepository) FindAll(ctx context.Context) ([]*entity.Department, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	departments := make([]*entity.Department, 0, len(r.departments))
+	for _, department := range r.departments {
+		departments = append(departments, department)
+	}
+	sort.Slice(departments, func(i, j int) bool {
+		return departments[i].Code() < departments[j].Code()
+	})
+
+	return departments, nil
+}
+
+// Update 部署を更新
+func (r *MemoryDepartmentRepository) Update(ctx context.Context, department *entity.Department) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.departments[department.ID().String()]; !exists {
+		return errors.NewDomainError(errors.DepartmentNotFound, "部署が見つかりません")
+	}
+
+	r.departments[department.ID().String()] = department
+	return nil
+}
+
+// Delete 部署を削除
+func (r *MemoryDepartmentRepository) Delete(ctx context.Context, id *valueobject.DepartmentID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.departments[id.String()]; !exists {
+		return errors.NewDomainError(errors.DepartmentNotFound, "部署が見つかりません")
+	}
+
+	delete(r.departments, id.String())
+	return nil
+}
@@ -0,0 +1,99 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sync"
+)
+
+// MemoryExpenseReportRepository メモリベースの経費レポートリポジトリ実装
+type MemoryExpenseReportRepository struct {
+	mu      sync.RWMutex
+	reports map[string]*entity.ExpenseReport
+}
+
+// NewMemoryExpenseReportRepository MemoryExpenseReportRepositoryのコンストラクタ
+func NewMemoryExpenseReportRepository() *MemoryExpenseReportRepository {
+	return &MemoryExpenseReportRepository{
+		reports: make(map[string]*entity.ExpenseReport),
+	}
+}
+
+// Save 経費レポートを保存
+func (r *MemoryExpenseReportRepository) Save(ctx context.Context, report *entity.ExpenseReport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reports[report.ID().String()] = report
+	return nil
+}
+
+// FindByID IDで経費レポートを検索
+func (r *MemoryExpenseReportRepository) FindByID(ctx context.Context, id *valueobject.ExpenseReportID) (*entity.ExpenseReport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	report, exists := r.reports[id.String()]
+	if !exists {
+		return nil, errors.NewDomainError(errors.ExpenseReportNotFound, "経費レポートが見つかりません")
+	}
+
+	return report, nil
+}
+
+// FindByOwnerID 所有者のユーザーIDで経費レポートを検索
+func (r *MemoryExpenseReportRepository) FindByOwnerID(ctx context.Context, ownerID *valueobject.UserID) ([]*entity.ExpenseReport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reports := make([]*entity.ExpenseReport, 0)
+	for _, report := range r.reports {
+		if report.OwnerID().Equals(ownerID) {
+			reports = append(reports, report)
+		}
+	}
+
+	return reports, nil
+}
+
+// FindByExpenseID 経費を含む経費レポートを検索
+func (r *MemoryExpenseReportRepository) FindByExpenseID(ctx context.Context, expenseID *valueobject.ExpenseID) (*entity.ExpenseReport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, report := range r.reports {
+		if report.Contains(expenseID) {
+			return report, nil
+		}
+	}
+
+	return nil, errors.NewDomainError(errors.ExpenseReportNotFound, "経費レポートが見つかりません")
+}
+
+// Update 経費レポートを更新
+func (r *MemoryExpenseReportRepository) Update(ctx context.Context, report *entity.ExpenseReport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.reports[report.ID().String()]; !exists {
+		return errors.NewDomainError(errors.ExpenseReportNotFound, "経費レポートが見つかりません")
+	}
+
+	r.reports[report.ID().String()] = report
+	return nil
+}
+
+// Delete 経費レポートを削除
+func (r *MemoryExpenseReportRepository) Delete(ctx context.Context, id *valueobject.ExpenseReportID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.reports[id.String()]; !exists {
+		return errors.NewDomainError(errors.ExpenseReportNotFound, "経費レポートが見つかりません")
+	}
+
+	delete(r.reports, id.String())
+	return nil
+}
@@ -3,10 +3,11 @@ package persistence
 import (
 	"context"
 	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/repository"
 	"expense-management-system/internal/domain/valueobject"
 	"expense-management-system/pkg/errors"
+	"sort"
 	"sync"
-	"time"
 )
 
 // MemoryExpenseRepository メモリベースの経費リポジトリ実装
@@ -31,6 +32,27 @@ func (r *MemoryExpenseRepository) Save(ctx context.Context, expense *entity.Expe
 	return nil
 }
 
+// SaveAll 複数の経費をまとめて保存（1件でも保存できない場合はいずれも保存しない）
+func (r *MemoryExpenseRepository) SaveAll(ctx context.Context, expenses []*entity.Expense) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	seen := make(map[string]bool)
+	for _, expense := range expenses {
+		id := expense.ID().String()
+		if _, exists := r.expenses[id]; exists || seen[id] {
+			return errors.NewDomainError("EXPENSE_ALREADY_EXISTS", "経費は既に存在します")
+		}
+		seen[id] = true
+	}
+
+	for _, expense := range expenses {
+		r.expenses[expense.ID().String()] = expense
+	}
+
+	return nil
+}
+
 // FindByID IDで経費を検索
 func (r *MemoryExpenseRepository) FindByID(ctx context.Context, id *valueobject.ExpenseID) (*entity.Expense, error) {
 	r.mu.RLock()
@@ -74,14 +96,44 @@ func (r *MemoryExpenseRepository) FindByUserIDAndStatus(ctx context.Context, use
 	return expenses, nil
 }
 
-// FindByCategoryID カテゴリIDで経費を検索
+// FindByStatus ステータスで経費を検索
+func (r *MemoryExpenseRepository) FindByStatus(ctx context.Context, status entity.ExpenseStatus) ([]*entity.Expense, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	expenses := make([]*entity.Expense, 0)
+	for _, expense := range r.expenses {
+		if expense.Status() == status {
+			expenses = append(expenses, expense)
+		}
+	}
+
+	return expenses, nil
+}
+
+// FindByCategoryID カテゴリIDで経費を検索（明細のカテゴリを含む）
 func (r *MemoryExpenseRepository) FindByCategoryID(ctx context.Context, categoryID *valueobject.CategoryID) ([]*entity.Expense, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	expenses := make([]*entity.Expense, 0)
 	for _, expense := range r.expenses {
-		if expense.CategoryID().Equals(categoryID) {
+		if expense.HasCategory(categoryID) {
+			expenses = append(expenses, expense)
+		}
+	}
+
+	return expenses, nil
+}
+
+// FindByTripRequestID 出張申請IDで経費を検索
+func (r *MemoryExpenseRepository) FindByTripRequestID(ctx context.Context, tripRequestID *valueobject.TripRequestID) ([]*entity.Expense, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	expenses := make([]*entity.Expense, 0)
+	for _, expense := range r.expenses {
+		if expense.TripRequestID() != nil && expense.TripRequestID().Equals(tripRequestID) {
 			expenses = append(expenses, expense)
 		}
 	}
@@ -89,8 +141,8 @@ func (r *MemoryExpenseRepository) FindByCategoryID(ctx context.Context, category
 	return expenses, nil
 }
 
-// FindByDateRange 日付範囲で経費を検索
-func (r *MemoryExpenseRepository) FindByDateRange(ctx context.Context, userID *valueobject.UserID, from, to time.Time) ([]*entity.Expense, error) {
+// FindByDateRange 経費日付が期間内（両端を含む）の経費を検索
+func (r *MemoryExpenseRepository) FindByDateRange(ctx context.Context, userID *valueobject.UserID, from, to valueobject.Date) ([]*entity.Expense, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -98,8 +150,7 @@ func (r *MemoryExpenseRepository) FindByDateRange(ctx context.Context, userID *v
 	for _, expense := range r.expenses {
 		if expense.UserID().Equals(userID) {
 			expenseDate := expense.Date()
-			if (expenseDate.Equal(from) || expenseDate.After(from)) &&
-				(expenseDate.Equal(to) || expenseDate.Before(to)) {
+			if !expenseDate.Before(from) && !expenseDate.After(to) {
 				expenses = append(expenses, expense)
 			}
 		}
@@ -108,6 +159,60 @@ func (r *MemoryExpenseRepository) FindByDateRange(ctx context.Context, userID *v
 	return expenses, nil
 }
 
+// Iterate 条件に一致する経費を日付の順に1件ずつ処理する（fnがエラーを返した場合は中断）
+func (r *MemoryExpenseRepository) Iterate(ctx context.Context, filter repository.ExpenseFilter, fn func(expense *entity.Expense) error) error {
+	r.mu.RLock()
+	expenses := make([]*entity.Expense, 0)
+	for _, expense := range r.expenses {
+		if matchesExpenseFilter(expense, filter) {
+			expenses = append(expenses, expense)
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(expenses, func(i, j int) bool {
+		if !expenses[i].Date().Equals(expenses[j].Date()) {
+			return expenses[i].Date().Before(expenses[j].Date())
+		}
+		return expenses[i].ID().String() < expenses[j].ID().String()
+	})
+
+	// 処理中はロックを保持しない（fnが出力に時間をかけても他の操作を妨げない）
+	for _, expense := range expenses {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(expense); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// matchesExpenseFilter 経費が検索条件に一致するかどうか
+func matchesExpenseFilter(expense *entity.Expense, filter repository.ExpenseFilter) bool {
+	if filter.UserID != nil && !expense.UserID().Equals(filter.UserID) {
+		return false
+	}
+	if filter.CategoryID != nil && !expense.HasCategory(filter.CategoryID) {
+		return false
+	}
+	if filter.Status != "" && expense.Status() != filter.Status {
+		return false
+	}
+	if !filter.DateFrom.IsZero() && expense.Date().Before(filter.DateFrom) {
+		return false
+	}
+	if !filter.DateTo.IsZero() && expense.Date().After(filter.DateTo) {
+		return false
+	}
+	if filter.ReceiptHash != "" && expense.ReceiptHash() != filter.ReceiptHash {
+		return false
+	}
+	return true
+}
+
 // FindAll 全ての経費を取得
 func (r *MemoryExpenseRepository) FindAll(ctx context.Context) ([]*entity.Expense, error) {
 	r.mu.RLock()
@@ -134,6 +239,24 @@ func (r *MemoryExpenseRepository) Update(ctx context.Context, expense *entity.Ex
 	return nil
 }
 
+// UpdateAll 複数の経費をまとめて更新（1件でも存在しない場合はいずれも更新しない）
+func (r *MemoryExpenseRepository) UpdateAll(ctx context.Context, expenses []*entity.Expense) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, expense := range expenses {
+		if _, exists := r.expenses[expense.ID().String()]; !exists {
+			return errors.NewDomainError(errors.ExpenseNotFound, "経費が見つかりません")
+		}
+	}
+
+	for _, expense := range expenses {
+		r.expenses[expense.ID().String()] = expense
+	}
+
+	return nil
+}
+
 // Delete 経費を削除
 func (r *MemoryExpenseRepository) Delete(ctx context.Context, id *valueobject.ExpenseID) error {
 	r.mu.Lock()
@@ -0,0 +1,82 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sort"
+	"sync"
+)
+
+// MemoryJournalEntryRepository メモリベースの仕訳リポジトリ実装
+type MemoryJournalEntryRepository struct {
+	mu      sync.RWMutex
+	entries map[string]*entity.JournalEntry
+}
+
+// NewMemoryJournalEntryRepository MemoryJournalEntryRepositoryのコンストラクタ
+func NewMemoryJournalEntryRepository() *MemoryJournalEntryRepository {
+	return &MemoryJournalEntryRepository{
+		entries: make(map[string]*entity.JournalEntry),
+	}
+}
+
+// Save 仕訳を保存
+func (r *MemoryJournalEntryRepository) Save(ctx context.Context, entry *entity.JournalEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries[entry.ID().String()] = entry
+	return nil
+}
+
+// FindByID IDで仕訳を検索
+func (r *MemoryJournalEntryRepository) FindByID(ctx context.Context, id *valueobject.JournalEntryID) (*entity.JournalEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entry, exists := r.entries[id.String()]
+	if !exists {
+		return nil, errors.NewDomainError(errors.JournalEntryNotFound, "仕訳が見つかりません")
+	}
+
+	return entry, nil
+}
+
+// FindBySource 発生元で仕訳を検索
+func (r *MemoryJournalEntryRepository) FindBySource(ctx context.Context, source entity.JournalEntrySource, sourceID string) (*entity.JournalEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, entry := range r.entries {
+		if entry.Source() == source && entry.SourceID() == sourceID {
+			return entry, nil
+		}
+	}
+
+	return nil, errors.NewDomainError(errors.JournalEntryNotFound, "仕訳が見つかりません")
+}
+
+// FindByDateRange 計上日が期間内（両端を含む）の仕訳を計上日の順に検索
+func (r *MemoryJournalEntryRepository) FindByDateRange(ctx context.Context, from, to valueobject.Date) ([]*entity.JournalEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]*entity.JournalEntry, 0)
+	for _, entry := range r.entries {
+		if entry.Date().Before(from) || entry.Date().After(to) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].Date().Equals(entries[j].Date()) {
+			return entries[i].Date().Before(entries[j].Date())
+		}
+		return entries[i].CreatedAt().Before(entries[j].CreatedAt())
+	})
+
+	return entries, nil
+}
@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/pkg/errors"
+	"sync"
+)
+
+// MemoryPerDiemRateRepository メモリベースの日当単価リポジトリ実装
+type MemoryPerDiemRateRepository struct {
+	mu    sync.RWMutex
+	rates map[string]*entity.PerDiemRate
+}
+
+// NewMemoryPerDiemRateRepository MemoryPerDiemRateRepositoryのコンストラクタ
+func NewMemoryPerDiemRateRepository() *MemoryPerDiemRateRepository {
+	return &MemoryPerDiemRateRepository{
+		rates: make(map[string]*entity.PerDiemRate),
+	}
+}
+
+// Save 日当単価を保存（同じ地域区分と職能等級の単価は上書き）
+func (r *MemoryPerDiemRateRepository) Save(ctx context.Context, rate *entity.PerDiemRate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rates[perDiemRateKey(rate.DestinationClass(), rate.Grade())] = rate
+	return nil
+}
+
+// FindByClassAndGrade 地域区分と職能等級で日当単価を検索
+func (r *MemoryPerDiemRateRepository) FindByClassAndGrade(ctx context.Context, destinationClass, grade string) (*entity.PerDiemRate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rate, exists := r.rates[perDiemRateKey(destinationClass, grade)]
+	if !exists {
+		return nil, errors.NewDomainError(errors.PerDiemRateNotFound, "日当単価が見つかりません")
+	}
+
+	return rate, nil
+}
+
+// FindAll 全ての日当単価を取得
+func (r *MemoryPerDiemRateRepository) FindAll(ctx context.Context) ([]*entity.PerDiemRate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rates := make([]*entity.PerDiemRate, 0, len(r.rates))
+	for _, rate := range r.rates {
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+// Delete 日当単価を削除
+func (r *MemoryPerDiemRateRepository) Delete(ctx context.Context, destinationClass, grade string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := perDiemRateKey(destinationClass, grade)
+	if _, exists := r.rates[key]; !exists {
+		return errors.NewDomainError(errors.PerDiemRateNotFound, "日当単価が見つかりません")
+	}
+
+	delete(r.rates, key)
+	return nil
+}
+
+// perDiemRateKey 地域区分と職能等級からマップのキーを生成
+func perDiemRateKey(destinationClass, grade string) string {
+	return destinationClass + "\x00" + grade
+}
@@ -0,0 +1,101 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sort"
+	"sync"
+)
+
+// MemoryProjectRepository メモリベースのプロジェクトリポジトリ実装
+type MemoryProjectRepository struct {
+	mu       sync.RWMutex
+	projects map[string]*entity.Project
+}
+
+// NewMemoryProjectRepository MemoryProjectRepositoryのコンストラクタ
+func NewMemoryProjectRepository() *MemoryProjectRepository {
+	return &MemoryProjectRepository{
+		projects: make(map[string]*entity.Project),
+	}
+}
+
+// Save プロジェクトを保存
+func (r *MemoryProjectRepository) Save(ctx context.Context, project *entity.Project) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.projects[project.ID().String()] = project
+	return nil
+}
+
+// FindByID IDでプロジェクトを検索
+func (r *MemoryProjectRepository) FindByID(ctx context.Context, id *valueobject.ProjectID) (*entity.Project, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	project, exists := r.projects[id.String()]
+	if !exists {
+		return nil, errors.NewDomainError(errors.ProjectNotFound, "プロジェクトが見つかりません")
+	}
+
+	return project, nil
+}
+
+// FindByCode プロジェクトコードでプロジェクトを検索
+func (r *MemoryProjectRepository) FindByCode(ctx context.Context, code string) (*entity.Project, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, project := range r.projects {
+		if project.Code() == code {
+			return project, nil
+		}
+	}
+
+	return nil, errors.NewDomainError(errors.ProjectNotFound, "プロジェクトが見つかりません")
+}
+
+// FindAll 全てのプロジェクトを取得（プロジェクトコードの順）
+func (r *MemoryProjectRepository) FindAll(ctx context.Context) ([]*entity.Project, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	projects := make([]*entity.Project, 0, len(r.projects))
+	for _, project := range r.projects {
+		projects = append(projects, project)
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].Code() < projects[j].Code()
+	})
+
+	return projects, nil
+}
+
+// Update プロジェクトを更新
+func (r *MemoryProjectRepository) Update(ctx context.Context, project *entity.Project) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.projects[project.ID().String()]; !exists {
+		return errors.NewDomainError(errors.ProjectNotFound, "プロジェクトが見つかりません")
+	}
+
+	r.projects[project.ID().String()] = project
+	return nil
+}
+
+// Delete プロジェクトを削除
+func (r *MemoryProjectRepository) Delete(ctx context.Context, id *valueobject.ProjectID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.projects[id.String()]; !exists {
+		return errors.NewDomainError(errors.ProjectNotFound, "プロジェクトが見つかりません")
+	}
+
+	delete(r.projects, id.String())
+	return nil
+}
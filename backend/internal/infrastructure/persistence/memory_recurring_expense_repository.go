@@ -0,0 +1,108 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sort"
+	"sync"
+)
+
+// MemoryRecurringExpenseRepository メモリベースの定期経費リポジトリ実装
+type MemoryRecurringExpenseRepository struct {
+	mu         sync.RWMutex
+	recurrings map[string]*entity.RecurringExpense
+}
+
+// NewMemoryRecurringExpenseRepository MemoryRecurringExpenseRepositoryのコンストラクタ
+func NewMemoryRecurringExpenseRepository() *MemoryRecurringExpenseRepository {
+	return &MemoryRecurringExpenseRepository{
+		recurrings: make(map[string]*entity.RecurringExpense),
+	}
+}
+
+// Save 定期経費を保存
+func (r *MemoryRecurringExpenseRepository) Save(ctx context.Context, recurring *entity.RecurringExpense) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.recurrings[recurring.ID().String()] = recurring
+	return nil
+}
+
+// FindByID IDで定期経費を検索
+func (r *MemoryRecurringExpenseRepository) FindByID(ctx context.Context, id *valueobject.RecurringExpenseID) (*entity.RecurringExpense, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	recurring, exists := r.recurrings[id.String()]
+	if !exists {
+		return nil, errors.NewDomainError(errors.RecurringExpenseNotFound, "定期経費が見つかりません")
+	}
+
+	return recurring, nil
+}
+
+// FindByUserID ユーザーIDで定期経費を検索（作成日時の順）
+func (r *MemoryRecurringExpenseRepository) FindByUserID(ctx context.Context, userID *valueobject.UserID) ([]*entity.RecurringExpense, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	recurrings := make([]*entity.RecurringExpense, 0)
+	for _, recurring := range r.recurrings {
+		if recurring.UserID().Equals(userID) {
+			recurrings = append(recurrings, recurring)
+		}
+	}
+	sortRecurringExpenses(recurrings)
+
+	return recurrings, nil
+}
+
+// FindAll 全ての定期経費を取得（作成日時の順）
+func (r *MemoryRecurringExpenseRepository) FindAll(ctx context.Context) ([]*entity.RecurringExpense, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	recurrings := make([]*entity.RecurringExpense, 0, len(r.recurrings))
+	for _, recurring := range r.recurrings {
+		recurrings = append(recurrings, recurring)
+	}
+	sortRecurringExpenses(recurrings)
+
+	return recurrings, nil
+}
+
+// Update 定期経費を更新
+func (r *MemoryRecurringExpenseRepository) Update(ctx context.Context, recurring *entity.RecurringExpense) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.recurrings[recurring.ID().String()]; !exists {
+		return errors.NewDomainError(errors.RecurringExpenseNotFound, "定期経費が見つかりません")
+	}
+
+	r.recurrings[recurring.ID().String()] = recurring
+	return nil
+}
+
+// Delete 定期経費を削除
+func (r *MemoryRecurringExpenseRepository) Delete(ctx context.Context, id *valueobject.RecurringExpenseID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.recurrings[id.String()]; !exists {
+		return errors.NewDomainError(errors.RecurringExpenseNotFound, "定期経費が見つかりません")
+	}
+
+	delete(r.recurrings, id.String())
+	return nil
+}
+
+// sortRecurringExpenses 定期経費を作成日時の順に並べる
+func sortRecurringExpenses(recurrings []*entity.RecurringExpense) {
+	sort.Slice(recurrings, func(i, j int) bool {
+		return recurrings[i].CreatedAt().Before(recurrings[j].CreatedAt())
+	})
+}
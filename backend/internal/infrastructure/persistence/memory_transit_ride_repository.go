@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sync"
+)
+
+// MemoryTransitRideRepository メモリベースの乗車記録リポジトリ実装
+type MemoryTransitRideRepository struct {
+	mu    sync.RWMutex
+	rides map[string]*entity.TransitRide
+}
+
+// NewMemoryTransitRideRepository MemoryTransitRideRepositoryのコンストラクタ
+func NewMemoryTransitRideRepository() *MemoryTransitRideRepository {
+	return &MemoryTransitRideRepository{
+		rides: make(map[string]*entity.TransitRide),
+	}
+}
+
+// Save 乗車記録を保存
+func (r *MemoryTransitRideRepository) Save(ctx context.Context, ride *entity.TransitRide) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rides[transitRideKey(ride.UserID(), ride.Hash())] = ride
+	return nil
+}
+
+// FindByHash ユーザーIDと履歴行の識別子で乗車記録を検索
+func (r *MemoryTransitRideRepository) FindByHash(ctx context.Context, userID *valueobject.UserID, hash string) (*entity.TransitRide, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ride, exists := r.rides[transitRideKey(userID, hash)]
+	if !exists {
+		return nil, errors.NewDomainError(errors.TransitRideNotFound, "乗車記録が見つかりません")
+	}
+
+	return ride, nil
+}
+
+// transitRideKey ユーザーIDと履歴行の識別子からマップのキーを生成
+func transitRideKey(userID *valueobject.UserID, hash string) string {
+	return userID.String() + "\x00" + hash
+}
@@ -0,0 +1,85 @@
+package persistence
+
+import (
+	"context"
+	"expense-management-system/internal/domain/entity"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/pkg/errors"
+	"sync"
+)
+
+// MemoryTripRequestRepository メモリベースの出張申請リポジトリ実装
+type MemoryTripRequestRepository struct {
+	mu           sync.RWMutex
+	tripRequests map[string]*entity.TripRequest
+}
+
+// NewMemoryTripRequestRepository MemoryTripRequestRepositoryのコンストラクタ
+func NewMemoryTripRequestRepository() *MemoryTripRequestRepository {
+	return &MemoryTripRequestRepository{
+		tripRequests: make(map[string]*entity.TripRequest),
+	}
+}
+
+// Save 出張申請を保存
+func (r *MemoryTripRequestRepository) Save(ctx context.Context, tripRequest *entity.TripRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tripRequests[tripRequest.ID().String()] = tripRequest
+	return nil
+}
+
+// FindByID IDで出張申請を検索
+func (r *MemoryTripRequestRepository) FindByID(ctx context.Context, id *valueobject.TripRequestID) (*entity.TripRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tripRequest, exists := r.tripRequests[id.String()]
+	if !exists {
+		return nil, errors.NewDomainError(errors.TripRequestNotFound, "出張申請が見つかりません")
+	}
+
+	return tripRequest, nil
+}
+
+// FindByUserID ユーザーIDで出張申請を検索
+func (r *MemoryTripRequestRepository) FindByUserID(ctx context.Context, userID *valueobject.UserID) ([]*entity.TripRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tripRequests := make([]*entity.TripRequest, 0)
+	for _, tripRequest := range r.tripRequests {
+		if tripRequest.UserID().Equals(userID) {
+			tripRequests = append(tripRequests, tripRequest)
+		}
+	}
+
+	return tripRequests, nil
+}
+
+// Update 出張申請を更新
+func (r *MemoryTripRequestRepository) Update(ctx context.Context, tripRequest *entity.TripRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.tripRequests[tripRequest.ID().String()]; !exists {
+		return errors.NewDomainError(errors.TripRequestNotFound, "出張申請が見つかりません")
+	}
+
+	r.tripRequests[tripRequest.ID().String()] = tripRequest
+	return nil
+}
+
+// Delete 出張申請を削除
+func (r *MemoryTripRequestRepository) Delete(ctx context.Context, id *valueobject.TripRequestID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.tripRequests[id.String()]; !exists {
+		return errors.NewDomainError(errors.TripRequestNotFound, "出張申請が見つかりません")
+	}
+
+	delete(r.tripRequests, id.String())
+	return nil
+}
@@ -0,0 +1,133 @@
+// Package scheduler プロセス内で定期ジョブを実行するスケジューラを提供
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job 定期実行するジョブ
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler 登録されたジョブを一定間隔で実行するスケジューラ
+type Scheduler struct {
+	mu      sync.Mutex
+	jobs    []Job
+	logger  *log.Logger
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	running bool
+}
+
+// NewScheduler Schedulerのコンストラクタ
+func NewScheduler(logger *log.Logger) *Scheduler {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return &Scheduler{
+		logger: logger,
+	}
+}
+
+// Register ジョブを登録（開始前のみ）
+func (s *Scheduler) Register(job Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return fmt.Errorf("scheduler is already running")
+	}
+
+	if job.Run == nil {
+		return fmt.Errorf("job %s has no run function", job.Name)
+	}
+
+	if job.Interval <= 0 {
+		return fmt.Errorf("job %s must have a positive interval", job.Name)
+	}
+
+	s.jobs = append(s.jobs, job)
+	return nil
+}
+
+// Start 登録済みのジョブの実行を開始
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.running = true
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+}
+
+// Stop ジョブを停止し、実行中のジョブの完了を待つ
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return nil
+	}
+	s.cancel()
+	s.running = false
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("scheduler stop timed out: %w", ctx.Err())
+	}
+}
+
+// loop ジョブを一定間隔で実行
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runJob(ctx, job)
+		}
+	}
+}
+
+// runJob ジョブを1回実行（panicはログに記録して継続）
+func (s *Scheduler) runJob(ctx context.Context, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Printf("⚠️ job %s panicked: %v", job.Name, r)
+		}
+	}()
+
+	if err := job.Run(ctx); err != nil {
+		s.logger.Printf("⚠️ job %s failed: %v", job.Name, err)
+	}
+}
@@ -0,0 +1,116 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_Register(t *testing.T) {
+	s := NewScheduler(nil)
+	run := func(ctx context.Context) error { return nil }
+
+	assert.Error(t, s.Register(Job{Name: "no-run", Interval: time.Second}), "実行関数なし")
+	assert.Error(t, s.Register(Job{Name: "no-interval", Run: run}), "実行間隔なし")
+	require.NoError(t, s.Register(Job{Name: "job", Interval: time.Second, Run: run}))
+
+	s.Start()
+	defer s.Stop(context.Background())
+	assert.Error(t, s.Register(Job{Name: "late", Interval: time.Second, Run: run}), "開始後は登録できない")
+}
+
+func TestScheduler_StartStop(t *testing.T) {
+	s := NewScheduler(nil)
+
+	var runs atomic.Int32
+	require.NoError(t, s.Register(Job{
+		Name:     "counter",
+		Interval: 5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	}))
+
+	s.Start()
+	s.Start() // 二重に開始しても実行ループは1つ
+	assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+
+	require.NoError(t, s.Stop(context.Background()))
+	require.NoError(t, s.Stop(context.Background()), "停止済みの場合は何もしない")
+
+	// 停止後はジョブを実行しない
+	stopped := runs.Load()
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, stopped, runs.Load())
+}
+
+func TestScheduler_RecoversFromPanic(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewScheduler(log.New(&buf, "", 0))
+
+	var runs atomic.Int32
+	require.NoError(t, s.Register(Job{
+		Name:     "panicky",
+		Interval: 5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			runs.Add(1)
+			panic("boom")
+		},
+	}))
+
+	// panicしても次の間隔で再び実行される
+	s.Start()
+	assert.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, time.Millisecond)
+	require.NoError(t, s.Stop(context.Background()))
+
+	assert.Contains(t, buf.String(), "job panicky panicked: boom")
+}
+
+func TestScheduler_StopTimeout(t *testing.T) {
+	s := NewScheduler(log.New(&bytes.Buffer{}, "", 0))
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var once atomic.Bool
+	require.NoError(t, s.Register(Job{
+		Name:     "slow",
+		Interval: 5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			if once.CompareAndSwap(false, true) {
+				close(started)
+			}
+			// キャンセルを無視して実行を続けるジョブ
+			<-release
+			return nil
+		},
+	}))
+
+	s.Start()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := s.Stop(ctx)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// ジョブが完了すれば実行ループも終了する
+	close(release)
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job loop did not exit after the job finished")
+	}
+}
@@ -0,0 +1,271 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdvanceHandler 仮払金ハンドラー
+type AdvanceHandler struct {
+	advanceUseCase *usecase.AdvanceUseCase
+}
+
+// NewAdvanceHandler AdvanceHandlerのコンストラクタ
+func NewAdvanceHandler(advanceUseCase *usecase.AdvanceUseCase) *AdvanceHandler {
+	return &AdvanceHandler{
+		advanceUseCase: advanceUseCase,
+	}
+}
+
+// CreateAdvance 仮払金作成
+// @Summary 仮払金作成
+// @Description 仮払金の申請を下書きで作成します
+// @Tags advances
+// @Accept json
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Param advance body dto.CreateAdvanceRequest true "仮払金作成リクエスト"
+// @Success 201 {object} dto.AdvanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/{id}/advances [post]
+func (h *AdvanceHandler) CreateAdvance(c *gin.Context) {
+	userID := c.Param("id")
+
+	var req dto.CreateAdvanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	advance, err := h.advanceUseCase.CreateAdvance(c.Request.Context(), userID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, advance)
+}
+
+// GetAdvancesByUser ユーザーの仮払金一覧取得
+// @Summary ユーザーの仮払金一覧取得
+// @Description 指定されたユーザーの仮払金一覧を取得します
+// @Tags advances
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Success 200 {array} dto.AdvanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/{id}/advances [get]
+func (h *AdvanceHandler) GetAdvancesByUser(c *gin.Context) {
+	userID := c.Param("id")
+
+	advances, err := h.advanceUseCase.GetAdvancesByUser(c.Request.Context(), userID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, advances)
+}
+
+// GetAdvance 仮払金取得
+// @Summary 仮払金取得
+// @Description 指定されたIDの仮払金を取得します
+// @Tags advances
+// @Produce json
+// @Param id path string true "仮払金ID"
+// @Success 200 {object} dto.AdvanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /advances/{id} [get]
+func (h *AdvanceHandler) GetAdvance(c *gin.Context) {
+	advanceID := c.Param("id")
+
+	advance, err := h.advanceUseCase.GetAdvance(c.Request.Context(), advanceID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, advance)
+}
+
+// UpdateAdvance 仮払金更新
+// @Summary 仮払金更新
+// @Description 下書き状態の仮払金を更新します
+// @Tags advances
+// @Accept json
+// @Produce json
+// @Param id path string true "仮払金ID"
+// @Param advance body dto.UpdateAdvanceRequest true "仮払金更新リクエスト"
+// @Success 200 {object} dto.AdvanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /advances/{id} [put]
+func (h *AdvanceHandler) UpdateAdvance(c *gin.Context) {
+	advanceID := c.Param("id")
+
+	var req dto.UpdateAdvanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	advance, err := h.advanceUseCase.UpdateAdvance(c.Request.Context(), advanceID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, advance)
+}
+
+// DeleteAdvance 仮払金削除
+// @Summary 仮払金削除
+// @Description 指定されたIDの仮払金を削除します（下書きまたは却下のみ）
+// @Tags advances
+// @Param id path string true "仮払金ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /advances/{id} [delete]
+func (h *AdvanceHandler) DeleteAdvance(c *gin.Context) {
+	advanceID := c.Param("id")
+
+	err := h.advanceUseCase.DeleteAdvance(c.Request.Context(), advanceID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SubmitAdvance 仮払金申請
+// @Summary 仮払金申請
+// @Description 仮払金を申請状態に変更します
+// @Tags advances
+// @Param id path string true "仮払金ID"
+// @Success 200 {object} dto.AdvanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /advances/{id}/submit [post]
+func (h *AdvanceHandler) SubmitAdvance(c *gin.Context) {
+	advanceID := c.Param("id")
+
+	advance, err := h.advanceUseCase.SubmitAdvance(c.Request.Context(), advanceID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, advance)
+}
+
+// ApproveAdvance 仮払金承認
+// @Summary 仮払金承認
+// @Description 仮払金を承認状態に変更します
+// @Tags advances
+// @Param id path string true "仮払金ID"
+// @Success 200 {object} dto.AdvanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /advances/{id}/approve [post]
+func (h *AdvanceHandler) ApproveAdvance(c *gin.Context) {
+	advanceID := c.Param("id")
+
+	advance, err := h.advanceUseCase.ApproveAdvance(c.Request.Context(), advanceID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, advance)
+}
+
+// RejectAdvance 仮払金却下
+// @Summary 仮払金却下
+// @Description 仮払金を却下状態に変更します
+// @Tags advances
+// @Param id path string true "仮払金ID"
+// @Success 200 {object} dto.AdvanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /advances/{id}/reject [post]
+func (h *AdvanceHandler) RejectAdvance(c *gin.Context) {
+	advanceID := c.Param("id")
+
+	advance, err := h.advanceUseCase.RejectAdvance(c.Request.Context(), advanceID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, advance)
+}
+
+// GetOpenAdvancesByUser ユーザーの未精算の仮払金一覧取得
+// @Summary ユーザーの未精算の仮払金一覧取得
+// @Description 承認済みで未精算の仮払金を取得します
+// @Tags advances
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Success 200 {object} dto.OpenAdvancesResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/{id}/advances/open [get]
+func (h *AdvanceHandler) GetOpenAdvancesByUser(c *gin.Context) {
+	userID := c.Param("id")
+
+	result, err := h.advanceUseCase.GetOpenAdvancesByUser(c.Request.Context(), userID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// SettleAdvance 仮払金精算
+// @Summary 仮払金精算
+// @Description 承認済みの経費・経費レポートで仮払金を精算し、返金額または追加支払額を計算します
+// @Tags advances
+// @Accept json
+// @Produce json
+// @Param id path string true "仮払金ID"
+// @Param settlement body dto.SettleAdvanceRequest true "仮払金精算リクエスト"
+// @Success 200 {object} dto.AdvanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /advances/{id}/settle [post]
+func (h *AdvanceHandler) SettleAdvance(c *gin.Context) {
+	advanceID := c.Param("id")
+
+	var req dto.SettleAdvanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	advance, err := h.advanceUseCase.SettleAdvance(c.Request.Context(), advanceID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, advance)
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BudgetHandler 予算ハンドラー
+type BudgetHandler struct {
+	budgetUseCase *usecase.BudgetUseCase
+}
+
+// NewBudgetHandler BudgetHandlerのコンストラクタ
+func NewBudgetHandler(budgetUseCase *usecase.BudgetUseCase) *BudgetHandler {
+	return &BudgetHandler{
+		budgetUseCase: budgetUseCase,
+	}
+}
+
+// CreateBudget 予算作成
+// @Summary 予算作成
+// @Description カテゴリ・ユーザー・部署を対象とする期間の予算を作成します
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param budget body dto.CreateBudgetRequest true "予算作成リクエスト"
+// @Success 201 {object} dto.BudgetResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /budgets [post]
+func (h *BudgetHandler) CreateBudget(c *gin.Context) {
+	var req dto.CreateBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	budget, err := h.budgetUseCase.CreateBudget(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, budget)
+}
+
+// GetBudget 予算取得
+// @Summary 予算取得
+// @Description 指定されたIDの予算を取得します
+// @Tags budgets
+// @Produce json
+// @Param id path string true "予算ID"
+// @Success 200 {object} dto.BudgetResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /budgets/{id} [get]
+func (h *BudgetHandler) GetBudget(c *gin.Context) {
+	budget, err := h.budgetUseCase.GetBudget(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, budget)
+}
+
+// GetAllBudgets 予算一覧取得
+// @Summary 予算一覧取得
+// @Description 全ての予算を期間の開始日順に取得します
+// @Tags budgets
+// @Produce json
+// @Success 200 {array} dto.BudgetResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /budgets [get]
+func (h *BudgetHandler) GetAllBudgets(c *gin.Context) {
+	budgets, err := h.budgetUseCase.GetAllBudgets(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, budgets)
+}
+
+// UpdateBudget 予算更新
+// @Summary 予算更新
+// @Description 指定されたIDの予算を更新します
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param id path string true "予算ID"
+// @Param budget body dto.UpdateBudgetRequest true "予算更新リクエスト"
+// @Success 200 {object} dto.BudgetResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /budgets/{id} [put]
+func (h *BudgetHandler) UpdateBudget(c *gin.Context) {
+	var req dto.UpdateBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	budget, err := h.budgetUseCase.UpdateBudget(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, budget)
+}
+
+// DeleteBudget 予算削除
+// @Summary 予算削除
+// @Description 指定されたIDの予算を削除します
+// @Tags budgets
+// @Param id path string true "予算ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /budgets/{id} [delete]
+func (h *BudgetHandler) DeleteBudget(c *gin.Context) {
+	if err := h.budgetUseCase.DeleteBudget(c.Request.Context(), c.Param("id")); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetBudgetStatus 予算の消化状況取得
+// @Summary 予算の消化状況取得
+// @Description 指定されたIDの予算の申請中・承認済みの金額と残額を取得します
+// @Tags budgets
+// @Produce json
+// @Param id path string true "予算ID"
+// @Success 200 {object} dto.BudgetStatusResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /budgets/{id}/status [get]
+func (h *BudgetHandler) GetBudgetStatus(c *gin.Context) {
+	status, err := h.budgetUseCase.GetBudgetStatus(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// GetBudgetStatuses 予算の消化状況一覧取得
+// @Summary 予算の消化状況一覧取得
+// @Description 期間に指定日を含む予算の消化状況を取得します
+// @Tags budgets
+// @Produce json
+// @Param date query string false "基準日（YYYY-MM-DD、省略時は今日）"
+// @Success 200 {array} dto.BudgetStatusResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /budgets/status [get]
+func (h *BudgetHandler) GetBudgetStatuses(c *gin.Context) {
+	var req dto.BudgetStatusRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	statuses, err := h.budgetUseCase.GetBudgetStatuses(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, statuses)
+}
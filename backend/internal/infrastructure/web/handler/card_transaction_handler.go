@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CardTransactionHandler 法人カード利用明細ハンドラー
+type CardTransactionHandler struct {
+	cardTransactionUseCase *usecase.CardTransactionUseCase
+}
+
+// NewCardTransactionHandler CardTransactionHandlerのコンストラクタ
+func NewCardTransactionHandler(cardTransactionUseCase *usecase.CardTransactionUseCase) *CardTransactionHandler {
+	return &CardTransactionHandler{
+		cardTransactionUseCase: cardTransactionUseCase,
+	}
+}
+
+// ImportStatement カード利用明細取込
+// @Summary カード利用明細取込
+// @Description カード会社の利用明細（CSV・OFX）を取り込みます
+// @Tags card-transactions
+// @Accept json
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Param statement body dto.ImportCardStatementRequest true "カード利用明細取込リクエスト"
+// @Success 201 {object} dto.ImportCardStatementResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/{id}/card-transactions/import [post]
+func (h *CardTransactionHandler) ImportStatement(c *gin.Context) {
+	userID := c.Param("id")
+
+	var req dto.ImportCardStatementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.cardTransactionUseCase.ImportStatement(c.Request.Context(), userID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetCardTransactionsByUser ユーザーのカード利用明細一覧取得
+// @Summary ユーザーのカード利用明細一覧取得
+// @Description 指定されたユーザーのカード利用明細を利用日の順に取得します
+// @Tags card-transactions
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Success 200 {array} dto.CardTransactionResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/{id}/card-transactions [get]
+func (h *CardTransactionHandler) GetCardTransactionsByUser(c *gin.Context) {
+	userID := c.Param("id")
+
+	transactions, err := h.cardTransactionUseCase.GetCardTransactionsByUser(c.Request.Context(), userID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, transactions)
+}
+
+// GetUnreconciledTransactions 未照合のカード利用明細一覧取得
+// @Summary 未照合のカード利用明細一覧取得
+// @Description 指定されたユーザーの経費と照合されていないカード利用明細を取得します
+// @Tags card-transactions
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Success 200 {object} dto.UnreconciledCardTransactionsResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/{id}/card-transactions/unreconciled [get]
+func (h *CardTransactionHandler) GetUnreconciledTransactions(c *gin.Context) {
+	userID := c.Param("id")
+
+	result, err := h.cardTransactionUseCase.GetUnreconciledTransactions(c.Request.Context(), userID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReconcileTransactions カード利用明細照合
+// @Summary カード利用明細照合
+// @Description 未照合のカード利用明細を金額・日付・利用先の類似度で経費と照合し、カテゴリが指定された場合は照合できなかった明細から下書きの経費を作成します
+// @Tags card-transactions
+// @Accept json
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Param request body dto.ReconcileCardTransactionsRequest false "カード利用明細照合リクエスト"
+// @Success 200 {object} dto.ReconcileCardTransactionsResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/{id}/card-transactions/reconcile [post]
+func (h *CardTransactionHandler) ReconcileTransactions(c *gin.Context) {
+	userID := c.Param("id")
+
+	var req dto.ReconcileCardTransactionsRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "INVALID_REQUEST",
+				Message: "リクエストの形式が正しくありません",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	result, err := h.cardTransactionUseCase.ReconcileTransactions(c.Request.Context(), userID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetCardTransaction カード利用明細取得
+// @Summary カード利用明細取得
+// @Description 指定されたIDのカード利用明細を取得します
+// @Tags card-transactions
+// @Produce json
+// @Param id path string true "カード利用明細ID"
+// @Success 200 {object} dto.CardTransactionResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /card-transactions/{id} [get]
+func (h *CardTransactionHandler) GetCardTransaction(c *gin.Context) {
+	transactionID := c.Param("id")
+
+	transaction, err := h.cardTransactionUseCase.GetCardTransaction(c.Request.Context(), transactionID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, transaction)
+}
+
+// MatchCardTransaction カード利用明細の手動照合
+// @Summary カード利用明細の手動照合
+// @Description カード利用明細を指定した経費と照合します
+// @Tags card-transactions
+// @Accept json
+// @Produce json
+// @Param id path string true "カード利用明細ID"
+// @Param request body dto.MatchCardTransactionRequest true "照合リクエスト"
+// @Success 200 {object} dto.CardTransactionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /card-transactions/{id}/match [post]
+func (h *CardTransactionHandler) MatchCardTransaction(c *gin.Context) {
+	transactionID := c.Param("id")
+
+	var req dto.MatchCardTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	transaction, err := h.cardTransactionUseCase.MatchCardTransaction(c.Request.Context(), transactionID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, transaction)
+}
+
+// UnmatchCardTransaction カード利用明細の照合解除
+// @Summary カード利用明細の照合解除
+// @Description カード利用明細と経費の照合を解除します
+// @Tags card-transactions
+// @Produce json
+// @Param id path string true "カード利用明細ID"
+// @Success 200 {object} dto.CardTransactionResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /card-transactions/{id}/unmatch [post]
+func (h *CardTransactionHandler) UnmatchCardTransaction(c *gin.Context) {
+	transactionID := c.Param("id")
+
+	transaction, err := h.cardTransactionUseCase.UnmatchCardTransaction(c.Request.Context(), transactionID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, transaction)
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CostCenterHandler コストセンターハンドラー
+type CostCenterHandler struct {
+	costCenterUseCase *usecase.CostCenterUseCase
+}
+
+// NewCostCenterHandler CostCenterHandlerのコンストラクタ
+func NewCostCenterHandler(costCenterUseCase *usecase.CostCenterUseCase) *CostCenterHandler {
+	return &CostCenterHandler{
+		costCenterUseCase: costCenterUseCase,
+	}
+}
+
+// CreateCostCenter コストセンター作成
+// @Summary コストセンター作成
+// @Description 部署に所属するコストセンターを作成します
+// @Tags cost-centers
+// @Accept json
+// @Produce json
+// @Param cost_center body dto.CreateCostCenterRequest true "コストセンター作成リクエスト"
+// @Success 201 {object} dto.CostCenterResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /cost-centers [post]
+func (h *CostCenterHandler) CreateCostCenter(c *gin.Context) {
+	var req dto.CreateCostCenterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	costCenter, err := h.costCenterUseCase.CreateCostCenter(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, costCenter)
+}
+
+// GetCostCenter コストセンター取得
+// @Summary コストセンター取得
+// @Description 指定されたIDのコストセンターを取得します
+// @Tags cost-centers
+// @Produce json
+// @Param id path string true "コストセンターID"
+// @Success 200 {object} dto.CostCenterResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /cost-centers/{id} [get]
+func (h *CostCenterHandler) GetCostCenter(c *gin.Context) {
+	costCenter, err := h.costCenterUseCase.GetCostCenter(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, costCenter)
+}
+
+// GetCostCenters コストセンター一覧取得
+// @Summary コストセンター一覧取得
+// @Description コストセンターをコード順に取得します（部署IDを指定した場合は所属するコストセンターのみ）
+// @Tags cost-centers
+// @Produce json
+// @Param department_id query string false "部署ID"
+// @Success 200 {array} dto.CostCenterResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /cost-centers [get]
+func (h *CostCenterHandler) GetCostCenters(c *gin.Context) {
+	var req dto.CostCenterListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	costCenters, err := h.costCenterUseCase.GetCostCenters(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, costCenters)
+}
+
+// UpdateCostCenter コストセンター更新
+// @Summary コストセンター更新
+// @Description 指定されたIDのコストセンターを更新します
+// @Tags cost-centers
+// @Accept json
+// @Produce json
+// @Param id path string true "コストセンターID"
+// @Param cost_center body dto.UpdateCostCenterRequest true "コストセンター更新リクエスト"
+// @Success 200 {object} dto.CostCenterResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /cost-centers/{id} [put]
+func (h *CostCenterHandler) UpdateCostCenter(c *gin.Context) {
+	var req dto.UpdateCostCenterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	costCenter, err := h.costCenterUseCase.UpdateCostCenter(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, costCenter)
+}
+
+// DeleteCostCenter コストセンター削除
+// @Summary コストセンター削除
+// @Description 指定されたIDのコストセンターを削除します（ユーザーの既定や経費の按分で使用されている場合は削除できません）
+// @Tags cost-centers
+// @Param id path string true "コストセンターID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /cost-centers/{id} [delete]
+func (h *CostCenterHandler) DeleteCostCenter(c *gin.Context) {
+	if err := h.costCenterUseCase.DeleteCostCenter(c.Request.Context(), c.Param("id")); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
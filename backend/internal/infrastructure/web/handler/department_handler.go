@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DepartmentHandler 部署ハンドラー
+type DepartmentHandler struct {
+	departmentUseCase *usecase.DepartmentUseCase
+}
+
+// NewDepartmentHandler DepartmentHandlerのコンストラクタ
+func NewDepartmentHandler(departmentUseCase *usecase.DepartmentUseCase) *DepartmentHandler {
+	return &DepartmentHandler{
+		departmentUseCase: departmentUseCase,
+	}
+}
+
+// CreateDepartment 部署作成
+// @Summary 部署作成
+// @Description 新しい部署を作成します
+// @Tags departments
+// @Accept json
+// @Produce json
+// @Param department body dto.CreateDepartmentRequest true "部署作成リクエスト"
+// @Success 201 {object} dto.DepartmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /departments [post]
+func (h *DepartmentHandler) CreateDepartment(c *gin.Context) {
+	var req dto.CreateDepartmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	department, err := h.departmentUseCase.CreateDepartment(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, department)
+}
+
+// GetDepartment 部署取得
+// @Summary 部署取得
+// @Description 指定されたIDの部署を取得します
+// @Tags departments
+// @Produce json
+// @Param id path string true "部署ID"
+// @Success 200 {object} dto.DepartmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /departments/{id} [get]
+func (h *DepartmentHandler) GetDepartment(c *gin.Context) {
+	department, err := h.departmentUseCase.GetDepartment(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, department)
+}
+
+// GetAllDepartments 部署一覧取得
+// @Summary 部署一覧取得
+// @Description 全ての部署を部署コード順に取得します
+// @Tags departments
+// @Produce json
+// @Success 200 {array} dto.DepartmentResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /departments [get]
+func (h *DepartmentHandler) GetAllDepartments(c *gin.Context) {
+	departments, err := h.departmentUseCase.GetAllDepartments(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, departments)
+}
+
+// UpdateDepartment 部署更新
+// @Summary 部署更新
+// @Description 指定されたIDの部署を更新します
+// @Tags departments
+// @Accept json
+// @Produce json
+// @Param id path string true "部署ID"
+// @Param department body dto.UpdateDepartmentRequest true "部署更新リクエスト"
+// @Success 200 {object} dto.DepartmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /departments/{id} [put]
+func (h *DepartmentHandler) UpdateDepartment(c *gin.Context) {
+	var req dto.UpdateDepartmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	department, err := h.departmentUseCase.UpdateDepartment(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, department)
+}
+
+// DeleteDepartment 部署削除
+// @Summary 部署削除
+// @Description 指定されたIDの部署を削除します（コストセンター・ユーザー・予算から参照されている場合は削除できません）
+// @Tags departments
+// @Param id path string true "部署ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /departments/{id} [delete]
+func (h *DepartmentHandler) DeleteDepartment(c *gin.Context) {
+	if err := h.departmentUseCase.DeleteDepartment(c.Request.Context(), c.Param("id")); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
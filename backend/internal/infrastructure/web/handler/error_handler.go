@@ -35,9 +35,9 @@ func handleDomainError(c *gin.Context, err *errors.DomainError) {
 	statusCode := http.StatusBadRequest
 
 	switch err.Code {
-	case errors.UserNotFound, errors.CategoryNotFound, errors.ExpenseNotFound:
+	case errors.UserNotFound, errors.CategoryNotFound, errors.ExpenseNotFound, errors.ExpenseReportNotFound, errors.TripRequestNotFound, errors.PerDiemRateNotFound, errors.AdvanceNotFound, errors.CardTransactionNotFound, errors.TransitRideNotFound, errors.JournalEntryNotFound, errors.AccountingPeriodNotFound, errors.BudgetNotFound, errors.DepartmentNotFound, errors.CostCenterNotFound, errors.ProjectNotFound, errors.RecurringExpenseNotFound:
 		statusCode = http.StatusNotFound
-	case errors.InvalidUserID, errors.InvalidCategoryID, errors.InvalidExpenseAmount:
+	case errors.InvalidUserID, errors.InvalidCategoryID, errors.InvalidExpenseAmount, errors.InvalidManager, errors.InvalidEscalation, errors.InvalidTripRequestID, errors.InvalidUserGrade, errors.InvalidPerDiemRate, errors.InvalidMileage, errors.InvalidAdvanceID, errors.InvalidCardTransactionID, errors.InvalidCardTransaction, errors.InvalidTransitRide, errors.InvalidAccountMapping, errors.InvalidJournalEntryID, errors.InvalidJournalEntry, errors.UnbalancedJournalEntry, errors.InvalidUserRole, errors.InvalidFiscalPeriod, errors.InvalidExpenseDate, errors.InvalidDatePolicy, errors.InvalidUserTimezone, errors.InvalidTimeOfDay, errors.InvalidExpenseDistance, errors.InvalidSpendingRule, errors.PolicyViolation, errors.JustificationRequired, errors.InvalidAttendee, errors.InvalidBudgetID, errors.InvalidBudget, errors.InvalidDepartmentID, errors.InvalidDepartment, errors.InvalidCostCenterID, errors.InvalidCostCenter, errors.InvalidCostAllocation, errors.InvalidProjectID, errors.InvalidProject, errors.InvalidBillable, errors.InvalidLineItem, errors.InvalidRecurringExpenseID, errors.InvalidRecurringExpense, errors.InvalidReceipt:
 		statusCode = http.StatusBadRequest
 	}
 
@@ -52,7 +52,7 @@ func handleApplicationError(c *gin.Context, err *errors.ApplicationError) {
 	statusCode := http.StatusBadRequest
 
 	switch err.Code {
-	case errors.ValidationFailed:
+	case errors.ValidationFailed, errors.ManagerCycleDetected, errors.InvalidManager, errors.TripRequestNotApproved, errors.UserGradeNotSet, errors.InvalidCardStatement, errors.InvalidTransitHistory, errors.InvalidImportFile, errors.JournalExportNotAllowed, errors.ProjectNotActive:
 		statusCode = http.StatusBadRequest
 	case errors.ExpenseCreationFailed, errors.ExpenseUpdateFailed, errors.ExpenseDeletionFailed:
 		statusCode = http.StatusInternalServerError
@@ -60,10 +60,32 @@ func handleApplicationError(c *gin.Context, err *errors.ApplicationError) {
 		statusCode = http.StatusInternalServerError
 	case errors.CategoryCreationFailed, errors.CategoryUpdateFailed, errors.CategoryDeleteFailed:
 		statusCode = http.StatusInternalServerError
-	case errors.EmailAlreadyExists, errors.CategoryNameExists:
+	case errors.EmailAlreadyExists, errors.CategoryNameExists, errors.DepartmentCodeExists, errors.CostCenterCodeExists, errors.ProjectCodeExists:
 		statusCode = http.StatusConflict
-	case errors.CategoryInUse:
+	case errors.CategoryInUse, errors.ExpenseAlreadyInReport, errors.ExpenseNotDeletable, errors.TripRequestInUse, errors.ExpenseAlreadySettled, errors.CardTransactionAlreadyMatched, errors.FiscalPeriodClosed, errors.DepartmentInUse, errors.CostCenterInUse, errors.ProjectInUse:
 		statusCode = http.StatusConflict
+	case errors.PermissionDenied:
+		statusCode = http.StatusForbidden
+	case errors.ExpenseReportNotFound, errors.TripRequestNotFound, errors.PerDiemRateNotFound, errors.AdvanceNotFound, errors.CardTransactionNotFound, errors.BudgetNotFound, errors.DepartmentNotFound, errors.CostCenterNotFound, errors.ProjectNotFound, errors.RecurringExpenseNotFound:
+		statusCode = http.StatusNotFound
+	case errors.ExpenseReportCreationFailed, errors.ExpenseReportUpdateFailed, errors.ExpenseReportDeletionFailed:
+		statusCode = http.StatusInternalServerError
+	case errors.TripRequestCreationFailed, errors.TripRequestUpdateFailed, errors.TripRequestDeletionFailed:
+		statusCode = http.StatusInternalServerError
+	case errors.PerDiemRateUpdateFailed, errors.PerDiemRateDeletionFailed:
+		statusCode = http.StatusInternalServerError
+	case errors.AdvanceCreationFailed, errors.AdvanceUpdateFailed, errors.AdvanceDeletionFailed:
+		statusCode = http.StatusInternalServerError
+	case errors.CardTransactionImportFailed, errors.CardTransactionUpdateFailed, errors.TransitImportFailed, errors.ExpenseImportFailed, errors.LedgerPostingFailed, errors.FiscalPeriodUpdateFailed:
+		statusCode = http.StatusInternalServerError
+	case errors.BudgetCreationFailed, errors.BudgetUpdateFailed, errors.BudgetDeletionFailed:
+		statusCode = http.StatusInternalServerError
+	case errors.DepartmentCreationFailed, errors.DepartmentUpdateFailed, errors.DepartmentDeletionFailed, errors.CostCenterCreationFailed, errors.CostCenterUpdateFailed, errors.CostCenterDeletionFailed:
+		statusCode = http.StatusInternalServerError
+	case errors.ProjectCreationFailed, errors.ProjectUpdateFailed, errors.ProjectDeletionFailed:
+		statusCode = http.StatusInternalServerError
+	case errors.RecurringExpenseCreationFailed, errors.RecurringExpenseUpdateFailed, errors.RecurringExpenseDeletionFailed, errors.RecurringExpenseGenerationFailed:
+		statusCode = http.StatusInternalServerError
 	default:
 		statusCode = http.StatusInternalServerError
 	}
@@ -1,8 +1,10 @@
 package handler
 
 import (
+	"context"
 	"expense-management-system/internal/application/dto"
 	"expense-management-system/internal/application/usecase"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -110,6 +112,38 @@ func (h *ExpenseHandler) UpdateExpense(c *gin.Context) {
 	c.JSON(http.StatusOK, expense)
 }
 
+// SplitExpense 経費の明細への分割
+// @Summary 経費の明細への分割
+// @Description 下書きの経費をカテゴリの異なる明細に分けます（明細の金額の合計は経費の金額と一致する必要があります）
+// @Tags expenses
+// @Accept json
+// @Produce json
+// @Param id path string true "経費ID"
+// @Param split body dto.SplitExpenseRequest true "明細への分割リクエスト"
+// @Success 200 {object} dto.ExpenseResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /expenses/{id}/split [post]
+func (h *ExpenseHandler) SplitExpense(c *gin.Context) {
+	var req dto.SplitExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	expense, err := h.expenseUseCase.SplitExpense(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, expense)
+}
+
 // DeleteExpense 経費削除
 // @Summary 経費削除
 // @Description 指定されたIDの経費を削除します
@@ -131,6 +165,89 @@ func (h *ExpenseHandler) DeleteExpense(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// ExportExpenses 経費エクスポート
+// @Summary 経費エクスポート
+// @Description 検索条件に一致する経費をCSVまたはXLSXで出力します（カテゴリ名・申請者名・消費税の内訳・ステータスを含む）
+// @Tags expenses
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param user_id query string false "ユーザーID"
+// @Param category_id query string false "カテゴリID"
+// @Param status query string false "ステータス"
+// @Param date_from query string false "この日以降（YYYY-MM-DD）"
+// @Param date_to query string false "この日まで（YYYY-MM-DD）"
+// @Param format query string false "csv（既定）またはxlsx"
+// @Param encoding query string false "CSVの文字コード: utf-8（既定）、utf-8-bom、shift_jis"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Router /expenses/export [get]
+func (h *ExpenseHandler) ExportExpenses(c *gin.Context) {
+	var req dto.ExportExpensesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	export, err := h.expenseUseCase.ExportExpenses(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", export.ContentType())
+	c.Header("Content-Disposition", `attachment; filename="`+export.FileName()+`"`)
+	c.Status(http.StatusOK)
+
+	if err := export.Write(c.Request.Context(), c.Writer); err != nil {
+		// 出力を始めた後はステータスを変更できないため、エラーを記録して中断する
+		_ = c.Error(err)
+		c.Abort()
+	}
+}
+
+// ExportJournal 会計ソフト向け仕訳エクスポート
+// @Summary 会計ソフト向け仕訳エクスポート
+// @Description 期間内の承認済みの経費を、カテゴリの仕訳の対応に従ってfreee・マネーフォワード クラウド会計・弥生会計の仕訳インポート形式のCSVで出力します
+// @Tags expenses
+// @Produce text/csv
+// @Param software query string true "freee、moneyforward、yayoi"
+// @Param date_from query string true "この日以降（YYYY-MM-DD）"
+// @Param date_to query string true "この日まで（YYYY-MM-DD）"
+// @Param credit_account query string false "貸方勘定科目（省略時は未払金）"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Router /expenses/journal-export [get]
+func (h *ExpenseHandler) ExportJournal(c *gin.Context) {
+	var req dto.ExportJournalRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	export, err := h.expenseUseCase.ExportJournal(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", export.ContentType())
+	c.Header("Content-Disposition", `attachment; filename="`+export.FileName()+`"`)
+	c.Status(http.StatusOK)
+
+	if err := export.Write(c.Writer); err != nil {
+		_ = c.Error(err)
+		c.Abort()
+	}
+}
+
 // GetExpensesByUser ユーザーの経費一覧取得
 // @Summary ユーザーの経費一覧取得
 // @Description 指定されたユーザーの経費一覧を取得します
@@ -163,6 +280,29 @@ func (h *ExpenseHandler) GetExpensesByUser(c *gin.Context) {
 	c.JSON(http.StatusOK, expenses)
 }
 
+// GetApprovalQueue 承認待ちの経費一覧取得
+// @Summary 承認待ちの経費一覧取得
+// @Description 指定されたユーザーが承認者となっている申請済みの経費を申請の古い順に取得します。経費ごとに重複の疑いがある経費を含めます
+// @Tags expenses
+// @Produce json
+// @Param id path string true "承認者のユーザーID"
+// @Param duplicates_only query bool false "trueの場合は重複の疑いがある経費のみ"
+// @Success 200 {array} dto.ExpenseResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{id}/approval-queue [get]
+func (h *ExpenseHandler) GetApprovalQueue(c *gin.Context) {
+	duplicatesOnly := c.Query("duplicates_only") == "true"
+
+	expenses, err := h.expenseUseCase.GetApprovalQueue(c.Request.Context(), c.Param("id"), duplicatesOnly)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, expenses)
+}
+
 // SubmitExpense 経費申請
 // @Summary 経費申請
 // @Description 経費を申請状態に変更します
@@ -205,6 +345,41 @@ func (h *ExpenseHandler) ApproveExpense(c *gin.Context) {
 	c.JSON(http.StatusOK, expense)
 }
 
+// PayExpense 経費支払い
+// @Summary 経費支払い
+// @Description 承認済みの経費を支払済みにし、未払費用を消し込む仕訳を作成します。支払日時を省略した場合は現在日時とします
+// @Tags expenses
+// @Accept json
+// @Produce json
+// @Param id path string true "経費ID"
+// @Param payment body dto.PayExpenseRequest false "経費支払いリクエスト"
+// @Success 200 {object} dto.ExpenseResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /expenses/{id}/pay [post]
+func (h *ExpenseHandler) PayExpense(c *gin.Context) {
+	expenseID := c.Param("id")
+
+	// リクエストボディは省略可能
+	var req dto.PayExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	expense, err := h.expenseUseCase.PayExpense(c.Request.Context(), expenseID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, expense)
+}
+
 // RejectExpense 経費却下
 // @Summary 経費却下
 // @Description 経費を却下状態に変更します
@@ -225,3 +400,69 @@ func (h *ExpenseHandler) RejectExpense(c *gin.Context) {
 
 	c.JSON(http.StatusOK, expense)
 }
+
+// BulkSubmitExpenses 経費一括申請
+// @Summary 経費一括申請
+// @Description 複数の経費を申請状態に変更し、経費ごとの結果を返します
+// @Tags expenses
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkExpenseActionRequest true "経費一括ステータス変更リクエスト"
+// @Success 200 {object} dto.BulkExpenseActionResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /expenses/bulk/submit [post]
+func (h *ExpenseHandler) BulkSubmitExpenses(c *gin.Context) {
+	h.bulkChangeStatus(c, h.expenseUseCase.BulkSubmitExpenses)
+}
+
+// BulkApproveExpenses 経費一括承認
+// @Summary 経費一括承認
+// @Description 複数の経費を承認状態に変更し、経費ごとの結果を返します
+// @Tags expenses
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkExpenseActionRequest true "経費一括ステータス変更リクエスト"
+// @Success 200 {object} dto.BulkExpenseActionResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /expenses/bulk/approve [post]
+func (h *ExpenseHandler) BulkApproveExpenses(c *gin.Context) {
+	h.bulkChangeStatus(c, h.expenseUseCase.BulkApproveExpenses)
+}
+
+// BulkRejectExpenses 経費一括却下
+// @Summary 経費一括却下
+// @Description 複数の経費を却下状態に変更し、経費ごとの結果を返します
+// @Tags expenses
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkExpenseActionRequest true "経費一括ステータス変更リクエスト"
+// @Success 200 {object} dto.BulkExpenseActionResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /expenses/bulk/reject [post]
+func (h *ExpenseHandler) BulkRejectExpenses(c *gin.Context) {
+	h.bulkChangeStatus(c, h.expenseUseCase.BulkRejectExpenses)
+}
+
+// bulkChangeStatus 一括ステータス変更の共通処理
+func (h *ExpenseHandler) bulkChangeStatus(
+	c *gin.Context,
+	action func(ctx context.Context, req *dto.BulkExpenseActionRequest) (*dto.BulkExpenseActionResponse, error),
+) {
+	var req dto.BulkExpenseActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := action(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExpenseImportHandler 経費一括取込ハンドラー
+type ExpenseImportHandler struct {
+	expenseImportUseCase *usecase.ExpenseImportUseCase
+}
+
+// NewExpenseImportHandler ExpenseImportHandlerのコンストラクタ
+func NewExpenseImportHandler(expenseImportUseCase *usecase.ExpenseImportUseCase) *ExpenseImportHandler {
+	return &ExpenseImportHandler{
+		expenseImportUseCase: expenseImportUseCase,
+	}
+}
+
+// ImportExpenses 経費一括取込
+// @Summary 経費一括取込
+// @Description CSV（UTF-8・Shift_JIS）の全ての行を経費として検証します。commitがtrueで全ての行が正しい場合のみ、全ての経費を下書きで作成します
+// @Tags expenses
+// @Accept json
+// @Produce json
+// @Param request body dto.ImportExpensesRequest true "経費一括取込リクエスト"
+// @Success 200 {object} dto.ImportExpensesResponse "検証のみ（ドライラン）"
+// @Success 201 {object} dto.ImportExpensesResponse "作成済み"
+// @Failure 400 {object} ErrorResponse
+// @Failure 422 {object} dto.ImportExpensesResponse "検証エラーのため作成されなかった"
+// @Router /expenses/import [post]
+func (h *ExpenseImportHandler) ImportExpenses(c *gin.Context) {
+	var req dto.ImportExpensesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.expenseImportUseCase.ImportExpenses(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	switch {
+	case result.Committed:
+		c.JSON(http.StatusCreated, result)
+	case req.Commit:
+		c.JSON(http.StatusUnprocessableEntity, result)
+	default:
+		c.JSON(http.StatusOK, result)
+	}
+}
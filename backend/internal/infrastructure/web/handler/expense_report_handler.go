@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExpenseReportHandler 経費レポートハンドラー
+type ExpenseReportHandler struct {
+	reportUseCase *usecase.ExpenseReportUseCase
+}
+
+// NewExpenseReportHandler ExpenseReportHandlerのコンストラクタ
+func NewExpenseReportHandler(reportUseCase *usecase.ExpenseReportUseCase) *ExpenseReportHandler {
+	return &ExpenseReportHandler{
+		reportUseCase: reportUseCase,
+	}
+}
+
+// CreateExpenseReport 経費レポート作成
+// @Summary 経費レポート作成
+// @Description 複数の経費をまとめた経費レポートを作成します
+// @Tags expense-reports
+// @Accept json
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Param report body dto.CreateExpenseReportRequest true "経費レポート作成リクエスト"
+// @Success 201 {object} dto.ExpenseReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /users/{id}/expense-reports [post]
+func (h *ExpenseReportHandler) CreateExpenseReport(c *gin.Context) {
+	userID := c.Param("id")
+
+	var req dto.CreateExpenseReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	report, err := h.reportUseCase.CreateExpenseReport(c.Request.Context(), userID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, report)
+}
+
+// GetExpenseReportsByUser ユーザーの経費レポート一覧取得
+// @Summary ユーザーの経費レポート一覧取得
+// @Description 指定されたユーザーの経費レポート一覧を取得します
+// @Tags expense-reports
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Success 200 {array} dto.ExpenseReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/{id}/expense-reports [get]
+func (h *ExpenseReportHandler) GetExpenseReportsByUser(c *gin.Context) {
+	userID := c.Param("id")
+
+	reports, err := h.reportUseCase.GetExpenseReportsByUser(c.Request.Context(), userID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, reports)
+}
+
+// GetExpenseReport 経費レポート取得
+// @Summary 経費レポート取得
+// @Description 指定されたIDの経費レポートを合計金額とともに取得します
+// @Tags expense-reports
+// @Produce json
+// @Param id path string true "経費レポートID"
+// @Success 200 {object} dto.ExpenseReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /expense-reports/{id} [get]
+func (h *ExpenseReportHandler) GetExpenseReport(c *gin.Context) {
+	reportID := c.Param("id")
+
+	report, err := h.reportUseCase.GetExpenseReport(c.Request.Context(), reportID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// UpdateExpenseReport 経費レポート更新
+// @Summary 経費レポート更新
+// @Description 下書き状態の経費レポートを更新します
+// @Tags expense-reports
+// @Accept json
+// @Produce json
+// @Param id path string true "経費レポートID"
+// @Param report body dto.UpdateExpenseReportRequest true "経費レポート更新リクエスト"
+// @Success 200 {object} dto.ExpenseReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /expense-reports/{id} [put]
+func (h *ExpenseReportHandler) UpdateExpenseReport(c *gin.Context) {
+	reportID := c.Param("id")
+
+	var req dto.UpdateExpenseReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	report, err := h.reportUseCase.UpdateExpenseReport(c.Request.Context(), reportID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// DeleteExpenseReport 経費レポート削除
+// @Summary 経費レポート削除
+// @Description 指定されたIDの経費レポートを削除します（含まれる経費は削除されません）
+// @Tags expense-reports
+// @Param id path string true "経費レポートID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /expense-reports/{id} [delete]
+func (h *ExpenseReportHandler) DeleteExpenseReport(c *gin.Context) {
+	reportID := c.Param("id")
+
+	err := h.reportUseCase.DeleteExpenseReport(c.Request.Context(), reportID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SubmitExpenseReport 経費レポート申請
+// @Summary 経費レポート申請
+// @Description 経費レポートと含まれる全ての経費を申請状態に変更します
+// @Tags expense-reports
+// @Param id path string true "経費レポートID"
+// @Success 200 {object} dto.ExpenseReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /expense-reports/{id}/submit [post]
+func (h *ExpenseReportHandler) SubmitExpenseReport(c *gin.Context) {
+	reportID := c.Param("id")
+
+	report, err := h.reportUseCase.SubmitExpenseReport(c.Request.Context(), reportID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// ApproveExpenseReport 経費レポート承認
+// @Summary 経費レポート承認
+// @Description 経費レポートと含まれる全ての経費を承認状態に変更します
+// @Tags expense-reports
+// @Param id path string true "経費レポートID"
+// @Success 200 {object} dto.ExpenseReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /expense-reports/{id}/approve [post]
+func (h *ExpenseReportHandler) ApproveExpenseReport(c *gin.Context) {
+	reportID := c.Param("id")
+
+	report, err := h.reportUseCase.ApproveExpenseReport(c.Request.Context(), reportID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// RejectExpenseReport 経費レポート却下
+// @Summary 経費レポート却下
+// @Description 経費レポートと含まれる全ての経費を却下状態に変更します
+// @Tags expense-reports
+// @Param id path string true "経費レポートID"
+// @Success 200 {object} dto.ExpenseReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /expense-reports/{id}/reject [post]
+func (h *ExpenseReportHandler) RejectExpenseReport(c *gin.Context) {
+	reportID := c.Param("id")
+
+	report, err := h.reportUseCase.RejectExpenseReport(c.Request.Context(), reportID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FiscalPeriodHandler 会計期間ハンドラー
+type FiscalPeriodHandler struct {
+	fiscalPeriodUseCase *usecase.FiscalPeriodUseCase
+}
+
+// NewFiscalPeriodHandler FiscalPeriodHandlerのコンストラクタ
+func NewFiscalPeriodHandler(fiscalPeriodUseCase *usecase.FiscalPeriodUseCase) *FiscalPeriodHandler {
+	return &FiscalPeriodHandler{
+		fiscalPeriodUseCase: fiscalPeriodUseCase,
+	}
+}
+
+// GetFiscalYear 会計期間一覧取得
+// @Summary 会計期間一覧取得
+// @Description 会計年度の会計期間（月次）と締めの状態を第1期から順に取得します
+// @Tags fiscal-periods
+// @Produce json
+// @Param fiscal_year query int false "会計年度（省略時は現在の会計年度）"
+// @Success 200 {object} dto.FiscalYearResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /fiscal-periods [get]
+func (h *FiscalPeriodHandler) GetFiscalYear(c *gin.Context) {
+	var req dto.FiscalYearRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	fiscalYear, err := h.fiscalPeriodUseCase.GetFiscalYear(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, fiscalYear)
+}
+
+// ClosePeriod 会計期間の締め
+// @Summary 会計期間の締め
+// @Description 会計期間を締め、期間内の経費の作成・更新・承認・削除を禁止します（経理担当者・管理者のみ）
+// @Tags fiscal-periods
+// @Accept json
+// @Produce json
+// @Param period path string true "会計期間（YYYY-MM）"
+// @Param request body dto.FiscalPeriodActionRequest true "会計期間の締めリクエスト"
+// @Success 200 {object} dto.FiscalPeriodResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /fiscal-periods/{period}/close [post]
+func (h *FiscalPeriodHandler) ClosePeriod(c *gin.Context) {
+	h.changeStatus(c, h.fiscalPeriodUseCase.ClosePeriod)
+}
+
+// ReopenPeriod 会計期間の再開
+// @Summary 会計期間の再開
+// @Description 締め済みの会計期間を再開します（管理者のみ）
+// @Tags fiscal-periods
+// @Accept json
+// @Produce json
+// @Param period path string true "会計期間（YYYY-MM）"
+// @Param request body dto.FiscalPeriodActionRequest true "会計期間の再開リクエスト"
+// @Success 200 {object} dto.FiscalPeriodResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /fiscal-periods/{period}/reopen [post]
+func (h *FiscalPeriodHandler) ReopenPeriod(c *gin.Context) {
+	h.changeStatus(c, h.fiscalPeriodUseCase.ReopenPeriod)
+}
+
+// changeStatus 会計期間の締め・再開の共通処理
+func (h *FiscalPeriodHandler) changeStatus(
+	c *gin.Context,
+	action func(ctx context.Context, periodKey string, req *dto.FiscalPeriodActionRequest) (*dto.FiscalPeriodResponse, error),
+) {
+	var req dto.FiscalPeriodActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	period, err := action(c.Request.Context(), c.Param("period"), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, period)
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LedgerHandler 仕訳帳・試算表ハンドラー
+type LedgerHandler struct {
+	ledgerUseCase *usecase.LedgerUseCase
+}
+
+// NewLedgerHandler LedgerHandlerのコンストラクタ
+func NewLedgerHandler(ledgerUseCase *usecase.LedgerUseCase) *LedgerHandler {
+	return &LedgerHandler{
+		ledgerUseCase: ledgerUseCase,
+	}
+}
+
+// GetJournalEntries 仕訳帳取得
+// @Summary 仕訳帳取得
+// @Description 経費の承認・支払いで作成された期間内の仕訳を計上日の順に取得します
+// @Tags ledger
+// @Produce json
+// @Param date_from query string true "この日以降（YYYY-MM-DD）"
+// @Param date_to query string true "この日まで（YYYY-MM-DD）"
+// @Success 200 {object} dto.JournalEntryListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /ledger/journal-entries [get]
+func (h *LedgerHandler) GetJournalEntries(c *gin.Context) {
+	var req dto.LedgerPeriodRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	entries, err := h.ledgerUseCase.GetJournalEntries(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// GetTrialBalance 試算表取得
+// @Summary 試算表取得
+// @Description 期間内の仕訳を勘定科目・通貨ごとに集計した試算表を取得します
+// @Tags ledger
+// @Produce json
+// @Param date_from query string true "この日以降（YYYY-MM-DD）"
+// @Param date_to query string true "この日まで（YYYY-MM-DD）"
+// @Success 200 {object} dto.TrialBalanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /ledger/trial-balance [get]
+func (h *LedgerHandler) GetTrialBalance(c *gin.Context) {
+	var req dto.LedgerPeriodRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	balance, err := h.ledgerUseCase.GetTrialBalance(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, balance)
+}
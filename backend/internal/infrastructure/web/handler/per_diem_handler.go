@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PerDiemHandler 日当ハンドラー
+type PerDiemHandler struct {
+	perDiemUseCase *usecase.PerDiemUseCase
+}
+
+// NewPerDiemHandler PerDiemHandlerのコンストラクタ
+func NewPerDiemHandler(perDiemUseCase *usecase.PerDiemUseCase) *PerDiemHandler {
+	return &PerDiemHandler{
+		perDiemUseCase: perDiemUseCase,
+	}
+}
+
+// GetPerDiemRates 日当単価表取得
+// @Summary 日当単価表取得
+// @Description 地域区分×職能等級の日当単価表を取得します
+// @Tags per-diem
+// @Produce json
+// @Success 200 {array} dto.PerDiemRateResponse
+// @Router /per-diem-rates [get]
+func (h *PerDiemHandler) GetPerDiemRates(c *gin.Context) {
+	rates, err := h.perDiemUseCase.GetPerDiemRates(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, rates)
+}
+
+// SavePerDiemRate 日当単価登録
+// @Summary 日当単価登録
+// @Description 地域区分と職能等級の日当単価を登録します（既存の単価は上書きされます）
+// @Tags per-diem
+// @Accept json
+// @Produce json
+// @Param rate body dto.PerDiemRateRequest true "日当単価登録リクエスト"
+// @Success 200 {object} dto.PerDiemRateResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /per-diem-rates [put]
+func (h *PerDiemHandler) SavePerDiemRate(c *gin.Context) {
+	var req dto.PerDiemRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	rate, err := h.perDiemUseCase.SavePerDiemRate(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, rate)
+}
+
+// DeletePerDiemRate 日当単価削除
+// @Summary 日当単価削除
+// @Description 地域区分と職能等級の日当単価を削除します
+// @Tags per-diem
+// @Param class path string true "地域区分"
+// @Param grade path string true "職能等級"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /per-diem-rates/{class}/{grade} [delete]
+func (h *PerDiemHandler) DeletePerDiemRate(c *gin.Context) {
+	err := h.perDiemUseCase.DeletePerDiemRate(c.Request.Context(), c.Param("class"), c.Param("grade"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GeneratePerDiemExpenses 日当経費生成
+// @Summary 日当経費生成
+// @Description 出張期間・地域区分・ユーザーの職能等級から日当の経費を下書きで作成します
+// @Tags per-diem
+// @Accept json
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Param request body dto.GeneratePerDiemRequest true "日当経費生成リクエスト"
+// @Success 201 {object} dto.GeneratePerDiemResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{id}/per-diem-expenses [post]
+func (h *PerDiemHandler) GeneratePerDiemExpenses(c *gin.Context) {
+	userID := c.Param("id")
+
+	var req dto.GeneratePerDiemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.perDiemUseCase.GeneratePerDiemExpenses(c.Request.Context(), userID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProjectHandler プロジェクトハンドラー
+type ProjectHandler struct {
+	projectUseCase *usecase.ProjectUseCase
+}
+
+// NewProjectHandler ProjectHandlerのコンストラクタ
+func NewProjectHandler(projectUseCase *usecase.ProjectUseCase) *ProjectHandler {
+	return &ProjectHandler{
+		projectUseCase: projectUseCase,
+	}
+}
+
+// CreateProject プロジェクト作成
+// @Summary プロジェクト作成
+// @Description 経費を顧客に請求する単位となるプロジェクトを作成します
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param project body dto.CreateProjectRequest true "プロジェクト作成リクエスト"
+// @Success 201 {object} dto.ProjectResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /projects [post]
+func (h *ProjectHandler) CreateProject(c *gin.Context) {
+	var req dto.CreateProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	project, err := h.projectUseCase.CreateProject(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, project)
+}
+
+// GetProject プロジェクト取得
+// @Summary プロジェクト取得
+// @Description 指定されたIDのプロジェクトを取得します
+// @Tags projects
+// @Produce json
+// @Param id path string true "プロジェクトID"
+// @Success 200 {object} dto.ProjectResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /projects/{id} [get]
+func (h *ProjectHandler) GetProject(c *gin.Context) {
+	project, err := h.projectUseCase.GetProject(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, project)
+}
+
+// GetAllProjects プロジェクト一覧取得
+// @Summary プロジェクト一覧取得
+// @Description プロジェクトをコード順に取得します
+// @Tags projects
+// @Produce json
+// @Success 200 {array} dto.ProjectResponse
+// @Router /projects [get]
+func (h *ProjectHandler) GetAllProjects(c *gin.Context) {
+	projects, err := h.projectUseCase.GetAllProjects(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, projects)
+}
+
+// UpdateProject プロジェクト更新
+// @Summary プロジェクト更新
+// @Description 指定されたIDのプロジェクトを更新します
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "プロジェクトID"
+// @Param project body dto.UpdateProjectRequest true "プロジェクト更新リクエスト"
+// @Success 200 {object} dto.ProjectResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /projects/{id} [put]
+func (h *ProjectHandler) UpdateProject(c *gin.Context) {
+	var req dto.UpdateProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	project, err := h.projectUseCase.UpdateProject(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, project)
+}
+
+// DeleteProject プロジェクト削除
+// @Summary プロジェクト削除
+// @Description 指定されたIDのプロジェクトを削除します（経費が関連付けられている場合は削除できません）
+// @Tags projects
+// @Param id path string true "プロジェクトID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /projects/{id} [delete]
+func (h *ProjectHandler) DeleteProject(c *gin.Context) {
+	if err := h.projectUseCase.DeleteProject(c.Request.Context(), c.Param("id")); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetBillableSummary 請求対象経費の集計
+// @Summary 請求対象経費の集計
+// @Description プロジェクトの承認済みで請求対象の経費に上乗せ率を適用し、通貨ごとの合計と予算の消化状況を返します
+// @Tags projects
+// @Produce json
+// @Param id path string true "プロジェクトID"
+// @Param date_from query string false "この日以降（YYYY-MM-DD）"
+// @Param date_to query string false "この日まで（YYYY-MM-DD）"
+// @Param markup_percentage query number false "上乗せ率（%、0〜100）"
+// @Success 200 {object} dto.BillableSummaryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /projects/{id}/billable-summary [get]
+func (h *ProjectHandler) GetBillableSummary(c *gin.Context) {
+	var req dto.BillableSummaryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	summary, err := h.projectUseCase.GetBillableSummary(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// ExportBillable 請求対象経費のエクスポート
+// @Summary 請求対象経費のエクスポート
+// @Description 請求書の作成用に、プロジェクトの請求対象の経費と通貨ごとの合計をCSVまたはExcel形式で出力します
+// @Tags projects
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param id path string true "プロジェクトID"
+// @Param date_from query string false "この日以降（YYYY-MM-DD）"
+// @Param date_to query string false "この日まで（YYYY-MM-DD）"
+// @Param markup_percentage query number false "上乗せ率（%、0〜100）"
+// @Param format query string false "csv または xlsx（省略時はcsv）"
+// @Param encoding query string false "CSVの文字コード: utf-8、utf-8-bom、shift_jis（省略時はutf-8）"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /projects/{id}/billable-export [get]
+func (h *ProjectHandler) ExportBillable(c *gin.Context) {
+	var req dto.ExportBillableRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	export, err := h.projectUseCase.ExportBillable(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", export.ContentType())
+	c.Header("Content-Disposition", `attachment; filename="`+export.FileName()+`"`)
+	c.Status(http.StatusOK)
+
+	if err := export.Write(c.Writer); err != nil {
+		_ = c.Error(err)
+		c.Abort()
+	}
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RecurringExpenseHandler 定期経費ハンドラー
+type RecurringExpenseHandler struct {
+	recurringExpenseUseCase *usecase.RecurringExpenseUseCase
+}
+
+// NewRecurringExpenseHandler RecurringExpenseHandlerのコンストラクタ
+func NewRecurringExpenseHandler(recurringExpenseUseCase *usecase.RecurringExpenseUseCase) *RecurringExpenseHandler {
+	return &RecurringExpenseHandler{
+		recurringExpenseUseCase: recurringExpenseUseCase,
+	}
+}
+
+// CreateRecurringExpense 定期経費作成
+// @Summary 定期経費作成
+// @Description 定期券代・携帯電話手当・サブスクリプションなど、毎月・毎週発生する経費のテンプレートを作成します。発生日が到来すると下書きの経費が自動で作成されます
+// @Tags recurring-expenses
+// @Accept json
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Param recurring_expense body dto.CreateRecurringExpenseRequest true "定期経費作成リクエスト"
+// @Success 201 {object} dto.RecurringExpenseResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{id}/recurring-expenses [post]
+func (h *RecurringExpenseHandler) CreateRecurringExpense(c *gin.Context) {
+	var req dto.CreateRecurringExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	recurring, err := h.recurringExpenseUseCase.CreateRecurringExpense(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, recurring)
+}
+
+// GetRecurringExpensesByUser ユーザーの定期経費一覧取得
+// @Summary ユーザーの定期経費一覧取得
+// @Description 指定されたユーザーの定期経費を作成順に取得します
+// @Tags recurring-expenses
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Success 200 {array} dto.RecurringExpenseResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{id}/recurring-expenses [get]
+func (h *RecurringExpenseHandler) GetRecurringExpensesByUser(c *gin.Context) {
+	recurrings, err := h.recurringExpenseUseCase.GetRecurringExpensesByUser(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, recurrings)
+}
+
+// GetRecurringExpense 定期経費取得
+// @Summary 定期経費取得
+// @Description 指定されたIDの定期経費を取得します
+// @Tags recurring-expenses
+// @Produce json
+// @Param id path string true "定期経費ID"
+// @Success 200 {object} dto.RecurringExpenseResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /recurring-expenses/{id} [get]
+func (h *RecurringExpenseHandler) GetRecurringExpense(c *gin.Context) {
+	recurring, err := h.recurringExpenseUseCase.GetRecurringExpense(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, recurring)
+}
+
+// UpdateRecurringExpense 定期経費更新
+// @Summary 定期経費更新
+// @Description 指定されたIDの定期経費を更新します（作成済みの経費は変更されません）
+// @Tags recurring-expenses
+// @Accept json
+// @Produce json
+// @Param id path string true "定期経費ID"
+// @Param recurring_expense body dto.UpdateRecurringExpenseRequest true "定期経費更新リクエスト"
+// @Success 200 {object} dto.RecurringExpenseResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /recurring-expenses/{id} [put]
+func (h *RecurringExpenseHandler) UpdateRecurringExpense(c *gin.Context) {
+	var req dto.UpdateRecurringExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	recurring, err := h.recurringExpenseUseCase.UpdateRecurringExpense(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, recurring)
+}
+
+// DeleteRecurringExpense 定期経費削除
+// @Summary 定期経費削除
+// @Description 指定されたIDの定期経費を削除します（作成済みの経費は削除されません）
+// @Tags recurring-expenses
+// @Param id path string true "定期経費ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /recurring-expenses/{id} [delete]
+func (h *RecurringExpenseHandler) DeleteRecurringExpense(c *gin.Context) {
+	if err := h.recurringExpenseUseCase.DeleteRecurringExpense(c.Request.Context(), c.Param("id")); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TransitHandler 交通系ICカードの利用履歴取込ハンドラー
+type TransitHandler struct {
+	transitUseCase *usecase.TransitUseCase
+}
+
+// NewTransitHandler TransitHandlerのコンストラクタ
+func NewTransitHandler(transitUseCase *usecase.TransitUseCase) *TransitHandler {
+	return &TransitHandler{
+		transitUseCase: transitUseCase,
+	}
+}
+
+// ImportICCardHistory ICカード利用履歴取込
+// @Summary ICカード利用履歴取込
+// @Description Suica・PASMOなどの利用履歴CSVから交通費の経費を下書きで作成し、行ごとの取込結果を返します
+// @Tags transit
+// @Accept json
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Param history body dto.ImportTransitHistoryRequest true "ICカード利用履歴取込リクエスト"
+// @Success 201 {object} dto.ImportTransitHistoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/{id}/transit-expenses/import [post]
+func (h *TransitHandler) ImportICCardHistory(c *gin.Context) {
+	userID := c.Param("id")
+
+	var req dto.ImportTransitHistoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.transitUseCase.ImportICCardHistory(c.Request.Context(), userID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"expense-management-system/internal/application/dto"
+	"expense-management-system/internal/application/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TripRequestHandler 出張申請ハンドラー
+type TripRequestHandler struct {
+	tripRequestUseCase *usecase.TripRequestUseCase
+}
+
+// NewTripRequestHandler TripRequestHandlerのコンストラクタ
+func NewTripRequestHandler(tripRequestUseCase *usecase.TripRequestUseCase) *TripRequestHandler {
+	return &TripRequestHandler{
+		tripRequestUseCase: tripRequestUseCase,
+	}
+}
+
+// CreateTripRequest 出張申請作成
+// @Summary 出張申請作成
+// @Description 出張の事前申請を作成します
+// @Tags trip-requests
+// @Accept json
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Param tripRequest body dto.CreateTripRequestRequest true "出張申請作成リクエスト"
+// @Success 201 {object} dto.TripRequestResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/{id}/trip-requests [post]
+func (h *TripRequestHandler) CreateTripRequest(c *gin.Context) {
+	userID := c.Param("id")
+
+	var req dto.CreateTripRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	tripRequest, err := h.tripRequestUseCase.CreateTripRequest(c.Request.Context(), userID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, tripRequest)
+}
+
+// GetTripRequestsByUser ユーザーの出張申請一覧取得
+// @Summary ユーザーの出張申請一覧取得
+// @Description 指定されたユーザーの出張申請一覧を取得します
+// @Tags trip-requests
+// @Produce json
+// @Param id path string true "ユーザーID"
+// @Success 200 {array} dto.TripRequestResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/{id}/trip-requests [get]
+func (h *TripRequestHandler) GetTripRequestsByUser(c *gin.Context) {
+	userID := c.Param("id")
+
+	tripRequests, err := h.tripRequestUseCase.GetTripRequestsByUser(c.Request.Context(), userID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tripRequests)
+}
+
+// GetTripRequest 出張申請取得
+// @Summary 出張申請取得
+// @Description 指定されたIDの出張申請を取得します
+// @Tags trip-requests
+// @Produce json
+// @Param id path string true "出張申請ID"
+// @Success 200 {object} dto.TripRequestResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /trip-requests/{id} [get]
+func (h *TripRequestHandler) GetTripRequest(c *gin.Context) {
+	tripRequestID := c.Param("id")
+
+	tripRequest, err := h.tripRequestUseCase.GetTripRequest(c.Request.Context(), tripRequestID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tripRequest)
+}
+
+// UpdateTripRequest 出張申請更新
+// @Summary 出張申請更新
+// @Description 下書き状態の出張申請を更新します
+// @Tags trip-requests
+// @Accept json
+// @Produce json
+// @Param id path string true "出張申請ID"
+// @Param tripRequest body dto.UpdateTripRequestRequest true "出張申請更新リクエスト"
+// @Success 200 {object} dto.TripRequestResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /trip-requests/{id} [put]
+func (h *TripRequestHandler) UpdateTripRequest(c *gin.Context) {
+	tripRequestID := c.Param("id")
+
+	var req dto.UpdateTripRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "INVALID_REQUEST",
+			Message: "リクエストの形式が正しくありません",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	tripRequest, err := h.tripRequestUseCase.UpdateTripRequest(c.Request.Context(), tripRequestID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tripRequest)
+}
+
+// DeleteTripRequest 出張申請削除
+// @Summary 出張申請削除
+// @Description 指定されたIDの出張申請を削除します（経費から参照されている場合は削除できません）
+// @Tags trip-requests
+// @Param id path string true "出張申請ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /trip-requests/{id} [delete]
+func (h *TripRequestHandler) DeleteTripRequest(c *gin.Context) {
+	tripRequestID := c.Param("id")
+
+	err := h.tripRequestUseCase.DeleteTripRequest(c.Request.Context(), tripRequestID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SubmitTripRequest 出張申請の申請
+// @Summary 出張申請の申請
+// @Description 出張申請を申請状態に変更します
+// @Tags trip-requests
+// @Param id path string true "出張申請ID"
+// @Success 200 {object} dto.TripRequestResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /trip-requests/{id}/submit [post]
+func (h *TripRequestHandler) SubmitTripRequest(c *gin.Context) {
+	tripRequestID := c.Param("id")
+
+	tripRequest, err := h.tripRequestUseCase.SubmitTripRequest(c.Request.Context(), tripRequestID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tripRequest)
+}
+
+// ApproveTripRequest 出張申請承認
+// @Summary 出張申請承認
+// @Description 出張申請を承認状態に変更します
+// @Tags trip-requests
+// @Param id path string true "出張申請ID"
+// @Success 200 {object} dto.TripRequestResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /trip-requests/{id}/approve [post]
+func (h *TripRequestHandler) ApproveTripRequest(c *gin.Context) {
+	tripRequestID := c.Param("id")
+
+	tripRequest, err := h.tripRequestUseCase.ApproveTripRequest(c.Request.Context(), tripRequestID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tripRequest)
+}
+
+// RejectTripRequest 出張申請却下
+// @Summary 出張申請却下
+// @Description 出張申請を却下状態に変更します
+// @Tags trip-requests
+// @Param id path string true "出張申請ID"
+// @Success 200 {object} dto.TripRequestResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /trip-requests/{id}/reject [post]
+func (h *TripRequestHandler) RejectTripRequest(c *gin.Context) {
+	tripRequestID := c.Param("id")
+
+	tripRequest, err := h.tripRequestUseCase.RejectTripRequest(c.Request.Context(), tripRequestID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tripRequest)
+}
+
+// GetTripCostReport 出張費用レポート取得
+// @Summary 出張費用レポート取得
+// @Description 出張申請の概算費用と関連経費の実費を比較します
+// @Tags trip-requests
+// @Produce json
+// @Param id path string true "出張申請ID"
+// @Success 200 {object} dto.TripCostReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /trip-requests/{id}/cost-report [get]
+func (h *TripRequestHandler) GetTripCostReport(c *gin.Context) {
+	tripRequestID := c.Param("id")
+
+	report, err := h.tripRequestUseCase.GetTripCostReport(c.Request.Context(), tripRequestID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
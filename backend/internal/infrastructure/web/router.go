@@ -28,6 +28,20 @@ func SetupRouter(
 	userHandler *handler.UserHandler,
 	categoryHandler *handler.CategoryHandler,
 	expenseHandler *handler.ExpenseHandler,
+	expenseReportHandler *handler.ExpenseReportHandler,
+	tripRequestHandler *handler.TripRequestHandler,
+	perDiemHandler *handler.PerDiemHandler,
+	advanceHandler *handler.AdvanceHandler,
+	cardTransactionHandler *handler.CardTransactionHandler,
+	transitHandler *handler.TransitHandler,
+	expenseImportHandler *handler.ExpenseImportHandler,
+	ledgerHandler *handler.LedgerHandler,
+	fiscalPeriodHandler *handler.FiscalPeriodHandler,
+	budgetHandler *handler.BudgetHandler,
+	departmentHandler *handler.DepartmentHandler,
+	costCenterHandler *handler.CostCenterHandler,
+	projectHandler *handler.ProjectHandler,
+	recurringExpenseHandler *handler.RecurringExpenseHandler,
 ) *gin.Engine {
 	// Ginのモードを設定
 	gin.SetMode(gin.ReleaseMode)
@@ -60,6 +74,36 @@ func SetupRouter(
 			// ユーザーの経費関連のルート（同じパラメータ名を使用）
 			users.GET("/:id/expenses", expenseHandler.GetExpensesByUser)
 			users.POST("/:id/expenses", expenseHandler.CreateExpense)
+			users.GET("/:id/approval-queue", expenseHandler.GetApprovalQueue)
+
+			// ユーザーの経費レポート関連のルート
+			users.GET("/:id/expense-reports", expenseReportHandler.GetExpenseReportsByUser)
+			users.POST("/:id/expense-reports", expenseReportHandler.CreateExpenseReport)
+
+			// ユーザーの出張申請関連のルート
+			users.GET("/:id/trip-requests", tripRequestHandler.GetTripRequestsByUser)
+			users.POST("/:id/trip-requests", tripRequestHandler.CreateTripRequest)
+
+			// ユーザーの日当関連のルート
+			users.POST("/:id/per-diem-expenses", perDiemHandler.GeneratePerDiemExpenses)
+
+			// ユーザーの仮払金関連のルート
+			users.GET("/:id/advances", advanceHandler.GetAdvancesByUser)
+			users.GET("/:id/advances/open", advanceHandler.GetOpenAdvancesByUser)
+			users.POST("/:id/advances", advanceHandler.CreateAdvance)
+
+			// ユーザーの法人カード利用明細関連のルート
+			users.GET("/:id/card-transactions", cardTransactionHandler.GetCardTransactionsByUser)
+			users.GET("/:id/card-transactions/unreconciled", cardTransactionHandler.GetUnreconciledTransactions)
+			users.POST("/:id/card-transactions/import", cardTransactionHandler.ImportStatement)
+			users.POST("/:id/card-transactions/reconcile", cardTransactionHandler.ReconcileTransactions)
+
+			// ユーザーの交通系ICカード利用履歴取込のルート
+			users.POST("/:id/transit-expenses/import", transitHandler.ImportICCardHistory)
+
+			// ユーザーの定期経費関連のルート
+			users.GET("/:id/recurring-expenses", recurringExpenseHandler.GetRecurringExpensesByUser)
+			users.POST("/:id/recurring-expenses", recurringExpenseHandler.CreateRecurringExpense)
 		}
 
 		// カテゴリ関連のルート
@@ -75,14 +119,152 @@ func SetupRouter(
 		// 経費関連のルート
 		expenses := v1.Group("/expenses")
 		{
+			expenses.GET("/export", expenseHandler.ExportExpenses)
+			expenses.GET("/journal-export", expenseHandler.ExportJournal)
 			expenses.GET("/:id", expenseHandler.GetExpense)
 			expenses.PUT("/:id", expenseHandler.UpdateExpense)
 			expenses.DELETE("/:id", expenseHandler.DeleteExpense)
+			expenses.POST("/:id/split", expenseHandler.SplitExpense)
 
 			// 経費ステータス変更のルート
 			expenses.POST("/:id/submit", expenseHandler.SubmitExpense)
 			expenses.POST("/:id/approve", expenseHandler.ApproveExpense)
 			expenses.POST("/:id/reject", expenseHandler.RejectExpense)
+
+			// 経費の支払いのルート
+			expenses.POST("/:id/pay", expenseHandler.PayExpense)
+
+			// 経費の一括ステータス変更のルート
+			expenses.POST("/bulk/submit", expenseHandler.BulkSubmitExpenses)
+			expenses.POST("/bulk/approve", expenseHandler.BulkApproveExpenses)
+			expenses.POST("/bulk/reject", expenseHandler.BulkRejectExpenses)
+
+			// 経費の一括取込のルート
+			expenses.POST("/import", expenseImportHandler.ImportExpenses)
+		}
+
+		// 経費レポート関連のルート
+		expenseReports := v1.Group("/expense-reports")
+		{
+			expenseReports.GET("/:id", expenseReportHandler.GetExpenseReport)
+			expenseReports.PUT("/:id", expenseReportHandler.UpdateExpenseReport)
+			expenseReports.DELETE("/:id", expenseReportHandler.DeleteExpenseReport)
+
+			// 経費レポートのステータス変更のルート
+			expenseReports.POST("/:id/submit", expenseReportHandler.SubmitExpenseReport)
+			expenseReports.POST("/:id/approve", expenseReportHandler.ApproveExpenseReport)
+			expenseReports.POST("/:id/reject", expenseReportHandler.RejectExpenseReport)
+		}
+
+		// 出張申請関連のルート
+		tripRequests := v1.Group("/trip-requests")
+		{
+			tripRequests.GET("/:id", tripRequestHandler.GetTripRequest)
+			tripRequests.PUT("/:id", tripRequestHandler.UpdateTripRequest)
+			tripRequests.DELETE("/:id", tripRequestHandler.DeleteTripRequest)
+			tripRequests.GET("/:id/cost-report", tripRequestHandler.GetTripCostReport)
+
+			// 出張申請のステータス変更のルート
+			tripRequests.POST("/:id/submit", tripRequestHandler.SubmitTripRequest)
+			tripRequests.POST("/:id/approve", tripRequestHandler.ApproveTripRequest)
+			tripRequests.POST("/:id/reject", tripRequestHandler.RejectTripRequest)
+		}
+
+		// 日当単価表関連のルート
+		perDiemRates := v1.Group("/per-diem-rates")
+		{
+			perDiemRates.GET("", perDiemHandler.GetPerDiemRates)
+			perDiemRates.PUT("", perDiemHandler.SavePerDiemRate)
+			perDiemRates.DELETE("/:class/:grade", perDiemHandler.DeletePerDiemRate)
+		}
+
+		// 仮払金関連のルート
+		advances := v1.Group("/advances")
+		{
+			advances.GET("/:id", advanceHandler.GetAdvance)
+			advances.PUT("/:id", advanceHandler.UpdateAdvance)
+			advances.DELETE("/:id", advanceHandler.DeleteAdvance)
+
+			// 仮払金のステータス変更・精算のルート
+			advances.POST("/:id/submit", advanceHandler.SubmitAdvance)
+			advances.POST("/:id/approve", advanceHandler.ApproveAdvance)
+			advances.POST("/:id/reject", advanceHandler.RejectAdvance)
+			advances.POST("/:id/settle", advanceHandler.SettleAdvance)
+		}
+
+		// 法人カード利用明細関連のルート
+		cardTransactions := v1.Group("/card-transactions")
+		{
+			cardTransactions.GET("/:id", cardTransactionHandler.GetCardTransaction)
+			cardTransactions.POST("/:id/match", cardTransactionHandler.MatchCardTransaction)
+			cardTransactions.POST("/:id/unmatch", cardTransactionHandler.UnmatchCardTransaction)
+		}
+
+		// 仕訳帳・試算表関連のルート
+		ledger := v1.Group("/ledger")
+		{
+			ledger.GET("/journal-entries", ledgerHandler.GetJournalEntries)
+			ledger.GET("/trial-balance", ledgerHandler.GetTrialBalance)
+		}
+
+		// 会計期間関連のルート
+		fiscalPeriods := v1.Group("/fiscal-periods")
+		{
+			fiscalPeriods.GET("", fiscalPeriodHandler.GetFiscalYear)
+			fiscalPeriods.POST("/:period/close", fiscalPeriodHandler.ClosePeriod)
+			fiscalPeriods.POST("/:period/reopen", fiscalPeriodHandler.ReopenPeriod)
+		}
+
+		// 予算関連のルート
+		budgets := v1.Group("/budgets")
+		{
+			budgets.POST("", budgetHandler.CreateBudget)
+			budgets.GET("", budgetHandler.GetAllBudgets)
+			budgets.GET("/status", budgetHandler.GetBudgetStatuses)
+			budgets.GET("/:id", budgetHandler.GetBudget)
+			budgets.PUT("/:id", budgetHandler.UpdateBudget)
+			budgets.DELETE("/:id", budgetHandler.DeleteBudget)
+			budgets.GET("/:id/status", budgetHandler.GetBudgetStatus)
+		}
+
+		// 部署関連のルート
+		departments := v1.Group("/departments")
+		{
+			departments.POST("", departmentHandler.CreateDepartment)
+			departments.GET("", departmentHandler.GetAllDepartments)
+			departments.GET("/:id", departmentHandler.GetDepartment)
+			departments.PUT("/:id", departmentHandler.UpdateDepartment)
+			departments.DELETE("/:id", departmentHandler.DeleteDepartment)
+		}
+
+		// コストセンター関連のルート
+		costCenters := v1.Group("/cost-centers")
+		{
+			costCenters.POST("", costCenterHandler.CreateCostCenter)
+			costCenters.GET("", costCenterHandler.GetCostCenters)
+			costCenters.GET("/:id", costCenterHandler.GetCostCenter)
+			costCenters.PUT("/:id", costCenterHandler.UpdateCostCenter)
+			costCenters.DELETE("/:id", costCenterHandler.DeleteCostCenter)
+		}
+
+		// プロジェクト関連のルート
+		projects := v1.Group("/projects")
+		{
+			projects.POST("", projectHandler.CreateProject)
+			projects.GET("", projectHandler.GetAllProjects)
+			projects.GET("/:id", projectHandler.GetProject)
+			projects.PUT("/:id", projectHandler.UpdateProject)
+			projects.DELETE("/:id", projectHandler.DeleteProject)
+			projects.GET("/:id/billable-summary", projectHandler.GetBillableSummary)
+			projects.GET("/:id/billable-export", projectHandler.ExportBillable)
+		}
+
+		// 定期経費関連のルート
+		recurringExpenses := v1.Group("/recurring-expenses")
+		{
+			recurringExpenses.GET("/:id", recurringExpenseHandler.GetRecurringExpense)
+			recurringExpenses.PUT("/:id", recurringExpenseHandler.UpdateRecurringExpense)
+			recurringExpenses.DELETE("/:id", recurringExpenseHandler.DeleteRecurringExpense)
 		}
 	}
 
@@ -42,27 +42,136 @@ func NewApplicationError(code, message string) error {
 // 定義済みエラーコード
 const (
 	// Domain errors
-	InvalidExpenseAmount = "INVALID_EXPENSE_AMOUNT"
-	InvalidUserID        = "INVALID_USER_ID"
-	InvalidUserName      = "INVALID_USER_NAME"
-	InvalidUserEmail     = "INVALID_USER_EMAIL"
-	InvalidCategoryID    = "INVALID_CATEGORY_ID"
-	ExpenseNotFound      = "EXPENSE_NOT_FOUND"
-	UserNotFound         = "USER_NOT_FOUND"
-	CategoryNotFound     = "CATEGORY_NOT_FOUND"
+	InvalidExpenseAmount      = "INVALID_EXPENSE_AMOUNT"
+	InvalidUserID             = "INVALID_USER_ID"
+	InvalidUserName           = "INVALID_USER_NAME"
+	InvalidUserEmail          = "INVALID_USER_EMAIL"
+	InvalidCategoryID         = "INVALID_CATEGORY_ID"
+	ExpenseNotFound           = "EXPENSE_NOT_FOUND"
+	UserNotFound              = "USER_NOT_FOUND"
+	CategoryNotFound          = "CATEGORY_NOT_FOUND"
+	InvalidManager            = "INVALID_MANAGER"
+	InvalidEscalation         = "INVALID_ESCALATION"
+	InvalidExpenseReportID    = "INVALID_EXPENSE_REPORT_ID"
+	ExpenseReportNotFound     = "EXPENSE_REPORT_NOT_FOUND"
+	InvalidTripRequestID      = "INVALID_TRIP_REQUEST_ID"
+	TripRequestNotFound       = "TRIP_REQUEST_NOT_FOUND"
+	InvalidUserGrade          = "INVALID_USER_GRADE"
+	InvalidPerDiemRate        = "INVALID_PER_DIEM_RATE"
+	PerDiemRateNotFound       = "PER_DIEM_RATE_NOT_FOUND"
+	InvalidMileage            = "INVALID_MILEAGE"
+	MileageAmountLocked       = "MILEAGE_AMOUNT_NOT_EDITABLE"
+	InvalidAdvanceID          = "INVALID_ADVANCE_ID"
+	AdvanceNotFound           = "ADVANCE_NOT_FOUND"
+	InvalidCardTransactionID  = "INVALID_CARD_TRANSACTION_ID"
+	CardTransactionNotFound   = "CARD_TRANSACTION_NOT_FOUND"
+	InvalidCardTransaction    = "INVALID_CARD_TRANSACTION"
+	InvalidTransitRide        = "INVALID_TRANSIT_RIDE"
+	TransitRideNotFound       = "TRANSIT_RIDE_NOT_FOUND"
+	InvalidAccountMapping     = "INVALID_ACCOUNT_MAPPING"
+	InvalidJournalEntryID     = "INVALID_JOURNAL_ENTRY_ID"
+	JournalEntryNotFound      = "JOURNAL_ENTRY_NOT_FOUND"
+	InvalidJournalEntry       = "INVALID_JOURNAL_ENTRY"
+	UnbalancedJournalEntry    = "UNBALANCED_JOURNAL_ENTRY"
+	InvalidUserRole           = "INVALID_USER_ROLE"
+	InvalidFiscalPeriod       = "INVALID_FISCAL_PERIOD"
+	AccountingPeriodNotFound  = "ACCOUNTING_PERIOD_NOT_FOUND"
+	InvalidExpenseDate        = "INVALID_EXPENSE_DATE"
+	InvalidDatePolicy         = "INVALID_DATE_POLICY"
+	InvalidUserTimezone       = "INVALID_USER_TIMEZONE"
+	InvalidTimeOfDay          = "INVALID_TIME_OF_DAY"
+	InvalidExpenseDistance    = "INVALID_EXPENSE_DISTANCE"
+	InvalidSpendingRule       = "INVALID_SPENDING_RULE"
+	PolicyViolation           = "POLICY_VIOLATION"
+	JustificationRequired     = "POLICY_JUSTIFICATION_REQUIRED"
+	InvalidAttendee           = "INVALID_ATTENDEE"
+	InvalidBudgetID           = "INVALID_BUDGET_ID"
+	InvalidBudget             = "INVALID_BUDGET"
+	BudgetNotFound            = "BUDGET_NOT_FOUND"
+	InvalidDepartmentID       = "INVALID_DEPARTMENT_ID"
+	InvalidDepartment         = "INVALID_DEPARTMENT"
+	DepartmentNotFound        = "DEPARTMENT_NOT_FOUND"
+	InvalidCostCenterID       = "INVALID_COST_CENTER_ID"
+	InvalidCostCenter         = "INVALID_COST_CENTER"
+	CostCenterNotFound        = "COST_CENTER_NOT_FOUND"
+	InvalidCostAllocation     = "INVALID_COST_ALLOCATION"
+	InvalidProjectID          = "INVALID_PROJECT_ID"
+	InvalidProject            = "INVALID_PROJECT"
+	ProjectNotFound           = "PROJECT_NOT_FOUND"
+	InvalidBillable           = "INVALID_BILLABLE"
+	InvalidLineItem           = "INVALID_LINE_ITEM"
+	InvalidRecurringExpenseID = "INVALID_RECURRING_EXPENSE_ID"
+	InvalidRecurringExpense   = "INVALID_RECURRING_EXPENSE"
+	RecurringExpenseNotFound  = "RECURRING_EXPENSE_NOT_FOUND"
+	InvalidReceipt            = "INVALID_RECEIPT"
 
 	// Application errors
-	ValidationFailed       = "VALIDATION_FAILED"
-	EmailAlreadyExists     = "EMAIL_ALREADY_EXISTS"
-	CategoryNameExists     = "CATEGORY_NAME_ALREADY_EXISTS"
-	CategoryInUse          = "CATEGORY_IN_USE"
-	ExpenseCreationFailed  = "EXPENSE_CREATION_FAILED"
-	ExpenseUpdateFailed    = "EXPENSE_UPDATE_FAILED"
-	ExpenseDeletionFailed  = "EXPENSE_DELETION_FAILED"
-	UserCreationFailed     = "USER_CREATION_FAILED"
-	UserUpdateFailed       = "USER_UPDATE_FAILED"
-	UserDeleteFailed       = "USER_DELETE_FAILED"
-	CategoryCreationFailed = "CATEGORY_CREATION_FAILED"
-	CategoryUpdateFailed   = "CATEGORY_UPDATE_FAILED"
-	CategoryDeleteFailed   = "CATEGORY_DELETE_FAILED"
+	ValidationFailed                 = "VALIDATION_FAILED"
+	EmailAlreadyExists               = "EMAIL_ALREADY_EXISTS"
+	CategoryNameExists               = "CATEGORY_NAME_ALREADY_EXISTS"
+	CategoryInUse                    = "CATEGORY_IN_USE"
+	ExpenseCreationFailed            = "EXPENSE_CREATION_FAILED"
+	ExpenseUpdateFailed              = "EXPENSE_UPDATE_FAILED"
+	ExpenseDeletionFailed            = "EXPENSE_DELETION_FAILED"
+	ExpenseNotDeletable              = "EXPENSE_NOT_DELETABLE"
+	UserCreationFailed               = "USER_CREATION_FAILED"
+	UserUpdateFailed                 = "USER_UPDATE_FAILED"
+	UserDeleteFailed                 = "USER_DELETE_FAILED"
+	CategoryCreationFailed           = "CATEGORY_CREATION_FAILED"
+	CategoryUpdateFailed             = "CATEGORY_UPDATE_FAILED"
+	CategoryDeleteFailed             = "CATEGORY_DELETE_FAILED"
+	ManagerCycleDetected             = "MANAGER_CYCLE_DETECTED"
+	EscalationFailed                 = "ESCALATION_FAILED"
+	ExpenseAlreadyInReport           = "EXPENSE_ALREADY_IN_REPORT"
+	ExpenseReportCreationFailed      = "EXPENSE_REPORT_CREATION_FAILED"
+	ExpenseReportUpdateFailed        = "EXPENSE_REPORT_UPDATE_FAILED"
+	ExpenseReportDeletionFailed      = "EXPENSE_REPORT_DELETION_FAILED"
+	TripRequestNotApproved           = "TRIP_REQUEST_NOT_APPROVED"
+	TripRequestInUse                 = "TRIP_REQUEST_IN_USE"
+	TripRequestCreationFailed        = "TRIP_REQUEST_CREATION_FAILED"
+	TripRequestUpdateFailed          = "TRIP_REQUEST_UPDATE_FAILED"
+	TripRequestDeletionFailed        = "TRIP_REQUEST_DELETION_FAILED"
+	UserGradeNotSet                  = "USER_GRADE_NOT_SET"
+	PerDiemRateUpdateFailed          = "PER_DIEM_RATE_UPDATE_FAILED"
+	PerDiemRateDeletionFailed        = "PER_DIEM_RATE_DELETION_FAILED"
+	ExpenseAlreadySettled            = "EXPENSE_ALREADY_SETTLED"
+	AdvanceCreationFailed            = "ADVANCE_CREATION_FAILED"
+	AdvanceUpdateFailed              = "ADVANCE_UPDATE_FAILED"
+	AdvanceDeletionFailed            = "ADVANCE_DELETION_FAILED"
+	InvalidCardStatement             = "INVALID_CARD_STATEMENT"
+	CardTransactionAlreadyMatched    = "CARD_TRANSACTION_ALREADY_MATCHED"
+	CardTransactionImportFailed      = "CARD_TRANSACTION_IMPORT_FAILED"
+	CardTransactionUpdateFailed      = "CARD_TRANSACTION_UPDATE_FAILED"
+	InvalidTransitHistory            = "INVALID_TRANSIT_HISTORY"
+	TransitImportFailed              = "TRANSIT_IMPORT_FAILED"
+	InvalidImportFile                = "INVALID_IMPORT_FILE"
+	ExpenseImportFailed              = "EXPENSE_IMPORT_FAILED"
+	JournalExportNotAllowed          = "JOURNAL_EXPORT_NOT_ALLOWED"
+	LedgerPostingFailed              = "LEDGER_POSTING_FAILED"
+	FiscalPeriodClosed               = "FISCAL_PERIOD_CLOSED"
+	PermissionDenied                 = "PERMISSION_DENIED"
+	FiscalPeriodUpdateFailed         = "FISCAL_PERIOD_UPDATE_FAILED"
+	BudgetCreationFailed             = "BUDGET_CREATION_FAILED"
+	BudgetUpdateFailed               = "BUDGET_UPDATE_FAILED"
+	BudgetDeletionFailed             = "BUDGET_DELETION_FAILED"
+	DepartmentCodeExists             = "DEPARTMENT_CODE_ALREADY_EXISTS"
+	DepartmentInUse                  = "DEPARTMENT_IN_USE"
+	DepartmentCreationFailed         = "DEPARTMENT_CREATION_FAILED"
+	DepartmentUpdateFailed           = "DEPARTMENT_UPDATE_FAILED"
+	DepartmentDeletionFailed         = "DEPARTMENT_DELETION_FAILED"
+	CostCenterCodeExists             = "COST_CENTER_CODE_ALREADY_EXISTS"
+	CostCenterInUse                  = "COST_CENTER_IN_USE"
+	CostCenterCreationFailed         = "COST_CENTER_CREATION_FAILED"
+	CostCenterUpdateFailed           = "COST_CENTER_UPDATE_FAILED"
+	CostCenterDeletionFailed         = "COST_CENTER_DELETION_FAILED"
+	ProjectCodeExists                = "PROJECT_CODE_ALREADY_EXISTS"
+	ProjectInUse                     = "PROJECT_IN_USE"
+	ProjectNotActive                 = "PROJECT_NOT_ACTIVE"
+	ProjectCreationFailed            = "PROJECT_CREATION_FAILED"
+	ProjectUpdateFailed              = "PROJECT_UPDATE_FAILED"
+	ProjectDeletionFailed            = "PROJECT_DELETION_FAILED"
+	RecurringExpenseCreationFailed   = "RECURRING_EXPENSE_CREATION_FAILED"
+	RecurringExpenseUpdateFailed     = "RECURRING_EXPENSE_UPDATE_FAILED"
+	RecurringExpenseDeletionFailed   = "RECURRING_EXPENSE_DELETION_FAILED"
+	RecurringExpenseGenerationFailed = "RECURRING_EXPENSE_GENERATION_FAILED"
 )
@@ -0,0 +1,172 @@
+// Package xlsx 1枚のシートからなるXLSXファイルを行ごとに書き出す最小限の実装
+//
+// 行は書き込んだ順にそのままZIPへ出力されるため、全ての行をメモリに保持しない。
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// excelEpoch Excelのシリアル値の基準日（1900年うるう年の不具合を考慮した1899-12-30）
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// StreamWriter XLSXファイルを行ごとに書き出すライター
+type StreamWriter struct {
+	zip    *zip.Writer
+	sheet  io.Writer
+	row    int
+	closed bool
+}
+
+// NewStreamWriter StreamWriterのコンストラクタ
+// シート以外のパーツを先に書き出し、以降はWriteRowでシートの行を書き出す
+func NewStreamWriter(w io.Writer, sheetName string) (*StreamWriter, error) {
+	zw := zip.NewWriter(w)
+
+	var name bytes.Buffer
+	if err := xml.EscapeText(&name, []byte(sheetName)); err != nil {
+		return nil, err
+	}
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", rootRelsXML},
+		{"xl/workbook.xml", fmt.Sprintf(workbookXML, name.String())},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML},
+		{"xl/styles.xml", stylesXML},
+	}
+	for _, part := range parts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(sheet, sheetHeaderXML); err != nil {
+		return nil, err
+	}
+
+	return &StreamWriter{zip: zw, sheet: sheet}, nil
+}
+
+// WriteRow 1行を書き出す
+// 値はstring・float64・int・time.Time（日付として表示）・nil（空のセル）に対応する
+func (w *StreamWriter) WriteRow(values []interface{}) error {
+	if w.closed {
+		return fmt.Errorf("xlsx: 閉じたライターには書き込めません")
+	}
+
+	w.row++
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<row r="%d">`, w.row)
+
+	for i, value := range values {
+		ref := columnName(i) + strconv.Itoa(w.row)
+
+		switch v := value.(type) {
+		case nil:
+			continue
+		case string:
+			fmt.Fprintf(&buf, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+			if err := xml.EscapeText(&buf, []byte(v)); err != nil {
+				return err
+			}
+			buf.WriteString(`</t></is></c>`)
+		case float64:
+			fmt.Fprintf(&buf, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(v, 'f', -1, 64))
+		case int:
+			fmt.Fprintf(&buf, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case time.Time:
+			// 日付のスタイル（styles.xmlの1番目）でシリアル値を表示する
+			date := time.Date(v.Year(), v.Month(), v.Day(), 0, 0, 0, 0, time.UTC)
+			serial := date.Sub(excelEpoch).Hours() / 24
+			fmt.Fprintf(&buf, `<c r="%s" s="1"><v>%s</v></c>`, ref, strconv.FormatFloat(serial, 'f', -1, 64))
+		default:
+			return fmt.Errorf("xlsx: 対応していない値の型です: %T", value)
+		}
+	}
+
+	buf.WriteString(`</row>`)
+	_, err := w.sheet.Write(buf.Bytes())
+	return err
+}
+
+// Close シートを閉じてXLSXファイルを完成させる（出力先のライターは閉じない）
+func (w *StreamWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	if _, err := io.WriteString(w.sheet, sheetFooterXML); err != nil {
+		return err
+	}
+	return w.zip.Close()
+}
+
+// columnName 0始まりの列番号を列名（A, B, ..., Z, AA, ...）に変換
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+const contentTypesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>
+</Types>`
+
+const rootRelsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+
+const workbookXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`
+
+const workbookRelsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+</Relationships>`
+
+// stylesXML 0番目は標準、1番目は日付（yyyy/mm/dd）のセルのスタイル
+const stylesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy/mm/dd"/></numFmts>
+<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>
+<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>
+<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>
+<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>
+<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs>
+</styleSheet>`
+
+const sheetHeaderXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const sheetFooterXML = `</sheetData></worksheet>`
@@ -0,0 +1,177 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sheetXML シートのXMLを読み取るための構造
+type sheetXML struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R      string `xml:"r,attr"`
+			T      string `xml:"t,attr"`
+			S      string `xml:"s,attr"`
+			V      string `xml:"v"`
+			Inline string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// readParts XLSXファイルのパーツ名と内容を書き出した順に取得
+func readParts(t *testing.T, data []byte) ([]string, map[string]string) {
+	t.Helper()
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(reader.File))
+	contents := make(map[string]string)
+	for _, f := range reader.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+
+		names = append(names, f.Name)
+		contents[f.Name] = string(content)
+	}
+	return names, contents
+}
+
+func TestStreamWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewStreamWriter(&buf, `経費<2024&"Q1">`)
+	require.NoError(t, err)
+
+	jst := time.FixedZone("JST", 9*60*60)
+	require.NoError(t, w.WriteRow([]interface{}{"日付", "件名", "金額"}))
+	require.NoError(t, w.WriteRow([]interface{}{time.Date(2024, 4, 1, 23, 30, 0, 0, jst), `A&B <"社外">`, 1500.5}))
+	require.NoError(t, w.WriteRow([]interface{}{nil, "  前後の空白  ", 3}))
+	require.NoError(t, w.Close())
+
+	names, contents := readParts(t, buf.Bytes())
+
+	t.Run("必要なパーツを全て含む", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"[Content_Types].xml",
+			"_rels/.rels",
+			"xl/workbook.xml",
+			"xl/_rels/workbook.xml.rels",
+			"xl/styles.xml",
+			"xl/worksheets/sheet1.xml",
+		}, names)
+
+		for _, name := range names {
+			var v interface{}
+			assert.NoError(t, xml.Unmarshal([]byte(contents[name]), &v), name)
+		}
+	})
+
+	t.Run("シート名をエスケープする", func(t *testing.T) {
+		var workbook struct {
+			Sheets []struct {
+				Name string `xml:"name,attr"`
+			} `xml:"sheets>sheet"`
+		}
+		require.NoError(t, xml.Unmarshal([]byte(contents["xl/workbook.xml"]), &workbook))
+		require.Len(t, workbook.Sheets, 1)
+		assert.Equal(t, `経費<2024&"Q1">`, workbook.Sheets[0].Name)
+	})
+
+	var sheet sheetXML
+	require.NoError(t, xml.Unmarshal([]byte(contents["xl/worksheets/sheet1.xml"]), &sheet))
+	require.Len(t, sheet.Rows, 3)
+
+	t.Run("文字列のセルをエスケープする", func(t *testing.T) {
+		assert.NotContains(t, contents["xl/worksheets/sheet1.xml"], `A&B <`)
+
+		cell := sheet.Rows[1].Cells[1]
+		assert.Equal(t, "B2", cell.R)
+		assert.Equal(t, "inlineStr", cell.T)
+		assert.Equal(t, `A&B <"社外">`, cell.Inline)
+
+		assert.Equal(t, "  前後の空白  ", sheet.Rows[2].Cells[0].Inline)
+	})
+
+	t.Run("日付はタイムゾーンの日付をシリアル値にする", func(t *testing.T) {
+		cell := sheet.Rows[1].Cells[0]
+		assert.Equal(t, "A2", cell.R)
+		assert.Equal(t, "1", cell.S)
+		assert.Equal(t, "45383", cell.V)
+	})
+
+	t.Run("数値と空のセル", func(t *testing.T) {
+		assert.Equal(t, "1500.5", sheet.Rows[1].Cells[2].V)
+
+		// nilのセルは出力せず、以降のセルの列はずらさない
+		require.Len(t, sheet.Rows[2].Cells, 2)
+		assert.Equal(t, "B3", sheet.Rows[2].Cells[0].R)
+		assert.Equal(t, "C3", sheet.Rows[2].Cells[1].R)
+		assert.Equal(t, "3", sheet.Rows[2].Cells[1].V)
+		assert.Equal(t, 3, sheet.Rows[2].R)
+	})
+}
+
+func TestStreamWriter_Errors(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewStreamWriter(&buf, "経費")
+	require.NoError(t, err)
+
+	assert.Error(t, w.WriteRow([]interface{}{true}), "対応していない値の型")
+
+	require.NoError(t, w.Close())
+	require.NoError(t, w.Close(), "二重に閉じても何もしない")
+	assert.Error(t, w.WriteRow([]interface{}{"閉じた後"}))
+}
+
+func TestExcelDateSerial(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		expected string
+	}{
+		{time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC), "61"},
+		{time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "36526"},
+		{time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC), "45351"},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		w, err := NewStreamWriter(&buf, "日付")
+		require.NoError(t, err)
+		require.NoError(t, w.WriteRow([]interface{}{tt.date}))
+		require.NoError(t, w.Close())
+
+		_, contents := readParts(t, buf.Bytes())
+		var sheet sheetXML
+		require.NoError(t, xml.Unmarshal([]byte(contents["xl/worksheets/sheet1.xml"]), &sheet))
+		assert.Equal(t, tt.expected, sheet.Rows[0].Cells[0].V, tt.date.Format("2006-01-02"))
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	tests := map[int]string{
+		0:   "A",
+		1:   "B",
+		25:  "Z",
+		26:  "AA",
+		27:  "AB",
+		51:  "AZ",
+		52:  "BA",
+		701: "ZZ",
+		702: "AAA",
+	}
+
+	for index, expected := range tests {
+		assert.Equal(t, expected, columnName(index), index)
+	}
+}
@@ -2,7 +2,9 @@ package test
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
+	"expense-management-system/internal/domain/clock"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -10,6 +12,9 @@ import (
 
 	"expense-management-system/internal/application/dto"
 	"expense-management-system/internal/application/usecase"
+	"expense-management-system/internal/domain/event"
+	"expense-management-system/internal/domain/valueobject"
+	"expense-management-system/internal/infrastructure/messaging"
 	"expense-management-system/internal/infrastructure/persistence"
 	"expense-management-system/internal/infrastructure/web"
 	"expense-management-system/internal/infrastructure/web/handler"
@@ -24,19 +29,73 @@ func setupTestServer() *httptest.Server {
 	userRepo := persistence.NewMemoryUserRepository()
 	categoryRepo := persistence.NewMemoryCategoryRepository()
 	expenseRepo := persistence.NewMemoryExpenseRepository()
+	expenseReportRepo := persistence.NewMemoryExpenseReportRepository()
+	tripRequestRepo := persistence.NewMemoryTripRequestRepository()
+	perDiemRateRepo := persistence.NewMemoryPerDiemRateRepository()
+	advanceRepo := persistence.NewMemoryAdvanceRepository()
+	cardTransactionRepo := persistence.NewMemoryCardTransactionRepository()
+	transitRideRepo := persistence.NewMemoryTransitRideRepository()
+	journalEntryRepo := persistence.NewMemoryJournalEntryRepository()
+	accountingPeriodRepo := persistence.NewMemoryAccountingPeriodRepository()
+	budgetRepo := persistence.NewMemoryBudgetRepository()
+	departmentRepo := persistence.NewMemoryDepartmentRepository()
+	costCenterRepo := persistence.NewMemoryCostCenterRepository()
+	projectRepo := persistence.NewMemoryProjectRepository()
+	recurringExpenseRepo := persistence.NewMemoryRecurringExpenseRepository()
+
+	// イベント配信の初期化
+	publisher := messaging.NewInMemoryPublisher()
+
+	// 会計年度の暦の初期化（4月開始）
+	fiscalCalendar, _ := valueobject.NewFiscalCalendar(4)
+
+	// 現在日時の取得元（システムの時計）
+	systemClock := clock.System()
 
 	// ユースケースの初期化
-	userUseCase := usecase.NewUserUseCase(userRepo)
-	categoryUseCase := usecase.NewCategoryUseCase(categoryRepo, expenseRepo)
-	expenseUseCase := usecase.NewExpenseUseCase(expenseRepo, userRepo, categoryRepo)
+	userUseCase := usecase.NewUserUseCase(userRepo, departmentRepo, costCenterRepo, systemClock)
+	categoryUseCase := usecase.NewCategoryUseCase(categoryRepo, expenseRepo, systemClock)
+	expenseUseCase := usecase.NewExpenseUseCase(expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithExpenseReportRepository(expenseReportRepo), usecase.WithTripRequestRepository(tripRequestRepo), usecase.WithEventPublisher(publisher), usecase.WithFiscalPeriods(fiscalCalendar, accountingPeriodRepo), usecase.WithBudgetRepository(budgetRepo), usecase.WithCostCenterRepository(costCenterRepo), usecase.WithProjectRepository(projectRepo))
+	expenseReportUseCase := usecase.NewExpenseReportUseCase(expenseReportRepo, expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithReportEventPublisher(publisher), usecase.WithReportFiscalPeriods(fiscalCalendar, accountingPeriodRepo), usecase.WithReportCostCenterRepository(costCenterRepo), usecase.WithReportBudgetRepository(budgetRepo))
+	tripRequestUseCase := usecase.NewTripRequestUseCase(tripRequestRepo, expenseRepo, userRepo, systemClock)
+	perDiemUseCase := usecase.NewPerDiemUseCase(perDiemRateRepo, expenseRepo, userRepo, categoryRepo, tripRequestRepo, systemClock, usecase.WithPerDiemFiscalPeriods(fiscalCalendar, accountingPeriodRepo))
+	advanceUseCase := usecase.NewAdvanceUseCase(advanceRepo, expenseRepo, expenseReportRepo, userRepo, systemClock)
+	cardTransactionUseCase := usecase.NewCardTransactionUseCase(cardTransactionRepo, expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithCardFiscalPeriods(fiscalCalendar, accountingPeriodRepo))
+	transitUseCase := usecase.NewTransitUseCase(transitRideRepo, expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithTransitFiscalPeriods(fiscalCalendar, accountingPeriodRepo))
+	expenseImportUseCase := usecase.NewExpenseImportUseCase(expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithImportFiscalPeriods(fiscalCalendar, accountingPeriodRepo))
+	ledgerUseCase := usecase.NewLedgerUseCase(journalEntryRepo, expenseRepo, categoryRepo, systemClock)
+	fiscalPeriodUseCase := usecase.NewFiscalPeriodUseCase(fiscalCalendar, accountingPeriodRepo, userRepo, systemClock)
+	budgetUseCase := usecase.NewBudgetUseCase(budgetRepo, expenseRepo, userRepo, categoryRepo, departmentRepo, systemClock)
+	departmentUseCase := usecase.NewDepartmentUseCase(departmentRepo, costCenterRepo, userRepo, budgetRepo, systemClock)
+	costCenterUseCase := usecase.NewCostCenterUseCase(costCenterRepo, departmentRepo, userRepo, expenseRepo, systemClock)
+	projectUseCase := usecase.NewProjectUseCase(projectRepo, expenseRepo, userRepo, categoryRepo, systemClock)
+	recurringExpenseUseCase := usecase.NewRecurringExpenseUseCase(recurringExpenseRepo, expenseRepo, userRepo, categoryRepo, systemClock, usecase.WithRecurringFiscalPeriods(fiscalCalendar, accountingPeriodRepo))
+
+	// 経費の承認・支払いから仕訳を作成
+	publisher.Subscribe(event.ExpenseApprovedEvent, ledgerUseCase.HandleEvent)
+	publisher.Subscribe(event.ExpensePaidEvent, ledgerUseCase.HandleEvent)
 
 	// ハンドラーの初期化
 	userHandler := handler.NewUserHandler(userUseCase)
 	categoryHandler := handler.NewCategoryHandler(categoryUseCase)
 	expenseHandler := handler.NewExpenseHandler(expenseUseCase)
+	expenseReportHandler := handler.NewExpenseReportHandler(expenseReportUseCase)
+	tripRequestHandler := handler.NewTripRequestHandler(tripRequestUseCase)
+	perDiemHandler := handler.NewPerDiemHandler(perDiemUseCase)
+	advanceHandler := handler.NewAdvanceHandler(advanceUseCase)
+	cardTransactionHandler := handler.NewCardTransactionHandler(cardTransactionUseCase)
+	transitHandler := handler.NewTransitHandler(transitUseCase)
+	expenseImportHandler := handler.NewExpenseImportHandler(expenseImportUseCase)
+	ledgerHandler := handler.NewLedgerHandler(ledgerUseCase)
+	fiscalPeriodHandler := handler.NewFiscalPeriodHandler(fiscalPeriodUseCase)
+	budgetHandler := handler.NewBudgetHandler(budgetUseCase)
+	departmentHandler := handler.NewDepartmentHandler(departmentUseCase)
+	costCenterHandler := handler.NewCostCenterHandler(costCenterUseCase)
+	projectHandler := handler.NewProjectHandler(projectUseCase)
+	recurringExpenseHandler := handler.NewRecurringExpenseHandler(recurringExpenseUseCase)
 
 	// ルーターの設定
-	router := web.SetupRouter(userHandler, categoryHandler, expenseHandler)
+	router := web.SetupRouter(userHandler, categoryHandler, expenseHandler, expenseReportHandler, tripRequestHandler, perDiemHandler, advanceHandler, cardTransactionHandler, transitHandler, expenseImportHandler, ledgerHandler, fiscalPeriodHandler, budgetHandler, departmentHandler, costCenterHandler, projectHandler, recurringExpenseHandler)
 
 	return httptest.NewServer(router)
 }
@@ -212,7 +271,7 @@ func TestExpenseWorkflow(t *testing.T) {
 			Currency:    "JPY",
 			Title:       "渋谷駅からオフィス",
 			Description: "営業訪問のための交通費",
-			Date:        time.Now().AddDate(0, 0, -1),
+			Date:        time.Now().AddDate(0, 0, -1).Format("2006-01-02"),
 		}
 
 		body, _ := json.Marshal(expenseReq)
@@ -231,6 +290,7 @@ func TestExpenseWorkflow(t *testing.T) {
 		assert.Equal(t, "渋谷駅からオフィス", expense.Title)
 		assert.Equal(t, 1500.0, expense.Amount)
 		assert.Equal(t, "draft", expense.Status)
+		assert.Equal(t, expenseReq.Date, expense.Date)
 		assert.NotNil(t, expense.Category)
 		assert.Equal(t, "交通費", expense.Category.Name)
 	})
@@ -297,7 +357,341 @@ func TestExpenseWorkflow(t *testing.T) {
 	})
 }
 
+// TestExpenseBulkActions 経費の一括ステータス変更の統合テスト
+func TestExpenseBulkActions(t *testing.T) {
+	server := setupTestServer()
+	defer server.Close()
+
+	client := &http.Client{}
+
+	// 前提データの作成（ユーザーとカテゴリ）
+	body, _ := json.Marshal(dto.CreateUserRequest{Name: "テストユーザー", Email: "bulk@example.com"})
+	resp, err := client.Post(server.URL+"/api/v1/users", "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var user dto.UserResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&user))
+
+	body, _ = json.Marshal(dto.CreateCategoryRequest{Name: "交通費", Color: "#FF0000"})
+	resp, err = client.Post(server.URL+"/api/v1/categories", "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var category dto.CategoryResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&category))
+
+	// 経費を2件作成
+	expenseIDs := make([]string, 0, 2)
+	for _, title := range []string{"電車代", "バス代"} {
+		body, _ := json.Marshal(dto.CreateExpenseRequest{
+			CategoryID: category.ID,
+			Amount:     300,
+			Title:      title,
+			Date:       time.Now().AddDate(0, 0, -1).Format("2006-01-02"),
+		})
+		resp, err := client.Post(server.URL+"/api/v1/users/"+user.ID+"/expenses", "application/json", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var expense dto.ExpenseResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&expense))
+		expenseIDs = append(expenseIDs, expense.ID)
+	}
+
+	t.Run("一括申請は経費ごとの結果を返す", func(t *testing.T) {
+		body, _ := json.Marshal(dto.BulkExpenseActionRequest{
+			ExpenseIDs: append(append([]string{}, expenseIDs...), "invalid-expense-id"),
+		})
+		resp, err := client.Post(server.URL+"/api/v1/expenses/bulk/submit", "application/json", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result dto.BulkExpenseActionResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+
+		assert.Equal(t, 2, result.SucceededCount)
+		assert.Equal(t, 1, result.FailedCount)
+		require.Len(t, result.Results, 3)
+		assert.Equal(t, "submitted", result.Results[0].Expense.Status)
+		assert.False(t, result.Results[2].Success)
+		assert.NotEmpty(t, result.Results[2].Error.Code)
+	})
+
+	t.Run("一括承認で状態遷移できない経費はドメインエラーを返す", func(t *testing.T) {
+		// 1件目のみ却下してから両方の承認を試行
+		body, _ := json.Marshal(dto.BulkExpenseActionRequest{ExpenseIDs: expenseIDs[:1], Comment: "領収書不備"})
+		resp, err := client.Post(server.URL+"/api/v1/expenses/bulk/reject", "application/json", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, _ = json.Marshal(dto.BulkExpenseActionRequest{ExpenseIDs: expenseIDs, Comment: "月末処理"})
+		resp, err = client.Post(server.URL+"/api/v1/expenses/bulk/approve", "application/json", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var result dto.BulkExpenseActionResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+
+		assert.Equal(t, 1, result.SucceededCount)
+		assert.Equal(t, 1, result.FailedCount)
+		assert.Equal(t, "EXPENSE_APPROVE_NOT_ALLOWED", result.Results[0].Error.Code)
+		assert.Equal(t, "approved", result.Results[1].Expense.Status)
+		assert.Equal(t, "月末処理", result.Results[1].Expense.Comment)
+	})
+
+	t.Run("空のIDリストはエラー", func(t *testing.T) {
+		body, _ := json.Marshal(dto.BulkExpenseActionRequest{ExpenseIDs: []string{}})
+		resp, err := client.Post(server.URL+"/api/v1/expenses/bulk/approve", "application/json", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+// TestExpenseExport 経費エクスポートのテスト
+func TestExpenseExport(t *testing.T) {
+	server := setupTestServer()
+	defer server.Close()
+
+	client := &http.Client{}
+
+	// 前提データの作成（ユーザー・カテゴリ・経費）
+	body, _ := json.Marshal(dto.CreateUserRequest{Name: "エクスポート太郎", Email: "export@example.com"})
+	resp, err := client.Post(server.URL+"/api/v1/users", "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var user dto.UserResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&user))
+
+	body, _ = json.Marshal(dto.CreateCategoryRequest{Name: "交通費", Color: "#FF0000"})
+	resp, err = client.Post(server.URL+"/api/v1/categories", "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var category dto.CategoryResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&category))
+
+	date := time.Now().AddDate(0, 0, -1)
+	body, _ = json.Marshal(dto.CreateExpenseRequest{CategoryID: category.ID, Amount: 1100, Title: "電車代", Date: date.Format("2006-01-02")})
+	resp, err = client.Post(server.URL+"/api/v1/users/"+user.ID+"/expenses", "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	t.Run("検索条件に一致する経費をCSVで出力", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/v1/expenses/export?user_id=" + user.ID + "&date_from=" + date.Format("2006-01-02"))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/csv; charset=UTF-8", resp.Header.Get("Content-Type"))
+		assert.Contains(t, resp.Header.Get("Content-Disposition"), "attachment")
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, "エクスポート太郎", records[1][3])
+		assert.Equal(t, "交通費", records[1][4])
+		assert.Equal(t, "1000", records[1][9])
+		assert.Equal(t, "100", records[1][10])
+	})
+
+	t.Run("条件に一致しない場合は見出しのみ", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/v1/expenses/export?status=approved")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		require.NoError(t, err)
+		assert.Len(t, records, 1)
+	})
+
+	t.Run("不正な検索条件はエラー", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/v1/expenses/export?format=pdf")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, err = client.Get(server.URL + "/api/v1/expenses/export?status=unknown")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
 // TestHealthCheck ヘルスチェックエンドポイントのテスト
+func TestLedger(t *testing.T) {
+	server := setupTestServer()
+	defer server.Close()
+
+	client := &http.Client{}
+
+	// 前提データの作成（ユーザー・カテゴリ・経費）
+	body, _ := json.Marshal(dto.CreateUserRequest{Name: "経理花子", Email: "ledger@example.com"})
+	resp, err := client.Post(server.URL+"/api/v1/users", "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var user dto.UserResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&user))
+
+	body, _ = json.Marshal(dto.CreateCategoryRequest{
+		Name:       "交通費",
+		Accounting: &dto.AccountMappingRequest{DebitAccount: "旅費交通費"},
+	})
+	resp, err = client.Post(server.URL+"/api/v1/categories", "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var category dto.CategoryResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&category))
+
+	date := time.Now().AddDate(0, 0, -1)
+	body, _ = json.Marshal(dto.CreateExpenseRequest{CategoryID: category.ID, Amount: 1100, Title: "電車代", Date: date.Format("2006-01-02")})
+	resp, err = client.Post(server.URL+"/api/v1/users/"+user.ID+"/expenses", "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var expense dto.ExpenseResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&expense))
+
+	period := "?date_from=" + date.AddDate(0, 0, -1).Format("2006-01-02") + "&date_to=" + time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+
+	t.Run("承認前の支払いはエラー", func(t *testing.T) {
+		resp, err := client.Post(server.URL+"/api/v1/expenses/"+expense.ID+"/pay", "application/json", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("承認と支払いで仕訳を作成", func(t *testing.T) {
+		for _, action := range []string{"submit", "approve", "pay"} {
+			resp, err := client.Post(server.URL+"/api/v1/expenses/"+expense.ID+"/"+action, "application/json", nil)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+
+		resp, err := client.Get(server.URL + "/api/v1/ledger/journal-entries" + period)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var entries dto.JournalEntryListResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&entries))
+		require.Equal(t, 2, entries.Count)
+		assert.Equal(t, "expense_approval", entries.Entries[0].Source)
+		assert.Equal(t, "expense_payment", entries.Entries[1].Source)
+	})
+
+	t.Run("試算表の借方と貸方の合計が一致", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/v1/ledger/trial-balance" + period)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var balance dto.TrialBalanceResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&balance))
+		assert.True(t, balance.Balanced)
+		require.Len(t, balance.Totals, 1)
+		assert.Equal(t, 2200.0, balance.Totals[0].Debit)
+	})
+
+	t.Run("期間の指定がない場合はエラー", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/v1/ledger/trial-balance")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestFiscalPeriods(t *testing.T) {
+	server := setupTestServer()
+	defer server.Close()
+
+	client := &http.Client{}
+
+	// 前提データの作成（経理担当者・一般の従業員・カテゴリ）
+	createUser := func(name, email, role string) dto.UserResponse {
+		body, _ := json.Marshal(dto.CreateUserRequest{Name: name, Email: email, Role: role})
+		resp, err := client.Post(server.URL+"/api/v1/users", "application/json", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var user dto.UserResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&user))
+		return user
+	}
+	finance := createUser("経理花子", "fiscal-finance@example.com", "finance")
+	member := createUser("山田太郎", "fiscal-member@example.com", "")
+	assert.Equal(t, "finance", finance.Role)
+	assert.Equal(t, "member", member.Role)
+
+	body, _ := json.Marshal(dto.CreateCategoryRequest{Name: "交通費"})
+	resp, err := client.Post(server.URL+"/api/v1/categories", "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var category dto.CategoryResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&category))
+
+	now := time.Now()
+	lastMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local).AddDate(0, -1, 0)
+	closeURL := server.URL + "/api/v1/fiscal-periods/" + lastMonth.Format("2006-01") + "/close"
+
+	t.Run("一般の従業員は会計期間を締められない", func(t *testing.T) {
+		body, _ := json.Marshal(dto.FiscalPeriodActionRequest{UserID: member.ID})
+		resp, err := client.Post(closeURL, "application/json", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("締め済みの会計期間には経費を作成できない", func(t *testing.T) {
+		body, _ := json.Marshal(dto.FiscalPeriodActionRequest{UserID: finance.ID})
+		resp, err := client.Post(closeURL, "application/json", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var period dto.FiscalPeriodResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&period))
+		assert.Equal(t, "closed", period.Status)
+
+		body, _ = json.Marshal(dto.CreateExpenseRequest{CategoryID: category.ID, Amount: 500, Title: "バス代", Date: lastMonth.Format("2006-01-02")})
+		resp, err = client.Post(server.URL+"/api/v1/users/"+member.ID+"/expenses", "application/json", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		var errorResp handler.ErrorResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&errorResp))
+		assert.Equal(t, "FISCAL_PERIOD_CLOSED", errorResp.Error)
+	})
+
+	t.Run("会計年度の会計期間一覧", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/v1/fiscal-periods")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var fiscalYear dto.FiscalYearResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&fiscalYear))
+		assert.Equal(t, 4, fiscalYear.StartMonth)
+		assert.Len(t, fiscalYear.Periods, 12)
+	})
+}
+
 func TestHealthCheck(t *testing.T) {
 	server := setupTestServer()
 	defer server.Close()